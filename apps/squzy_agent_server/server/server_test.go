@@ -42,6 +42,22 @@ func (s storageMock) GetSchedulerUptime(ctx context.Context, in *apiPb.GetSchedu
 	panic("implement me")
 }
 
+func (s storageMock) GetSchedulerUptimeSeries(ctx context.Context, in *apiPb.GetSchedulerUptimeSeriesRequest, opts ...grpc.CallOption) (*apiPb.GetSchedulerUptimeSeriesResponse, error) {
+	panic("implement me")
+}
+
+func (s storageMock) GetSchedulerOutages(ctx context.Context, in *apiPb.GetSchedulerOutagesRequest, opts ...grpc.CallOption) (*apiPb.GetSchedulerOutagesResponse, error) {
+	panic("implement me")
+}
+
+func (s storageMock) GetAgentSeries(ctx context.Context, in *apiPb.GetAgentSeriesRequest, opts ...grpc.CallOption) (*apiPb.GetAgentSeriesResponse, error) {
+	panic("implement me")
+}
+
+func (s storageMock) Subscribe(ctx context.Context, in *apiPb.SubscribeRequest, opts ...grpc.CallOption) (apiPb.Storage_SubscribeClient, error) {
+	panic("implement me")
+}
+
 func (s storageMock) SaveTransaction(ctx context.Context, in *apiPb.TransactionInfo, opts ...grpc.CallOption) (*empty.Empty, error) {
 	panic("implement me")
 }
@@ -58,10 +74,18 @@ func (s storageMock) GetTransactionById(ctx context.Context, in *apiPb.GetTransa
 	panic("implement me")
 }
 
+func (s storageMock) GetTransactionTree(ctx context.Context, in *apiPb.GetTransactionByIdRequest, opts ...grpc.CallOption) (*apiPb.GetTransactionTreeResponse, error) {
+	panic("implement me")
+}
+
 func (s storageMock) SaveResponseFromScheduler(ctx context.Context, in *apiPb.SchedulerResponse, opts ...grpc.CallOption) (*empty.Empty, error) {
 	return nil, nil
 }
 
+func (s storageMock) SaveResponsesFromScheduler(ctx context.Context, in *apiPb.SchedulerResponseBatch, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, nil
+}
+
 func (s storageMock) SaveResponseFromAgent(ctx context.Context, in *apiPb.Metric, opts ...grpc.CallOption) (*empty.Empty, error) {
 	return nil, nil
 }
@@ -3,6 +3,8 @@ package config
 import (
 	"os"
 	"strconv"
+	"strings"
+	"time"
 )
 
 type Config interface {
@@ -13,6 +15,10 @@ type Config interface {
 	GetApplicationMonitoringAddress() string
 	GetIncidentServerAddress() string
 	GetNotificationServerAddress() string
+	// Origins of dashboards, which are allowed to open stream from other host, "*" allows any origin
+	GetAllowedOrigins() []string
+	// Longer window of Prometheus metrics is shortened to it
+	GetMaxMetricsWindow() time.Duration
 }
 
 type cfg struct {
@@ -23,6 +29,16 @@ type cfg struct {
 	applicationMonitoringServer string
 	incidentServer              string
 	notificationServer          string
+	allowedOrigins              []string
+	maxMetricsWindow            time.Duration
+}
+
+func (c *cfg) GetMaxMetricsWindow() time.Duration {
+	return c.maxMetricsWindow
+}
+
+func (c *cfg) GetAllowedOrigins() []string {
+	return c.allowedOrigins
 }
 
 func (c *cfg) GetNotificationServerAddress() string {
@@ -61,8 +77,11 @@ const (
 	ENV_APPLICATION_MONITORING_SERVER = "APPLICATION_MONITORING_SERVER_HOST"
 	ENV_INCIDENT_SERVER               = "INCIDENT_SERVER_HOST"
 	ENV_NOTIFICATION_SERVER           = "NOTIFICATION_SERVER_HOST"
+	ENV_ALLOWED_ORIGINS               = "ALLOWED_ORIGINS"
+	ENV_METRICS_MAX_WINDOW_HOURS      = "METRICS_MAX_WINDOW_HOURS"
 
-	defaultPort int32 = 8080
+	defaultPort             int32 = 8080
+	defaultMaxMetricsWindow       = time.Hour * 24 * 7
 )
 
 func New() Config {
@@ -74,6 +93,21 @@ func New() Config {
 			port = int32(i)
 		}
 	}
+	maxMetricsWindowValue := os.Getenv(ENV_METRICS_MAX_WINDOW_HOURS)
+	maxMetricsWindow := defaultMaxMetricsWindow
+	if maxMetricsWindowValue != "" {
+		i, err := strconv.ParseInt(maxMetricsWindowValue, 10, 32)
+		if err == nil && i > 0 {
+			maxMetricsWindow = time.Hour * time.Duration(i)
+		}
+	}
+	// Comma separated list
+	var allowedOrigins []string
+	for _, origin := range strings.Split(os.Getenv(ENV_ALLOWED_ORIGINS), ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			allowedOrigins = append(allowedOrigins, origin)
+		}
+	}
 	return &cfg{
 		port:                        port,
 		agentServer:                 os.Getenv(ENV_AGENT_SERVER),
@@ -82,5 +116,7 @@ func New() Config {
 		applicationMonitoringServer: os.Getenv(ENV_APPLICATION_MONITORING_SERVER),
 		incidentServer:              os.Getenv(ENV_INCIDENT_SERVER),
 		notificationServer:          os.Getenv(ENV_NOTIFICATION_SERVER),
+		allowedOrigins:              allowedOrigins,
+		maxMetricsWindow:            maxMetricsWindow,
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"os"
 	"testing"
+	"time"
 )
 
 func TestNew(t *testing.T) {
@@ -68,3 +69,24 @@ func TestCfg_GetNotificationServerAddress(t *testing.T) {
 		assert.Equal(t, s.GetNotificationServerAddress(), "11124")
 	})
 }
+
+func TestCfg_GetAllowedOrigins(t *testing.T) {
+	t.Run("Should: return from env", func(t *testing.T) {
+		os.Setenv(ENV_ALLOWED_ORIGINS, "https://a.com, https://b.com,")
+		s := New()
+		assert.Equal(t, []string{"https://a.com", "https://b.com"}, s.GetAllowedOrigins())
+	})
+}
+
+func TestCfg_GetMaxMetricsWindow(t *testing.T) {
+	t.Run("Should: return from env", func(t *testing.T) {
+		os.Setenv(ENV_METRICS_MAX_WINDOW_HOURS, "48")
+		s := New()
+		assert.Equal(t, time.Hour*48, s.GetMaxMetricsWindow())
+	})
+	t.Run("Should: return default if env is wrong", func(t *testing.T) {
+		os.Setenv(ENV_METRICS_MAX_WINDOW_HOURS, "-1")
+		s := New()
+		assert.Equal(t, defaultMaxMetricsWindow, s.GetMaxMetricsWindow())
+	})
+}
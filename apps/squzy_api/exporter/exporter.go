@@ -0,0 +1,367 @@
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"github.com/squzy/squzy/internal/semaphore"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	timestamp "google.golang.org/protobuf/types/known/timestamppb"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultWindow = time.Hour * 24
+
+	// Scrapes inside ttl get the same metrics, so several Prometheus instances do not load storage
+	cacheTTL = time.Second * 15
+	// Count of schedulers or agents which data is requested at the same time
+	maxConcurrency = 8
+
+	schedulerUp        = "squzy_scheduler_up"
+	schedulerLatency   = "squzy_scheduler_latency_seconds"
+	schedulerUptime    = "squzy_scheduler_uptime_ratio"
+	agentCPU           = "squzy_agent_cpu_load_percent"
+	agentMemory        = "squzy_agent_memory_used_percent"
+	agentSwap          = "squzy_agent_swap_used_percent"
+	agentDisk          = "squzy_agent_disk_used_percent"
+	incidentsOpen      = "squzy_incidents_open"
+	queueLength        = "squzy_storage_queue_length"
+	queueBytes         = "squzy_storage_queue_bytes"
+	queueReplayed      = "squzy_storage_queue_replayed_total"
+	queueDropped       = "squzy_storage_queue_dropped_total"
+	queueRejected      = "squzy_storage_queue_rejected_total"
+	storageConnection  = "squzy_storage_connection_state"
+	labelSchedulerID   = "scheduler_id"
+	labelSchedulerName = "scheduler_name"
+	labelAgentID       = "agent_id"
+	labelAgentName     = "agent_name"
+)
+
+var (
+	help = map[string]string{
+		schedulerUp:       "Whether the last check of scheduler was successful",
+		schedulerLatency:  "Duration of the last check of scheduler",
+		schedulerUptime:   "Share of successful checks of scheduler in the window",
+		agentCPU:          "Last load of agent cpu",
+		agentMemory:       "Last memory usage of agent",
+		agentSwap:         "Last swap usage of agent",
+		agentDisk:         "Last usage of agent disk",
+		incidentsOpen:     "Count of not closed incidents",
+		queueLength:       "Count of snapshots which wait in monitoring queue until storage is available",
+		queueBytes:        "Size of monitoring queue on disk",
+		queueReplayed:     "Count of snapshots which were replayed from monitoring queue",
+		queueDropped:      "Count of snapshots which were dropped because monitoring queue was full",
+		queueRejected:     "Count of snapshots which were dropped because storage rejected them",
+		storageConnection: "State of connection from monitoring to storage, current state has value 1",
+	}
+	counters = map[string]bool{
+		queueReplayed: true,
+		queueDropped:  true,
+		queueRejected: true,
+	}
+	order = []string{
+		schedulerUp,
+		schedulerLatency,
+		schedulerUptime,
+		agentCPU,
+		agentMemory,
+		agentSwap,
+		agentDisk,
+		incidentsOpen,
+		queueLength,
+		queueBytes,
+		queueReplayed,
+		queueDropped,
+		queueRejected,
+		storageConnection,
+	}
+	// States of grpc connection
+	connectionStates = []string{
+		"IDLE",
+		"CONNECTING",
+		"READY",
+		"TRANSIENT_FAILURE",
+		"SHUTDOWN",
+	}
+	openIncidentStatuses = []apiPb.IncidentStatus{
+		apiPb.IncidentStatus_INCIDENT_STATUS_OPENED,
+		apiPb.IncidentStatus_INCIDENT_STATUS_STUDIED,
+		apiPb.IncidentStatus_INCIDENT_STATUS_CAN_BE_CLOSED,
+	}
+	labelReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+)
+
+// Source is the part of api handlers which is needed to collect metrics
+type Source interface {
+	GetSchedulerList(ctx context.Context) ([]*apiPb.Scheduler, error)
+	GetSchedulerHistoryByID(ctx context.Context, rq *apiPb.GetSchedulerInformationRequest) (*apiPb.GetSchedulerInformationResponse, error)
+	GetSchedulerUptime(ctx context.Context, rq *apiPb.GetSchedulerUptimeRequest) (*apiPb.GetSchedulerUptimeResponse, error)
+	GetAgentList(ctx context.Context) ([]*apiPb.AgentItem, error)
+	GetAgentHistoryByID(ctx context.Context, rq *apiPb.GetAgentInformationRequest) (*apiPb.GetAgentInformationResponse, error)
+	GetIncidentList(ctx context.Context, req *apiPb.GetIncidentsListRequest) (*apiPb.GetIncidentsListResponse, error)
+	GetStorageStatus(ctx context.Context) (*apiPb.GetStorageStatusResponse, error)
+}
+
+// Exporter writes latest squzy data in Prometheus text exposition format
+type Exporter interface {
+	// Only latest values inside window are exported, uptime is calculated over whole window
+	Write(ctx context.Context, w io.Writer, window time.Duration) error
+}
+
+type exporter struct {
+	source Source
+	nowFn  func() time.Time
+	// Collection is done under mutex, so concurrent scrapes wait for the first one and take its result
+	mutex sync.Mutex
+	cache map[time.Duration]*cachedMetrics
+}
+
+type cachedMetrics struct {
+	body      string
+	expiresAt time.Time
+}
+
+type label struct {
+	name  string
+	value string
+}
+
+type sample struct {
+	labels []label
+	value  float64
+}
+
+type metrics map[string][]sample
+
+func New(source Source) Exporter {
+	return &exporter{
+		source: source,
+		nowFn:  time.Now,
+		cache:  map[time.Duration]*cachedMetrics{},
+	}
+}
+
+// Lists of schedulers and agents are required, data of single scheduler or agent is skipped if it could not be read
+func (e *exporter) Write(ctx context.Context, w io.Writer, window time.Duration) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	now := e.nowFn()
+	for key, cached := range e.cache {
+		if !now.Before(cached.expiresAt) {
+			delete(e.cache, key)
+		}
+	}
+	cached, ok := e.cache[window]
+	if !ok {
+		body, err := e.collect(ctx, now, window)
+		if err != nil {
+			return err
+		}
+		cached = &cachedMetrics{
+			body:      body,
+			expiresAt: now.Add(cacheTTL),
+		}
+		e.cache[window] = cached
+	}
+	_, err := io.WriteString(w, cached.body)
+	return err
+}
+
+func (e *exporter) collect(ctx context.Context, now time.Time, window time.Duration) (string, error) {
+	timeRange := &apiPb.TimeFilter{
+		From: timestamp.New(now.Add(-window)),
+		To:   timestamp.New(now),
+	}
+	m := metrics{}
+
+	schedulers, err := e.source.GetSchedulerList(ctx)
+	if err != nil {
+		return "", err
+	}
+	e.collectConcurrently(ctx, m, len(schedulers), func(ctx context.Context, m metrics, i int) {
+		e.collectScheduler(ctx, m, schedulers[i], timeRange)
+	})
+
+	agents, err := e.source.GetAgentList(ctx)
+	if err != nil {
+		return "", err
+	}
+	e.collectConcurrently(ctx, m, len(agents), func(ctx context.Context, m metrics, i int) {
+		e.collectAgent(ctx, m, agents[i], timeRange)
+	})
+
+	for _, status := range openIncidentStatuses {
+		incidents, err := e.source.GetIncidentList(ctx, &apiPb.GetIncidentsListRequest{
+			Status:     status,
+			Pagination: &apiPb.Pagination{Page: 1, Limit: 1},
+		})
+		if err != nil {
+			return "", err
+		}
+		m.add(incidentsOpen, float64(incidents.GetCount()), label{"status", statusLabel(status)})
+	}
+
+	// Storage metrics are skipped if monitoring is not available, queue is disabled or storage is not configured
+	storageStatus, err := e.source.GetStorageStatus(ctx)
+	if err == nil {
+		e.collectStorage(m, storageStatus)
+	}
+
+	return m.String(), nil
+}
+
+// Every item is collected to own metrics, so order of samples does not depend on order of responses
+func (e *exporter) collectConcurrently(ctx context.Context, m metrics, count int, collect func(ctx context.Context, m metrics, i int)) {
+	results := make([]metrics, count)
+	sem := semaphore.NewSemaphore(maxConcurrency)
+	wg := sync.WaitGroup{}
+	for i := 0; i < count; i++ {
+		if err := sem.Acquire(ctx); err != nil {
+			break
+		}
+		results[i] = metrics{}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer sem.Release()
+			collect(ctx, results[i], i)
+		}(i)
+	}
+	wg.Wait()
+	for _, result := range results {
+		for name, samples := range result {
+			m[name] = append(m[name], samples...)
+		}
+	}
+}
+
+func (e *exporter) collectStorage(m metrics, status *apiPb.GetStorageStatusResponse) {
+	if queue := status.GetQueue(); queue != nil {
+		m.add(queueLength, float64(queue.GetLength()))
+		m.add(queueBytes, float64(queue.GetBytes()))
+		m.add(queueReplayed, float64(queue.GetReplayed()))
+		m.add(queueDropped, float64(queue.GetDropped()))
+		m.add(queueRejected, float64(queue.GetRejected()))
+	}
+	if current := status.GetConnectionState(); current != "" {
+		for _, state := range connectionStates {
+			value := 0.0
+			if state == current {
+				value = 1
+			}
+			m.add(storageConnection, value, label{"state", state})
+		}
+	}
+}
+
+func (e *exporter) collectScheduler(ctx context.Context, m metrics, scheduler *apiPb.Scheduler, timeRange *apiPb.TimeFilter) {
+	labels := []label{
+		{labelSchedulerID, scheduler.GetId()},
+		{labelSchedulerName, scheduler.GetName()},
+	}
+	// Snapshots are sorted by start time desc by default
+	history, err := e.source.GetSchedulerHistoryByID(ctx, &apiPb.GetSchedulerInformationRequest{
+		SchedulerId: scheduler.GetId(),
+		Pagination:  &apiPb.Pagination{Page: 1, Limit: 1},
+		TimeRange:   timeRange,
+	})
+	if err == nil && len(history.GetSnapshots()) > 0 {
+		last := history.GetSnapshots()[0]
+		up := 0.0
+		if last.GetCode() == apiPb.SchedulerCode_OK {
+			up = 1
+		}
+		m.add(schedulerUp, up, labels...)
+		meta := last.GetMeta()
+		if meta.GetStartTime() != nil && meta.GetEndTime() != nil {
+			m.add(schedulerLatency, meta.GetEndTime().AsTime().Sub(meta.GetStartTime().AsTime()).Seconds(), labels...)
+		}
+	}
+
+	uptime, err := e.source.GetSchedulerUptime(ctx, &apiPb.GetSchedulerUptimeRequest{
+		SchedulerId: scheduler.GetId(),
+		TimeRange:   timeRange,
+	})
+	if err == nil {
+		m.add(schedulerUptime, uptime.GetUptime(), labels...)
+	}
+}
+
+func (e *exporter) collectAgent(ctx context.Context, m metrics, agent *apiPb.AgentItem, timeRange *apiPb.TimeFilter) {
+	// Page -1 returns the last stats, stats are sorted by time asc
+	history, err := e.source.GetAgentHistoryByID(ctx, &apiPb.GetAgentInformationRequest{
+		AgentId:    agent.GetId(),
+		Pagination: &apiPb.Pagination{Page: -1, Limit: 1},
+		TimeRange:  timeRange,
+		Type:       apiPb.TypeAgentStat_ALL,
+	})
+	if err != nil || len(history.GetStats()) == 0 {
+		return
+	}
+	stat := history.GetStats()[len(history.GetStats())-1]
+	labels := []label{
+		{labelAgentID, agent.GetId()},
+		{labelAgentName, agent.GetAgentName()},
+	}
+	for i, cpu := range stat.GetCpuInfo().GetCpus() {
+		m.add(agentCPU, cpu.GetLoad(), append(labels, label{"cpu", strconv.Itoa(i)})...)
+	}
+	if mem := stat.GetMemoryInfo().GetMem(); mem != nil {
+		m.add(agentMemory, mem.GetUsedPercent(), labels...)
+	}
+	if swap := stat.GetMemoryInfo().GetSwap(); swap != nil {
+		m.add(agentSwap, swap.GetUsedPercent(), labels...)
+	}
+	disks := stat.GetDiskInfo().GetDisks()
+	names := make([]string, 0, len(disks))
+	for name := range disks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		m.add(agentDisk, disks[name].GetUsedPercent(), append(labels, label{"disk", name})...)
+	}
+}
+
+func (m metrics) add(name string, value float64, labels ...label) {
+	m[name] = append(m[name], sample{
+		labels: labels,
+		value:  value,
+	})
+}
+
+func (m metrics) String() string {
+	builder := &strings.Builder{}
+	for _, name := range order {
+		samples, ok := m[name]
+		if !ok {
+			continue
+		}
+		metricType := "gauge"
+		if counters[name] {
+			metricType = "counter"
+		}
+		builder.WriteString(fmt.Sprintf("# HELP %s %s\n# TYPE %s %s\n", name, help[name], name, metricType))
+		for _, s := range samples {
+			builder.WriteString(name)
+			if len(s.labels) > 0 {
+				pairs := make([]string, 0, len(s.labels))
+				for _, l := range s.labels {
+					pairs = append(pairs, fmt.Sprintf(`%s="%s"`, l.name, labelReplacer.Replace(l.value)))
+				}
+				builder.WriteString("{" + strings.Join(pairs, ",") + "}")
+			}
+			builder.WriteString(" " + strconv.FormatFloat(s.value, 'g', -1, 64) + "\n")
+		}
+	}
+	return builder.String()
+}
+
+func statusLabel(status apiPb.IncidentStatus) string {
+	return strings.ToLower(strings.TrimPrefix(status.String(), "INCIDENT_STATUS_"))
+}
@@ -0,0 +1,208 @@
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/stretchr/testify/assert"
+	timestamp "google.golang.org/protobuf/types/known/timestamppb"
+	"testing"
+	"time"
+)
+
+type sourceMock struct {
+	listErr     error
+	incidentErr error
+	itemErr     error
+	listCalls   int
+}
+
+func (s *sourceMock) GetStorageStatus(ctx context.Context) (*apiPb.GetStorageStatusResponse, error) {
+	if s.itemErr != nil {
+		return nil, s.itemErr
+	}
+	return &apiPb.GetStorageStatusResponse{
+		Queue:           &apiPb.StorageQueueStatus{Length: 2, Bytes: 100, Replayed: 3, Dropped: 4, Rejected: 5},
+		ConnectionState: "READY",
+	}, nil
+}
+
+func (s *sourceMock) GetSchedulerList(ctx context.Context) ([]*apiPb.Scheduler, error) {
+	s.listCalls++
+	if s.listErr != nil {
+		return nil, s.listErr
+	}
+	return []*apiPb.Scheduler{
+		{Id: "1", Name: `site "main"`},
+	}, nil
+}
+
+func (s *sourceMock) GetSchedulerHistoryByID(ctx context.Context, rq *apiPb.GetSchedulerInformationRequest) (*apiPb.GetSchedulerInformationResponse, error) {
+	if s.itemErr != nil {
+		return nil, s.itemErr
+	}
+	return &apiPb.GetSchedulerInformationResponse{
+		Snapshots: []*apiPb.SchedulerSnapshot{
+			{
+				Code: apiPb.SchedulerCode_OK,
+				Meta: &apiPb.SchedulerSnapshot_MetaData{
+					StartTime: timestamp.New(time.Unix(10, 0)),
+					EndTime:   timestamp.New(time.Unix(10, int64(time.Millisecond*250))),
+				},
+			},
+		},
+	}, nil
+}
+
+func (s *sourceMock) GetSchedulerUptime(ctx context.Context, rq *apiPb.GetSchedulerUptimeRequest) (*apiPb.GetSchedulerUptimeResponse, error) {
+	if s.itemErr != nil {
+		return nil, s.itemErr
+	}
+	return &apiPb.GetSchedulerUptimeResponse{Uptime: 0.5}, nil
+}
+
+func (s *sourceMock) GetAgentList(ctx context.Context) ([]*apiPb.AgentItem, error) {
+	return []*apiPb.AgentItem{
+		{Id: "2", AgentName: "host"},
+	}, nil
+}
+
+func (s *sourceMock) GetAgentHistoryByID(ctx context.Context, rq *apiPb.GetAgentInformationRequest) (*apiPb.GetAgentInformationResponse, error) {
+	if s.itemErr != nil {
+		return nil, s.itemErr
+	}
+	return &apiPb.GetAgentInformationResponse{
+		Stats: []*apiPb.GetAgentInformationResponse_Statistic{
+			{
+				CpuInfo: &apiPb.CpuInfo{Cpus: []*apiPb.CpuInfo_CPU{{Load: 10}, {Load: 20}}},
+				MemoryInfo: &apiPb.MemoryInfo{
+					Mem:  &apiPb.MemoryInfo_Memory{UsedPercent: 30},
+					Swap: &apiPb.MemoryInfo_Memory{UsedPercent: 1},
+				},
+				DiskInfo: &apiPb.DiskInfo{Disks: map[string]*apiPb.DiskInfo_Disk{
+					"/":     {UsedPercent: 40},
+					"/data": {UsedPercent: 50},
+				}},
+			},
+		},
+	}, nil
+}
+
+func (s *sourceMock) GetIncidentList(ctx context.Context, req *apiPb.GetIncidentsListRequest) (*apiPb.GetIncidentsListResponse, error) {
+	if s.incidentErr != nil {
+		return nil, s.incidentErr
+	}
+	return &apiPb.GetIncidentsListResponse{Count: int64(req.GetStatus())}, nil
+}
+
+func TestNew(t *testing.T) {
+	t.Run("Should: create exporter", func(t *testing.T) {
+		assert.NotNil(t, New(&sourceMock{}))
+	})
+}
+
+func TestExporter_Write(t *testing.T) {
+	t.Run("Should: write metrics in exposition format", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		err := New(&sourceMock{}).Write(context.Background(), buf, time.Hour)
+		assert.NoError(t, err)
+		assert.Equal(t, `# HELP squzy_scheduler_up Whether the last check of scheduler was successful
+# TYPE squzy_scheduler_up gauge
+squzy_scheduler_up{scheduler_id="1",scheduler_name="site \"main\""} 1
+# HELP squzy_scheduler_latency_seconds Duration of the last check of scheduler
+# TYPE squzy_scheduler_latency_seconds gauge
+squzy_scheduler_latency_seconds{scheduler_id="1",scheduler_name="site \"main\""} 0.25
+# HELP squzy_scheduler_uptime_ratio Share of successful checks of scheduler in the window
+# TYPE squzy_scheduler_uptime_ratio gauge
+squzy_scheduler_uptime_ratio{scheduler_id="1",scheduler_name="site \"main\""} 0.5
+# HELP squzy_agent_cpu_load_percent Last load of agent cpu
+# TYPE squzy_agent_cpu_load_percent gauge
+squzy_agent_cpu_load_percent{agent_id="2",agent_name="host",cpu="0"} 10
+squzy_agent_cpu_load_percent{agent_id="2",agent_name="host",cpu="1"} 20
+# HELP squzy_agent_memory_used_percent Last memory usage of agent
+# TYPE squzy_agent_memory_used_percent gauge
+squzy_agent_memory_used_percent{agent_id="2",agent_name="host"} 30
+# HELP squzy_agent_swap_used_percent Last swap usage of agent
+# TYPE squzy_agent_swap_used_percent gauge
+squzy_agent_swap_used_percent{agent_id="2",agent_name="host"} 1
+# HELP squzy_agent_disk_used_percent Last usage of agent disk
+# TYPE squzy_agent_disk_used_percent gauge
+squzy_agent_disk_used_percent{agent_id="2",agent_name="host",disk="/"} 40
+squzy_agent_disk_used_percent{agent_id="2",agent_name="host",disk="/data"} 50
+# HELP squzy_incidents_open Count of not closed incidents
+# TYPE squzy_incidents_open gauge
+squzy_incidents_open{status="opened"} 1
+squzy_incidents_open{status="studied"} 2
+squzy_incidents_open{status="can_be_closed"} 3
+# HELP squzy_storage_queue_length Count of snapshots which wait in monitoring queue until storage is available
+# TYPE squzy_storage_queue_length gauge
+squzy_storage_queue_length 2
+# HELP squzy_storage_queue_bytes Size of monitoring queue on disk
+# TYPE squzy_storage_queue_bytes gauge
+squzy_storage_queue_bytes 100
+# HELP squzy_storage_queue_replayed_total Count of snapshots which were replayed from monitoring queue
+# TYPE squzy_storage_queue_replayed_total counter
+squzy_storage_queue_replayed_total 3
+# HELP squzy_storage_queue_dropped_total Count of snapshots which were dropped because monitoring queue was full
+# TYPE squzy_storage_queue_dropped_total counter
+squzy_storage_queue_dropped_total 4
+# HELP squzy_storage_queue_rejected_total Count of snapshots which were dropped because storage rejected them
+# TYPE squzy_storage_queue_rejected_total counter
+squzy_storage_queue_rejected_total 5
+# HELP squzy_storage_connection_state State of connection from monitoring to storage, current state has value 1
+# TYPE squzy_storage_connection_state gauge
+squzy_storage_connection_state{state="IDLE"} 0
+squzy_storage_connection_state{state="CONNECTING"} 0
+squzy_storage_connection_state{state="READY"} 1
+squzy_storage_connection_state{state="TRANSIENT_FAILURE"} 0
+squzy_storage_connection_state{state="SHUTDOWN"} 0
+`, buf.String())
+	})
+	t.Run("Should: skip schedulers and agents without data", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		err := New(&sourceMock{itemErr: errors.New("")}).Write(context.Background(), buf, time.Hour)
+		assert.NoError(t, err)
+		assert.NotContains(t, buf.String(), "squzy_scheduler")
+		assert.NotContains(t, buf.String(), "squzy_agent")
+		assert.NotContains(t, buf.String(), "squzy_storage_queue")
+		assert.NotContains(t, buf.String(), "squzy_storage_connection_state")
+		assert.Contains(t, buf.String(), "squzy_incidents_open")
+	})
+	t.Run("Should: return error if list could not be read", func(t *testing.T) {
+		err := New(&sourceMock{listErr: errors.New("")}).Write(context.Background(), &bytes.Buffer{}, time.Hour)
+		assert.Error(t, err)
+	})
+	t.Run("Should: return error if incidents could not be read", func(t *testing.T) {
+		err := New(&sourceMock{incidentErr: errors.New("")}).Write(context.Background(), &bytes.Buffer{}, time.Hour)
+		assert.Error(t, err)
+	})
+	t.Run("Should: return cached metrics until ttl is expired", func(t *testing.T) {
+		source := &sourceMock{}
+		now := time.Unix(1000, 0)
+		e := New(source).(*exporter)
+		e.nowFn = func() time.Time {
+			return now
+		}
+		first := &bytes.Buffer{}
+		assert.NoError(t, e.Write(context.Background(), first, time.Hour))
+		second := &bytes.Buffer{}
+		assert.NoError(t, e.Write(context.Background(), second, time.Hour))
+		assert.Equal(t, 1, source.listCalls)
+		assert.Equal(t, first.String(), second.String())
+
+		assert.NoError(t, e.Write(context.Background(), &bytes.Buffer{}, time.Minute))
+		assert.Equal(t, 2, source.listCalls)
+
+		now = now.Add(cacheTTL)
+		assert.NoError(t, e.Write(context.Background(), &bytes.Buffer{}, time.Hour))
+		assert.Equal(t, 3, source.listCalls)
+	})
+	t.Run("Should: not cache error", func(t *testing.T) {
+		source := &sourceMock{listErr: errors.New("")}
+		e := New(source)
+		assert.Error(t, e.Write(context.Background(), &bytes.Buffer{}, time.Hour))
+		assert.Error(t, e.Write(context.Background(), &bytes.Buffer{}, time.Hour))
+		assert.Equal(t, 2, source.listCalls)
+	})
+}
@@ -15,21 +15,27 @@ type Handlers interface {
 	GetSchedulerByID(ctx context.Context, id string) (*apiPb.Scheduler, error)
 	GetSchedulerHistoryByID(ctx context.Context, rq *apiPb.GetSchedulerInformationRequest) (*apiPb.GetSchedulerInformationResponse, error)
 	GetAgentHistoryByID(ctx context.Context, rq *apiPb.GetAgentInformationRequest) (*apiPb.GetAgentInformationResponse, error)
+	GetAgentSeries(ctx context.Context, rq *apiPb.GetAgentSeriesRequest) (*apiPb.GetAgentSeriesResponse, error)
 	RunScheduler(ctx context.Context, id string) error
 	StopScheduler(ctx context.Context, id string) error
+	PingScheduler(ctx context.Context, rq *apiPb.PingRequest) error
 	RemoveScheduler(ctx context.Context, id string) error
 	AddScheduler(ctx context.Context, scheduler *apiPb.AddRequest) (*apiPb.AddResponse, error)
 	RegisterApplication(ctx context.Context, rq *apiPb.ApplicationInfo) (*apiPb.InitializeApplicationResponse, error)
 	SaveTransaction(ctx context.Context, rq *apiPb.TransactionInfo) (*empty.Empty, error)
 	GetSchedulerUptime(ctx context.Context, rq *apiPb.GetSchedulerUptimeRequest) (*apiPb.GetSchedulerUptimeResponse, error)
+	GetSchedulerUptimeSeries(ctx context.Context, rq *apiPb.GetSchedulerUptimeSeriesRequest) (*apiPb.GetSchedulerUptimeSeriesResponse, error)
+	GetSchedulerOutages(ctx context.Context, rq *apiPb.GetSchedulerOutagesRequest) (*apiPb.GetSchedulerOutagesResponse, error)
 	GetTransactionGroups(ctx context.Context, req *apiPb.GetTransactionGroupRequest) (*apiPb.GetTransactionGroupResponse, error)
 	GetTransactionsList(ctx context.Context, req *apiPb.GetTransactionsRequest) (*apiPb.GetTransactionsResponse, error)
 	GetApplicationById(ctx context.Context, id string) (*apiPb.Application, error)
 	ArchivedApplicationById(ctx context.Context, id string) (*apiPb.Application, error)
 	EnabledApplicationById(ctx context.Context, id string) (*apiPb.Application, error)
 	DisabledApplicationById(ctx context.Context, id string) (*apiPb.Application, error)
+	SetApplicationApdexThreshold(ctx context.Context, id string, threshold float64) (*apiPb.Application, error)
 	GetApplicationList(ctx context.Context) ([]*apiPb.Application, error)
 	GetTransactionById(ctx context.Context, id string) (*apiPb.GetTransactionByIdResponse, error)
+	GetTransactionTree(ctx context.Context, id string) (*apiPb.GetTransactionTreeResponse, error)
 	CreateRule(ctx context.Context, rule *apiPb.CreateRuleRequest) (*apiPb.Rule, error)
 	ValidateRule(ctx context.Context, rule *apiPb.ValidateRuleRequest) (*apiPb.ValidateRuleResponse, error)
 	GetRulesByOwnerId(ctx context.Context, req *apiPb.GetRulesByOwnerIdRequest) (*apiPb.Rules, error)
@@ -49,6 +55,11 @@ type Handlers interface {
 	DeleteById(ctx context.Context, req *apiPb.NotificationMethodIdRequest) (*apiPb.NotificationMethod, error)
 	LinkById(ctx context.Context, req *apiPb.NotificationMethodRequest) (*apiPb.NotificationMethod, error)
 	UnLinkById(ctx context.Context, req *apiPb.NotificationMethodRequest) (*apiPb.NotificationMethod, error)
+	GetSecretList(ctx context.Context) ([]string, error)
+	SetSecret(ctx context.Context, name string, value string) error
+	RemoveSecret(ctx context.Context, name string) error
+	GetStorageStatus(ctx context.Context) (*apiPb.GetStorageStatusResponse, error)
+	Subscribe(ctx context.Context, rq *apiPb.SubscribeRequest) (apiPb.Storage_SubscribeClient, error)
 }
 
 const (
@@ -212,6 +223,15 @@ func (h *handlers) DisabledApplicationById(ctx context.Context, id string) (*api
 	})
 }
 
+func (h *handlers) SetApplicationApdexThreshold(ctx context.Context, id string, threshold float64) (*apiPb.Application, error) {
+	c, cancel := helpers.TimeoutContext(ctx, defaultRequestTimeout)
+	defer cancel()
+	return h.applicationMonitoringClient.SetApplicationApdexThreshold(c, &apiPb.ApplicationApdexThresholdRequest{
+		ApplicationId:  id,
+		ApdexThreshold: threshold,
+	})
+}
+
 func (h *handlers) SaveTransaction(ctx context.Context, rq *apiPb.TransactionInfo) (*empty.Empty, error) {
 	c, cancel := helpers.TimeoutContext(ctx, defaultRequestTimeout)
 	defer cancel()
@@ -230,6 +250,17 @@ func (h *handlers) GetAgentHistoryByID(ctx context.Context, rq *apiPb.GetAgentIn
 	return h.storageClient.GetAgentInformation(c, rq)
 }
 
+func (h *handlers) GetAgentSeries(ctx context.Context, rq *apiPb.GetAgentSeriesRequest) (*apiPb.GetAgentSeriesResponse, error) {
+	c, cancel := helpers.TimeoutContext(ctx, defaultRequestTimeout)
+	defer cancel()
+	return h.storageClient.GetAgentSeries(c, rq)
+}
+
+// Stream lives till ctx is done, so no timeout is applied
+func (h *handlers) Subscribe(ctx context.Context, rq *apiPb.SubscribeRequest) (apiPb.Storage_SubscribeClient, error) {
+	return h.storageClient.Subscribe(ctx, rq)
+}
+
 func (h *handlers) RunScheduler(ctx context.Context, id string) error {
 	c, cancel := helpers.TimeoutContext(ctx, defaultRequestTimeout)
 	defer cancel()
@@ -254,6 +285,13 @@ func (h *handlers) StopScheduler(ctx context.Context, id string) error {
 	return err
 }
 
+func (h *handlers) PingScheduler(ctx context.Context, rq *apiPb.PingRequest) error {
+	c, cancel := helpers.TimeoutContext(ctx, defaultRequestTimeout)
+	defer cancel()
+	_, err := h.monitoringClient.Ping(c, rq)
+	return err
+}
+
 func (h *handlers) RemoveScheduler(ctx context.Context, id string) error {
 	c, cancel := helpers.TimeoutContext(ctx, defaultRequestTimeout)
 	defer cancel()
@@ -263,6 +301,41 @@ func (h *handlers) RemoveScheduler(ctx context.Context, id string) error {
 	return err
 }
 
+func (h *handlers) GetSecretList(ctx context.Context) ([]string, error) {
+	c, cancel := helpers.TimeoutContext(ctx, defaultRequestTimeout)
+	defer cancel()
+	list, err := h.monitoringClient.GetSecretList(c, &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	return list.Names, nil
+}
+
+func (h *handlers) GetStorageStatus(ctx context.Context) (*apiPb.GetStorageStatusResponse, error) {
+	c, cancel := helpers.TimeoutContext(ctx, defaultRequestTimeout)
+	defer cancel()
+	return h.monitoringClient.GetStorageStatus(c, &empty.Empty{})
+}
+
+func (h *handlers) SetSecret(ctx context.Context, name string, value string) error {
+	c, cancel := helpers.TimeoutContext(ctx, defaultRequestTimeout)
+	defer cancel()
+	_, err := h.monitoringClient.SetSecret(c, &apiPb.SetSecretRequest{
+		Name:  name,
+		Value: value,
+	})
+	return err
+}
+
+func (h *handlers) RemoveSecret(ctx context.Context, name string) error {
+	c, cancel := helpers.TimeoutContext(ctx, defaultRequestTimeout)
+	defer cancel()
+	_, err := h.monitoringClient.RemoveSecret(c, &apiPb.SecretRequest{
+		Name: name,
+	})
+	return err
+}
+
 func (h *handlers) GetSchedulerList(ctx context.Context) ([]*apiPb.Scheduler, error) {
 	c, cancel := helpers.TimeoutContext(ctx, defaultRequestTimeout)
 	defer cancel()
@@ -311,6 +384,18 @@ func (h *handlers) GetSchedulerUptime(ctx context.Context, rq *apiPb.GetSchedule
 	return h.storageClient.GetSchedulerUptime(c, rq)
 }
 
+func (h *handlers) GetSchedulerUptimeSeries(ctx context.Context, rq *apiPb.GetSchedulerUptimeSeriesRequest) (*apiPb.GetSchedulerUptimeSeriesResponse, error) {
+	c, cancel := helpers.TimeoutContext(ctx, defaultRequestTimeout)
+	defer cancel()
+	return h.storageClient.GetSchedulerUptimeSeries(c, rq)
+}
+
+func (h *handlers) GetSchedulerOutages(ctx context.Context, rq *apiPb.GetSchedulerOutagesRequest) (*apiPb.GetSchedulerOutagesResponse, error) {
+	c, cancel := helpers.TimeoutContext(ctx, defaultRequestTimeout)
+	defer cancel()
+	return h.storageClient.GetSchedulerOutages(c, rq)
+}
+
 func (h *handlers) GetTransactionGroups(ctx context.Context, req *apiPb.GetTransactionGroupRequest) (*apiPb.GetTransactionGroupResponse, error) {
 	c, cancel := helpers.TimeoutContext(ctx, defaultRequestTimeout)
 	defer cancel()
@@ -331,6 +416,14 @@ func (h *handlers) GetTransactionById(ctx context.Context, id string) (*apiPb.Ge
 	})
 }
 
+func (h *handlers) GetTransactionTree(ctx context.Context, id string) (*apiPb.GetTransactionTreeResponse, error) {
+	c, cancel := helpers.TimeoutContext(ctx, defaultRequestTimeout)
+	defer cancel()
+	return h.storageClient.GetTransactionTree(c, &apiPb.GetTransactionByIdRequest{
+		TransactionId: id,
+	})
+}
+
 func (h *handlers) GetApplicationById(ctx context.Context, id string) (*apiPb.Application, error) {
 	c, cancel := helpers.TimeoutContext(ctx, defaultRequestTimeout)
 	defer cancel()
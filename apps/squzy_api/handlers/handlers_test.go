@@ -201,6 +201,10 @@ func (m mockAmOk) DisableApplicationById(ctx context.Context, in *apiPb.Applicat
 	return &apiPb.Application{}, nil
 }
 
+func (m mockAmOk) SetApplicationApdexThreshold(ctx context.Context, in *apiPb.ApplicationApdexThresholdRequest, opts ...grpc.CallOption) (*apiPb.Application, error) {
+	return &apiPb.Application{}, nil
+}
+
 func (m mockAmOk) InitializeApplication(ctx context.Context, in *apiPb.ApplicationInfo, opts ...grpc.CallOption) (*apiPb.InitializeApplicationResponse, error) {
 	return &apiPb.InitializeApplicationResponse{}, nil
 }
@@ -236,6 +240,10 @@ func (m mockAmError) DisableApplicationById(ctx context.Context, in *apiPb.Appli
 	return nil, errors.New("")
 }
 
+func (m mockAmError) SetApplicationApdexThreshold(ctx context.Context, in *apiPb.ApplicationApdexThresholdRequest, opts ...grpc.CallOption) (*apiPb.Application, error) {
+	return nil, errors.New("")
+}
+
 func (m mockAmError) InitializeApplication(ctx context.Context, in *apiPb.ApplicationInfo, opts ...grpc.CallOption) (*apiPb.InitializeApplicationResponse, error) {
 	return nil, errors.New("")
 }
@@ -279,6 +287,22 @@ func (s storageMockOk) GetSchedulerUptime(ctx context.Context, in *apiPb.GetSche
 	return &apiPb.GetSchedulerUptimeResponse{}, nil
 }
 
+func (s storageMockOk) GetSchedulerUptimeSeries(ctx context.Context, in *apiPb.GetSchedulerUptimeSeriesRequest, opts ...grpc.CallOption) (*apiPb.GetSchedulerUptimeSeriesResponse, error) {
+	return &apiPb.GetSchedulerUptimeSeriesResponse{}, nil
+}
+
+func (s storageMockOk) GetSchedulerOutages(ctx context.Context, in *apiPb.GetSchedulerOutagesRequest, opts ...grpc.CallOption) (*apiPb.GetSchedulerOutagesResponse, error) {
+	return &apiPb.GetSchedulerOutagesResponse{}, nil
+}
+
+func (s storageMockOk) GetAgentSeries(ctx context.Context, in *apiPb.GetAgentSeriesRequest, opts ...grpc.CallOption) (*apiPb.GetAgentSeriesResponse, error) {
+	return &apiPb.GetAgentSeriesResponse{}, nil
+}
+
+func (s storageMockOk) Subscribe(ctx context.Context, in *apiPb.SubscribeRequest, opts ...grpc.CallOption) (apiPb.Storage_SubscribeClient, error) {
+	return nil, nil
+}
+
 func (s storageMockOk) SaveTransaction(ctx context.Context, in *apiPb.TransactionInfo, opts ...grpc.CallOption) (*empty.Empty, error) {
 	return &empty.Empty{}, nil
 }
@@ -295,10 +319,18 @@ func (s storageMockOk) GetTransactionById(ctx context.Context, in *apiPb.GetTran
 	return &apiPb.GetTransactionByIdResponse{}, nil
 }
 
+func (s storageMockOk) GetTransactionTree(ctx context.Context, in *apiPb.GetTransactionByIdRequest, opts ...grpc.CallOption) (*apiPb.GetTransactionTreeResponse, error) {
+	return &apiPb.GetTransactionTreeResponse{}, nil
+}
+
 func (s storageMockOk) SaveResponseFromScheduler(ctx context.Context, in *apiPb.SchedulerResponse, opts ...grpc.CallOption) (*empty.Empty, error) {
 	return &empty.Empty{}, nil
 }
 
+func (s storageMockOk) SaveResponsesFromScheduler(ctx context.Context, in *apiPb.SchedulerResponseBatch, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return &empty.Empty{}, nil
+}
+
 func (s storageMockOk) SaveResponseFromAgent(ctx context.Context, in *apiPb.Metric, opts ...grpc.CallOption) (*empty.Empty, error) {
 	return &empty.Empty{}, nil
 }
@@ -338,6 +370,22 @@ func (s storageMockError) GetSchedulerUptime(ctx context.Context, in *apiPb.GetS
 	return nil, errors.New("")
 }
 
+func (s storageMockError) GetSchedulerUptimeSeries(ctx context.Context, in *apiPb.GetSchedulerUptimeSeriesRequest, opts ...grpc.CallOption) (*apiPb.GetSchedulerUptimeSeriesResponse, error) {
+	return nil, errors.New("")
+}
+
+func (s storageMockError) GetSchedulerOutages(ctx context.Context, in *apiPb.GetSchedulerOutagesRequest, opts ...grpc.CallOption) (*apiPb.GetSchedulerOutagesResponse, error) {
+	return nil, errors.New("")
+}
+
+func (s storageMockError) GetAgentSeries(ctx context.Context, in *apiPb.GetAgentSeriesRequest, opts ...grpc.CallOption) (*apiPb.GetAgentSeriesResponse, error) {
+	return nil, errors.New("")
+}
+
+func (s storageMockError) Subscribe(ctx context.Context, in *apiPb.SubscribeRequest, opts ...grpc.CallOption) (apiPb.Storage_SubscribeClient, error) {
+	return nil, errors.New("")
+}
+
 func (s storageMockError) SaveTransaction(ctx context.Context, in *apiPb.TransactionInfo, opts ...grpc.CallOption) (*empty.Empty, error) {
 	return nil, errors.New("")
 }
@@ -354,10 +402,18 @@ func (s storageMockError) GetTransactionById(ctx context.Context, in *apiPb.GetT
 	return nil, errors.New("")
 }
 
+func (s storageMockError) GetTransactionTree(ctx context.Context, in *apiPb.GetTransactionByIdRequest, opts ...grpc.CallOption) (*apiPb.GetTransactionTreeResponse, error) {
+	return nil, errors.New("")
+}
+
 func (s storageMockError) SaveResponseFromScheduler(ctx context.Context, in *apiPb.SchedulerResponse, opts ...grpc.CallOption) (*empty.Empty, error) {
 	return nil, errors.New("")
 }
 
+func (s storageMockError) SaveResponsesFromScheduler(ctx context.Context, in *apiPb.SchedulerResponseBatch, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, errors.New("")
+}
+
 func (s storageMockError) SaveResponseFromAgent(ctx context.Context, in *apiPb.Metric, opts ...grpc.CallOption) (*empty.Empty, error) {
 	return nil, errors.New("")
 }
@@ -451,6 +507,26 @@ func (m mockMonitoringError) Stop(ctx context.Context, in *apiPb.StopRequest, op
 	return nil, errors.New("")
 }
 
+func (m mockMonitoringError) Ping(ctx context.Context, in *apiPb.PingRequest, opts ...grpc.CallOption) (*apiPb.PingResponse, error) {
+	return nil, errors.New("")
+}
+
+func (m mockMonitoringError) SetSecret(ctx context.Context, in *apiPb.SetSecretRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, errors.New("")
+}
+
+func (m mockMonitoringError) RemoveSecret(ctx context.Context, in *apiPb.SecretRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, errors.New("")
+}
+
+func (m mockMonitoringError) GetStorageStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*apiPb.GetStorageStatusResponse, error) {
+	return nil, errors.New("")
+}
+
+func (m mockMonitoringError) GetSecretList(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*apiPb.GetSecretListResponse, error) {
+	return nil, errors.New("")
+}
+
 type mockMonitoringOk struct {
 }
 
@@ -478,6 +554,26 @@ func (m mockMonitoringOk) Stop(ctx context.Context, in *apiPb.StopRequest, opts
 	return &apiPb.StopResponse{}, nil
 }
 
+func (m mockMonitoringOk) Ping(ctx context.Context, in *apiPb.PingRequest, opts ...grpc.CallOption) (*apiPb.PingResponse, error) {
+	return &apiPb.PingResponse{}, nil
+}
+
+func (m mockMonitoringOk) SetSecret(ctx context.Context, in *apiPb.SetSecretRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return &empty.Empty{}, nil
+}
+
+func (m mockMonitoringOk) RemoveSecret(ctx context.Context, in *apiPb.SecretRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return &empty.Empty{}, nil
+}
+
+func (m mockMonitoringOk) GetStorageStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*apiPb.GetStorageStatusResponse, error) {
+	return &apiPb.GetStorageStatusResponse{Queue: &apiPb.StorageQueueStatus{Length: 1}}, nil
+}
+
+func (m mockMonitoringOk) GetSecretList(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*apiPb.GetSecretListResponse, error) {
+	return &apiPb.GetSecretListResponse{Names: []string{"token"}}, nil
+}
+
 func TestNew(t *testing.T) {
 	t.Run("Should: not return error", func(t *testing.T) {
 		s := New(nil, nil, nil, nil, nil, nil)
@@ -615,6 +711,73 @@ func TestHandlers_StopScheduler(t *testing.T) {
 	})
 }
 
+func TestHandlers_PingScheduler(t *testing.T) {
+	t.Run("Should: not return error", func(t *testing.T) {
+		s := New(nil, &mockMonitoringOk{}, nil, nil, nil, nil)
+		err := s.PingScheduler(context.Background(), &apiPb.PingRequest{})
+		assert.Nil(t, err)
+	})
+	t.Run("Should: return error", func(t *testing.T) {
+		s := New(nil, &mockMonitoringError{}, nil, nil, nil, nil)
+		err := s.PingScheduler(context.Background(), &apiPb.PingRequest{})
+		assert.NotNil(t, err)
+	})
+}
+
+func TestHandlers_GetSecretList(t *testing.T) {
+	t.Run("Should: not return error", func(t *testing.T) {
+		s := New(nil, &mockMonitoringOk{}, nil, nil, nil, nil)
+		names, err := s.GetSecretList(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"token"}, names)
+	})
+	t.Run("Should: return error", func(t *testing.T) {
+		s := New(nil, &mockMonitoringError{}, nil, nil, nil, nil)
+		_, err := s.GetSecretList(context.Background())
+		assert.NotNil(t, err)
+	})
+}
+
+func TestHandlers_GetStorageStatus(t *testing.T) {
+	t.Run("Should: not return error", func(t *testing.T) {
+		s := New(nil, &mockMonitoringOk{}, nil, nil, nil, nil)
+		res, err := s.GetStorageStatus(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), res.GetQueue().GetLength())
+	})
+	t.Run("Should: return error", func(t *testing.T) {
+		s := New(nil, &mockMonitoringError{}, nil, nil, nil, nil)
+		_, err := s.GetStorageStatus(context.Background())
+		assert.NotNil(t, err)
+	})
+}
+
+func TestHandlers_SetSecret(t *testing.T) {
+	t.Run("Should: not return error", func(t *testing.T) {
+		s := New(nil, &mockMonitoringOk{}, nil, nil, nil, nil)
+		err := s.SetSecret(context.Background(), "token", "qwerty")
+		assert.Nil(t, err)
+	})
+	t.Run("Should: return error", func(t *testing.T) {
+		s := New(nil, &mockMonitoringError{}, nil, nil, nil, nil)
+		err := s.SetSecret(context.Background(), "token", "qwerty")
+		assert.NotNil(t, err)
+	})
+}
+
+func TestHandlers_RemoveSecret(t *testing.T) {
+	t.Run("Should: not return error", func(t *testing.T) {
+		s := New(nil, &mockMonitoringOk{}, nil, nil, nil, nil)
+		err := s.RemoveSecret(context.Background(), "token")
+		assert.Nil(t, err)
+	})
+	t.Run("Should: return error", func(t *testing.T) {
+		s := New(nil, &mockMonitoringError{}, nil, nil, nil, nil)
+		err := s.RemoveSecret(context.Background(), "token")
+		assert.NotNil(t, err)
+	})
+}
+
 func TestHandlers_GetApplicationById(t *testing.T) {
 	t.Run("Should: not return error", func(t *testing.T) {
 		s := New(nil, nil, nil, &mockAmOk{}, nil, nil)
@@ -654,6 +817,71 @@ func TestHandlers_GetSchedulerUptime(t *testing.T) {
 	})
 }
 
+func TestHandlers_GetSchedulerUptimeSeries(t *testing.T) {
+	t.Run("Should: not return error", func(t *testing.T) {
+		s := New(nil, nil, &storageMockOk{}, nil, nil, nil)
+		_, err := s.GetSchedulerUptimeSeries(context.Background(), nil)
+		assert.Nil(t, err)
+	})
+	t.Run("Should: return error", func(t *testing.T) {
+		s := New(nil, nil, &storageMockError{}, nil, nil, nil)
+		_, err := s.GetSchedulerUptimeSeries(context.Background(), nil)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestHandlers_GetSchedulerOutages(t *testing.T) {
+	t.Run("Should: not return error", func(t *testing.T) {
+		s := New(nil, nil, &storageMockOk{}, nil, nil, nil)
+		_, err := s.GetSchedulerOutages(context.Background(), nil)
+		assert.Nil(t, err)
+	})
+	t.Run("Should: return error", func(t *testing.T) {
+		s := New(nil, nil, &storageMockError{}, nil, nil, nil)
+		_, err := s.GetSchedulerOutages(context.Background(), nil)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestHandlers_GetAgentSeries(t *testing.T) {
+	t.Run("Should: not return error", func(t *testing.T) {
+		s := New(nil, nil, &storageMockOk{}, nil, nil, nil)
+		_, err := s.GetAgentSeries(context.Background(), nil)
+		assert.Nil(t, err)
+	})
+	t.Run("Should: return error", func(t *testing.T) {
+		s := New(nil, nil, &storageMockError{}, nil, nil, nil)
+		_, err := s.GetAgentSeries(context.Background(), nil)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestHandlers_Subscribe(t *testing.T) {
+	t.Run("Should: not return error", func(t *testing.T) {
+		s := New(nil, nil, &storageMockOk{}, nil, nil, nil)
+		_, err := s.Subscribe(context.Background(), nil)
+		assert.Nil(t, err)
+	})
+	t.Run("Should: return error", func(t *testing.T) {
+		s := New(nil, nil, &storageMockError{}, nil, nil, nil)
+		_, err := s.Subscribe(context.Background(), nil)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestHandlers_GetTransactionTree(t *testing.T) {
+	t.Run("Should: not return error", func(t *testing.T) {
+		s := New(nil, nil, &storageMockOk{}, nil, nil, nil)
+		_, err := s.GetTransactionTree(context.Background(), "")
+		assert.Nil(t, err)
+	})
+	t.Run("Should: return error", func(t *testing.T) {
+		s := New(nil, nil, &storageMockError{}, nil, nil, nil)
+		_, err := s.GetTransactionTree(context.Background(), "nil")
+		assert.NotNil(t, err)
+	})
+}
+
 func TestHandlers_GetTransactionById(t *testing.T) {
 	t.Run("Should: not return error", func(t *testing.T) {
 		s := New(nil, nil, &storageMockOk{}, nil, nil, nil)
@@ -732,6 +960,19 @@ func TestHandlers_ArchivedApplicationById(t *testing.T) {
 	})
 }
 
+func TestHandlers_SetApplicationApdexThreshold(t *testing.T) {
+	t.Run("Should: not return error", func(t *testing.T) {
+		s := New(nil, nil, nil, &mockAmOk{}, nil, nil)
+		_, err := s.SetApplicationApdexThreshold(context.Background(), "", 100)
+		assert.Nil(t, err)
+	})
+	t.Run("Should: return error", func(t *testing.T) {
+		s := New(nil, nil, nil, &mockAmError{}, nil, nil)
+		_, err := s.SetApplicationApdexThreshold(context.Background(), "", 100)
+		assert.NotNil(t, err)
+	})
+}
+
 func TestHandlers_DisabledApplicationById(t *testing.T) {
 	t.Run("Should: not return error", func(t *testing.T) {
 		s := New(nil, nil, nil, &mockAmOk{}, nil, nil)
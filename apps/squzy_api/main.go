@@ -72,6 +72,8 @@ func main() {
 	logger.Fatal(
 		router.New(
 			handlers.New(agentServerClient, monitoringClient, storageClient, appMonClient, incidentClient, notificicationClient),
+			cfg.GetAllowedOrigins(),
+			cfg.GetMaxMetricsWindow(),
 		).GetEngine().Run(fmt.Sprintf(":%d", cfg.GetPort())).Error(),
 	)
 }
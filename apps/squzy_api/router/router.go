@@ -1,11 +1,16 @@
 package router
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"github.com/gin-gonic/gin"
+	"github.com/squzy/squzy/apps/squzy_api/exporter"
 	"github.com/squzy/squzy/apps/squzy_api/handlers"
+	"github.com/squzy/squzy/internal/logger"
 	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	timestamp "google.golang.org/protobuf/types/known/timestamppb"
 	wrappers "google.golang.org/protobuf/types/known/wrapperspb"
 	"net/http"
@@ -17,6 +22,12 @@ var (
 	errMissingConfig         = errors.New("missing config of scheduler")
 	errNotFoundConfigType    = errors.New("not found config type")
 	errWrongNotificationType = errors.New("wrong notification type")
+	errWrongMetricsWindow    = errors.New("wrong metrics window")
+	errTransactionNotFound   = errors.New("transaction not found")
+)
+
+const (
+	prometheusContentType = "text/plain; version=0.0.4; charset=utf-8"
 )
 
 type Router interface {
@@ -25,6 +36,12 @@ type Router interface {
 
 type router struct {
 	handlers handlers.Handlers
+	exporter exporter.Exporter
+	// Origins of dashboards, which are allowed to open stream from other host
+	allowedOrigins    []string
+	keepAliveInterval time.Duration
+	// Window is also the key of exporter cache, so it is limited
+	maxMetricsWindow time.Duration
 }
 
 type E struct {
@@ -39,6 +56,11 @@ type SchedulerUptimeRequest struct {
 	TimeRange *TimeFilterRequest
 }
 
+type SchedulerUptimeSeriesRequest struct {
+	TimeRange  *TimeFilterRequest
+	Resolution apiPb.SeriesResolution `form:"resolution"`
+}
+
 type SchedulerHistory struct {
 	Pagination    *PaginationRequest
 	TimeFilters   *TimeFilterRequest
@@ -53,6 +75,12 @@ type AgentHistory struct {
 	Type        apiPb.TypeAgentStat `form:"type"`
 }
 
+type AgentSeries struct {
+	TimeFilters *TimeFilterRequest
+	// Seconds
+	BucketSize int64 `form:"bucket_size"`
+}
+
 type GetIncidentListRequest struct {
 	Pagination    *PaginationRequest
 	TimeFilters   *TimeFilterRequest
@@ -80,6 +108,13 @@ type GetTransactionGroupRequest struct {
 	GroupType         apiPb.GroupTransaction  `form:"group_by"`
 	TransactionType   apiPb.TransactionType   `form:"transaction_type"`
 	TransactionStatus apiPb.TransactionStatus `form:"transaction_status"`
+	// Overrides threshold of application
+	ApdexThreshold float64 `form:"apdex_threshold"`
+}
+
+type ApplicationApdexThreshold struct {
+	// Milliseconds
+	Threshold float64 `json:"threshold" binding:"min=0"`
 }
 
 type TimeFilterRequest struct {
@@ -90,6 +125,8 @@ type TimeFilterRequest struct {
 type PaginationRequest struct {
 	Page  int32 `form:"page"`
 	Limit int32 `form:"limit"`
+	// Next or prev cursor of previous response, page is ignored when it is set
+	Cursor string `form:"cursor"`
 }
 
 type ValidateRuleRequest struct {
@@ -142,6 +179,11 @@ type Scheduler struct {
 	GRPCConfig          *apiPb.GrpcConfig          `json:"grpcConfig,omitempty"`
 	SiteMapConfig       *apiPb.SiteMapConfig       `json:"siteMapConfig,omitempty"`
 	SSLExpirationConfig *apiPb.SslExpirationConfig `json:"sslExpirationConfig,omitempty"`
+	WebSocketConfig     *apiPb.WebSocketConfig     `json:"webSocketConfig,omitempty"`
+	DatabaseConfig      *apiPb.DatabaseConfig      `json:"databaseConfig,omitempty"`
+	HeartbeatConfig     *apiPb.HeartbeatConfig     `json:"heartbeatConfig,omitempty"`
+	ContentChangeConfig *apiPb.ContentChangeConfig `json:"contentChangeConfig,omitempty"`
+	PrometheusConfig    *apiPb.PrometheusConfig    `json:"prometheusConfig,omitempty"`
 }
 
 type Application struct {
@@ -150,6 +192,10 @@ type Application struct {
 	AgentId string `json:"agentId"`
 }
 
+type Secret struct {
+	Value string `json:"value" binding:"required"`
+}
+
 type transactionTime timestamp.Timestamp
 
 var _ json.Unmarshaler = &transactionTime{}
@@ -207,11 +253,65 @@ func successWrap(c *gin.Context, status int, data interface{}) {
 	})
 }
 
+func (r *router) pingHandler(pingType apiPb.PingRequest_PingType) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		schedulerID := context.Param("schedulerId")
+		err := r.handlers.PingScheduler(context, &apiPb.PingRequest{
+			Id:      schedulerID,
+			Type:    pingType,
+			Message: context.Query("message"),
+		})
+		if err != nil {
+			errWrap(context, getPingErrorStatus(err), err)
+			return
+		}
+		successWrap(context, http.StatusOK, nil)
+	}
+}
+
+func getPingErrorStatus(err error) int {
+	switch status.Code(err) {
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.FailedPrecondition:
+		return http.StatusConflict
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
 func (r *router) GetEngine() *gin.Engine {
 	engine := gin.New()
 	engine.Use(gin.Recovery())
+	engine.GET("metrics", func(context *gin.Context) {
+		window := exporter.DefaultWindow
+		if value := context.Query("window"); value != "" {
+			parsed, err := time.ParseDuration(value)
+			if err != nil || parsed < time.Second {
+				errWrap(context, http.StatusBadRequest, errWrongMetricsWindow)
+				return
+			}
+			// Fractions of second do not change metrics, but would be cached separately
+			window = parsed.Truncate(time.Second)
+		}
+		if window > r.maxMetricsWindow {
+			window = r.maxMetricsWindow
+		}
+		buf := &bytes.Buffer{}
+		err := r.exporter.Write(context, buf, window)
+		if err != nil {
+			errWrap(context, http.StatusInternalServerError, err)
+			return
+		}
+		context.Data(http.StatusOK, prometheusContentType, buf.Bytes())
+	})
 	v1 := engine.Group("v1")
 	{
+		v1.GET("stream", r.streamHandler)
 		notifications := v1.Group("notifications")
 		{
 			notifications.POST("", func(context *gin.Context) {
@@ -559,6 +659,8 @@ func (r *router) GetEngine() *gin.Engine {
 				}
 				successWrap(context, http.StatusOK, res)
 			})
+			transaction.GET(":transaction_id/tree", r.transactionTreeHandler)
+			transaction.GET(":transaction_id/waterfall", r.transactionWaterfallHandler)
 		}
 		applications := v1.Group("applications")
 		{
@@ -630,6 +732,22 @@ func (r *router) GetEngine() *gin.Engine {
 					successWrap(context, http.StatusAccepted, res)
 				})
 
+				application.PUT("apdex", func(context *gin.Context) {
+					applicationId := context.Param("applicationId")
+					rq := &ApplicationApdexThreshold{}
+					err := context.ShouldBindJSON(rq)
+					if err != nil {
+						errWrap(context, http.StatusUnprocessableEntity, err)
+						return
+					}
+					res, err := r.handlers.SetApplicationApdexThreshold(context, applicationId, rq.Threshold)
+					if err != nil {
+						errWrap(context, http.StatusInternalServerError, err)
+						return
+					}
+					successWrap(context, http.StatusAccepted, res)
+				})
+
 				transactions := application.Group("transactions")
 				{
 					transactions.GET("list", func(context *gin.Context) {
@@ -677,12 +795,24 @@ func (r *router) GetEngine() *gin.Engine {
 							return
 						}
 
+						// Storage uses default threshold if application threshold could not be read
+						apdexThreshold := rq.ApdexThreshold
+						if apdexThreshold <= 0 {
+							app, err := r.handlers.GetApplicationById(context, applicationId)
+							if err != nil {
+								logger.Errorf("Could not read apdex threshold of application %s, default threshold is used: %s", applicationId, err.Error())
+							} else {
+								apdexThreshold = app.GetApdexThreshold()
+							}
+						}
+
 						res, err := r.handlers.GetTransactionGroups(context, &apiPb.GetTransactionGroupRequest{
-							ApplicationId: applicationId,
-							TimeRange:     timeRange,
-							GroupType:     rq.GroupType,
-							Type:          rq.TransactionType,
-							Status:        rq.TransactionStatus,
+							ApplicationId:  applicationId,
+							TimeRange:      timeRange,
+							GroupType:      rq.GroupType,
+							Type:           rq.TransactionType,
+							Status:         rq.TransactionStatus,
+							ApdexThreshold: apdexThreshold,
 						})
 						if err != nil {
 							errWrap(context, http.StatusInternalServerError, err)
@@ -784,6 +914,34 @@ func (r *router) GetEngine() *gin.Engine {
 
 					successWrap(context, http.StatusOK, res)
 				})
+
+				agent.GET("/series", func(context *gin.Context) {
+					agentID := context.Param("agentId")
+					rq := &AgentSeries{}
+
+					err := context.ShouldBind(rq)
+					if err != nil {
+						errWrap(context, http.StatusUnprocessableEntity, err)
+						return
+					}
+					_, timeRange, err := GetFilters(nil, rq.TimeFilters)
+					if err != nil {
+						errWrap(context, http.StatusUnprocessableEntity, err)
+						return
+					}
+					res, err := r.handlers.GetAgentSeries(context, &apiPb.GetAgentSeriesRequest{
+						AgentId:    agentID,
+						TimeRange:  timeRange,
+						BucketSize: rq.BucketSize,
+					})
+
+					if err != nil {
+						errWrap(context, http.StatusInternalServerError, err)
+						return
+					}
+
+					successWrap(context, http.StatusOK, res)
+				})
 			}
 		}
 
@@ -871,6 +1029,61 @@ func (r *router) GetEngine() *gin.Engine {
 						},
 					}
 
+				case apiPb.SchedulerType_WEBSOCKET:
+					if request.WebSocketConfig == nil {
+						errWrap(context, http.StatusUnprocessableEntity, errMissingConfig)
+						return
+					}
+					addReq = &apiPb.AddRequest{
+						Config: &apiPb.AddRequest_Websocket{
+							Websocket: request.WebSocketConfig,
+						},
+					}
+
+				case apiPb.SchedulerType_DATABASE:
+					if request.DatabaseConfig == nil {
+						errWrap(context, http.StatusUnprocessableEntity, errMissingConfig)
+						return
+					}
+					addReq = &apiPb.AddRequest{
+						Config: &apiPb.AddRequest_Database{
+							Database: request.DatabaseConfig,
+						},
+					}
+
+				case apiPb.SchedulerType_HEARTBEAT:
+					if request.HeartbeatConfig == nil {
+						errWrap(context, http.StatusUnprocessableEntity, errMissingConfig)
+						return
+					}
+					addReq = &apiPb.AddRequest{
+						Config: &apiPb.AddRequest_Heartbeat{
+							Heartbeat: request.HeartbeatConfig,
+						},
+					}
+
+				case apiPb.SchedulerType_CONTENT_CHANGE:
+					if request.ContentChangeConfig == nil {
+						errWrap(context, http.StatusUnprocessableEntity, errMissingConfig)
+						return
+					}
+					addReq = &apiPb.AddRequest{
+						Config: &apiPb.AddRequest_ContentChange{
+							ContentChange: request.ContentChangeConfig,
+						},
+					}
+
+				case apiPb.SchedulerType_PROMETHEUS:
+					if request.PrometheusConfig == nil {
+						errWrap(context, http.StatusUnprocessableEntity, errMissingConfig)
+						return
+					}
+					addReq = &apiPb.AddRequest{
+						Config: &apiPb.AddRequest_Prometheus{
+							Prometheus: request.PrometheusConfig,
+						},
+					}
+
 				default:
 					errWrap(context, http.StatusUnprocessableEntity, errNotFoundConfigType)
 					return
@@ -929,6 +1142,20 @@ func (r *router) GetEngine() *gin.Engine {
 					successWrap(context, http.StatusAccepted, nil)
 				})
 
+				// Heartbeat pings, GET and POST are allowed for simple usage from cron jobs
+				ping := scheduler.Group("ping")
+				{
+					for pingPath, pingType := range map[string]apiPb.PingRequest_PingType{
+						"":      apiPb.PingRequest_SUCCESS,
+						"start": apiPb.PingRequest_START,
+						"fail":  apiPb.PingRequest_FAIL,
+					} {
+						handler := r.pingHandler(pingType)
+						ping.GET(pingPath, handler)
+						ping.POST(pingPath, handler)
+					}
+				}
+
 				scheduler.GET("uptime", func(context *gin.Context) {
 					schedulerID := context.Param("schedulerId")
 					req := &SchedulerUptimeRequest{}
@@ -957,6 +1184,63 @@ func (r *router) GetEngine() *gin.Engine {
 					successWrap(context, http.StatusOK, res)
 				})
 
+				scheduler.GET("uptime/series", func(context *gin.Context) {
+					schedulerID := context.Param("schedulerId")
+					req := &SchedulerUptimeSeriesRequest{}
+					err := context.ShouldBind(req)
+
+					if err != nil {
+						errWrap(context, http.StatusUnprocessableEntity, err)
+						return
+					}
+
+					_, timeRange, err := GetFilters(nil, req.TimeRange)
+
+					if err != nil {
+						errWrap(context, http.StatusUnprocessableEntity, err)
+						return
+					}
+					res, err := r.handlers.GetSchedulerUptimeSeries(context, &apiPb.GetSchedulerUptimeSeriesRequest{
+						SchedulerId: schedulerID,
+						TimeRange:   timeRange,
+						Resolution:  req.Resolution,
+					})
+
+					if err != nil {
+						errWrap(context, http.StatusInternalServerError, err)
+						return
+					}
+					successWrap(context, http.StatusOK, res)
+				})
+
+				scheduler.GET("outages", func(context *gin.Context) {
+					schedulerID := context.Param("schedulerId")
+					req := &SchedulerUptimeRequest{}
+					err := context.ShouldBind(req)
+
+					if err != nil {
+						errWrap(context, http.StatusUnprocessableEntity, err)
+						return
+					}
+
+					_, timeRange, err := GetFilters(nil, req.TimeRange)
+
+					if err != nil {
+						errWrap(context, http.StatusUnprocessableEntity, err)
+						return
+					}
+					res, err := r.handlers.GetSchedulerOutages(context, &apiPb.GetSchedulerOutagesRequest{
+						SchedulerId: schedulerID,
+						TimeRange:   timeRange,
+					})
+
+					if err != nil {
+						errWrap(context, http.StatusInternalServerError, err)
+						return
+					}
+					successWrap(context, http.StatusOK, res)
+				})
+
 				//History
 				scheduler.GET("/history", func(context *gin.Context) {
 					schedulerID := context.Param("schedulerId")
@@ -990,6 +1274,51 @@ func (r *router) GetEngine() *gin.Engine {
 				})
 			}
 		}
+		// Secrets can be referenced from checks as {{secret "name"}}, values are never returned
+		secrets := v1.Group("secrets")
+		{
+			secrets.GET("", func(context *gin.Context) {
+				names, err := r.handlers.GetSecretList(context)
+				if err != nil {
+					errWrap(context, http.StatusInternalServerError, err)
+					return
+				}
+				successWrap(context, http.StatusOK, names)
+			})
+			secrets.PUT(":name", func(context *gin.Context) {
+				rq := &Secret{}
+				err := context.ShouldBindJSON(rq)
+				if err != nil {
+					errWrap(context, http.StatusUnprocessableEntity, err)
+					return
+				}
+				err = r.handlers.SetSecret(context, context.Param("name"), rq.Value)
+				if err != nil {
+					errWrap(context, http.StatusInternalServerError, err)
+					return
+				}
+				successWrap(context, http.StatusAccepted, nil)
+			})
+			secrets.DELETE(":name", func(context *gin.Context) {
+				err := r.handlers.RemoveSecret(context, context.Param("name"))
+				if err != nil {
+					errWrap(context, http.StatusInternalServerError, err)
+					return
+				}
+				successWrap(context, http.StatusAccepted, nil)
+			})
+		}
+		monitoring := v1.Group("monitoring")
+		{
+			monitoring.GET("storage", func(context *gin.Context) {
+				res, err := r.handlers.GetStorageStatus(context)
+				if err != nil {
+					errWrap(context, http.StatusInternalServerError, err)
+					return
+				}
+				successWrap(context, http.StatusOK, res)
+			})
+		}
 	}
 
 	return engine
@@ -1050,8 +1379,9 @@ func GetFilters(paginationFilter *PaginationRequest, timeFilter *TimeFilterReque
 		pagination = nil
 	} else {
 		pagination = &apiPb.Pagination{
-			Page:  paginationFilter.Page,
-			Limit: paginationFilter.Limit,
+			Page:   paginationFilter.Page,
+			Limit:  paginationFilter.Limit,
+			Cursor: paginationFilter.Cursor,
 		}
 	}
 
@@ -1079,8 +1409,12 @@ func GetFilters(paginationFilter *PaginationRequest, timeFilter *TimeFilterReque
 	return pagination, nil, nil
 }
 
-func New(handlers handlers.Handlers) Router {
+func New(handlers handlers.Handlers, allowedOrigins []string, maxMetricsWindow time.Duration) Router {
 	return &router{
-		handlers: handlers,
+		handlers:          handlers,
+		exporter:          exporter.New(handlers),
+		allowedOrigins:    allowedOrigins,
+		keepAliveInterval: defaultStreamKeepAliveInterval,
+		maxMetricsWindow:  maxMetricsWindow,
 	}
 }
@@ -4,8 +4,11 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"github.com/squzy/squzy/apps/squzy_api/exporter"
 	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	empty "google.golang.org/protobuf/types/known/emptypb"
 	timestamp "google.golang.org/protobuf/types/known/timestamppb"
 	"io"
@@ -106,10 +109,34 @@ func (m mockOk) DisabledApplicationById(ctx context.Context, id string) (*apiPb.
 	return &apiPb.Application{}, nil
 }
 
+func (m mockOk) SetApplicationApdexThreshold(ctx context.Context, id string, threshold float64) (*apiPb.Application, error) {
+	return &apiPb.Application{}, nil
+}
+
 func (m mockOk) GetSchedulerUptime(ctx context.Context, rq *apiPb.GetSchedulerUptimeRequest) (*apiPb.GetSchedulerUptimeResponse, error) {
 	return &apiPb.GetSchedulerUptimeResponse{}, nil
 }
 
+func (m mockOk) GetSchedulerUptimeSeries(ctx context.Context, rq *apiPb.GetSchedulerUptimeSeriesRequest) (*apiPb.GetSchedulerUptimeSeriesResponse, error) {
+	return &apiPb.GetSchedulerUptimeSeriesResponse{}, nil
+}
+
+func (m mockOk) GetSchedulerOutages(ctx context.Context, rq *apiPb.GetSchedulerOutagesRequest) (*apiPb.GetSchedulerOutagesResponse, error) {
+	return &apiPb.GetSchedulerOutagesResponse{}, nil
+}
+
+func (m mockOk) GetAgentSeries(ctx context.Context, rq *apiPb.GetAgentSeriesRequest) (*apiPb.GetAgentSeriesResponse, error) {
+	return &apiPb.GetAgentSeriesResponse{}, nil
+}
+
+func (m mockOk) Subscribe(ctx context.Context, rq *apiPb.SubscribeRequest) (apiPb.Storage_SubscribeClient, error) {
+	return &subscribeClientMock{
+		events: []*apiPb.StreamEvent{
+			{Type: apiPb.StreamEventType_STREAM_EVENT_SNAPSHOT, OwnerId: "scheduler"},
+		},
+	}, nil
+}
+
 func (m mockOk) GetTransactionGroups(ctx context.Context, req *apiPb.GetTransactionGroupRequest) (*apiPb.GetTransactionGroupResponse, error) {
 	return &apiPb.GetTransactionGroupResponse{}, nil
 }
@@ -130,6 +157,15 @@ func (m mockOk) GetTransactionById(ctx context.Context, id string) (*apiPb.GetTr
 	return &apiPb.GetTransactionByIdResponse{}, nil
 }
 
+func (m mockOk) GetTransactionTree(ctx context.Context, id string) (*apiPb.GetTransactionTreeResponse, error) {
+	return &apiPb.GetTransactionTreeResponse{
+		Root: &apiPb.TransactionTreeNode{
+			Transaction: &apiPb.TransactionInfo{Id: id},
+		},
+		Count: 1,
+	}, nil
+}
+
 func (m mockOk) RegisterApplication(ctx context.Context, rq *apiPb.ApplicationInfo) (*apiPb.InitializeApplicationResponse, error) {
 	return &apiPb.InitializeApplicationResponse{}, nil
 }
@@ -166,6 +202,26 @@ func (m mockOk) RunScheduler(ctx context.Context, id string) error {
 	return nil
 }
 
+func (m mockOk) PingScheduler(ctx context.Context, rq *apiPb.PingRequest) error {
+	return nil
+}
+
+func (m mockOk) GetStorageStatus(ctx context.Context) (*apiPb.GetStorageStatusResponse, error) {
+	return &apiPb.GetStorageStatusResponse{}, nil
+}
+
+func (m mockOk) GetSecretList(ctx context.Context) ([]string, error) {
+	return []string{"token"}, nil
+}
+
+func (m mockOk) SetSecret(ctx context.Context, name string, value string) error {
+	return nil
+}
+
+func (m mockOk) RemoveSecret(ctx context.Context, name string) error {
+	return nil
+}
+
 func (m mockOk) StopScheduler(ctx context.Context, id string) error {
 	return nil
 }
@@ -269,10 +325,30 @@ func (m mockError) DisabledApplicationById(ctx context.Context, id string) (*api
 	return nil, errors.New("")
 }
 
+func (m mockError) SetApplicationApdexThreshold(ctx context.Context, id string, threshold float64) (*apiPb.Application, error) {
+	return nil, errors.New("")
+}
+
 func (m mockError) GetSchedulerUptime(ctx context.Context, rq *apiPb.GetSchedulerUptimeRequest) (*apiPb.GetSchedulerUptimeResponse, error) {
 	return nil, errors.New("")
 }
 
+func (m mockError) GetSchedulerUptimeSeries(ctx context.Context, rq *apiPb.GetSchedulerUptimeSeriesRequest) (*apiPb.GetSchedulerUptimeSeriesResponse, error) {
+	return nil, errors.New("")
+}
+
+func (m mockError) GetSchedulerOutages(ctx context.Context, rq *apiPb.GetSchedulerOutagesRequest) (*apiPb.GetSchedulerOutagesResponse, error) {
+	return nil, errors.New("")
+}
+
+func (m mockError) GetAgentSeries(ctx context.Context, rq *apiPb.GetAgentSeriesRequest) (*apiPb.GetAgentSeriesResponse, error) {
+	return nil, errors.New("")
+}
+
+func (m mockError) Subscribe(ctx context.Context, rq *apiPb.SubscribeRequest) (apiPb.Storage_SubscribeClient, error) {
+	return nil, errors.New("")
+}
+
 func (m mockError) GetTransactionGroups(ctx context.Context, req *apiPb.GetTransactionGroupRequest) (*apiPb.GetTransactionGroupResponse, error) {
 	return nil, errors.New("")
 }
@@ -293,6 +369,10 @@ func (m mockError) GetTransactionById(ctx context.Context, id string) (*apiPb.Ge
 	return nil, errors.New("")
 }
 
+func (m mockError) GetTransactionTree(ctx context.Context, id string) (*apiPb.GetTransactionTreeResponse, error) {
+	return nil, errors.New("")
+}
+
 func (m mockError) RegisterApplication(ctx context.Context, rq *apiPb.ApplicationInfo) (*apiPb.InitializeApplicationResponse, error) {
 	return nil, errors.New("")
 }
@@ -329,6 +409,26 @@ func (m mockError) RunScheduler(ctx context.Context, id string) error {
 	return errors.New("")
 }
 
+func (m mockError) PingScheduler(ctx context.Context, rq *apiPb.PingRequest) error {
+	return errors.New("")
+}
+
+func (m mockError) GetStorageStatus(ctx context.Context) (*apiPb.GetStorageStatusResponse, error) {
+	return nil, errors.New("")
+}
+
+func (m mockError) GetSecretList(ctx context.Context) ([]string, error) {
+	return nil, errors.New("")
+}
+
+func (m mockError) SetSecret(ctx context.Context, name string, value string) error {
+	return errors.New("")
+}
+
+func (m mockError) RemoveSecret(ctx context.Context, name string) error {
+	return errors.New("")
+}
+
 func (m mockError) StopScheduler(ctx context.Context, id string) error {
 	return errors.New("")
 }
@@ -343,19 +443,19 @@ func (m mockError) AddScheduler(ctx context.Context, scheduler *apiPb.AddRequest
 
 func TestNew(t *testing.T) {
 	t.Run("Should: not return error", func(t *testing.T) {
-		r := New(nil)
+		r := New(nil, nil, exporter.DefaultWindow)
 		assert.NotEqual(t, nil, r)
 	})
 }
 
 func TestRouter_GetEngine(t *testing.T) {
 	t.Run("Should: create router without error", func(t *testing.T) {
-		r := New(nil)
+		r := New(nil, nil, exporter.DefaultWindow)
 		engine := r.GetEngine()
 		assert.NotEqual(t, nil, engine)
 	})
 	t.Run("Should: return error with mockError", func(t *testing.T) {
-		r := New(&mockError{}).GetEngine()
+		r := New(&mockError{}, nil, exporter.DefaultWindow).GetEngine()
 		type TestCase struct {
 			Path         string
 			Method       string
@@ -363,6 +463,16 @@ func TestRouter_GetEngine(t *testing.T) {
 			Body         io.Reader
 		}
 		tt := []*TestCase{
+			{
+				Path:         "/metrics",
+				Method:       http.MethodGet,
+				ExpectedCode: http.StatusInternalServerError,
+			},
+			{
+				Path:         "/metrics?window=test",
+				Method:       http.MethodGet,
+				ExpectedCode: http.StatusBadRequest,
+			},
 			{
 				Path:         "/v1/agents",
 				Method:       http.MethodGet,
@@ -398,6 +508,52 @@ func TestRouter_GetEngine(t *testing.T) {
 				Method:       http.MethodPut,
 				ExpectedCode: http.StatusNotFound,
 			},
+			{
+				Path:         "/v1/schedulers/scheduler/ping",
+				Method:       http.MethodGet,
+				ExpectedCode: http.StatusInternalServerError,
+			},
+			{
+				Path:         "/v1/schedulers/scheduler/ping",
+				Method:       http.MethodPost,
+				ExpectedCode: http.StatusInternalServerError,
+			},
+			{
+				Path:         "/v1/schedulers/scheduler/ping/start",
+				Method:       http.MethodGet,
+				ExpectedCode: http.StatusInternalServerError,
+			},
+			{
+				Path:         "/v1/schedulers/scheduler/ping/fail?message=error",
+				Method:       http.MethodPost,
+				ExpectedCode: http.StatusInternalServerError,
+			},
+			{
+				Path:         "/v1/secrets",
+				Method:       http.MethodGet,
+				ExpectedCode: http.StatusInternalServerError,
+			},
+			{
+				Path:         "/v1/monitoring/storage",
+				Method:       http.MethodGet,
+				ExpectedCode: http.StatusInternalServerError,
+			},
+			{
+				Path:         "/v1/secrets/token",
+				Method:       http.MethodPut,
+				ExpectedCode: http.StatusUnprocessableEntity,
+			},
+			{
+				Path:         "/v1/secrets/token",
+				Method:       http.MethodPut,
+				ExpectedCode: http.StatusInternalServerError,
+				Body:         bytes.NewBuffer([]byte(`{"value": "qwerty"}`)),
+			},
+			{
+				Path:         "/v1/secrets/token",
+				Method:       http.MethodDelete,
+				ExpectedCode: http.StatusInternalServerError,
+			},
 			{
 				Path:         "/v1/schedulers",
 				Method:       http.MethodPost,
@@ -459,6 +615,76 @@ func TestRouter_GetEngine(t *testing.T) {
 					`,
 				)),
 			},
+			{
+				Path:         "/v1/schedulers",
+				Method:       http.MethodPost,
+				ExpectedCode: http.StatusUnprocessableEntity,
+				Body: bytes.NewBuffer([]byte(
+					`
+						{
+							"interval": 10,
+							"timeout": 10,
+							"type": 7
+						}
+					`,
+				)),
+			},
+			{
+				Path:         "/v1/schedulers",
+				Method:       http.MethodPost,
+				ExpectedCode: http.StatusUnprocessableEntity,
+				Body: bytes.NewBuffer([]byte(
+					`
+						{
+							"interval": 10,
+							"timeout": 10,
+							"type": 8
+						}
+					`,
+				)),
+			},
+			{
+				Path:         "/v1/schedulers",
+				Method:       http.MethodPost,
+				ExpectedCode: http.StatusUnprocessableEntity,
+				Body: bytes.NewBuffer([]byte(
+					`
+						{
+							"interval": 10,
+							"timeout": 10,
+							"type": 9
+						}
+					`,
+				)),
+			},
+			{
+				Path:         "/v1/schedulers",
+				Method:       http.MethodPost,
+				ExpectedCode: http.StatusUnprocessableEntity,
+				Body: bytes.NewBuffer([]byte(
+					`
+						{
+							"interval": 10,
+							"timeout": 10,
+							"type": 10
+						}
+					`,
+				)),
+			},
+			{
+				Path:         "/v1/schedulers",
+				Method:       http.MethodPost,
+				ExpectedCode: http.StatusUnprocessableEntity,
+				Body: bytes.NewBuffer([]byte(
+					`
+						{
+							"interval": 10,
+							"timeout": 10,
+							"type": 11
+						}
+					`,
+				)),
+			},
 			{
 				Path:         "/v1/schedulers",
 				Method:       http.MethodPost,
@@ -539,6 +765,103 @@ func TestRouter_GetEngine(t *testing.T) {
 					`,
 				)),
 			},
+			{
+				Path:         "/v1/schedulers",
+				Method:       http.MethodPost,
+				ExpectedCode: http.StatusUnprocessableEntity,
+				Body: bytes.NewBuffer([]byte(
+					`
+						{
+							"interval": 10,
+							"timeout": 10,
+							"type": 7,
+							"webSocketConfig": {
+								"url": "ws://localhost",
+								"pattern": "pong"
+							}
+						}
+					`,
+				)),
+			},
+			{
+				Path:         "/v1/schedulers",
+				Method:       http.MethodPost,
+				ExpectedCode: http.StatusUnprocessableEntity,
+				Body: bytes.NewBuffer([]byte(
+					`
+						{
+							"interval": 10,
+							"timeout": 10,
+							"type": 9,
+							"heartbeatConfig": {
+								"grace": 60
+							}
+						}
+					`,
+				)),
+			},
+			{
+				Path:         "/v1/schedulers",
+				Method:       http.MethodPost,
+				ExpectedCode: http.StatusUnprocessableEntity,
+				Body: bytes.NewBuffer([]byte(
+					`
+						{
+							"interval": 10,
+							"timeout": 10,
+							"type": 10,
+							"contentChangeConfig": {
+								"url": "http://localhost",
+								"selectorType": 1,
+								"selector": "#terms"
+							}
+						}
+					`,
+				)),
+			},
+			{
+				Path:         "/v1/schedulers",
+				Method:       http.MethodPost,
+				ExpectedCode: http.StatusUnprocessableEntity,
+				Body: bytes.NewBuffer([]byte(
+					`
+						{
+							"interval": 10,
+							"timeout": 10,
+							"type": 11,
+							"prometheusConfig": {
+								"url": "http://localhost/metrics",
+								"rules": ["http_requests_inflight{job=\"api\"} < 500"]
+							}
+						}
+					`,
+				)),
+			},
+			{
+				Path:         "/v1/schedulers",
+				Method:       http.MethodPost,
+				ExpectedCode: http.StatusUnprocessableEntity,
+				Body: bytes.NewBuffer([]byte(
+					`
+						{
+							"interval": 10,
+							"timeout": 10,
+							"type": 8,
+							"databaseConfig": {
+								"type": 1,
+								"dsn": "postgres://localhost:5432/db",
+								"query": "SELECT count(*) FROM users",
+								"assertions": [
+									{
+										"operator": 3,
+										"value": "0"
+									}
+								]
+							}
+						}
+					`,
+				)),
+			},
 			{
 				Path:         "/v1/schedulers",
 				Method:       http.MethodPost,
@@ -628,6 +951,21 @@ func TestRouter_GetEngine(t *testing.T) {
 				Method:       http.MethodGet,
 				ExpectedCode: http.StatusInternalServerError,
 			},
+			{
+				Path:         "/v1/agents/agent/series?bucket_size=test",
+				Method:       http.MethodGet,
+				ExpectedCode: http.StatusUnprocessableEntity,
+			},
+			{
+				Path:         "/v1/agents/agent/series?dateFrom=12321323&dateTo=12321323",
+				Method:       http.MethodGet,
+				ExpectedCode: http.StatusUnprocessableEntity,
+			},
+			{
+				Path:         "/v1/agents/agent/series?bucket_size=60",
+				Method:       http.MethodGet,
+				ExpectedCode: http.StatusInternalServerError,
+			},
 			{
 				Path:         "/v1/agents/schdeduler/history?dateFrom=2020-05-07T19:17:05.899Z&dateTo=2020-05-17T19:17:05.899Z&page=2&limit=4",
 				Method:       http.MethodGet,
@@ -648,6 +986,31 @@ func TestRouter_GetEngine(t *testing.T) {
 				Method:       http.MethodGet,
 				ExpectedCode: http.StatusUnprocessableEntity,
 			},
+			{
+				Path:         "/v1/schedulers/scheduler/uptime/series",
+				Method:       http.MethodGet,
+				ExpectedCode: http.StatusInternalServerError,
+			},
+			{
+				Path:         "/v1/schedulers/scheduler/uptime/series?dateFrom=12321323&dateTo=12321323",
+				Method:       http.MethodGet,
+				ExpectedCode: http.StatusUnprocessableEntity,
+			},
+			{
+				Path:         "/v1/schedulers/scheduler/uptime/series?resolution=test",
+				Method:       http.MethodGet,
+				ExpectedCode: http.StatusUnprocessableEntity,
+			},
+			{
+				Path:         "/v1/schedulers/scheduler/outages",
+				Method:       http.MethodGet,
+				ExpectedCode: http.StatusInternalServerError,
+			},
+			{
+				Path:         "/v1/schedulers/scheduler/outages?dateFrom=12321323&dateTo=12321323",
+				Method:       http.MethodGet,
+				ExpectedCode: http.StatusUnprocessableEntity,
+			},
 			{
 				Path:         "/v1/applications",
 				Method:       http.MethodGet,
@@ -712,11 +1075,38 @@ func TestRouter_GetEngine(t *testing.T) {
 				Method:       http.MethodGet,
 				ExpectedCode: http.StatusInternalServerError,
 			},
+			{
+				Path:         "/v1/applications/app/transactions/group?apdex_threshold=100",
+				Method:       http.MethodGet,
+				ExpectedCode: http.StatusInternalServerError,
+			},
+			{
+				Path:         "/v1/applications/app/apdex",
+				Method:       http.MethodPut,
+				Body:         bytes.NewBuffer([]byte(`{"threshold": -1}`)),
+				ExpectedCode: http.StatusUnprocessableEntity,
+			},
+			{
+				Path:         "/v1/applications/app/apdex",
+				Method:       http.MethodPut,
+				Body:         bytes.NewBuffer([]byte(`{"threshold": 100}`)),
+				ExpectedCode: http.StatusInternalServerError,
+			},
 			{
 				Path:         "/v1/transaction/trra",
 				Method:       http.MethodGet,
 				ExpectedCode: http.StatusInternalServerError,
 			},
+			{
+				Path:         "/v1/transaction/trra/tree",
+				Method:       http.MethodGet,
+				ExpectedCode: http.StatusInternalServerError,
+			},
+			{
+				Path:         "/v1/transaction/trra/waterfall",
+				Method:       http.MethodGet,
+				ExpectedCode: http.StatusInternalServerError,
+			},
 			{
 				Path:         "/v1/applications/app/transactions",
 				Method:       http.MethodPost,
@@ -1036,7 +1426,7 @@ func TestRouter_GetEngine(t *testing.T) {
 		}
 	})
 	t.Run("Should: return success with mockOk", func(t *testing.T) {
-		r := New(&mockOk{}).GetEngine()
+		r := New(&mockOk{}, nil, exporter.DefaultWindow).GetEngine()
 		type TestCase struct {
 			Path         string
 			Method       string
@@ -1044,6 +1434,11 @@ func TestRouter_GetEngine(t *testing.T) {
 			Body         io.Reader
 		}
 		tt := []*TestCase{
+			{
+				Path:         "/metrics?window=1h",
+				Method:       http.MethodGet,
+				ExpectedCode: http.StatusOK,
+			},
 			{
 				Path:         "/v1/agents",
 				Method:       http.MethodGet,
@@ -1079,6 +1474,47 @@ func TestRouter_GetEngine(t *testing.T) {
 				Method:       http.MethodPut,
 				ExpectedCode: http.StatusAccepted,
 			},
+			{
+				Path:         "/v1/schedulers/scheduler/ping",
+				Method:       http.MethodGet,
+				ExpectedCode: http.StatusOK,
+			},
+			{
+				Path:         "/v1/schedulers/scheduler/ping",
+				Method:       http.MethodPost,
+				ExpectedCode: http.StatusOK,
+			},
+			{
+				Path:         "/v1/schedulers/scheduler/ping/start",
+				Method:       http.MethodGet,
+				ExpectedCode: http.StatusOK,
+			},
+			{
+				Path:         "/v1/schedulers/scheduler/ping/fail?message=error",
+				Method:       http.MethodPost,
+				ExpectedCode: http.StatusOK,
+			},
+			{
+				Path:         "/v1/secrets",
+				Method:       http.MethodGet,
+				ExpectedCode: http.StatusOK,
+			},
+			{
+				Path:         "/v1/monitoring/storage",
+				Method:       http.MethodGet,
+				ExpectedCode: http.StatusOK,
+			},
+			{
+				Path:         "/v1/secrets/token",
+				Method:       http.MethodPut,
+				ExpectedCode: http.StatusAccepted,
+				Body:         bytes.NewBuffer([]byte(`{"value": "qwerty"}`)),
+			},
+			{
+				Path:         "/v1/secrets/token",
+				Method:       http.MethodDelete,
+				ExpectedCode: http.StatusAccepted,
+			},
 			{
 				Path:         "/v1/schedulers",
 				Method:       http.MethodPost,
@@ -1115,6 +1551,103 @@ func TestRouter_GetEngine(t *testing.T) {
 					`,
 				)),
 			},
+			{
+				Path:         "/v1/schedulers",
+				Method:       http.MethodPost,
+				ExpectedCode: http.StatusCreated,
+				Body: bytes.NewBuffer([]byte(
+					`
+						{
+							"interval": 10,
+							"timeout": 10,
+							"type": 7,
+							"webSocketConfig": {
+								"url": "ws://localhost",
+								"pattern": "pong"
+							}
+						}
+					`,
+				)),
+			},
+			{
+				Path:         "/v1/schedulers",
+				Method:       http.MethodPost,
+				ExpectedCode: http.StatusCreated,
+				Body: bytes.NewBuffer([]byte(
+					`
+						{
+							"interval": 10,
+							"timeout": 10,
+							"type": 9,
+							"heartbeatConfig": {
+								"grace": 60
+							}
+						}
+					`,
+				)),
+			},
+			{
+				Path:         "/v1/schedulers",
+				Method:       http.MethodPost,
+				ExpectedCode: http.StatusCreated,
+				Body: bytes.NewBuffer([]byte(
+					`
+						{
+							"interval": 10,
+							"timeout": 10,
+							"type": 10,
+							"contentChangeConfig": {
+								"url": "http://localhost",
+								"selectorType": 1,
+								"selector": "#terms"
+							}
+						}
+					`,
+				)),
+			},
+			{
+				Path:         "/v1/schedulers",
+				Method:       http.MethodPost,
+				ExpectedCode: http.StatusCreated,
+				Body: bytes.NewBuffer([]byte(
+					`
+						{
+							"interval": 10,
+							"timeout": 10,
+							"type": 11,
+							"prometheusConfig": {
+								"url": "http://localhost/metrics",
+								"rules": ["http_requests_inflight{job=\"api\"} < 500"]
+							}
+						}
+					`,
+				)),
+			},
+			{
+				Path:         "/v1/schedulers",
+				Method:       http.MethodPost,
+				ExpectedCode: http.StatusCreated,
+				Body: bytes.NewBuffer([]byte(
+					`
+						{
+							"interval": 10,
+							"timeout": 10,
+							"type": 8,
+							"databaseConfig": {
+								"type": 1,
+								"dsn": "postgres://localhost:5432/db",
+								"query": "SELECT count(*) FROM users",
+								"assertions": [
+									{
+										"operator": 3,
+										"value": "0"
+									}
+								]
+							}
+						}
+					`,
+				)),
+			},
 			{
 				Path:         "/v1/schedulers",
 				Method:       http.MethodPost,
@@ -1186,6 +1719,11 @@ func TestRouter_GetEngine(t *testing.T) {
 				Method:       http.MethodGet,
 				ExpectedCode: http.StatusOK,
 			},
+			{
+				Path:         "/v1/agents/agent/series?dateFrom=2020-05-07T19:17:05.899Z&dateTo=2020-05-17T19:17:05.899Z&bucket_size=60",
+				Method:       http.MethodGet,
+				ExpectedCode: http.StatusOK,
+			},
 			{
 				Path:         "/v1/agents/schdeduler/history?dateFrom=2020-05-17T19:17:05.899Z&dateTo=2020-05-17T19:17:05.899Z&page=2&limit=4",
 				Method:       http.MethodGet,
@@ -1201,11 +1739,32 @@ func TestRouter_GetEngine(t *testing.T) {
 				Method:       http.MethodGet,
 				ExpectedCode: http.StatusOK,
 			},
+			{
+				Path:         "/v1/applications/app/transactions/group?apdex_threshold=100",
+				Method:       http.MethodGet,
+				ExpectedCode: http.StatusOK,
+			},
+			{
+				Path:         "/v1/applications/app/apdex",
+				Method:       http.MethodPut,
+				Body:         bytes.NewBuffer([]byte(`{"threshold": 100}`)),
+				ExpectedCode: http.StatusAccepted,
+			},
 			{
 				Path:         "/v1/schedulers/scheduler/uptime",
 				Method:       http.MethodGet,
 				ExpectedCode: http.StatusOK,
 			},
+			{
+				Path:         "/v1/schedulers/scheduler/uptime/series?dateFrom=2020-05-07T19:17:05.899Z&dateTo=2020-05-17T19:17:05.899Z&resolution=2",
+				Method:       http.MethodGet,
+				ExpectedCode: http.StatusOK,
+			},
+			{
+				Path:         "/v1/schedulers/scheduler/outages?dateFrom=2020-05-07T19:17:05.899Z&dateTo=2020-05-17T19:17:05.899Z",
+				Method:       http.MethodGet,
+				ExpectedCode: http.StatusOK,
+			},
 			{
 				Path:         "/v1/applications/app/transactions",
 				Method:       http.MethodPost,
@@ -1269,6 +1828,16 @@ func TestRouter_GetEngine(t *testing.T) {
 				Method:       http.MethodGet,
 				ExpectedCode: http.StatusOK,
 			},
+			{
+				Path:         "/v1/transaction/trra/tree",
+				Method:       http.MethodGet,
+				ExpectedCode: http.StatusOK,
+			},
+			{
+				Path:         "/v1/transaction/trra/waterfall",
+				Method:       http.MethodGet,
+				ExpectedCode: http.StatusOK,
+			},
 			{
 				Path:         "/v1/applications/app/enabled",
 				Method:       http.MethodPut,
@@ -1442,6 +2011,80 @@ func TestRouter_GetEngine(t *testing.T) {
 	})
 }
 
+type mockApplicationNotFound struct {
+	mockOk
+	request *apiPb.GetTransactionGroupRequest
+}
+
+func (m *mockApplicationNotFound) GetApplicationById(ctx context.Context, id string) (*apiPb.Application, error) {
+	return nil, errors.New("")
+}
+
+func (m *mockApplicationNotFound) GetTransactionGroups(ctx context.Context, req *apiPb.GetTransactionGroupRequest) (*apiPb.GetTransactionGroupResponse, error) {
+	m.request = req
+	return &apiPb.GetTransactionGroupResponse{}, nil
+}
+
+func TestRouter_TransactionGroups(t *testing.T) {
+	t.Run("Should: use default apdex threshold if application is not found", func(t *testing.T) {
+		handlers := &mockApplicationNotFound{}
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/v1/applications/app/transactions/group", nil)
+		New(handlers, nil, exporter.DefaultWindow).GetEngine().ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, float64(0), handlers.request.GetApdexThreshold())
+	})
+}
+
+type exporterMock struct {
+	window time.Duration
+}
+
+func (m *exporterMock) Write(ctx context.Context, w io.Writer, window time.Duration) error {
+	m.window = window
+	return nil
+}
+
+func TestRouter_Metrics(t *testing.T) {
+	tt := []struct {
+		path   string
+		window time.Duration
+	}{
+		{"/metrics", exporter.DefaultWindow},
+		{"/metrics?window=1h30m", time.Hour + time.Minute*30},
+		{"/metrics?window=1h0m0.5s", time.Hour},
+		{"/metrics?window=100000h", time.Hour * 24 * 7},
+	}
+	for _, test := range tt {
+		t.Run("Should: limit metrics window "+test.path, func(t *testing.T) {
+			mock := &exporterMock{}
+			r := New(&mockOk{}, nil, time.Hour*24*7).(*router)
+			r.exporter = mock
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, test.path, nil)
+			r.GetEngine().ServeHTTP(w, req)
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, test.window, mock.window)
+		})
+	}
+	t.Run("Should: reject window shorter than second", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/metrics?window=10ms", nil)
+		New(&mockOk{}, nil, exporter.DefaultWindow).GetEngine().ServeHTTP(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestGetPingErrorStatus(t *testing.T) {
+	t.Run("Should: map grpc codes to http statuses", func(t *testing.T) {
+		assert.Equal(t, http.StatusNotFound, getPingErrorStatus(status.Error(codes.NotFound, "")))
+		assert.Equal(t, http.StatusBadRequest, getPingErrorStatus(status.Error(codes.InvalidArgument, "")))
+		assert.Equal(t, http.StatusConflict, getPingErrorStatus(status.Error(codes.FailedPrecondition, "")))
+		assert.Equal(t, http.StatusServiceUnavailable, getPingErrorStatus(status.Error(codes.Unavailable, "")))
+		assert.Equal(t, http.StatusInternalServerError, getPingErrorStatus(errors.New("")))
+	})
+}
+
 func TestGetStringValueFromString(t *testing.T) {
 	t.Run("Should: return nil", func(t *testing.T) {
 		assert.Nil(t, GetStringValueFromString(""))
@@ -1523,6 +2166,15 @@ func TestGetFilters(t *testing.T) {
 		assert.Equal(t, res, tF.To)
 		assert.Equal(t, res, tF.From)
 	})
+	t.Run("Should: pass cursor", func(t *testing.T) {
+		r, _, err := GetFilters(&PaginationRequest{
+			Limit:  24,
+			Cursor: "cursor",
+		}, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, int32(24), r.Limit)
+		assert.Equal(t, "cursor", r.Cursor)
+	})
 	t.Run("Should: parse time correct", func(t *testing.T) {
 		r, tf, err := GetFilters(&PaginationRequest{
 			Page:  2,
@@ -0,0 +1,180 @@
+package router
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	streamEventName     = "message"
+	streamKeepAliveBody = ": keepalive\n\n"
+	anyOrigin           = "*"
+
+	// Proxies usually close connections which are idle for a minute
+	defaultStreamKeepAliveInterval = time.Second * 30
+)
+
+type StreamRequest struct {
+	Types    []apiPb.StreamEventType `form:"types"`
+	OwnerIds []string                `form:"owner_ids"`
+}
+
+// Events are sent as WebSocket messages if connection asks for upgrade, otherwise as server-sent events
+func (r *router) streamHandler(c *gin.Context) {
+	rq := &StreamRequest{}
+	err := c.ShouldBind(rq)
+	if err != nil {
+		errWrap(c, http.StatusBadRequest, err)
+		return
+	}
+	subscribeRq := &apiPb.SubscribeRequest{
+		Types:    rq.Types,
+		OwnerIds: rq.OwnerIds,
+	}
+	if websocket.IsWebSocketUpgrade(c.Request) {
+		r.webSocketStream(c, subscribeRq)
+		return
+	}
+	r.serverSentEventsStream(c, subscribeRq)
+}
+
+// Requests without origin and from the same host are always allowed, as by default websocket check
+func (r *router) isOriginAllowed(req *http.Request) bool {
+	origin := req.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	if u, err := url.Parse(origin); err == nil && strings.EqualFold(u.Host, req.Host) {
+		return true
+	}
+	for _, allowed := range r.allowedOrigins {
+		if allowed == anyOrigin || strings.EqualFold(allowed, origin) {
+			return true
+		}
+	}
+	return false
+}
+
+// Events are received by separate goroutine, so keepalive could be sent while there are no events,
+// goroutine stops when stream is ended or context is cancelled
+func receiveEvents(ctx context.Context, events apiPb.Storage_SubscribeClient) <-chan *apiPb.StreamEvent {
+	ch := make(chan *apiPb.StreamEvent)
+	go func() {
+		defer close(ch)
+		for {
+			event, err := events.Recv()
+			if err != nil {
+				return
+			}
+			select {
+			case ch <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+func (r *router) serverSentEventsStream(c *gin.Context, rq *apiPb.SubscribeRequest) {
+	if !r.isOriginAllowed(c.Request) {
+		c.AbortWithStatus(http.StatusForbidden)
+		return
+	}
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer cancel()
+	events, err := r.handlers.Subscribe(ctx, rq)
+	if err != nil {
+		errWrap(c, http.StatusInternalServerError, err)
+		return
+	}
+	if origin := c.GetHeader("Origin"); origin != "" {
+		c.Header("Access-Control-Allow-Origin", origin)
+		c.Header("Vary", "Origin")
+	}
+	received := receiveEvents(ctx, events)
+	ticker := time.NewTicker(r.keepAliveInterval)
+	defer ticker.Stop()
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case event, ok := <-received:
+			if !ok {
+				return false
+			}
+			c.SSEvent(streamEventName, event)
+			return true
+		case <-ticker.C:
+			// Comment is ignored by clients, it only keeps connection alive
+			_, err := io.WriteString(w, streamKeepAliveBody)
+			return err == nil
+		case <-ctx.Done():
+			return false
+		}
+	})
+}
+
+func (r *router) webSocketStream(c *gin.Context, rq *apiPb.SubscribeRequest) {
+	upgrader := websocket.Upgrader{
+		CheckOrigin: r.isOriginAllowed,
+	}
+	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		// Upgrader already replied with error
+		return
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer cancel()
+	// Client should answer every ping, so connection is closed if nothing was read for two intervals
+	pongWait := r.keepAliveInterval * 2
+	_ = conn.SetReadDeadline(time.Now().Add(pongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+	// Messages from client are not expected, reading is needed to notice that connection was closed
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	events, err := r.handlers.Subscribe(ctx, rq)
+	if err != nil {
+		_ = conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseInternalServerErr, err.Error()))
+		return
+	}
+	received := receiveEvents(ctx, events)
+	ticker := time.NewTicker(r.keepAliveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case event, ok := <-received:
+			if !ok {
+				_ = conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+				return
+			}
+			if err := conn.WriteJSON(event); err != nil {
+				return
+			}
+		case <-ticker.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(r.keepAliveInterval)); err != nil {
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package router
+
+import (
+	"bufio"
+	"context"
+	"github.com/gorilla/websocket"
+	"github.com/squzy/squzy/apps/squzy_api/exporter"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type subscribeClientMock struct {
+	grpc.ClientStream
+	events []*apiPb.StreamEvent
+}
+
+func (m *subscribeClientMock) Recv() (*apiPb.StreamEvent, error) {
+	if len(m.events) == 0 {
+		return nil, io.EOF
+	}
+	event := m.events[0]
+	m.events = m.events[1:]
+	return event, nil
+}
+
+// Stream without events, which is ended only by client
+type idleSubscribeClientMock struct {
+	grpc.ClientStream
+	ctx context.Context
+}
+
+func (m *idleSubscribeClientMock) Recv() (*apiPb.StreamEvent, error) {
+	<-m.ctx.Done()
+	return nil, m.ctx.Err()
+}
+
+type mockIdle struct {
+	mockOk
+}
+
+func (m mockIdle) Subscribe(ctx context.Context, rq *apiPb.SubscribeRequest) (apiPb.Storage_SubscribeClient, error) {
+	return &idleSubscribeClientMock{ctx: ctx}, nil
+}
+
+func TestRouter_Stream(t *testing.T) {
+	t.Run("Should: send server-sent events", func(t *testing.T) {
+		ts := httptest.NewServer(New(&mockOk{}, nil, exporter.DefaultWindow).GetEngine())
+		defer ts.Close()
+		resp, err := http.Get(ts.URL + "/v1/stream?types=1&owner_ids=scheduler")
+		assert.NoError(t, err)
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+		body, err := io.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+		assert.True(t, strings.HasPrefix(string(body), "event:message\n"))
+		assert.Contains(t, string(body), `"owner_id":"scheduler"`)
+	})
+	t.Run("Should: return error if request is invalid", func(t *testing.T) {
+		engine := New(&mockOk{}, nil, exporter.DefaultWindow).GetEngine()
+		req, _ := http.NewRequest(http.MethodGet, "/v1/stream?types=test", nil)
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+	t.Run("Should: return error if subscription failed", func(t *testing.T) {
+		engine := New(&mockError{}, nil, exporter.DefaultWindow).GetEngine()
+		req, _ := http.NewRequest(http.MethodGet, "/v1/stream", nil)
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+	t.Run("Should: send events over websocket", func(t *testing.T) {
+		ts := httptest.NewServer(New(&mockOk{}, nil, exporter.DefaultWindow).GetEngine())
+		defer ts.Close()
+		conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(ts.URL, "http")+"/v1/stream", nil)
+		assert.NoError(t, err)
+		defer func() {
+			_ = conn.Close()
+		}()
+		event := &apiPb.StreamEvent{}
+		assert.NoError(t, conn.ReadJSON(event))
+		assert.Equal(t, "scheduler", event.GetOwnerId())
+		_, _, err = conn.ReadMessage()
+		assert.True(t, websocket.IsCloseError(err, websocket.CloseNormalClosure))
+	})
+	t.Run("Should: close websocket if subscription failed", func(t *testing.T) {
+		ts := httptest.NewServer(New(&mockError{}, nil, exporter.DefaultWindow).GetEngine())
+		defer ts.Close()
+		conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(ts.URL, "http")+"/v1/stream", nil)
+		assert.NoError(t, err)
+		defer func() {
+			_ = conn.Close()
+		}()
+		_, _, err = conn.ReadMessage()
+		assert.True(t, websocket.IsCloseError(err, websocket.CloseInternalServerErr))
+	})
+	t.Run("Should: reject websocket from not allowed origin", func(t *testing.T) {
+		ts := httptest.NewServer(New(&mockOk{}, []string{"https://dashboard.com"}, exporter.DefaultWindow).GetEngine())
+		defer ts.Close()
+		_, resp, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(ts.URL, "http")+"/v1/stream", http.Header{"Origin": {"https://other.com"}})
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+	t.Run("Should: accept websocket from allowed origin", func(t *testing.T) {
+		ts := httptest.NewServer(New(&mockOk{}, []string{"https://dashboard.com"}, exporter.DefaultWindow).GetEngine())
+		defer ts.Close()
+		conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(ts.URL, "http")+"/v1/stream", http.Header{"Origin": {"https://dashboard.com"}})
+		assert.NoError(t, err)
+		_ = conn.Close()
+	})
+	t.Run("Should: allow server-sent events for allowed origin", func(t *testing.T) {
+		ts := httptest.NewServer(New(&mockOk{}, []string{"*"}, exporter.DefaultWindow).GetEngine())
+		defer ts.Close()
+		req, _ := http.NewRequest(http.MethodGet, ts.URL+"/v1/stream", nil)
+		req.Header.Set("Origin", "https://dashboard.com")
+		resp, err := http.DefaultClient.Do(req)
+		assert.NoError(t, err)
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "https://dashboard.com", resp.Header.Get("Access-Control-Allow-Origin"))
+	})
+	t.Run("Should: reject server-sent events from not allowed origin", func(t *testing.T) {
+		engine := New(&mockOk{}, nil, exporter.DefaultWindow).GetEngine()
+		req, _ := http.NewRequest(http.MethodGet, "/v1/stream", nil)
+		req.Header.Set("Origin", "https://dashboard.com")
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
+func newIdleRouter() Router {
+	r := New(&mockIdle{}, nil, exporter.DefaultWindow).(*router)
+	r.keepAliveInterval = time.Millisecond * 10
+	return r
+}
+
+func TestRouter_StreamKeepAlive(t *testing.T) {
+	t.Run("Should: send keepalive comment", func(t *testing.T) {
+		ts := httptest.NewServer(newIdleRouter().GetEngine())
+		defer ts.Close()
+		resp, err := http.Get(ts.URL + "/v1/stream")
+		assert.NoError(t, err)
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+		line, err := bufio.NewReader(resp.Body).ReadString('\n')
+		assert.NoError(t, err)
+		assert.Equal(t, ": keepalive\n", line)
+	})
+	t.Run("Should: send websocket ping", func(t *testing.T) {
+		ts := httptest.NewServer(newIdleRouter().GetEngine())
+		defer ts.Close()
+		conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(ts.URL, "http")+"/v1/stream", nil)
+		assert.NoError(t, err)
+		defer func() {
+			_ = conn.Close()
+		}()
+		pinged := make(chan struct{}, 1)
+		conn.SetPingHandler(func(string) error {
+			select {
+			case pinged <- struct{}{}:
+			default:
+			}
+			return nil
+		})
+		go func() {
+			for {
+				if _, _, err := conn.NextReader(); err != nil {
+					return
+				}
+			}
+		}()
+		select {
+		case <-pinged:
+		case <-time.After(time.Second):
+			assert.Fail(t, "ping was not received")
+		}
+	})
+}
@@ -0,0 +1,79 @@
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"net/http"
+)
+
+// Times are in nanoseconds, start and width are parts of the whole trace duration from 0 to 1
+type WaterfallRow struct {
+	Transaction *apiPb.TransactionInfo `json:"transaction"`
+	Offset      int64                  `json:"offset"`
+	Duration    int64                  `json:"duration"`
+	SelfTime    int64                  `json:"self_time"`
+	Depth       int32                  `json:"depth"`
+	Start       float64                `json:"start"`
+	Width       float64                `json:"width"`
+}
+
+// Rows are ordered depth-first, so every row follows its parent and previous siblings
+type Waterfall struct {
+	Duration int64           `json:"duration"`
+	Count    int32           `json:"count"`
+	Rows     []*WaterfallRow `json:"rows"`
+}
+
+func (r *router) transactionTreeHandler(c *gin.Context) {
+	res, err := r.handlers.GetTransactionTree(c, c.Param("transaction_id"))
+	if err != nil {
+		errWrap(c, http.StatusInternalServerError, err)
+		return
+	}
+	if res.GetRoot() == nil {
+		errWrap(c, http.StatusNotFound, errTransactionNotFound)
+		return
+	}
+	successWrap(c, http.StatusOK, res)
+}
+
+func (r *router) transactionWaterfallHandler(c *gin.Context) {
+	res, err := r.handlers.GetTransactionTree(c, c.Param("transaction_id"))
+	if err != nil {
+		errWrap(c, http.StatusInternalServerError, err)
+		return
+	}
+	if res.GetRoot() == nil {
+		errWrap(c, http.StatusNotFound, errTransactionNotFound)
+		return
+	}
+	successWrap(c, http.StatusOK, newWaterfall(res))
+}
+
+func newWaterfall(tree *apiPb.GetTransactionTreeResponse) *Waterfall {
+	waterfall := &Waterfall{
+		Duration: tree.GetDuration(),
+		Count:    tree.GetCount(),
+		Rows:     make([]*WaterfallRow, 0, tree.GetCount()),
+	}
+	appendWaterfallRows(waterfall, tree.GetRoot())
+	return waterfall
+}
+
+func appendWaterfallRows(waterfall *Waterfall, node *apiPb.TransactionTreeNode) {
+	row := &WaterfallRow{
+		Transaction: node.GetTransaction(),
+		Offset:      node.GetOffset(),
+		Duration:    node.GetDuration(),
+		SelfTime:    node.GetSelfTime(),
+		Depth:       node.GetDepth(),
+	}
+	if waterfall.Duration > 0 {
+		row.Start = float64(node.GetOffset()) / float64(waterfall.Duration)
+		row.Width = float64(node.GetDuration()) / float64(waterfall.Duration)
+	}
+	waterfall.Rows = append(waterfall.Rows, row)
+	for _, child := range node.GetChildren() {
+		appendWaterfallRows(waterfall, child)
+	}
+}
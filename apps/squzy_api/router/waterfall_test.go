@@ -0,0 +1,84 @@
+package router
+
+import (
+	"context"
+	"github.com/squzy/squzy/apps/squzy_api/exporter"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type mockEmptyTree struct {
+	mockOk
+}
+
+func (m mockEmptyTree) GetTransactionTree(ctx context.Context, id string) (*apiPb.GetTransactionTreeResponse, error) {
+	return &apiPb.GetTransactionTreeResponse{}, nil
+}
+
+func TestNewWaterfall(t *testing.T) {
+	t.Run("Should: return rows depth-first", func(t *testing.T) {
+		res := newWaterfall(&apiPb.GetTransactionTreeResponse{
+			Root: &apiPb.TransactionTreeNode{
+				Transaction: &apiPb.TransactionInfo{Id: "1"},
+				Duration:    100,
+				SelfTime:    40,
+				Children: []*apiPb.TransactionTreeNode{
+					{
+						Transaction: &apiPb.TransactionInfo{Id: "2"},
+						Offset:      10,
+						Duration:    50,
+						Depth:       1,
+						Children: []*apiPb.TransactionTreeNode{
+							{
+								Transaction: &apiPb.TransactionInfo{Id: "3"},
+								Offset:      20,
+								Duration:    10,
+								Depth:       2,
+							},
+						},
+					},
+					{
+						Transaction: &apiPb.TransactionInfo{Id: "4"},
+						Offset:      60,
+						Duration:    10,
+						Depth:       1,
+					},
+				},
+			},
+			Duration: 100,
+			Count:    4,
+		})
+		assert.Equal(t, int64(100), res.Duration)
+		assert.Len(t, res.Rows, 4)
+		for i, id := range []string{"1", "2", "3", "4"} {
+			assert.Equal(t, id, res.Rows[i].Transaction.GetId())
+		}
+		assert.Equal(t, int64(40), res.Rows[0].SelfTime)
+		assert.Equal(t, int32(2), res.Rows[2].Depth)
+		assert.Equal(t, 0.1, res.Rows[1].Start)
+		assert.Equal(t, 0.5, res.Rows[1].Width)
+	})
+	t.Run("Should: not divide by zero duration", func(t *testing.T) {
+		res := newWaterfall(&apiPb.GetTransactionTreeResponse{
+			Root:  &apiPb.TransactionTreeNode{},
+			Count: 1,
+		})
+		assert.Len(t, res.Rows, 1)
+		assert.Equal(t, float64(0), res.Rows[0].Width)
+	})
+}
+
+func TestRouter_TransactionTree(t *testing.T) {
+	for _, path := range []string{"/v1/transaction/trra/tree", "/v1/transaction/trra/waterfall"} {
+		t.Run("Should: return not found for "+path, func(t *testing.T) {
+			engine := New(&mockEmptyTree{}, nil, exporter.DefaultWindow).GetEngine()
+			req, _ := http.NewRequest(http.MethodGet, path, nil)
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, req)
+			assert.Equal(t, http.StatusNotFound, w.Code)
+		})
+	}
+}
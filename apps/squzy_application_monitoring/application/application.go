@@ -4,21 +4,31 @@ import (
 	"fmt"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
+	"github.com/squzy/squzy/apps/squzy_application_monitoring/otlp"
+	"github.com/squzy/squzy/apps/squzy_application_monitoring/server"
+	"github.com/squzy/squzy/apps/squzy_application_monitoring/zipkin"
 	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	collectorTracePb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
 	"google.golang.org/grpc"
+	// OpenTelemetry exporters compress requests by gzip
+	_ "google.golang.org/grpc/encoding/gzip"
 	"net"
+	"net/http"
 )
 
 type app struct {
-	server apiPb.ApplicationMonitoringServer
+	server   server.Server
+	receiver otlp.Receiver
 }
 
-func New(server apiPb.ApplicationMonitoringServer) *app {
+func New(server server.Server, receiver otlp.Receiver) *app {
 	return &app{
-		server: server,
+		server:   server,
+		receiver: receiver,
 	}
 }
 
+// OTLP/gRPC traces are received on the same port as squzy api
 func (a *app) Run(port int32) error {
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
 	if err != nil {
@@ -35,5 +45,20 @@ func (a *app) Run(port int32) error {
 		),
 	)
 	apiPb.RegisterApplicationMonitoringServer(grpcServer, a.server)
+	if a.receiver != nil {
+		collectorTracePb.RegisterTraceServiceServer(grpcServer, a.receiver)
+	}
 	return grpcServer.Serve(lis)
 }
+
+// OTLP/HTTP traces and Zipkin v2 spans are received on the same port
+func (a *app) RunHTTP(port int32) error {
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return err
+	}
+	mux := http.NewServeMux()
+	mux.Handle(otlp.TracesPath, a.receiver)
+	mux.Handle(zipkin.SpansPath, zipkin.New(a.server))
+	return http.Serve(lis, mux)
+}
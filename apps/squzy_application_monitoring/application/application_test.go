@@ -4,8 +4,11 @@ import (
 	"context"
 	empty "google.golang.org/protobuf/types/known/emptypb"
 	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/squzy/squzy/apps/squzy_application_monitoring/otlp"
 	"github.com/stretchr/testify/assert"
 	"net"
+	"net/http"
+	"strings"
 	"testing"
 	"time"
 )
@@ -45,16 +48,24 @@ func (m mock) GetApplicationList(ctx context.Context, empty *empty.Empty) (*apiP
 	panic("implement me")
 }
 
+func (m mock) SetApplicationApdexThreshold(ctx context.Context, request *apiPb.ApplicationApdexThresholdRequest) (*apiPb.Application, error) {
+	panic("implement me")
+}
+
+func (m mock) SaveTransactions(ctx context.Context, applicationId string, transactions []*apiPb.TransactionInfo) error {
+	return nil
+}
+
 func TestNew(t *testing.T) {
 	t.Run("Should: not be nil", func(t *testing.T) {
-		s := New(nil)
+		s := New(nil, nil)
 		assert.NotEqual(t, nil, s)
 	})
 }
 
 func TestApp_Run(t *testing.T) {
 	t.Run("Should: not return error", func(t *testing.T) {
-		app := New(&mock{})
+		app := New(&mock{}, nil)
 		go func() {
 			_ = app.Run(11102)
 		}()
@@ -63,7 +74,29 @@ func TestApp_Run(t *testing.T) {
 		assert.Equal(t, nil, err)
 	})
 	t.Run("Should: return error", func(t *testing.T) {
-		app := New(nil)
+		app := New(nil, nil)
 		assert.NotEqual(t, nil, app.Run(1231323))
 	})
 }
+
+func TestApp_RunHTTP(t *testing.T) {
+	t.Run("Should: receive traces", func(t *testing.T) {
+		app := New(&mock{}, otlp.New(&mock{}))
+		go func() {
+			_ = app.RunHTTP(11103)
+		}()
+		time.Sleep(time.Second)
+		resp, err := http.Post("http://localhost:11103/v1/traces", "application/json", strings.NewReader("{}"))
+		assert.Equal(t, nil, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		_ = resp.Body.Close()
+		resp, err = http.Post("http://localhost:11103/api/v2/spans", "application/json", strings.NewReader("[]"))
+		assert.Equal(t, nil, err)
+		assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+		_ = resp.Body.Close()
+	})
+	t.Run("Should: return error", func(t *testing.T) {
+		app := New(nil, nil)
+		assert.NotEqual(t, nil, app.RunHTTP(1231323))
+	})
+}
@@ -15,9 +15,11 @@ const (
 	ENV_MONGO_COLLECTION = "MONGO_COLLECTION"
 	ENV_STORAGE_HOST     = "SQUZY_STORAGE_HOST"
 	ENV_STORAGE_TIMEOUT  = "SQUZY_STORAGE_TIMEOUT"
+	ENV_HTTP_PORT        = "HTTP_PORT"
 
 	defaultTracingHeader        = "Squzy_transaction"
 	defaultPort           int32 = 9095
+	defaultHttpPort       int32 = 4318
 	defaultMongoDb              = "applications_monitoring"
 	defaultCollection           = "application"
 	defaultStorageTimeout       = time.Second * 5
@@ -26,6 +28,8 @@ const (
 type Config interface {
 	GetTracingHeader() string
 	GetPort() int32
+	// Port of OTLP/HTTP receiver
+	GetHttpPort() int32
 	GetMongoURI() string
 	GetMongoDb() string
 	GetMongoCollection() string
@@ -36,6 +40,7 @@ type Config interface {
 type cfg struct {
 	tracingHeader   string
 	port            int32
+	httpPort        int32
 	timeout         time.Duration
 	storageAddress  string
 	mongoURI        string
@@ -51,6 +56,10 @@ func (c *cfg) GetPort() int32 {
 	return c.port
 }
 
+func (c *cfg) GetHttpPort() int32 {
+	return c.httpPort
+}
+
 func (c *cfg) GetMongoURI() string {
 	return c.mongoURI
 }
@@ -86,6 +95,15 @@ func New() Config {
 		}
 	}
 
+	httpPortValue := os.Getenv(ENV_HTTP_PORT)
+	httpPort := defaultHttpPort
+	if httpPortValue != "" {
+		i, err := strconv.ParseInt(httpPortValue, 10, 32)
+		if err == nil {
+			httpPort = int32(i)
+		}
+	}
+
 	mongoDb := os.Getenv(ENV_MONGO_DB)
 	if mongoDb == "" {
 		mongoDb = defaultMongoDb
@@ -107,6 +125,7 @@ func New() Config {
 	return &cfg{
 		tracingHeader:   header,
 		port:            port,
+		httpPort:        httpPort,
 		mongoURI:        os.Getenv(ENV_MONGO_URI),
 		mongoDb:         mongoDb,
 		mongoCollection: collection,
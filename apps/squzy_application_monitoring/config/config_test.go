@@ -11,6 +11,7 @@ func TestNew(t *testing.T) {
 	t.Run("Shoud: return default value", func(t *testing.T) {
 		s := New()
 		assert.Equal(t, s.GetPort(), defaultPort)
+		assert.Equal(t, s.GetHttpPort(), defaultHttpPort)
 		assert.Equal(t, s.GetStorageHost(), "")
 		assert.Equal(t, s.GetMongoURI(), "")
 		assert.Equal(t, s.GetMongoDb(), defaultMongoDb)
@@ -28,6 +29,14 @@ func TestCfg_GetPort(t *testing.T) {
 	})
 }
 
+func TestCfg_GetHttpPort(t *testing.T) {
+	t.Run("Should: return from env", func(t *testing.T) {
+		os.Setenv(ENV_HTTP_PORT, "11125")
+		s := New()
+		assert.Equal(t, s.GetHttpPort(), int32(11125))
+	})
+}
+
 func TestCfg_GetStorageHost(t *testing.T) {
 	t.Run("Should: return from env", func(t *testing.T) {
 		os.Setenv(ENV_STORAGE_HOST, "11124")
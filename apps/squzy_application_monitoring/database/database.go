@@ -16,6 +16,8 @@ type Application struct {
 	Host    string                  `bson:"host,omitempty"`
 	Status  apiPb.ApplicationStatus `bson:"status"`
 	AgentId string                  `bson:"agentId,omitempty"`
+	// Milliseconds
+	ApdexThreshold float64 `bson:"apdexThreshold,omitempty"`
 }
 
 type Database interface {
@@ -25,6 +27,7 @@ type Database interface {
 	FindAllApplication(ctx context.Context) ([]*Application, error)
 	FindApplicationByAgentId(ctx context.Context, agentId primitive.ObjectID) ([]*Application, error)
 	SetStatus(ctx context.Context, id primitive.ObjectID, status apiPb.ApplicationStatus) error
+	SetApdexThreshold(ctx context.Context, id primitive.ObjectID, threshold float64) error
 }
 
 type db struct {
@@ -72,6 +75,17 @@ func (d *db) SetStatus(ctx context.Context, id primitive.ObjectID, status apiPb.
 	return err
 }
 
+func (d *db) SetApdexThreshold(ctx context.Context, id primitive.ObjectID, threshold float64) error {
+	_, err := d.connector.UpdateOne(ctx, bson.M{
+		"_id": id,
+	}, bson.M{
+		"$set": bson.M{
+			"apdexThreshold": threshold,
+		},
+	})
+	return err
+}
+
 func (d *db) FindApplicationById(ctx context.Context, id primitive.ObjectID) (*Application, error) {
 	app, err := d.findApplication(ctx, bson.M{
 		"_id": bson.M{
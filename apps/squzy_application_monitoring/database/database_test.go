@@ -262,3 +262,16 @@ func TestDb_SetStatus(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 }
+
+func TestDb_SetApdexThreshold(t *testing.T) {
+	t.Run("Should: return nil", func(t *testing.T) {
+		s := New(&mockOk{})
+		err := s.SetApdexThreshold(context.Background(), primitive.NewObjectID(), 100)
+		assert.Nil(t, err)
+	})
+	t.Run("Should: return error because error when db update", func(t *testing.T) {
+		s := New(&mockOkStatusDisabledUpdateError{})
+		err := s.SetApdexThreshold(context.Background(), primitive.NewObjectID(), 100)
+		assert.NotNil(t, err)
+	})
+}
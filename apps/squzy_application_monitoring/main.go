@@ -6,6 +6,7 @@ import (
 	"github.com/squzy/squzy/apps/squzy_application_monitoring/application"
 	"github.com/squzy/squzy/apps/squzy_application_monitoring/config"
 	"github.com/squzy/squzy/apps/squzy_application_monitoring/database"
+	"github.com/squzy/squzy/apps/squzy_application_monitoring/otlp"
 	"github.com/squzy/squzy/apps/squzy_application_monitoring/server"
 	_ "github.com/squzy/squzy/apps/squzy_application_monitoring/version"
 	"github.com/squzy/squzy/internal/grpctools"
@@ -44,5 +45,10 @@ func main() {
 	}()
 	connector := mongo_helper.New(client.Database(cfg.GetMongoDb()).Collection(cfg.GetMongoCollection()))
 
-	logger.Fatal(application.New(server.New(database.New(connector), cfg, storageClient)).Run(cfg.GetPort()).Error())
+	monitoringServer := server.New(database.New(connector), cfg, storageClient)
+	app := application.New(monitoringServer, otlp.New(monitoringServer))
+	go func() {
+		logger.Fatal(app.RunHTTP(cfg.GetHttpPort()).Error())
+	}()
+	logger.Fatal(app.Run(cfg.GetPort()).Error())
 }
@@ -0,0 +1,86 @@
+package otlp
+
+import (
+	"encoding/hex"
+	"fmt"
+	"github.com/squzy/squzy/apps/squzy_application_monitoring/tracing"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	commonPb "go.opentelemetry.io/proto/otlp/common/v1"
+	tracePb "go.opentelemetry.io/proto/otlp/trace/v1"
+	timestamp "google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+const (
+	// https://opentelemetry.io/docs/reference/specification/resource/semantic_conventions/#service
+	defaultServiceName = "unknown_service"
+)
+
+var (
+	serviceNameKeys = []string{"service.name"}
+	hostNameKeys    = []string{"host.name"}
+
+	kinds = map[tracePb.Span_SpanKind]tracing.Kind{
+		tracePb.Span_SPAN_KIND_SERVER: tracing.KindServer,
+		tracePb.Span_SPAN_KIND_CLIENT: tracing.KindClient,
+	}
+)
+
+func newAttributes(kvs []*commonPb.KeyValue) tracing.Attributes {
+	attrs := tracing.Attributes{}
+	for _, kv := range kvs {
+		if value := anyValueToString(kv.GetValue()); value != "" {
+			attrs[kv.GetKey()] = value
+		}
+	}
+	return attrs
+}
+
+func anyValueToString(value *commonPb.AnyValue) string {
+	switch v := value.GetValue().(type) {
+	case *commonPb.AnyValue_StringValue:
+		return v.StringValue
+	case *commonPb.AnyValue_IntValue:
+		return fmt.Sprint(v.IntValue)
+	case *commonPb.AnyValue_DoubleValue:
+		return fmt.Sprint(v.DoubleValue)
+	case *commonPb.AnyValue_BoolValue:
+		return fmt.Sprint(v.BoolValue)
+	}
+	return ""
+}
+
+// Application is initialized by service.name and host.name of span resource
+func convertToApplicationInfo(resourceSpans *tracePb.ResourceSpans) *apiPb.ApplicationInfo {
+	attrs := newAttributes(resourceSpans.GetResource().GetAttributes())
+	name := attrs.First(serviceNameKeys)
+	if name == "" {
+		name = defaultServiceName
+	}
+	return &apiPb.ApplicationInfo{
+		Name:     name,
+		HostName: attrs.First(hostNameKeys),
+	}
+}
+
+func convertToTransactionInfo(applicationId string, span *tracePb.Span) *apiPb.TransactionInfo {
+	attrs := newAttributes(span.GetAttributes())
+	transaction := &apiPb.TransactionInfo{
+		Id:            hex.EncodeToString(span.GetSpanId()),
+		ApplicationId: applicationId,
+		ParentId:      hex.EncodeToString(span.GetParentSpanId()),
+		Name:          span.GetName(),
+		StartTime:     timestamp.New(time.Unix(0, int64(span.GetStartTimeUnixNano()))),
+		EndTime:       timestamp.New(time.Unix(0, int64(span.GetEndTimeUnixNano()))),
+		Status:        apiPb.TransactionStatus_TRANSACTION_SUCCESSFUL,
+		Type:          tracing.GetTransactionType(kinds[span.GetKind()], attrs),
+		Meta:          tracing.GetTransactionMeta(attrs),
+	}
+	if span.GetStatus().GetCode() == tracePb.Status_STATUS_CODE_ERROR {
+		transaction.Status = apiPb.TransactionStatus_TRANSACTION_FAILED
+		transaction.Error = &apiPb.TransactionInfo_Error{
+			Message: span.GetStatus().GetMessage(),
+		}
+	}
+	return transaction
+}
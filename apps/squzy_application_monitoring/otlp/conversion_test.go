@@ -0,0 +1,86 @@
+package otlp
+
+import (
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/stretchr/testify/assert"
+	commonPb "go.opentelemetry.io/proto/otlp/common/v1"
+	resourcePb "go.opentelemetry.io/proto/otlp/resource/v1"
+	tracePb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"testing"
+)
+
+func stringAttribute(key, value string) *commonPb.KeyValue {
+	return &commonPb.KeyValue{
+		Key:   key,
+		Value: &commonPb.AnyValue{Value: &commonPb.AnyValue_StringValue{StringValue: value}},
+	}
+}
+
+func TestConvertToApplicationInfo(t *testing.T) {
+	t.Run("Should: use service and host names", func(t *testing.T) {
+		info := convertToApplicationInfo(&tracePb.ResourceSpans{
+			Resource: &resourcePb.Resource{
+				Attributes: []*commonPb.KeyValue{
+					stringAttribute("service.name", "checkout"),
+					stringAttribute("host.name", "node-1"),
+				},
+			},
+		})
+		assert.Equal(t, "checkout", info.GetName())
+		assert.Equal(t, "node-1", info.GetHostName())
+	})
+	t.Run("Should: use default service name", func(t *testing.T) {
+		info := convertToApplicationInfo(&tracePb.ResourceSpans{})
+		assert.Equal(t, defaultServiceName, info.GetName())
+	})
+}
+
+func TestConvertToTransactionInfo(t *testing.T) {
+	t.Run("Should: convert http client span", func(t *testing.T) {
+		transaction := convertToTransactionInfo("app", &tracePb.Span{
+			SpanId:            []byte{0, 1, 2, 3, 4, 5, 6, 7},
+			ParentSpanId:      []byte{7, 6, 5, 4, 3, 2, 1, 0},
+			Name:              "GET",
+			Kind:              tracePb.Span_SPAN_KIND_CLIENT,
+			StartTimeUnixNano: 1e9,
+			EndTimeUnixNano:   2e9,
+			Attributes: []*commonPb.KeyValue{
+				stringAttribute("http.method", "GET"),
+				stringAttribute("http.url", "https://squzy.app/api/users?page=1"),
+				{Key: "http.status_code", Value: &commonPb.AnyValue{Value: &commonPb.AnyValue_IntValue{IntValue: 200}}},
+			},
+		})
+		assert.Equal(t, "0001020304050607", transaction.GetId())
+		assert.Equal(t, "0706050403020100", transaction.GetParentId())
+		assert.Equal(t, "app", transaction.GetApplicationId())
+		assert.Equal(t, "GET", transaction.GetName())
+		assert.Equal(t, int64(1), transaction.GetStartTime().GetSeconds())
+		assert.Equal(t, int64(2), transaction.GetEndTime().GetSeconds())
+		assert.Equal(t, apiPb.TransactionStatus_TRANSACTION_SUCCESSFUL, transaction.GetStatus())
+		assert.Equal(t, apiPb.TransactionType_TRANSACTION_TYPE_HTTP, transaction.GetType())
+		assert.Equal(t, "squzy.app", transaction.GetMeta().GetHost())
+		assert.Equal(t, "/api/users", transaction.GetMeta().GetPath())
+		assert.Equal(t, "GET", transaction.GetMeta().GetMethod())
+		assert.Nil(t, transaction.GetError())
+	})
+	t.Run("Should: convert failed root span", func(t *testing.T) {
+		transaction := convertToTransactionInfo("app", &tracePb.Span{
+			SpanId: []byte{1},
+			Kind:   tracePb.Span_SPAN_KIND_SERVER,
+			Status: &tracePb.Status{Code: tracePb.Status_STATUS_CODE_ERROR, Message: "timeout"},
+			Attributes: []*commonPb.KeyValue{
+				stringAttribute("http.target", "/orders?id=1"),
+			},
+		})
+		assert.Equal(t, "", transaction.GetParentId())
+		assert.Equal(t, apiPb.TransactionStatus_TRANSACTION_FAILED, transaction.GetStatus())
+		assert.Equal(t, "timeout", transaction.GetError().GetMessage())
+		assert.Equal(t, apiPb.TransactionType_TRANSACTION_TYPE_ROUTER, transaction.GetType())
+		assert.Equal(t, "/orders", transaction.GetMeta().GetPath())
+	})
+	t.Run("Should: not set meta without attributes", func(t *testing.T) {
+		transaction := convertToTransactionInfo("app", &tracePb.Span{})
+		assert.Nil(t, transaction.GetMeta())
+		assert.Equal(t, apiPb.TransactionType_TRANSACTION_TYPE_INTERNAL, transaction.GetType())
+	})
+}
@@ -0,0 +1,197 @@
+package otlp
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/squzy/squzy/apps/squzy_application_monitoring/tracing"
+	"github.com/squzy/squzy/internal/logger"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	collectorTracePb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"net/http"
+	"strings"
+)
+
+const (
+	TracesPath = "/v1/traces"
+
+	contentTypeProtobuf = "application/x-protobuf"
+	contentTypeJSON     = "application/json"
+)
+
+var (
+	errInvalidJSON = errors.New("invalid character after top-level value")
+
+	// OTLP/JSON encodes ids as hex instead of base64, which is used by protojson for bytes
+	jsonIdKeys = map[string]bool{
+		"traceId":      true,
+		"spanId":       true,
+		"parentSpanId": true,
+	}
+)
+
+// Receiver accepts OpenTelemetry traces over OTLP/gRPC and OTLP/HTTP and saves spans as transactions
+type Receiver interface {
+	collectorTracePb.TraceServiceServer
+	http.Handler
+}
+
+type receiver struct {
+	collectorTracePb.UnimplementedTraceServiceServer
+	monitoring tracing.Monitoring
+}
+
+func New(monitoring tracing.Monitoring) Receiver {
+	return &receiver{
+		monitoring: monitoring,
+	}
+}
+
+// Errors of monitoring are errors of database. Failed initialization of application is reported as unavailable
+// and retried by exporter, spans which could not be saved are only logged, as zipkin receiver does
+func (r *receiver) Export(ctx context.Context, request *collectorTracePb.ExportTraceServiceRequest) (*collectorTracePb.ExportTraceServiceResponse, error) {
+	applicationIds := make([]string, len(request.GetResourceSpans()))
+	for i, resourceSpans := range request.GetResourceSpans() {
+		count := 0
+		for _, scopeSpans := range resourceSpans.GetScopeSpans() {
+			count += len(scopeSpans.GetSpans())
+		}
+		if count == 0 {
+			continue
+		}
+		application, err := r.monitoring.InitializeApplication(ctx, convertToApplicationInfo(resourceSpans))
+		if err != nil {
+			return nil, status.Error(codes.Unavailable, err.Error())
+		}
+		applicationIds[i] = application.GetApplicationId()
+	}
+	failed, total := 0, 0
+	var lastErr error
+	for i, resourceSpans := range request.GetResourceSpans() {
+		var transactions []*apiPb.TransactionInfo
+		for _, scopeSpans := range resourceSpans.GetScopeSpans() {
+			for _, span := range scopeSpans.GetSpans() {
+				transactions = append(transactions, convertToTransactionInfo(applicationIds[i], span))
+			}
+		}
+		if len(transactions) == 0 {
+			continue
+		}
+		total += len(transactions)
+		err := r.monitoring.SaveTransactions(ctx, applicationIds[i], transactions)
+		if err != nil {
+			failed += len(transactions)
+			lastErr = err
+		}
+	}
+	if failed > 0 {
+		logger.Errorf("%d of %d otlp spans were not saved: %s", failed, total, lastErr.Error())
+	}
+	return &collectorTracePb.ExportTraceServiceResponse{}, nil
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	contentType := strings.TrimSpace(strings.Split(req.Header.Get("Content-Type"), ";")[0])
+	if contentType != contentTypeProtobuf && contentType != contentTypeJSON {
+		http.Error(w, http.StatusText(http.StatusUnsupportedMediaType), http.StatusUnsupportedMediaType)
+		return
+	}
+
+	body, err := tracing.ReadBody(w, req)
+	if err != nil {
+		tracing.WriteBodyError(w, err)
+		return
+	}
+
+	request := &collectorTracePb.ExportTraceServiceRequest{}
+	if contentType == contentTypeJSON {
+		err = unmarshalJSON(body, request)
+	} else {
+		err = proto.Unmarshal(body, request)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// OTLP/HTTP exporters retry requests on 503, it is returned only if nothing was saved
+	response, err := r.Export(req.Context(), request)
+	if err != nil {
+		http.Error(w, status.Convert(err).Message(), http.StatusServiceUnavailable)
+		return
+	}
+	var data []byte
+	if contentType == contentTypeJSON {
+		data, err = protojson.Marshal(response)
+	} else {
+		data, err = proto.Marshal(response)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
+
+// Numbers are kept as they are, int64 timestamps in nanoseconds lose precision as float64
+func unmarshalJSON(body []byte, request *collectorTracePb.ExportTraceServiceRequest) error {
+	var raw interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	err := decoder.Decode(&raw)
+	if err != nil {
+		return err
+	}
+	if decoder.More() {
+		return errInvalidJSON
+	}
+	err = hexIdsToBase64(raw)
+	if err != nil {
+		return err
+	}
+	body, err = json.Marshal(raw)
+	if err != nil {
+		return err
+	}
+	return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(body, request)
+}
+
+func hexIdsToBase64(value interface{}) error {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if id, ok := item.(string); ok && jsonIdKeys[key] {
+				decoded, err := hex.DecodeString(id)
+				if err != nil {
+					return fmt.Errorf("invalid %s: %s", key, err.Error())
+				}
+				v[key] = base64.StdEncoding.EncodeToString(decoded)
+				continue
+			}
+			if err := hexIdsToBase64(item); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			if err := hexIdsToBase64(item); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
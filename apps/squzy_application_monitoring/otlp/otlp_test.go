@@ -0,0 +1,184 @@
+package otlp
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	collectorTracePb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonPb "go.opentelemetry.io/proto/otlp/common/v1"
+	resourcePb "go.opentelemetry.io/proto/otlp/resource/v1"
+	tracePb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type monitoringMock struct {
+	initErr      error
+	saveErr      error
+	saves        int
+	applications []*apiPb.ApplicationInfo
+	transactions []*apiPb.TransactionInfo
+}
+
+func (m *monitoringMock) InitializeApplication(ctx context.Context, info *apiPb.ApplicationInfo) (*apiPb.InitializeApplicationResponse, error) {
+	if m.initErr != nil {
+		return nil, m.initErr
+	}
+	m.applications = append(m.applications, info)
+	return &apiPb.InitializeApplicationResponse{ApplicationId: info.GetName() + "_id"}, nil
+}
+
+func (m *monitoringMock) SaveTransactions(ctx context.Context, applicationId string, transactions []*apiPb.TransactionInfo) error {
+	m.saves++
+	if m.saveErr != nil {
+		return m.saveErr
+	}
+	m.transactions = append(m.transactions, transactions...)
+	return nil
+}
+
+func exportRequest() *collectorTracePb.ExportTraceServiceRequest {
+	return &collectorTracePb.ExportTraceServiceRequest{
+		ResourceSpans: []*tracePb.ResourceSpans{
+			{
+				Resource: &resourcePb.Resource{
+					Attributes: []*commonPb.KeyValue{stringAttribute("service.name", "checkout")},
+				},
+				ScopeSpans: []*tracePb.ScopeSpans{
+					{Spans: []*tracePb.Span{{SpanId: []byte{1}}, {SpanId: []byte{2}, ParentSpanId: []byte{1}}}},
+				},
+			},
+			{
+				Resource: &resourcePb.Resource{
+					Attributes: []*commonPb.KeyValue{stringAttribute("service.name", "empty")},
+				},
+			},
+		},
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Run("Should: create receiver", func(t *testing.T) {
+		assert.NotNil(t, New(&monitoringMock{}))
+	})
+}
+
+func TestReceiver_Export(t *testing.T) {
+	t.Run("Should: save spans as transactions of application", func(t *testing.T) {
+		monitoring := &monitoringMock{}
+		response, err := New(monitoring).Export(context.Background(), exportRequest())
+		assert.NoError(t, err)
+		assert.Nil(t, response.GetPartialSuccess())
+		assert.Len(t, monitoring.applications, 1)
+		assert.Equal(t, 1, monitoring.saves)
+		assert.Len(t, monitoring.transactions, 2)
+		assert.Equal(t, "checkout_id", monitoring.transactions[1].GetApplicationId())
+		assert.Equal(t, "01", monitoring.transactions[1].GetParentId())
+	})
+	t.Run("Should: return unavailable if application was not initialized", func(t *testing.T) {
+		monitoring := &monitoringMock{initErr: errors.New("init")}
+		_, err := New(monitoring).Export(context.Background(), exportRequest())
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Equal(t, "init", status.Convert(err).Message())
+		assert.Empty(t, monitoring.transactions)
+	})
+	t.Run("Should: not ask to retry if spans were not saved", func(t *testing.T) {
+		_, err := New(&monitoringMock{saveErr: errors.New("save")}).Export(context.Background(), exportRequest())
+		assert.NoError(t, err)
+	})
+}
+
+func TestReceiver_ServeHTTP(t *testing.T) {
+	t.Run("Should: accept protobuf", func(t *testing.T) {
+		monitoring := &monitoringMock{}
+		body, _ := proto.Marshal(exportRequest())
+		req := httptest.NewRequest(http.MethodPost, TracesPath, bytes.NewReader(body))
+		req.Header.Set("Content-Type", contentTypeProtobuf)
+		w := httptest.NewRecorder()
+		New(monitoring).ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, contentTypeProtobuf, w.Header().Get("Content-Type"))
+		assert.NoError(t, proto.Unmarshal(w.Body.Bytes(), &collectorTracePb.ExportTraceServiceResponse{}))
+		assert.Len(t, monitoring.transactions, 2)
+	})
+	t.Run("Should: accept gzipped json with hex ids", func(t *testing.T) {
+		monitoring := &monitoringMock{}
+		buf := &bytes.Buffer{}
+		gz := gzip.NewWriter(buf)
+		_, _ = gz.Write([]byte(`{"resourceSpans":[{"scopeSpans":[{"spans":[
+			{"traceId":"5b8efff798038103d269b633813fc60c","spanId":"eee19b7ec3c1b174","parentSpanId":"eee19b7ec3c1b173",
+			"name":"query","kind":3,"startTimeUnixNano":"1544712660000000000","endTimeUnixNano":"1544712661000000000",
+			"attributes":[{"key":"db.system","value":{"stringValue":"mysql"}}],"status":{"code":2}}]}]}]}`))
+		_ = gz.Close()
+		req := httptest.NewRequest(http.MethodPost, TracesPath, buf)
+		req.Header.Set("Content-Type", "application/json; charset=utf-8")
+		req.Header.Set("Content-Encoding", "gzip")
+		w := httptest.NewRecorder()
+		New(monitoring).ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "{}", w.Body.String())
+		assert.Len(t, monitoring.transactions, 1)
+		transaction := monitoring.transactions[0]
+		assert.Equal(t, defaultServiceName+"_id", transaction.GetApplicationId())
+		assert.Equal(t, "eee19b7ec3c1b174", transaction.GetId())
+		assert.Equal(t, "eee19b7ec3c1b173", transaction.GetParentId())
+		assert.Equal(t, apiPb.TransactionType_TRANSACTION_TYPE_DB, transaction.GetType())
+		assert.Equal(t, apiPb.TransactionStatus_TRANSACTION_FAILED, transaction.GetStatus())
+		assert.Equal(t, int64(1544712661), transaction.GetEndTime().GetSeconds())
+	})
+	t.Run("Should: keep precision of numeric timestamps", func(t *testing.T) {
+		monitoring := &monitoringMock{}
+		req := httptest.NewRequest(http.MethodPost, TracesPath, strings.NewReader(`{"resourceSpans":[{"scopeSpans":[{"spans":[
+			{"spanId":"eee19b7ec3c1b174","startTimeUnixNano":1544712660000000001,"endTimeUnixNano":1544712661000000001}]}]}]}`))
+		req.Header.Set("Content-Type", contentTypeJSON)
+		w := httptest.NewRecorder()
+		New(monitoring).ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+		require.Len(t, monitoring.transactions, 1)
+		assert.Equal(t, int32(1), monitoring.transactions[0].GetStartTime().GetNanos())
+		assert.Equal(t, int32(1), monitoring.transactions[0].GetEndTime().GetNanos())
+	})
+	t.Run("Should: return service unavailable if application was not initialized", func(t *testing.T) {
+		body, _ := proto.Marshal(exportRequest())
+		req := httptest.NewRequest(http.MethodPost, TracesPath, bytes.NewReader(body))
+		req.Header.Set("Content-Type", contentTypeProtobuf)
+		w := httptest.NewRecorder()
+		New(&monitoringMock{initErr: errors.New("init")}).ServeHTTP(w, req)
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	})
+	t.Run("Should: return errors", func(t *testing.T) {
+		type testCase struct {
+			method      string
+			contentType string
+			encoding    string
+			body        string
+			code        int
+		}
+		for _, c := range []testCase{
+			{http.MethodGet, contentTypeJSON, "", "{}", http.StatusMethodNotAllowed},
+			{http.MethodPost, "text/plain", "", "{}", http.StatusUnsupportedMediaType},
+			{http.MethodPost, contentTypeJSON, "gzip", "{}", http.StatusBadRequest},
+			{http.MethodPost, contentTypeJSON, "", "{", http.StatusBadRequest},
+			{http.MethodPost, contentTypeJSON, "", "{}{}", http.StatusBadRequest},
+			{http.MethodPost, contentTypeJSON, "", `{"resourceSpans":[{"scopeSpans":[{"spans":[{"spanId":"zz"}]}]}]}`, http.StatusBadRequest},
+			{http.MethodPost, contentTypeJSON, "", `{"resourceSpans":1}`, http.StatusBadRequest},
+			{http.MethodPost, contentTypeProtobuf, "", "{", http.StatusBadRequest},
+		} {
+			req := httptest.NewRequest(c.method, TracesPath, strings.NewReader(c.body))
+			req.Header.Set("Content-Type", c.contentType)
+			req.Header.Set("Content-Encoding", c.encoding)
+			w := httptest.NewRecorder()
+			New(&monitoringMock{}).ServeHTTP(w, req)
+			assert.Equal(t, c.code, w.Code, c.body)
+		}
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"github.com/squzy/squzy/apps/squzy_application_monitoring/config"
 	"github.com/squzy/squzy/apps/squzy_application_monitoring/database"
+	"github.com/squzy/squzy/apps/squzy_application_monitoring/tracing"
 	"github.com/squzy/squzy/internal/helpers"
 )
 
@@ -79,12 +80,35 @@ func (s *server) DisableApplicationById(ctx context.Context, request *apiPb.Appl
 	return s.updateStatus(ctx, applicationId, apiPb.ApplicationStatus_APPLICATION_STATUS_DISABLED)
 }
 
+func (s *server) SetApplicationApdexThreshold(ctx context.Context, request *apiPb.ApplicationApdexThresholdRequest) (*apiPb.Application, error) {
+	applicationId, err := primitive.ObjectIDFromHex(request.ApplicationId)
+	if err != nil {
+		return nil, err
+	}
+	if request.ApdexThreshold < 0 {
+		return nil, errNegativeApdexThreshold
+	}
+
+	err = s.db.SetApdexThreshold(ctx, applicationId, request.ApdexThreshold)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := s.db.FindApplicationById(ctx, applicationId)
+	if err != nil {
+		return nil, err
+	}
+
+	return transformDbApplication(app), nil
+}
+
 func transformDbApplication(dbApp *database.Application) *apiPb.Application {
 	return &apiPb.Application{
-		Id:       dbApp.Id.Hex(),
-		Name:     dbApp.Name,
-		HostName: dbApp.Host,
-		Status:   dbApp.Status,
+		Id:             dbApp.Id.Hex(),
+		Name:           dbApp.Name,
+		HostName:       dbApp.Host,
+		Status:         dbApp.Status,
+		ApdexThreshold: dbApp.ApdexThreshold,
 	}
 }
 
@@ -120,7 +144,8 @@ func (s *server) GetApplicationList(ctx context.Context, e *empty.Empty) (*apiPb
 }
 
 var (
-	errMissingName = errors.New("missing application name")
+	errMissingName            = errors.New("missing application name")
+	errNegativeApdexThreshold = errors.New("apdex threshold can not be negative")
 )
 
 func (s *server) InitializeApplication(ctx context.Context, req *apiPb.ApplicationInfo) (*apiPb.InitializeApplicationResponse, error) {
@@ -139,29 +164,44 @@ func (s *server) InitializeApplication(ctx context.Context, req *apiPb.Applicati
 }
 
 func (s *server) SaveTransaction(ctx context.Context, req *apiPb.TransactionInfo) (*empty.Empty, error) {
-	applicationId, err := primitive.ObjectIDFromHex(req.ApplicationId)
+	err := s.SaveTransactions(ctx, req.ApplicationId, []*apiPb.TransactionInfo{req})
 	if err != nil {
 		return nil, err
 	}
+	return &empty.Empty{}, nil
+}
+
+func (s *server) SaveTransactions(ctx context.Context, applicationIdHex string, transactions []*apiPb.TransactionInfo) error {
+	applicationId, err := primitive.ObjectIDFromHex(applicationIdHex)
+	if err != nil {
+		return err
+	}
 
 	app, err := s.db.FindApplicationById(ctx, applicationId)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// We not collect stats if not enabled
 	if app.Status != apiPb.ApplicationStatus_APPLICATION_STATUS_ENABLED {
-		return &empty.Empty{}, nil
+		return nil
 	}
 
-	reqCtx, cancel := helpers.TimeoutContext(context.Background(), s.config.GetStorageTimeout())
-	defer cancel()
-	_, _ = s.storage.SaveTransaction(reqCtx, req)
+	for _, transaction := range transactions {
+		reqCtx, cancel := helpers.TimeoutContext(context.Background(), s.config.GetStorageTimeout())
+		_, _ = s.storage.SaveTransaction(reqCtx, transaction)
+		cancel()
+	}
+	return nil
+}
 
-	return &empty.Empty{}, nil
+// Server is also used by span receivers, which save transactions of application by one status lookup
+type Server interface {
+	apiPb.ApplicationMonitoringServer
+	tracing.Monitoring
 }
 
-func New(db database.Database, config config.Config, storage apiPb.StorageClient) apiPb.ApplicationMonitoringServer {
+func New(db database.Database, config config.Config, storage apiPb.StorageClient) Server {
 	return &server{
 		db:      db,
 		config:  config,
@@ -72,6 +72,10 @@ func (d dbMockOkEnabled) SetStatus(ctx context.Context, id primitive.ObjectID, s
 	panic("implement me")
 }
 
+func (d dbMockOkEnabled) SetApdexThreshold(ctx context.Context, id primitive.ObjectID, threshold float64) error {
+	panic("implement me")
+}
+
 func (d dbMockFindError) FindOrCreate(ctx context.Context, name string, host string, agentId string) (*database.Application, error) {
 	panic("implement me")
 }
@@ -92,10 +96,18 @@ func (d dbMockFindError) SetStatus(ctx context.Context, id primitive.ObjectID, s
 	return nil
 }
 
+func (d dbMockFindError) SetApdexThreshold(ctx context.Context, id primitive.ObjectID, threshold float64) error {
+	return nil
+}
+
 func (m mockStorage) SaveResponseFromScheduler(ctx context.Context, in *apiPb.SchedulerResponse, opts ...grpc.CallOption) (*empty.Empty, error) {
 	panic("implement me")
 }
 
+func (m mockStorage) SaveResponsesFromScheduler(ctx context.Context, in *apiPb.SchedulerResponseBatch, opts ...grpc.CallOption) (*empty.Empty, error) {
+	panic("implement me")
+}
+
 func (m mockStorage) SaveResponseFromAgent(ctx context.Context, in *apiPb.Metric, opts ...grpc.CallOption) (*empty.Empty, error) {
 	panic("implement me")
 }
@@ -112,6 +124,22 @@ func (m mockStorage) GetSchedulerUptime(ctx context.Context, in *apiPb.GetSchedu
 	panic("implement me")
 }
 
+func (m mockStorage) GetSchedulerUptimeSeries(ctx context.Context, in *apiPb.GetSchedulerUptimeSeriesRequest, opts ...grpc.CallOption) (*apiPb.GetSchedulerUptimeSeriesResponse, error) {
+	panic("implement me")
+}
+
+func (m mockStorage) GetSchedulerOutages(ctx context.Context, in *apiPb.GetSchedulerOutagesRequest, opts ...grpc.CallOption) (*apiPb.GetSchedulerOutagesResponse, error) {
+	panic("implement me")
+}
+
+func (m mockStorage) GetAgentSeries(ctx context.Context, in *apiPb.GetAgentSeriesRequest, opts ...grpc.CallOption) (*apiPb.GetAgentSeriesResponse, error) {
+	panic("implement me")
+}
+
+func (m mockStorage) Subscribe(ctx context.Context, in *apiPb.SubscribeRequest, opts ...grpc.CallOption) (apiPb.Storage_SubscribeClient, error) {
+	panic("implement me")
+}
+
 func (m mockStorage) GetAgentInformation(ctx context.Context, in *apiPb.GetAgentInformationRequest, opts ...grpc.CallOption) (*apiPb.GetAgentInformationResponse, error) {
 	panic("implement me")
 }
@@ -128,6 +156,10 @@ func (m mockStorage) GetTransactionById(ctx context.Context, in *apiPb.GetTransa
 	panic("implement me")
 }
 
+func (m mockStorage) GetTransactionTree(ctx context.Context, in *apiPb.GetTransactionByIdRequest, opts ...grpc.CallOption) (*apiPb.GetTransactionTreeResponse, error) {
+	panic("implement me")
+}
+
 type mockCfg struct {
 }
 
@@ -139,6 +171,10 @@ func (m mockCfg) GetPort() int32 {
 	panic("implement me")
 }
 
+func (m mockCfg) GetHttpPort() int32 {
+	panic("implement me")
+}
+
 func (m mockCfg) GetMongoURI() string {
 	panic("implement me")
 }
@@ -170,6 +206,10 @@ func (d dbMockError) SetStatus(ctx context.Context, id primitive.ObjectID, statu
 	return errors.New("")
 }
 
+func (d dbMockError) SetApdexThreshold(ctx context.Context, id primitive.ObjectID, threshold float64) error {
+	return errors.New("")
+}
+
 func (d dbMockError) FindOrCreate(ctx context.Context, name string, host string, agentId string) (*database.Application, error) {
 	return nil, errors.New("as")
 }
@@ -199,6 +239,10 @@ func (d dbMockOk) SetStatus(ctx context.Context, id primitive.ObjectID, status a
 	return nil
 }
 
+func (d dbMockOk) SetApdexThreshold(ctx context.Context, id primitive.ObjectID, threshold float64) error {
+	return nil
+}
+
 func (d dbMockOk) FindOrCreate(ctx context.Context, name string, host string, agentId string) (*database.Application, error) {
 	return &database.Application{}, nil
 }
@@ -357,6 +401,50 @@ func TestServer_SaveTransaction(t *testing.T) {
 	})
 }
 
+type dbMockCounting struct {
+	dbMockOkEnabled
+	finds int
+}
+
+func (d *dbMockCounting) FindApplicationById(ctx context.Context, id primitive.ObjectID) (*database.Application, error) {
+	d.finds++
+	return d.dbMockOkEnabled.FindApplicationById(ctx, id)
+}
+
+type mockStorageCounting struct {
+	mockStorage
+	saved int
+}
+
+func (m *mockStorageCounting) SaveTransaction(ctx context.Context, in *apiPb.TransactionInfo, opts ...grpc.CallOption) (*empty.Empty, error) {
+	m.saved++
+	return &empty.Empty{}, nil
+}
+
+func TestServer_SaveTransactions(t *testing.T) {
+	t.Run("Should: read application once for all transactions", func(t *testing.T) {
+		db := &dbMockCounting{}
+		storage := &mockStorageCounting{}
+		s := New(db, &mockCfg{}, storage)
+		err := s.SaveTransactions(context.Background(), primitive.NewObjectID().Hex(), []*apiPb.TransactionInfo{{}, {}, {}})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, db.finds)
+		assert.Equal(t, 3, storage.saved)
+	})
+	t.Run("Should: not send to storage if application is not enabled", func(t *testing.T) {
+		storage := &mockStorageCounting{}
+		s := New(&dbMockOk{}, &mockCfg{}, storage)
+		err := s.SaveTransactions(context.Background(), primitive.NewObjectID().Hex(), []*apiPb.TransactionInfo{{}, {}})
+		assert.Nil(t, err)
+		assert.Equal(t, 0, storage.saved)
+	})
+	t.Run("Should: return error database", func(t *testing.T) {
+		s := New(&dbMockError{}, &mockCfg{}, &mockStorage{})
+		err := s.SaveTransactions(context.Background(), primitive.NewObjectID().Hex(), []*apiPb.TransactionInfo{{}})
+		assert.NotNil(t, err)
+	})
+}
+
 func TestServer_ArchiveApplicationById(t *testing.T) {
 	t.Run("Should: return application without error", func(t *testing.T) {
 		s := New(&dbMockOk{}, nil, nil)
@@ -436,6 +524,46 @@ func TestServer_DisableApplicationById(t *testing.T) {
 	})
 }
 
+func TestServer_SetApplicationApdexThreshold(t *testing.T) {
+	t.Run("Should: return application without error", func(t *testing.T) {
+		s := New(&dbMockOk{}, nil, nil)
+		_, err := s.SetApplicationApdexThreshold(context.Background(), &apiPb.ApplicationApdexThresholdRequest{
+			ApplicationId:  primitive.NewObjectID().Hex(),
+			ApdexThreshold: 100,
+		})
+		assert.Nil(t, err)
+	})
+	t.Run("Should: return error because objectId", func(t *testing.T) {
+		s := New(&dbMockOk{}, nil, nil)
+		_, err := s.SetApplicationApdexThreshold(context.Background(), &apiPb.ApplicationApdexThresholdRequest{
+			ApplicationId: "primitive.NewObjectID().Hex()",
+		})
+		assert.NotNil(t, err)
+	})
+	t.Run("Should: return error because threshold is negative", func(t *testing.T) {
+		s := New(&dbMockOk{}, nil, nil)
+		_, err := s.SetApplicationApdexThreshold(context.Background(), &apiPb.ApplicationApdexThresholdRequest{
+			ApplicationId:  primitive.NewObjectID().Hex(),
+			ApdexThreshold: -1,
+		})
+		assert.Equal(t, errNegativeApdexThreshold, err)
+	})
+	t.Run("Should: return error database", func(t *testing.T) {
+		s := New(&dbMockError{}, nil, nil)
+		_, err := s.SetApplicationApdexThreshold(context.Background(), &apiPb.ApplicationApdexThresholdRequest{
+			ApplicationId: primitive.NewObjectID().Hex(),
+		})
+		assert.NotNil(t, err)
+	})
+	t.Run("Should: return error database", func(t *testing.T) {
+		s := New(&dbMockFindError{}, nil, nil)
+		_, err := s.SetApplicationApdexThreshold(context.Background(), &apiPb.ApplicationApdexThresholdRequest{
+			ApplicationId: primitive.NewObjectID().Hex(),
+		})
+		assert.NotNil(t, err)
+	})
+}
+
 func TestServer_GetApplicationListByAgentId(t *testing.T) {
 	t.Run("Should: return application without error", func(t *testing.T) {
 		s := New(&dbMockOk{}, nil, nil)
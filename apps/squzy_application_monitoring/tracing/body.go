@@ -0,0 +1,58 @@
+package tracing
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"net/http"
+)
+
+const (
+	// Limits are checked for body as it is sent and after decompression, so small gzip body can not take all memory
+	maxBodySize         = 4 * 1024 * 1024
+	maxDecompressedSize = 32 * 1024 * 1024
+)
+
+var (
+	ErrBodyTooLarge = errors.New("request body is too large")
+)
+
+// ReadBody reads body of receiver request, gzip content encoding is supported
+func ReadBody(w http.ResponseWriter, req *http.Request) ([]byte, error) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, req.Body, maxBodySize))
+	if err != nil {
+		// Reader returns exactly limit of bytes before error if body is bigger
+		if len(body) >= maxBodySize {
+			return nil, ErrBodyTooLarge
+		}
+		return nil, err
+	}
+	if req.Header.Get("Content-Encoding") != "gzip" {
+		return body, nil
+	}
+	gzipReader, err := gzip.NewReader(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = gzipReader.Close()
+	}()
+	body, err = io.ReadAll(io.LimitReader(gzipReader, maxDecompressedSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > maxDecompressedSize {
+		return nil, ErrBodyTooLarge
+	}
+	return body, nil
+}
+
+// WriteBodyError responds 413 if body is too large and 400 otherwise
+func WriteBodyError(w http.ResponseWriter, err error) {
+	if err == ErrBodyTooLarge {
+		http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		return
+	}
+	http.Error(w, err.Error(), http.StatusBadRequest)
+}
@@ -0,0 +1,64 @@
+package tracing
+
+import (
+	"bytes"
+	"compress/gzip"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func gzipped(data []byte) *bytes.Buffer {
+	buf := &bytes.Buffer{}
+	gz := gzip.NewWriter(buf)
+	_, _ = gz.Write(data)
+	_ = gz.Close()
+	return buf
+}
+
+func TestReadBody(t *testing.T) {
+	t.Run("Should: read plain body", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader("body"))
+		body, err := ReadBody(httptest.NewRecorder(), req)
+		assert.NoError(t, err)
+		assert.Equal(t, "body", string(body))
+	})
+	t.Run("Should: read gzipped body", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/", gzipped([]byte("body")))
+		req.Header.Set("Content-Encoding", "gzip")
+		body, err := ReadBody(httptest.NewRecorder(), req)
+		assert.NoError(t, err)
+		assert.Equal(t, "body", string(body))
+	})
+	t.Run("Should: return error if body is not gzipped", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader("body"))
+		req.Header.Set("Content-Encoding", "gzip")
+		_, err := ReadBody(httptest.NewRecorder(), req)
+		assert.Error(t, err)
+		assert.NotEqual(t, ErrBodyTooLarge, err)
+	})
+	t.Run("Should: return error if body is too large", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(make([]byte, maxBodySize+1)))
+		_, err := ReadBody(httptest.NewRecorder(), req)
+		assert.Equal(t, ErrBodyTooLarge, err)
+	})
+	t.Run("Should: return error if decompressed body is too large", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/", gzipped(make([]byte, maxDecompressedSize+1)))
+		req.Header.Set("Content-Encoding", "gzip")
+		_, err := ReadBody(httptest.NewRecorder(), req)
+		assert.Equal(t, ErrBodyTooLarge, err)
+	})
+}
+
+func TestWriteBodyError(t *testing.T) {
+	t.Run("Should: respond by status of error", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		WriteBodyError(w, ErrBodyTooLarge)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+		w = httptest.NewRecorder()
+		WriteBodyError(w, gzip.ErrHeader)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,15 @@
+package tracing
+
+import (
+	"context"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+)
+
+// Monitoring is used by receivers of OTLP and Zipkin spans. Receivers initialize all applications of request first,
+// failed initialization is retried by exporter, because nothing was saved yet. Spans which could not be saved
+// after that are only logged, so retries do not duplicate saved spans
+type Monitoring interface {
+	InitializeApplication(ctx context.Context, info *apiPb.ApplicationInfo) (*apiPb.InitializeApplicationResponse, error)
+	// Status of application is read once for all transactions, transactions of not enabled application are skipped
+	SaveTransactions(ctx context.Context, applicationId string, transactions []*apiPb.TransactionInfo) error
+}
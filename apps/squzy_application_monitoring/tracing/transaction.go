@@ -0,0 +1,80 @@
+package tracing
+
+import (
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"net/url"
+	"strings"
+)
+
+// Kind is direction of span call, kinds of OTLP and Zipkin spans are converted to it
+type Kind int32
+
+const (
+	KindInternal Kind = iota
+	KindServer
+	KindClient
+)
+
+var (
+	// Keys of old and current semantic conventions and Zipkin tags, the first found value is used
+	MethodKeys = []string{"http.request.method", "http.method", "rpc.method"}
+	HostKeys   = []string{"server.address", "http.host", "net.host.name", "net.peer.name"}
+	PathKeys   = []string{"url.path", "http.target", "http.route", "http.path"}
+	UrlKeys    = []string{"url.full", "http.url"}
+	dbKeys     = []string{"db.system", "db.type", "sql.query"}
+)
+
+// Attributes of OTLP span or tags of Zipkin span
+type Attributes map[string]string
+
+func (a Attributes) First(keys []string) string {
+	for _, key := range keys {
+		if value, ok := a[key]; ok {
+			return value
+		}
+	}
+	return ""
+}
+
+// Span kind says only direction of call, so protocol is taken from attributes
+func GetTransactionType(kind Kind, attrs Attributes) apiPb.TransactionType {
+	switch {
+	case attrs.First(dbKeys) != "":
+		return apiPb.TransactionType_TRANSACTION_TYPE_DB
+	case attrs["rpc.system"] == "grpc" || attrs["grpc.status_code"] != "":
+		return apiPb.TransactionType_TRANSACTION_TYPE_GRPC
+	case kind == KindServer:
+		return apiPb.TransactionType_TRANSACTION_TYPE_ROUTER
+	case kind == KindClient && attrs.First(MethodKeys) != "":
+		return apiPb.TransactionType_TRANSACTION_TYPE_HTTP
+	default:
+		return apiPb.TransactionType_TRANSACTION_TYPE_INTERNAL
+	}
+}
+
+// Returns nil if span has no host, path and method
+func GetTransactionMeta(attrs Attributes) *apiPb.TransactionInfo_Meta {
+	meta := &apiPb.TransactionInfo_Meta{
+		Host:   attrs.First(HostKeys),
+		Path:   attrs.First(PathKeys),
+		Method: attrs.First(MethodKeys),
+	}
+	if rawUrl := attrs.First(UrlKeys); rawUrl != "" {
+		if u, err := url.Parse(rawUrl); err == nil {
+			if meta.Host == "" {
+				meta.Host = u.Hostname()
+			}
+			if meta.Path == "" {
+				meta.Path = u.Path
+			}
+		}
+	}
+	// http.target contains query
+	if i := strings.IndexByte(meta.Path, '?'); i >= 0 {
+		meta.Path = meta.Path[:i]
+	}
+	if meta.Host == "" && meta.Path == "" && meta.Method == "" {
+		return nil
+	}
+	return meta
+}
@@ -0,0 +1,52 @@
+package tracing
+
+import (
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestAttributes_First(t *testing.T) {
+	t.Run("Should: return value of the first found key", func(t *testing.T) {
+		attrs := Attributes{"http.method": "GET", "rpc.method": "Get"}
+		assert.Equal(t, "GET", attrs.First(MethodKeys))
+		assert.Equal(t, "", attrs.First(HostKeys))
+	})
+}
+
+func TestGetTransactionType(t *testing.T) {
+	t.Run("Should: detect type by attributes", func(t *testing.T) {
+		assert.Equal(t, apiPb.TransactionType_TRANSACTION_TYPE_DB, GetTransactionType(
+			KindClient, Attributes{"db.system": "postgresql"}))
+		assert.Equal(t, apiPb.TransactionType_TRANSACTION_TYPE_DB, GetTransactionType(
+			KindInternal, Attributes{"sql.query": "select 1"}))
+		assert.Equal(t, apiPb.TransactionType_TRANSACTION_TYPE_GRPC, GetTransactionType(
+			KindServer, Attributes{"rpc.system": "grpc"}))
+		assert.Equal(t, apiPb.TransactionType_TRANSACTION_TYPE_GRPC, GetTransactionType(
+			KindClient, Attributes{"grpc.status_code": "0"}))
+		assert.Equal(t, apiPb.TransactionType_TRANSACTION_TYPE_ROUTER, GetTransactionType(
+			KindServer, Attributes{}))
+		assert.Equal(t, apiPb.TransactionType_TRANSACTION_TYPE_HTTP, GetTransactionType(
+			KindClient, Attributes{"http.method": "GET"}))
+		assert.Equal(t, apiPb.TransactionType_TRANSACTION_TYPE_INTERNAL, GetTransactionType(
+			KindClient, Attributes{}))
+		assert.Equal(t, apiPb.TransactionType_TRANSACTION_TYPE_INTERNAL, GetTransactionType(
+			KindInternal, Attributes{"http.method": "POST"}))
+	})
+}
+
+func TestGetTransactionMeta(t *testing.T) {
+	t.Run("Should: take host and path from url", func(t *testing.T) {
+		meta := GetTransactionMeta(Attributes{"http.method": "GET", "url.full": "https://squzy.app/api/users?id=1"})
+		assert.Equal(t, "squzy.app", meta.GetHost())
+		assert.Equal(t, "/api/users", meta.GetPath())
+		assert.Equal(t, "GET", meta.GetMethod())
+	})
+	t.Run("Should: cut query from path", func(t *testing.T) {
+		assert.Equal(t, "/orders", GetTransactionMeta(Attributes{"http.target": "/orders?id=1"}).GetPath())
+		assert.Equal(t, "/api", GetTransactionMeta(Attributes{"http.path": "/api"}).GetPath())
+	})
+	t.Run("Should: return nil without attributes", func(t *testing.T) {
+		assert.Nil(t, GetTransactionMeta(Attributes{"db.system": "mysql"}))
+	})
+}
@@ -0,0 +1,206 @@
+package zipkin
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/squzy/squzy/apps/squzy_application_monitoring/tracing"
+	"github.com/squzy/squzy/internal/logger"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	timestamp "google.golang.org/protobuf/types/known/timestamppb"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	SpansPath = "/api/v2/spans"
+
+	defaultServiceName = "unknown"
+)
+
+var (
+	errMissingSpanId = errors.New("span id is missing")
+	errInvalidSpanId = func(id string) error {
+		return fmt.Errorf("invalid span id %s", id)
+	}
+
+	kinds = map[string]tracing.Kind{
+		"SERVER": tracing.KindServer,
+		"CLIENT": tracing.KindClient,
+	}
+)
+
+// Span is Zipkin v2 span, https://zipkin.io/zipkin-api/#/default/post_spans
+type Span struct {
+	TraceId        string             `json:"traceId"`
+	Id             string             `json:"id"`
+	ParentId       string             `json:"parentId"`
+	Name           string             `json:"name"`
+	Kind           string             `json:"kind"`
+	Timestamp      int64              `json:"timestamp"`
+	Duration       int64              `json:"duration"`
+	LocalEndpoint  *Endpoint          `json:"localEndpoint"`
+	RemoteEndpoint *Endpoint          `json:"remoteEndpoint"`
+	Tags           tracing.Attributes `json:"tags"`
+}
+
+type Endpoint struct {
+	ServiceName string `json:"serviceName"`
+	Ipv4        string `json:"ipv4"`
+	Ipv6        string `json:"ipv6"`
+	Port        int32  `json:"port"`
+}
+
+type handler struct {
+	monitoring tracing.Monitoring
+}
+
+// Spans of one service are saved together
+type application struct {
+	id           string
+	transactions []*apiPb.TransactionInfo
+}
+
+// New returns handler which saves Zipkin spans as transactions of applications, applications are initialized by service name
+func New(monitoring tracing.Monitoring) http.Handler {
+	return &handler{
+		monitoring: monitoring,
+	}
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	if !strings.HasPrefix(req.Header.Get("Content-Type"), "application/json") {
+		http.Error(w, http.StatusText(http.StatusUnsupportedMediaType), http.StatusUnsupportedMediaType)
+		return
+	}
+	body, err := tracing.ReadBody(w, req)
+	if err != nil {
+		tracing.WriteBodyError(w, err)
+		return
+	}
+
+	var spans []*Span
+	err = json.Unmarshal(body, &spans)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	for _, span := range spans {
+		if err := validateSpan(span); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	// Zipkin reporters retry requests on 5xx, it is returned only if nothing was saved
+	err = h.save(req, spans)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// Applications of all spans are initialized before anything is saved, so failed initialization could be retried.
+// Spans which could not be saved after that are only logged, so retries of reporter do not duplicate saved spans
+func (h *handler) save(req *http.Request, spans []*Span) error {
+	var applications []*application
+	byName := map[string]*application{}
+	for _, span := range spans {
+		info := convertToApplicationInfo(span)
+		app, ok := byName[info.GetName()]
+		if !ok {
+			initialized, err := h.monitoring.InitializeApplication(req.Context(), info)
+			if err != nil {
+				return err
+			}
+			app = &application{
+				id: initialized.GetApplicationId(),
+			}
+			byName[info.GetName()] = app
+			applications = append(applications, app)
+		}
+		app.transactions = append(app.transactions, convertToTransactionInfo(app.id, span))
+	}
+	failed := 0
+	var lastErr error
+	for _, app := range applications {
+		err := h.monitoring.SaveTransactions(req.Context(), app.id, app.transactions)
+		if err != nil {
+			failed += len(app.transactions)
+			lastErr = err
+		}
+	}
+	if failed > 0 {
+		logger.Errorf("%d of %d zipkin spans were not saved: %s", failed, len(spans), lastErr.Error())
+	}
+	return nil
+}
+
+func validateSpan(span *Span) error {
+	if span.Id == "" {
+		return errMissingSpanId
+	}
+	for _, id := range []string{span.Id, span.ParentId} {
+		for _, r := range id {
+			if !strings.ContainsRune("0123456789abcdefABCDEF", r) {
+				return errInvalidSpanId(id)
+			}
+		}
+	}
+	return nil
+}
+
+func convertToApplicationInfo(span *Span) *apiPb.ApplicationInfo {
+	info := &apiPb.ApplicationInfo{
+		Name: defaultServiceName,
+	}
+	if endpoint := span.LocalEndpoint; endpoint != nil {
+		if endpoint.ServiceName != "" {
+			info.Name = endpoint.ServiceName
+		}
+		info.HostName = endpoint.Ipv4
+		if info.HostName == "" {
+			info.HostName = endpoint.Ipv6
+		}
+	}
+	return info
+}
+
+// Timestamp and duration of Zipkin span are in microseconds
+func convertToTransactionInfo(applicationId string, span *Span) *apiPb.TransactionInfo {
+	start := time.Unix(0, span.Timestamp*int64(time.Microsecond))
+	transaction := &apiPb.TransactionInfo{
+		Id:            strings.ToLower(span.Id),
+		ApplicationId: applicationId,
+		ParentId:      strings.ToLower(span.ParentId),
+		Name:          span.Name,
+		StartTime:     timestamp.New(start),
+		EndTime:       timestamp.New(start.Add(time.Duration(span.Duration) * time.Microsecond)),
+		Status:        apiPb.TransactionStatus_TRANSACTION_SUCCESSFUL,
+		Type:          tracing.GetTransactionType(kinds[span.Kind], span.Tags),
+		Meta:          getTransactionMeta(span),
+	}
+	// Error tag contains message or just "true"
+	if message, ok := span.Tags["error"]; ok {
+		transaction.Status = apiPb.TransactionStatus_TRANSACTION_FAILED
+		transaction.Error = &apiPb.TransactionInfo_Error{
+			Message: message,
+		}
+	}
+	return transaction
+}
+
+// Client span without host tags is called by name of remote service
+func getTransactionMeta(span *Span) *apiPb.TransactionInfo_Meta {
+	meta := tracing.GetTransactionMeta(span.Tags)
+	if meta != nil && meta.Host == "" && meta.Method != "" && kinds[span.Kind] == tracing.KindClient && span.RemoteEndpoint != nil {
+		meta.Host = span.RemoteEndpoint.ServiceName
+	}
+	return meta
+}
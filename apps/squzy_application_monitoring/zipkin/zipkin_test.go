@@ -0,0 +1,163 @@
+package zipkin
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type monitoringMock struct {
+	initErr      error
+	saveErr      error
+	saves        int
+	applications []*apiPb.ApplicationInfo
+	transactions []*apiPb.TransactionInfo
+}
+
+func (m *monitoringMock) InitializeApplication(ctx context.Context, info *apiPb.ApplicationInfo) (*apiPb.InitializeApplicationResponse, error) {
+	if m.initErr != nil {
+		return nil, m.initErr
+	}
+	m.applications = append(m.applications, info)
+	return &apiPb.InitializeApplicationResponse{ApplicationId: info.GetName() + "_id"}, nil
+}
+
+func (m *monitoringMock) SaveTransactions(ctx context.Context, applicationId string, transactions []*apiPb.TransactionInfo) error {
+	m.saves++
+	if m.saveErr != nil {
+		return m.saveErr
+	}
+	m.transactions = append(m.transactions, transactions...)
+	return nil
+}
+
+const spans = `[
+  {
+    "traceId": "5af7183fb1d4cf5f",
+    "id": "352bff9a74ca9ad2",
+    "name": "get /api",
+    "kind": "SERVER",
+    "timestamp": 1556604172355737,
+    "duration": 1431,
+    "localEndpoint": {"serviceName": "backend", "ipv4": "192.168.99.1"},
+    "tags": {"http.method": "GET", "http.path": "/api"}
+  },
+  {
+    "traceId": "5af7183fb1d4cf5f",
+    "parentId": "352BFF9A74CA9AD2",
+    "id": "6b221d5bc9e6496c",
+    "name": "get",
+    "kind": "CLIENT",
+    "timestamp": 1556604172355800,
+    "duration": 1000,
+    "localEndpoint": {"serviceName": "backend"},
+    "remoteEndpoint": {"serviceName": "users"},
+    "tags": {"http.method": "GET", "http.url": "http://users:8080/users/1", "error": "500"}
+  },
+  {
+    "traceId": "5af7183fb1d4cf5f",
+    "id": "7c221d5bc9e6496c",
+    "name": "query",
+    "tags": {"sql.query": "select 1"}
+  }
+]`
+
+func post(handler http.Handler, body string, contentType string, gzipped bool) *httptest.ResponseRecorder {
+	var reader = bytes.NewBufferString(body)
+	if gzipped {
+		buf := &bytes.Buffer{}
+		gz := gzip.NewWriter(buf)
+		_, _ = gz.Write([]byte(body))
+		_ = gz.Close()
+		reader = buf
+	}
+	req := httptest.NewRequest(http.MethodPost, SpansPath, reader)
+	req.Header.Set("Content-Type", contentType)
+	if gzipped {
+		req.Header.Set("Content-Encoding", "gzip")
+	}
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	return w
+}
+
+func TestNew(t *testing.T) {
+	t.Run("Should: create handler", func(t *testing.T) {
+		assert.NotNil(t, New(&monitoringMock{}))
+	})
+}
+
+func TestHandler_ServeHTTP(t *testing.T) {
+	t.Run("Should: save spans as transactions", func(t *testing.T) {
+		monitoring := &monitoringMock{}
+		w := post(New(monitoring), spans, "application/json", true)
+		assert.Equal(t, http.StatusAccepted, w.Code)
+		assert.Len(t, monitoring.applications, 2)
+		assert.Equal(t, "backend", monitoring.applications[0].GetName())
+		assert.Equal(t, "192.168.99.1", monitoring.applications[0].GetHostName())
+		assert.Equal(t, defaultServiceName, monitoring.applications[1].GetName())
+		assert.Len(t, monitoring.transactions, 3)
+
+		server := monitoring.transactions[0]
+		assert.Equal(t, "backend_id", server.GetApplicationId())
+		assert.Equal(t, "352bff9a74ca9ad2", server.GetId())
+		assert.Equal(t, "", server.GetParentId())
+		assert.Equal(t, apiPb.TransactionType_TRANSACTION_TYPE_ROUTER, server.GetType())
+		assert.Equal(t, apiPb.TransactionStatus_TRANSACTION_SUCCESSFUL, server.GetStatus())
+		assert.Equal(t, "/api", server.GetMeta().GetPath())
+		assert.Equal(t, int64(1556604172), server.GetStartTime().GetSeconds())
+		assert.Equal(t, int32(355737000), server.GetStartTime().GetNanos())
+		assert.Equal(t, int32(357168000), server.GetEndTime().GetNanos())
+
+		client := monitoring.transactions[1]
+		assert.Equal(t, "352bff9a74ca9ad2", client.GetParentId())
+		assert.Equal(t, apiPb.TransactionType_TRANSACTION_TYPE_HTTP, client.GetType())
+		assert.Equal(t, apiPb.TransactionStatus_TRANSACTION_FAILED, client.GetStatus())
+		assert.Equal(t, "500", client.GetError().GetMessage())
+		assert.Equal(t, "users", client.GetMeta().GetHost())
+		assert.Equal(t, "/users/1", client.GetMeta().GetPath())
+
+		query := monitoring.transactions[2]
+		assert.Equal(t, apiPb.TransactionType_TRANSACTION_TYPE_DB, query.GetType())
+		assert.Nil(t, query.GetMeta())
+	})
+	t.Run("Should: save spans of application together", func(t *testing.T) {
+		monitoring := &monitoringMock{}
+		post(New(monitoring), spans, "application/json", false)
+		assert.Equal(t, 2, monitoring.saves)
+	})
+	t.Run("Should: ask to retry if application was not initialized", func(t *testing.T) {
+		monitoring := &monitoringMock{initErr: errors.New("init")}
+		w := post(New(monitoring), spans, "application/json", false)
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+		assert.Equal(t, 0, monitoring.saves)
+	})
+	t.Run("Should: accept spans which were not saved", func(t *testing.T) {
+		w := post(New(&monitoringMock{saveErr: errors.New("save")}), spans, "application/json", false)
+		assert.Equal(t, http.StatusAccepted, w.Code)
+	})
+	t.Run("Should: reject invalid requests", func(t *testing.T) {
+		assert.Equal(t, http.StatusUnsupportedMediaType, post(New(&monitoringMock{}), spans, "application/x-protobuf", false).Code)
+		assert.Equal(t, http.StatusBadRequest, post(New(&monitoringMock{}), "{}", "application/json", false).Code)
+		assert.Equal(t, http.StatusBadRequest, post(New(&monitoringMock{}), `[{"name":"no id"}]`, "application/json", false).Code)
+		assert.Equal(t, http.StatusBadRequest, post(New(&monitoringMock{}), `[{"id":"xyz"}]`, "application/json", false).Code)
+
+		req := httptest.NewRequest(http.MethodPost, SpansPath, strings.NewReader(spans))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Content-Encoding", "gzip")
+		w := httptest.NewRecorder()
+		New(&monitoringMock{}).ServeHTTP(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		w = httptest.NewRecorder()
+		New(&monitoringMock{}).ServeHTTP(w, httptest.NewRequest(http.MethodGet, SpansPath, nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+}
@@ -20,6 +20,10 @@ func (m mockStorage) SaveResponseFromScheduler(ctx context.Context, in *apiPb.Sc
 	return nil, nil
 }
 
+func (m mockStorage) SaveResponsesFromScheduler(ctx context.Context, in *apiPb.SchedulerResponseBatch, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, nil
+}
+
 func (m mockStorage) SaveResponseFromAgent(ctx context.Context, in *apiPb.Metric, opts ...grpc.CallOption) (*empty.Empty, error) {
 	return nil, nil
 }
@@ -48,6 +52,22 @@ func (m mockStorage) GetSchedulerUptime(ctx context.Context, in *apiPb.GetSchedu
 	return nil, nil
 }
 
+func (m mockStorage) GetSchedulerUptimeSeries(ctx context.Context, in *apiPb.GetSchedulerUptimeSeriesRequest, opts ...grpc.CallOption) (*apiPb.GetSchedulerUptimeSeriesResponse, error) {
+	return nil, nil
+}
+
+func (m mockStorage) GetSchedulerOutages(ctx context.Context, in *apiPb.GetSchedulerOutagesRequest, opts ...grpc.CallOption) (*apiPb.GetSchedulerOutagesResponse, error) {
+	return nil, nil
+}
+
+func (m mockStorage) GetAgentSeries(ctx context.Context, in *apiPb.GetAgentSeriesRequest, opts ...grpc.CallOption) (*apiPb.GetAgentSeriesResponse, error) {
+	return nil, nil
+}
+
+func (m mockStorage) Subscribe(ctx context.Context, in *apiPb.SubscribeRequest, opts ...grpc.CallOption) (apiPb.Storage_SubscribeClient, error) {
+	return nil, nil
+}
+
 func (m mockStorage) GetAgentInformation(ctx context.Context, in *apiPb.GetAgentInformationRequest, opts ...grpc.CallOption) (*apiPb.GetAgentInformationResponse, error) {
 	return &apiPb.GetAgentInformationResponse{
 		Stats: []*apiPb.GetAgentInformationResponse_Statistic{
@@ -92,6 +112,10 @@ func (m mockStorage) GetTransactionById(ctx context.Context, in *apiPb.GetTransa
 	return nil, nil
 }
 
+func (m mockStorage) GetTransactionTree(ctx context.Context, in *apiPb.GetTransactionByIdRequest, opts ...grpc.CallOption) (*apiPb.GetTransactionTreeResponse, error) {
+	return nil, nil
+}
+
 func (m mockStorage) SaveIncident(ctx context.Context, in *apiPb.Incident, opts ...grpc.CallOption) (*empty.Empty, error) {
 	return nil, nil
 }
@@ -119,6 +143,10 @@ func (m mockErrorStorage) SaveResponseFromScheduler(ctx context.Context, in *api
 	return nil, errors.New("ERROR")
 }
 
+func (m mockErrorStorage) SaveResponsesFromScheduler(ctx context.Context, in *apiPb.SchedulerResponseBatch, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, errors.New("ERROR")
+}
+
 func (m mockErrorStorage) SaveResponseFromAgent(ctx context.Context, in *apiPb.Metric, opts ...grpc.CallOption) (*empty.Empty, error) {
 	return nil, errors.New("ERROR")
 }
@@ -135,6 +163,22 @@ func (m mockErrorStorage) GetSchedulerUptime(ctx context.Context, in *apiPb.GetS
 	return nil, errors.New("ERROR")
 }
 
+func (m mockErrorStorage) GetSchedulerUptimeSeries(ctx context.Context, in *apiPb.GetSchedulerUptimeSeriesRequest, opts ...grpc.CallOption) (*apiPb.GetSchedulerUptimeSeriesResponse, error) {
+	return nil, errors.New("ERROR")
+}
+
+func (m mockErrorStorage) GetSchedulerOutages(ctx context.Context, in *apiPb.GetSchedulerOutagesRequest, opts ...grpc.CallOption) (*apiPb.GetSchedulerOutagesResponse, error) {
+	return nil, errors.New("ERROR")
+}
+
+func (m mockErrorStorage) GetAgentSeries(ctx context.Context, in *apiPb.GetAgentSeriesRequest, opts ...grpc.CallOption) (*apiPb.GetAgentSeriesResponse, error) {
+	return nil, errors.New("ERROR")
+}
+
+func (m mockErrorStorage) Subscribe(ctx context.Context, in *apiPb.SubscribeRequest, opts ...grpc.CallOption) (apiPb.Storage_SubscribeClient, error) {
+	return nil, errors.New("ERROR")
+}
+
 func (m mockErrorStorage) GetAgentInformation(ctx context.Context, in *apiPb.GetAgentInformationRequest, opts ...grpc.CallOption) (*apiPb.GetAgentInformationResponse, error) {
 	return nil, errors.New("ERROR")
 }
@@ -151,6 +195,10 @@ func (m mockErrorStorage) GetTransactionById(ctx context.Context, in *apiPb.GetT
 	return nil, errors.New("ERROR")
 }
 
+func (m mockErrorStorage) GetTransactionTree(ctx context.Context, in *apiPb.GetTransactionByIdRequest, opts ...grpc.CallOption) (*apiPb.GetTransactionTreeResponse, error) {
+	return nil, errors.New("ERROR")
+}
+
 func (m mockErrorStorage) SaveIncident(ctx context.Context, in *apiPb.Incident, opts ...grpc.CallOption) (*empty.Empty, error) {
 	return nil, errors.New("ERROR")
 }
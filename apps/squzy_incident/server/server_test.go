@@ -26,6 +26,10 @@ func (m mockFullSuccessStorage) SaveResponseFromScheduler(ctx context.Context, i
 	return nil, nil
 }
 
+func (m mockFullSuccessStorage) SaveResponsesFromScheduler(ctx context.Context, in *apiPb.SchedulerResponseBatch, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, nil
+}
+
 func (m mockFullSuccessStorage) SaveResponseFromAgent(ctx context.Context, in *apiPb.Metric, opts ...grpc.CallOption) (*empty.Empty, error) {
 	return nil, nil
 }
@@ -42,6 +46,22 @@ func (m mockFullSuccessStorage) GetSchedulerUptime(ctx context.Context, in *apiP
 	return nil, nil
 }
 
+func (m mockFullSuccessStorage) GetSchedulerUptimeSeries(ctx context.Context, in *apiPb.GetSchedulerUptimeSeriesRequest, opts ...grpc.CallOption) (*apiPb.GetSchedulerUptimeSeriesResponse, error) {
+	return nil, nil
+}
+
+func (m mockFullSuccessStorage) GetSchedulerOutages(ctx context.Context, in *apiPb.GetSchedulerOutagesRequest, opts ...grpc.CallOption) (*apiPb.GetSchedulerOutagesResponse, error) {
+	return nil, nil
+}
+
+func (m mockFullSuccessStorage) GetAgentSeries(ctx context.Context, in *apiPb.GetAgentSeriesRequest, opts ...grpc.CallOption) (*apiPb.GetAgentSeriesResponse, error) {
+	return nil, nil
+}
+
+func (m mockFullSuccessStorage) Subscribe(ctx context.Context, in *apiPb.SubscribeRequest, opts ...grpc.CallOption) (apiPb.Storage_SubscribeClient, error) {
+	return nil, nil
+}
+
 func (m mockFullSuccessStorage) GetAgentInformation(ctx context.Context, in *apiPb.GetAgentInformationRequest, opts ...grpc.CallOption) (*apiPb.GetAgentInformationResponse, error) {
 	return nil, nil
 }
@@ -58,6 +78,10 @@ func (m mockFullSuccessStorage) GetTransactionById(ctx context.Context, in *apiP
 	return nil, nil
 }
 
+func (m mockFullSuccessStorage) GetTransactionTree(ctx context.Context, in *apiPb.GetTransactionByIdRequest, opts ...grpc.CallOption) (*apiPb.GetTransactionTreeResponse, error) {
+	return nil, nil
+}
+
 func (m mockFullSuccessStorage) SaveIncident(ctx context.Context, in *apiPb.Incident, opts ...grpc.CallOption) (*empty.Empty, error) {
 	return &empty.Empty{}, nil
 }
@@ -139,6 +163,10 @@ func (m mockStorage) SaveResponseFromScheduler(ctx context.Context, in *apiPb.Sc
 	return nil, nil
 }
 
+func (m mockStorage) SaveResponsesFromScheduler(ctx context.Context, in *apiPb.SchedulerResponseBatch, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, nil
+}
+
 func (m mockStorage) SaveResponseFromAgent(ctx context.Context, in *apiPb.Metric, opts ...grpc.CallOption) (*empty.Empty, error) {
 	return nil, nil
 }
@@ -155,6 +183,22 @@ func (m mockStorage) GetSchedulerUptime(ctx context.Context, in *apiPb.GetSchedu
 	return nil, nil
 }
 
+func (m mockStorage) GetSchedulerUptimeSeries(ctx context.Context, in *apiPb.GetSchedulerUptimeSeriesRequest, opts ...grpc.CallOption) (*apiPb.GetSchedulerUptimeSeriesResponse, error) {
+	return nil, nil
+}
+
+func (m mockStorage) GetSchedulerOutages(ctx context.Context, in *apiPb.GetSchedulerOutagesRequest, opts ...grpc.CallOption) (*apiPb.GetSchedulerOutagesResponse, error) {
+	return nil, nil
+}
+
+func (m mockStorage) GetAgentSeries(ctx context.Context, in *apiPb.GetAgentSeriesRequest, opts ...grpc.CallOption) (*apiPb.GetAgentSeriesResponse, error) {
+	return nil, nil
+}
+
+func (m mockStorage) Subscribe(ctx context.Context, in *apiPb.SubscribeRequest, opts ...grpc.CallOption) (apiPb.Storage_SubscribeClient, error) {
+	return nil, nil
+}
+
 func (m mockStorage) GetAgentInformation(ctx context.Context, in *apiPb.GetAgentInformationRequest, opts ...grpc.CallOption) (*apiPb.GetAgentInformationResponse, error) {
 	return nil, nil
 }
@@ -171,6 +215,10 @@ func (m mockStorage) GetTransactionById(ctx context.Context, in *apiPb.GetTransa
 	return nil, nil
 }
 
+func (m mockStorage) GetTransactionTree(ctx context.Context, in *apiPb.GetTransactionByIdRequest, opts ...grpc.CallOption) (*apiPb.GetTransactionTreeResponse, error) {
+	return nil, nil
+}
+
 func (m mockStorage) SaveIncident(ctx context.Context, in *apiPb.Incident, opts ...grpc.CallOption) (*empty.Empty, error) {
 	if in.RuleId == isIncidentExistwasIncident.Hex() {
 		return nil, errors.New("ERROR")
@@ -210,6 +258,10 @@ func (m mockDatabase) SaveResponseFromScheduler(ctx context.Context, in *apiPb.S
 	panic("implement me")
 }
 
+func (m mockDatabase) SaveResponsesFromScheduler(ctx context.Context, in *apiPb.SchedulerResponseBatch, opts ...grpc.CallOption) (*empty.Empty, error) {
+	panic("implement me")
+}
+
 func (m mockDatabase) SaveResponseFromAgent(ctx context.Context, in *apiPb.Metric, opts ...grpc.CallOption) (*empty.Empty, error) {
 	panic("implement me")
 }
@@ -226,6 +278,22 @@ func (m mockDatabase) GetSchedulerUptime(ctx context.Context, in *apiPb.GetSched
 	panic("implement me")
 }
 
+func (m mockDatabase) GetSchedulerUptimeSeries(ctx context.Context, in *apiPb.GetSchedulerUptimeSeriesRequest, opts ...grpc.CallOption) (*apiPb.GetSchedulerUptimeSeriesResponse, error) {
+	panic("implement me")
+}
+
+func (m mockDatabase) GetSchedulerOutages(ctx context.Context, in *apiPb.GetSchedulerOutagesRequest, opts ...grpc.CallOption) (*apiPb.GetSchedulerOutagesResponse, error) {
+	panic("implement me")
+}
+
+func (m mockDatabase) GetAgentSeries(ctx context.Context, in *apiPb.GetAgentSeriesRequest, opts ...grpc.CallOption) (*apiPb.GetAgentSeriesResponse, error) {
+	panic("implement me")
+}
+
+func (m mockDatabase) Subscribe(ctx context.Context, in *apiPb.SubscribeRequest, opts ...grpc.CallOption) (apiPb.Storage_SubscribeClient, error) {
+	panic("implement me")
+}
+
 func (m mockDatabase) GetAgentInformation(ctx context.Context, in *apiPb.GetAgentInformationRequest, opts ...grpc.CallOption) (*apiPb.GetAgentInformationResponse, error) {
 	panic("implement me")
 }
@@ -242,6 +310,10 @@ func (m mockDatabase) GetTransactionById(ctx context.Context, in *apiPb.GetTrans
 	panic("implement me")
 }
 
+func (m mockDatabase) GetTransactionTree(ctx context.Context, in *apiPb.GetTransactionByIdRequest, opts ...grpc.CallOption) (*apiPb.GetTransactionTreeResponse, error) {
+	panic("implement me")
+}
+
 func (m mockDatabase) SaveIncident(ctx context.Context, in *apiPb.Incident, opts ...grpc.CallOption) (*empty.Empty, error) {
 	panic("implement me")
 }
@@ -324,6 +396,10 @@ func (m mockErrorStorage) SaveResponseFromScheduler(ctx context.Context, in *api
 	return nil, errors.New("ERROR")
 }
 
+func (m mockErrorStorage) SaveResponsesFromScheduler(ctx context.Context, in *apiPb.SchedulerResponseBatch, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, errors.New("ERROR")
+}
+
 func (m mockErrorStorage) SaveResponseFromAgent(ctx context.Context, in *apiPb.Metric, opts ...grpc.CallOption) (*empty.Empty, error) {
 	return nil, errors.New("ERROR")
 }
@@ -340,6 +416,22 @@ func (m mockErrorStorage) GetSchedulerUptime(ctx context.Context, in *apiPb.GetS
 	return nil, errors.New("ERROR")
 }
 
+func (m mockErrorStorage) GetSchedulerUptimeSeries(ctx context.Context, in *apiPb.GetSchedulerUptimeSeriesRequest, opts ...grpc.CallOption) (*apiPb.GetSchedulerUptimeSeriesResponse, error) {
+	return nil, errors.New("ERROR")
+}
+
+func (m mockErrorStorage) GetSchedulerOutages(ctx context.Context, in *apiPb.GetSchedulerOutagesRequest, opts ...grpc.CallOption) (*apiPb.GetSchedulerOutagesResponse, error) {
+	return nil, errors.New("ERROR")
+}
+
+func (m mockErrorStorage) GetAgentSeries(ctx context.Context, in *apiPb.GetAgentSeriesRequest, opts ...grpc.CallOption) (*apiPb.GetAgentSeriesResponse, error) {
+	return nil, errors.New("ERROR")
+}
+
+func (m mockErrorStorage) Subscribe(ctx context.Context, in *apiPb.SubscribeRequest, opts ...grpc.CallOption) (apiPb.Storage_SubscribeClient, error) {
+	return nil, errors.New("ERROR")
+}
+
 func (m mockErrorStorage) GetAgentInformation(ctx context.Context, in *apiPb.GetAgentInformationRequest, opts ...grpc.CallOption) (*apiPb.GetAgentInformationResponse, error) {
 	return nil, errors.New("ERROR")
 }
@@ -356,6 +448,10 @@ func (m mockErrorStorage) GetTransactionById(ctx context.Context, in *apiPb.GetT
 	return nil, errors.New("ERROR")
 }
 
+func (m mockErrorStorage) GetTransactionTree(ctx context.Context, in *apiPb.GetTransactionByIdRequest, opts ...grpc.CallOption) (*apiPb.GetTransactionTreeResponse, error) {
+	return nil, errors.New("ERROR")
+}
+
 func (m mockErrorStorage) SaveIncident(ctx context.Context, in *apiPb.Incident, opts ...grpc.CallOption) (*empty.Empty, error) {
 	return nil, errors.New("ERROR")
 }
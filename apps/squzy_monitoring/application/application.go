@@ -15,23 +15,31 @@ import (
 	"github.com/squzy/squzy/internal/scheduler"
 	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
 	scheduler_storage "github.com/squzy/squzy/internal/scheduler-storage"
+	secret_storage "github.com/squzy/squzy/internal/secret-storage"
+	"github.com/squzy/squzy/internal/storage"
 )
 
 type app struct {
 	schedulerStorage scheduler_storage.SchedulerStorage
 	jobExecutor      job_executor.JobExecutor
 	configStorage    scheduler_config_storage.Storage
+	secretStorage    secret_storage.SecretStorage
+	storageStatus    storage.StatusReporter
 }
 
 func New(
 	schedulerStorage scheduler_storage.SchedulerStorage,
 	jobExecutor job_executor.JobExecutor,
 	configStorage scheduler_config_storage.Storage,
+	secretStorage secret_storage.SecretStorage,
+	storageStatus storage.StatusReporter,
 ) *app {
 	return &app{
 		schedulerStorage: schedulerStorage,
 		jobExecutor:      jobExecutor,
 		configStorage:    configStorage,
+		secretStorage:    secretStorage,
+		storageStatus:    storageStatus,
 	}
 }
 
@@ -94,6 +102,8 @@ func (s *app) Run(port int32) error {
 			s.schedulerStorage,
 			s.jobExecutor,
 			s.configStorage,
+			s.secretStorage,
+			s.storageStatus,
 		),
 	)
 	return grpcServer.Serve(lis)
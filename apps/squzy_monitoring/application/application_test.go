@@ -126,16 +126,27 @@ func (m mockStorageOk) Remove(string) error {
 func (m mockExecuter) Execute(schedulerId primitive.ObjectID) {
 }
 
+func (m mockExecuter) Reset(schedulerId primitive.ObjectID) {
+}
+
+func (m mockExecuter) Remove(schedulerId primitive.ObjectID) error {
+	return nil
+}
+
+func (m mockExecuter) Ping(schedulerId primitive.ObjectID, pingType apiPb.PingRequest_PingType, message string) error {
+	return nil
+}
+
 func TestNew(t *testing.T) {
 	t.Run("Should: Create new application", func(t *testing.T) {
-		app := New(nil, nil, nil)
+		app := New(nil, nil, nil, nil, nil)
 		assert.NotEqual(t, nil, app)
 	})
 }
 
 func TestApp_Run(t *testing.T) {
 	t.Run("Should: not return error", func(t *testing.T) {
-		app := New(&mockStorageOk{}, &mockExecuter{}, &mockConfigStorageOk{})
+		app := New(&mockStorageOk{}, &mockExecuter{}, &mockConfigStorageOk{}, nil, nil)
 		go func() {
 			_ = app.Run(11111)
 		}()
@@ -144,11 +155,11 @@ func TestApp_Run(t *testing.T) {
 		assert.Equal(t, nil, err)
 	})
 	t.Run("Should: return error because port is wrong", func(t *testing.T) {
-		app := New(&mockStorageOk{}, &mockExecuter{}, &mockConfigStorageOk{})
+		app := New(&mockStorageOk{}, &mockExecuter{}, &mockConfigStorageOk{}, nil, nil)
 		assert.NotEqual(t, nil, app.Run(1244214))
 	})
 	t.Run("Should: return err because cant sync with DB", func(t *testing.T) {
-		app := New(&mockStorageOk{}, &mockExecuter{}, &mockConfigStorageError{})
+		app := New(&mockStorageOk{}, &mockExecuter{}, &mockConfigStorageError{}, nil, nil)
 		go func() {
 			_ = app.Run(11111)
 		}()
@@ -160,7 +171,7 @@ func TestApp_Run(t *testing.T) {
 
 func TestApp_SyncOne(t *testing.T) {
 	t.Run("Should: return error because config wrong", func(t *testing.T) {
-		app := New(&mockStorageOk{}, &mockExecuter{}, nil)
+		app := New(&mockStorageOk{}, &mockExecuter{}, nil, nil, nil)
 		err := app.SyncOne(&scheduler_config_storage.SchedulerConfig{
 			ID:       primitive.ObjectID{},
 			Type:     0,
@@ -171,7 +182,7 @@ func TestApp_SyncOne(t *testing.T) {
 		assert.NotEqual(t, nil, err)
 	})
 	t.Run("Should: return error because cant set in storage", func(t *testing.T) {
-		app := New(&mockStorageError{}, &mockExecuter{}, nil)
+		app := New(&mockStorageError{}, &mockExecuter{}, nil, nil, nil)
 		err := app.SyncOne(&scheduler_config_storage.SchedulerConfig{
 			ID:       primitive.ObjectID{},
 			Type:     0,
@@ -182,7 +193,7 @@ func TestApp_SyncOne(t *testing.T) {
 		assert.NotEqual(t, nil, err)
 	})
 	t.Run("Should: return nil because status stopped", func(t *testing.T) {
-		app := New(&mockStorageOk{}, &mockExecuter{}, nil)
+		app := New(&mockStorageOk{}, &mockExecuter{}, nil, nil, nil)
 		err := app.SyncOne(&scheduler_config_storage.SchedulerConfig{
 			ID:       primitive.ObjectID{},
 			Type:     0,
@@ -193,7 +204,7 @@ func TestApp_SyncOne(t *testing.T) {
 		assert.Equal(t, nil, err)
 	})
 	t.Run("Should: return nil because status runned, ", func(t *testing.T) {
-		app := New(&mockStorageOk{}, &mockExecuter{}, nil)
+		app := New(&mockStorageOk{}, &mockExecuter{}, nil, nil, nil)
 		err := app.SyncOne(&scheduler_config_storage.SchedulerConfig{
 			ID:       primitive.ObjectID{},
 			Type:     0,
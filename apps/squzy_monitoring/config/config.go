@@ -15,10 +15,27 @@ const (
 	ENV_MONGO_COLLECTION = "MONGO_COLLECTION"
 	ENV_STORAGE_HOST     = "SQUZY_STORAGE_HOST"
 
+	ENV_STORAGE_QUEUE_PATH        = "SQUZY_STORAGE_QUEUE_PATH"
+	ENV_STORAGE_QUEUE_SIZE        = "SQUZY_STORAGE_QUEUE_SIZE"
+	ENV_STORAGE_QUEUE_DROP_POLICY = "SQUZY_STORAGE_QUEUE_DROP_POLICY"
+	ENV_STORAGE_BATCH_SIZE        = "SQUZY_STORAGE_BATCH_SIZE"
+	ENV_STORAGE_BATCH_INTERVAL    = "SQUZY_STORAGE_BATCH_INTERVAL"
+
+	ENV_SECRETS_KEY              = "SQUZY_SECRETS_KEY"
+	ENV_SECRETS_KEY_FILE         = "SQUZY_SECRETS_KEY_FILE"
+	ENV_MONGO_SECRETS_COLLECTION = "MONGO_SECRETS_COLLECTION"
+
 	defaultPort           int32 = 9090
 	defaultStorageTimeout       = time.Second * 5
 	defaultMongoDb              = "squzy_monitoring"
 	defaultCollection           = "schedulers"
+	// In megabytes
+	defaultQueueSize       int64 = 64
+	defaultQueueDropPolicy       = "oldest"
+	megabyte               int64 = 1024 * 1024
+	defaultBatchSize             = 100
+	defaultBatchInterval         = time.Second
+	defaultSecretsCollection     = "secrets"
 )
 
 type cfg struct {
@@ -28,6 +45,15 @@ type cfg struct {
 	mongoURI        string
 	mongoDb         string
 	mongoCollection string
+	queuePath       string
+	queueSize       int64
+	queueDropPolicy string
+	batchSize       int
+	batchInterval   time.Duration
+	// Secrets
+	secretsKey        string
+	secretsKeyFile    string
+	secretsCollection string
 }
 
 func (c *cfg) GetPort() int32 {
@@ -54,6 +80,38 @@ func (c *cfg) GetMongoCollection() string {
 	return c.mongoCollection
 }
 
+func (c *cfg) GetStorageQueuePath() string {
+	return c.queuePath
+}
+
+func (c *cfg) GetStorageQueueSize() int64 {
+	return c.queueSize
+}
+
+func (c *cfg) GetStorageQueueDropPolicy() string {
+	return c.queueDropPolicy
+}
+
+func (c *cfg) GetStorageBatchSize() int {
+	return c.batchSize
+}
+
+func (c *cfg) GetStorageBatchInterval() time.Duration {
+	return c.batchInterval
+}
+
+func (c *cfg) GetSecretsKey() string {
+	return c.secretsKey
+}
+
+func (c *cfg) GetSecretsKeyFile() string {
+	return c.secretsKeyFile
+}
+
+func (c *cfg) GetMongoSecretsCollection() string {
+	return c.secretsCollection
+}
+
 type Config interface {
 	GetPort() int32
 	GetClientAddress() string
@@ -61,6 +119,17 @@ type Config interface {
 	GetMongoURI() string
 	GetMongoDb() string
 	GetMongoCollection() string
+	GetStorageQueuePath() string
+	// Size of the storage queue in bytes
+	GetStorageQueueSize() int64
+	GetStorageQueueDropPolicy() string
+	// Batching is disabled if size is not bigger than one
+	GetStorageBatchSize() int
+	GetStorageBatchInterval() time.Duration
+	// Base64 encoded 32 bytes key, secrets are disabled if key and key file are empty
+	GetSecretsKey() string
+	GetSecretsKeyFile() string
+	GetMongoSecretsCollection() string
 }
 
 func New() Config {
@@ -90,6 +159,41 @@ func New() Config {
 	if collection == "" {
 		collection = defaultCollection
 	}
+	// Read storage queue, it is disabled until path is set, so working directory is not written by default
+	queuePath := os.Getenv(ENV_STORAGE_QUEUE_PATH)
+	queueSize := defaultQueueSize
+	queueSizeValue := os.Getenv(ENV_STORAGE_QUEUE_SIZE)
+	if queueSizeValue != "" {
+		i, err := strconv.ParseInt(queueSizeValue, 10, 64)
+		if err == nil {
+			queueSize = i
+		}
+	}
+	queueDropPolicy := os.Getenv(ENV_STORAGE_QUEUE_DROP_POLICY)
+	if queueDropPolicy == "" {
+		queueDropPolicy = defaultQueueDropPolicy
+	}
+	// Read storage batch
+	batchSize := defaultBatchSize
+	batchSizeValue := os.Getenv(ENV_STORAGE_BATCH_SIZE)
+	if batchSizeValue != "" {
+		i, err := strconv.ParseInt(batchSizeValue, 10, 32)
+		if err == nil {
+			batchSize = int(i)
+		}
+	}
+	batchInterval := defaultBatchInterval
+	batchIntervalValue := os.Getenv(ENV_STORAGE_BATCH_INTERVAL)
+	if batchIntervalValue != "" {
+		i, err := strconv.ParseInt(batchIntervalValue, 10, 32)
+		if err == nil && i > 0 {
+			batchInterval = helpers.DurationFromSecond(int32(i))
+		}
+	}
+	secretsCollection := os.Getenv(ENV_MONGO_SECRETS_COLLECTION)
+	if secretsCollection == "" {
+		secretsCollection = defaultSecretsCollection
+	}
 	return &cfg{
 		clientAddress:   os.Getenv(ENV_STORAGE_HOST),
 		timeout:         timeoutStorage,
@@ -97,5 +201,14 @@ func New() Config {
 		mongoURI:        os.Getenv(ENV_MONGO_URI),
 		mongoDb:         mongoDb,
 		mongoCollection: collection,
+		queuePath:       queuePath,
+		queueSize:       queueSize * megabyte,
+		queueDropPolicy: queueDropPolicy,
+		batchSize:       batchSize,
+		batchInterval:   batchInterval,
+		// Secrets
+		secretsKey:        os.Getenv(ENV_SECRETS_KEY),
+		secretsKeyFile:    os.Getenv(ENV_SECRETS_KEY_FILE),
+		secretsCollection: secretsCollection,
 	}
 }
@@ -16,6 +16,14 @@ func TestNew(t *testing.T) {
 		assert.Equal(t, s.GetMongoDb(), defaultMongoDb)
 		assert.Equal(t, s.GetStorageTimeout(), defaultStorageTimeout)
 		assert.Equal(t, s.GetMongoCollection(), defaultCollection)
+		assert.Equal(t, s.GetStorageQueuePath(), "")
+		assert.Equal(t, s.GetStorageQueueSize(), defaultQueueSize*megabyte)
+		assert.Equal(t, s.GetStorageQueueDropPolicy(), defaultQueueDropPolicy)
+		assert.Equal(t, s.GetStorageBatchSize(), defaultBatchSize)
+		assert.Equal(t, s.GetStorageBatchInterval(), defaultBatchInterval)
+		assert.Equal(t, s.GetSecretsKey(), "")
+		assert.Equal(t, s.GetSecretsKeyFile(), "")
+		assert.Equal(t, s.GetMongoSecretsCollection(), defaultSecretsCollection)
 	})
 }
 
@@ -61,3 +69,56 @@ func TestCfg_GetMongoUri(t *testing.T) {
 		assert.Equal(t, s.GetMongoURI(), "11124")
 	})
 }
+
+func TestCfg_GetStorageQueuePath(t *testing.T) {
+	t.Run("Should: return from env", func(t *testing.T) {
+		os.Setenv(ENV_STORAGE_QUEUE_PATH, "/var/lib/squzy/storage.queue")
+		s := New()
+		assert.Equal(t, s.GetStorageQueuePath(), "/var/lib/squzy/storage.queue")
+		os.Unsetenv(ENV_STORAGE_QUEUE_PATH)
+	})
+}
+
+func TestCfg_GetStorageQueueSize(t *testing.T) {
+	t.Run("Should: return from env", func(t *testing.T) {
+		os.Setenv(ENV_STORAGE_QUEUE_SIZE, "2")
+		s := New()
+		assert.Equal(t, s.GetStorageQueueSize(), int64(2*1024*1024))
+	})
+}
+
+func TestCfg_GetStorageQueueDropPolicy(t *testing.T) {
+	t.Run("Should: return from env", func(t *testing.T) {
+		os.Setenv(ENV_STORAGE_QUEUE_DROP_POLICY, "newest")
+		s := New()
+		assert.Equal(t, s.GetStorageQueueDropPolicy(), "newest")
+	})
+}
+
+func TestCfg_GetStorageBatchSize(t *testing.T) {
+	t.Run("Should: return from env", func(t *testing.T) {
+		os.Setenv(ENV_STORAGE_BATCH_SIZE, "20")
+		s := New()
+		assert.Equal(t, s.GetStorageBatchSize(), 20)
+	})
+}
+
+func TestCfg_GetStorageBatchInterval(t *testing.T) {
+	t.Run("Should: return from env", func(t *testing.T) {
+		os.Setenv(ENV_STORAGE_BATCH_INTERVAL, "3")
+		s := New()
+		assert.Equal(t, s.GetStorageBatchInterval(), time.Second*3)
+	})
+}
+
+func TestCfg_GetSecretsKey(t *testing.T) {
+	t.Run("Should: return from env", func(t *testing.T) {
+		os.Setenv(ENV_SECRETS_KEY, "key")
+		os.Setenv(ENV_SECRETS_KEY_FILE, "/run/secrets/key")
+		os.Setenv(ENV_MONGO_SECRETS_COLLECTION, "credentials")
+		s := New()
+		assert.Equal(t, s.GetSecretsKey(), "key")
+		assert.Equal(t, s.GetSecretsKeyFile(), "/run/secrets/key")
+		assert.Equal(t, s.GetMongoSecretsCollection(), "credentials")
+	})
+}
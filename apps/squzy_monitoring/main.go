@@ -6,7 +6,9 @@ import (
 	"github.com/squzy/squzy/apps/squzy_monitoring/application"
 	"github.com/squzy/squzy/apps/squzy_monitoring/config"
 	"github.com/squzy/squzy/apps/squzy_monitoring/version"
+	content_storage "github.com/squzy/squzy/internal/content-storage"
 	"github.com/squzy/squzy/internal/grpctools"
+	heartbeat_storage "github.com/squzy/squzy/internal/heartbeat-storage"
 	"github.com/squzy/squzy/internal/helpers"
 	"github.com/squzy/squzy/internal/httptools"
 	"github.com/squzy/squzy/internal/job"
@@ -15,17 +17,23 @@ import (
 	"github.com/squzy/squzy/internal/parsers"
 	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
 	scheduler_storage "github.com/squzy/squzy/internal/scheduler-storage"
+	secret_storage "github.com/squzy/squzy/internal/secret-storage"
 	"github.com/squzy/squzy/internal/semaphore"
 	sitemap_storage "github.com/squzy/squzy/internal/sitemap-storage"
 	"github.com/squzy/squzy/internal/storage"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"google.golang.org/grpc"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 )
 
 const (
-	day = time.Hour * 24
+	day                 = time.Hour * 24
+	queueReplayInterval = time.Second * 10
+	siteMapStorageSize  = 1000
 )
 
 func main() {
@@ -40,9 +48,6 @@ func main() {
 	if err != nil {
 		logger.Fatal(err.Error())
 	}
-	defer func() {
-		_ = client.Disconnect(context.Background())
-	}()
 	connector := mongo_helper.New(client.Database(cfg.GetMongoDb()).Collection(cfg.GetMongoCollection()))
 	httpPackage := httptools.New(version.GetVersion())
 	grpcTool := grpctools.New()
@@ -52,30 +57,112 @@ func main() {
 		cfg.GetStorageTimeout(),
 		storage.GetInMemoryStorage(),
 		grpc.WithInsecure(),
+		grpc.WithBlock(),
 	)
+	// External storage reports state of connection only if address is configured
+	stateReporter, _ := externalStorage.(storage.StateReporter)
+	// Storages are closed in reverse order on shutdown, so batch is flushed before the queue is closed
+	var closers []func() error
+	var queue storage.QueueStorage
+	// Snapshots which were not saved are kept on disk until storage is available again,
+	// monitoring works without queue if it could not be opened
+	if cfg.GetStorageQueuePath() != "" {
+		queueStorage, err := storage.NewQueueStorage(
+			externalStorage,
+			cfg.GetStorageQueuePath(),
+			cfg.GetStorageQueueSize(),
+			storage.DropPolicy(cfg.GetStorageQueueDropPolicy()),
+			queueReplayInterval,
+		)
+		if err != nil {
+			logger.Errorf("Storage queue is disabled, it could not be opened: %s", err.Error())
+		} else {
+			closers = append(closers, queueStorage.Close)
+			queue = queueStorage
+			externalStorage = queueStorage
+		}
+	}
+	// Snapshots are sent to storage in batches, every batch is saved in one transaction
+	if batchWriter, ok := externalStorage.(storage.BatchWriter); ok && cfg.GetStorageBatchSize() > 1 {
+		batchStorage := storage.NewBatchStorage(
+			batchWriter,
+			cfg.GetStorageBatchSize(),
+			cfg.GetStorageBatchInterval(),
+		)
+		closers = append(closers, batchStorage.Close)
+		externalStorage = batchStorage
+	}
 	siteMapStorage := sitemap_storage.New(
 		day,
+		siteMapStorageSize,
 		httpPackage,
 		parsers.NewSiteMapParser(),
 	)
 	configStorage := scheduler_config_storage.New(connector)
+	// Executor gets configs with resolved secrets, api works only with references
+	executorConfigStorage := configStorage
+	secretsKey, err := secret_storage.LoadKey(cfg.GetSecretsKey(), cfg.GetSecretsKeyFile())
+	if err != nil {
+		logger.Fatal(err.Error())
+	}
+	var secretStorage secret_storage.SecretStorage
+	if secretsKey != nil {
+		secretStorage, err = secret_storage.New(
+			mongo_helper.New(client.Database(cfg.GetMongoDb()).Collection(cfg.GetMongoSecretsCollection())),
+			secretsKey,
+		)
+		if err != nil {
+			logger.Fatal(err.Error())
+		}
+		executorConfigStorage = scheduler_config_storage.NewWithSecrets(configStorage, secretStorage)
+	}
 	jobExecutor := job_executor.NewExecutor(
 		externalStorage,
 		siteMapStorage,
 		httpPackage,
 		semaphore.NewSemaphore,
-		configStorage,
+		executorConfigStorage,
 		job.ExecTCP,
 		job.ExecGrpc,
 		job.ExecHTTP,
 		job.ExecSiteMap,
 		job.ExecHTTPValue,
 		job.ExecSSL,
+		job.ExecWebSocket,
+		job.ExecDatabase,
+		heartbeat_storage.New(),
+		job.ExecHeartbeat,
+		job.ExecHeartbeatPing,
+		content_storage.New(connector),
+		job.ExecContentChange,
+		job.ExecPrometheus,
 	)
 	app := application.New(
 		scheduler_storage.New(),
 		jobExecutor,
 		configStorage,
+		secretStorage,
+		storage.NewStatusReporter(queue, stateReporter),
 	)
-	logger.Fatal(app.Run(cfg.GetPort()).Error())
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- app.Run(cfg.GetPort())
+	}()
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, syscall.SIGTERM, syscall.SIGINT)
+	select {
+	case err = <-runErr:
+	case sig := <-interrupt:
+		logger.Infof("Received %s, shutting down", sig)
+	}
+	for i := len(closers) - 1; i >= 0; i-- {
+		closeErr := closers[i]()
+		if closeErr != nil {
+			logger.Error(closeErr.Error())
+		}
+	}
+	_ = client.Disconnect(context.Background())
+	if err != nil {
+		logger.Fatal(err.Error())
+	}
 }
@@ -8,20 +8,28 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"golang.org/x/sync/errgroup"
 	"github.com/squzy/squzy/internal/helpers"
+	"github.com/squzy/squzy/internal/job"
 	job_executor "github.com/squzy/squzy/internal/job-executor"
 	"github.com/squzy/squzy/internal/scheduler"
 	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
 	scheduler_storage "github.com/squzy/squzy/internal/scheduler-storage"
+	secret_storage "github.com/squzy/squzy/internal/secret-storage"
+	"github.com/squzy/squzy/internal/storage"
 )
 
 var (
-	errInvalidTypeError = errors.New("invalid type of config")
+	errInvalidTypeError     = errors.New("invalid type of config")
+	errSecretsNotConfigured = errors.New("secrets storage is not configured")
+	errClientKeyNotSecret   = errors.New("client key should be secret reference")
+	errAuthTypeInvalid      = errors.New("invalid type of auth")
 )
 
 type server struct {
 	schedulerStorage scheduler_storage.SchedulerStorage
 	jobExecutor      job_executor.JobExecutor
 	configStorage    scheduler_config_storage.Storage
+	secretStorage    secret_storage.SecretStorage
+	storageStatus    storage.StatusReporter
 }
 
 func (s *server) GetSchedulerList(ctx context.Context, rq *empty.Empty) (*apiPb.GetSchedulerListResponse, error) {
@@ -55,7 +63,17 @@ func (s *server) GetSchedulerList(ctx context.Context, rq *empty.Empty) (*apiPb.
 	}, nil
 }
 
+// GetSchedulerById returns scheduler with credentials hidden, values of secrets references are kept as is
 func (s *server) GetSchedulerById(ctx context.Context, rq *apiPb.GetSchedulerByIdRequest) (*apiPb.Scheduler, error) {
+	schld, err := s.getSchedulerById(ctx, rq)
+	if err != nil {
+		return nil, err
+	}
+	secret_storage.RedactMessage(schld)
+	return schld, nil
+}
+
+func (s *server) getSchedulerById(ctx context.Context, rq *apiPb.GetSchedulerByIdRequest) (*apiPb.Scheduler, error) {
 	id := rq.Id
 	idBson, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
@@ -111,6 +129,8 @@ func (s *server) GetSchedulerById(ctx context.Context, rq *apiPb.GetSchedulerByI
 					Url:        config.HTTPConfig.URL,
 					Headers:    config.HTTPConfig.Headers,
 					StatusCode: config.HTTPConfig.StatusCode,
+					Client:     helpers.HTTPClientToProto(config.HTTPConfig.Client),
+					Auth:       helpers.HTTPAuthToProto(config.HTTPConfig.Auth),
 				},
 			},
 		}, nil
@@ -126,6 +146,7 @@ func (s *server) GetSchedulerById(ctx context.Context, rq *apiPb.GetSchedulerByI
 				Sitemap: &apiPb.SiteMapConfig{
 					Url:         config.SiteMapConfig.URL,
 					Concurrency: config.SiteMapConfig.Concurrency,
+					Client:      helpers.HTTPClientToProto(config.SiteMapConfig.Client),
 				},
 			},
 		}, nil
@@ -144,6 +165,92 @@ func (s *server) GetSchedulerById(ctx context.Context, rq *apiPb.GetSchedulerByI
 				},
 			},
 		}, nil
+	case apiPb.SchedulerType_WEBSOCKET:
+		return &apiPb.Scheduler{
+			Id:       id,
+			Name:     config.Name,
+			Type:     apiPb.SchedulerType_WEBSOCKET,
+			Status:   config.Status,
+			Interval: config.Interval,
+			Timeout:  config.Timeout,
+			Config: &apiPb.Scheduler_Websocket{
+				Websocket: &apiPb.WebSocketConfig{
+					Url:     config.WebSocketConfig.URL,
+					Headers: config.WebSocketConfig.Headers,
+					Message: config.WebSocketConfig.Message,
+					Pattern: config.WebSocketConfig.Pattern,
+				},
+			},
+		}, nil
+	case apiPb.SchedulerType_DATABASE:
+		return &apiPb.Scheduler{
+			Id:       id,
+			Name:     config.Name,
+			Type:     apiPb.SchedulerType_DATABASE,
+			Status:   config.Status,
+			Interval: config.Interval,
+			Timeout:  config.Timeout,
+			Config: &apiPb.Scheduler_Database{
+				Database: &apiPb.DatabaseConfig{
+					Type:       config.DatabaseConfig.Type,
+					Dsn:        config.DatabaseConfig.DSN,
+					Query:      config.DatabaseConfig.Query,
+					Path:       config.DatabaseConfig.Path,
+					Assertions: helpers.AssertionsToProto(config.DatabaseConfig.Assertions),
+					Args:       config.DatabaseConfig.Args,
+				},
+			},
+		}, nil
+	case apiPb.SchedulerType_HEARTBEAT:
+		return &apiPb.Scheduler{
+			Id:       id,
+			Name:     config.Name,
+			Type:     apiPb.SchedulerType_HEARTBEAT,
+			Status:   config.Status,
+			Interval: config.Interval,
+			Timeout:  config.Timeout,
+			Config: &apiPb.Scheduler_Heartbeat{
+				Heartbeat: &apiPb.HeartbeatConfig{
+					Grace: config.HeartbeatConfig.Grace,
+				},
+			},
+		}, nil
+	case apiPb.SchedulerType_CONTENT_CHANGE:
+		return &apiPb.Scheduler{
+			Id:       id,
+			Name:     config.Name,
+			Type:     apiPb.SchedulerType_CONTENT_CHANGE,
+			Status:   config.Status,
+			Interval: config.Interval,
+			Timeout:  config.Timeout,
+			Config: &apiPb.Scheduler_ContentChange{
+				ContentChange: &apiPb.ContentChangeConfig{
+					Url:          config.ContentChangeConfig.URL,
+					Headers:      config.ContentChangeConfig.Headers,
+					SelectorType: config.ContentChangeConfig.SelectorType,
+					Selector:     config.ContentChangeConfig.Selector,
+					Baseline:     config.ContentChangeConfig.Baseline,
+					Auth:         helpers.HTTPAuthToProto(config.ContentChangeConfig.Auth),
+				},
+			},
+		}, nil
+	case apiPb.SchedulerType_PROMETHEUS:
+		return &apiPb.Scheduler{
+			Id:       id,
+			Name:     config.Name,
+			Type:     apiPb.SchedulerType_PROMETHEUS,
+			Status:   config.Status,
+			Interval: config.Interval,
+			Timeout:  config.Timeout,
+			Config: &apiPb.Scheduler_Prometheus{
+				Prometheus: &apiPb.PrometheusConfig{
+					Url:     config.PrometheusConfig.URL,
+					Headers: config.PrometheusConfig.Headers,
+					Rules:   config.PrometheusConfig.Rules,
+					Auth:    helpers.HTTPAuthToProto(config.PrometheusConfig.Auth),
+				},
+			},
+		}, nil
 	case apiPb.SchedulerType_HTTP_JSON_VALUE:
 		return &apiPb.Scheduler{
 			Id:       id,
@@ -158,6 +265,8 @@ func (s *server) GetSchedulerById(ctx context.Context, rq *apiPb.GetSchedulerByI
 					Url:       config.HTTPValueConfig.URL,
 					Headers:   config.HTTPValueConfig.Headers,
 					Selectors: helpers.SelectorsToProto(config.HTTPValueConfig.Selectors),
+					Client:    helpers.HTTPClientToProto(config.HTTPValueConfig.Client),
+					Auth:      helpers.HTTPAuthToProto(config.HTTPValueConfig.Auth),
 				},
 			},
 		}, nil
@@ -180,6 +289,10 @@ func (s *server) Remove(ctx context.Context, rq *apiPb.RemoveRequest) (*apiPb.Re
 	if err != nil {
 		return nil, err
 	}
+	err = s.jobExecutor.Remove(idBson)
+	if err != nil {
+		return nil, err
+	}
 	return &apiPb.RemoveResponse{
 		Id: id,
 	}, nil
@@ -199,12 +312,29 @@ func (s *server) Run(ctx context.Context, rq *apiPb.RunRequest) (*apiPb.RunRespo
 	if err != nil {
 		return nil, err
 	}
+	// State of previous runs should not be used, for example heartbeat would report ping missed while scheduler was stopped
+	s.jobExecutor.Reset(idBson)
 	schld.Run()
 	return &apiPb.RunResponse{
 		Id: id,
 	}, nil
 }
 
+func (s *server) Ping(ctx context.Context, rq *apiPb.PingRequest) (*apiPb.PingResponse, error) {
+	id := rq.Id
+	idBson, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, err
+	}
+	err = s.jobExecutor.Ping(idBson, rq.Type, rq.Message)
+	if err != nil {
+		return nil, err
+	}
+	return &apiPb.PingResponse{
+		Id: id,
+	}, nil
+}
+
 func (s *server) Stop(ctx context.Context, rq *apiPb.StopRequest) (*apiPb.StopResponse, error) {
 	id := rq.Id
 	idBson, err := primitive.ObjectIDFromHex(id)
@@ -220,6 +350,7 @@ func (s *server) Stop(ctx context.Context, rq *apiPb.StopRequest) (*apiPb.StopRe
 		return nil, err
 	}
 	schld.Stop()
+	s.jobExecutor.Reset(idBson)
 	return &apiPb.StopResponse{
 		Id: id,
 	}, nil
@@ -251,6 +382,10 @@ func (s *server) Add(ctx context.Context, rq *apiPb.AddRequest) (*apiPb.AddRespo
 			},
 		}
 	case *apiPb.AddRequest_Sitemap:
+		err = validateHTTPClient(config.Sitemap.Client)
+		if err != nil {
+			return nil, err
+		}
 		schedulerConfig = &scheduler_config_storage.SchedulerConfig{
 			ID:       schld.GetIDBson(),
 			Name:     rq.Name,
@@ -261,6 +396,7 @@ func (s *server) Add(ctx context.Context, rq *apiPb.AddRequest) (*apiPb.AddRespo
 			SiteMapConfig: &scheduler_config_storage.SiteMapConfig{
 				URL:         config.Sitemap.Url,
 				Concurrency: config.Sitemap.Concurrency,
+				Client:      helpers.HTTPClientToDb(config.Sitemap.Client),
 			},
 		}
 	case *apiPb.AddRequest_Grpc:
@@ -278,6 +414,14 @@ func (s *server) Add(ctx context.Context, rq *apiPb.AddRequest) (*apiPb.AddRespo
 			},
 		}
 	case *apiPb.AddRequest_Http:
+		err = validateHTTPAuth(config.Http.Auth)
+		if err != nil {
+			return nil, err
+		}
+		err = validateHTTPClient(config.Http.Client)
+		if err != nil {
+			return nil, err
+		}
 		schedulerConfig = &scheduler_config_storage.SchedulerConfig{
 			ID:       schld.GetIDBson(),
 			Name:     rq.Name,
@@ -290,9 +434,19 @@ func (s *server) Add(ctx context.Context, rq *apiPb.AddRequest) (*apiPb.AddRespo
 				URL:        config.Http.Url,
 				Headers:    config.Http.Headers,
 				StatusCode: config.Http.StatusCode,
+				Client:     helpers.HTTPClientToDb(config.Http.Client),
+				Auth:       helpers.HTTPAuthToDb(config.Http.Auth),
 			},
 		}
 	case *apiPb.AddRequest_HttpValue:
+		err = validateHTTPAuth(config.HttpValue.Auth)
+		if err != nil {
+			return nil, err
+		}
+		err = validateHTTPClient(config.HttpValue.Client)
+		if err != nil {
+			return nil, err
+		}
 		schedulerConfig = &scheduler_config_storage.SchedulerConfig{
 			ID:       schld.GetIDBson(),
 			Name:     rq.Name,
@@ -305,6 +459,8 @@ func (s *server) Add(ctx context.Context, rq *apiPb.AddRequest) (*apiPb.AddRespo
 				URL:       config.HttpValue.Url,
 				Headers:   config.HttpValue.Headers,
 				Selectors: helpers.SelectorsToDb(config.HttpValue.Selectors),
+				Client:    helpers.HTTPClientToDb(config.HttpValue.Client),
+				Auth:      helpers.HTTPAuthToDb(config.HttpValue.Auth),
 			},
 		}
 	case *apiPb.AddRequest_SslExpiration:
@@ -320,6 +476,98 @@ func (s *server) Add(ctx context.Context, rq *apiPb.AddRequest) (*apiPb.AddRespo
 				Port: config.SslExpiration.Port,
 			},
 		}
+	case *apiPb.AddRequest_Websocket:
+		schedulerConfig = &scheduler_config_storage.SchedulerConfig{
+			ID:       schld.GetIDBson(),
+			Name:     rq.Name,
+			Type:     apiPb.SchedulerType_WEBSOCKET,
+			Status:   apiPb.SchedulerStatus_STOPPED,
+			Interval: rq.Interval,
+			Timeout:  rq.Timeout,
+			WebSocketConfig: &scheduler_config_storage.WebSocketConfig{
+				URL:     config.Websocket.Url,
+				Headers: config.Websocket.Headers,
+				Message: config.Websocket.Message,
+				Pattern: config.Websocket.Pattern,
+			},
+		}
+	case *apiPb.AddRequest_Database:
+		err = job.ValidateDatabaseConfig(config.Database)
+		if err != nil {
+			return nil, err
+		}
+		schedulerConfig = &scheduler_config_storage.SchedulerConfig{
+			ID:       schld.GetIDBson(),
+			Name:     rq.Name,
+			Type:     apiPb.SchedulerType_DATABASE,
+			Status:   apiPb.SchedulerStatus_STOPPED,
+			Interval: rq.Interval,
+			Timeout:  rq.Timeout,
+			DatabaseConfig: &scheduler_config_storage.DatabaseConfig{
+				Type:       config.Database.Type,
+				DSN:        config.Database.Dsn,
+				Query:      config.Database.Query,
+				Path:       config.Database.Path,
+				Assertions: helpers.AssertionsToDb(config.Database.Assertions),
+				Args:       config.Database.Args,
+			},
+		}
+	case *apiPb.AddRequest_Heartbeat:
+		schedulerConfig = &scheduler_config_storage.SchedulerConfig{
+			ID:       schld.GetIDBson(),
+			Name:     rq.Name,
+			Type:     apiPb.SchedulerType_HEARTBEAT,
+			Status:   apiPb.SchedulerStatus_STOPPED,
+			Interval: rq.Interval,
+			Timeout:  rq.Timeout,
+			HeartbeatConfig: &scheduler_config_storage.HeartbeatConfig{
+				Grace: config.Heartbeat.Grace,
+			},
+		}
+	case *apiPb.AddRequest_ContentChange:
+		err = validateHTTPAuth(config.ContentChange.Auth)
+		if err != nil {
+			return nil, err
+		}
+		schedulerConfig = &scheduler_config_storage.SchedulerConfig{
+			ID:       schld.GetIDBson(),
+			Name:     rq.Name,
+			Type:     apiPb.SchedulerType_CONTENT_CHANGE,
+			Status:   apiPb.SchedulerStatus_STOPPED,
+			Interval: rq.Interval,
+			Timeout:  rq.Timeout,
+			ContentChangeConfig: &scheduler_config_storage.ContentChangeConfig{
+				URL:          config.ContentChange.Url,
+				Headers:      config.ContentChange.Headers,
+				SelectorType: config.ContentChange.SelectorType,
+				Selector:     config.ContentChange.Selector,
+				Baseline:     config.ContentChange.Baseline,
+				Auth:         helpers.HTTPAuthToDb(config.ContentChange.Auth),
+			},
+		}
+	case *apiPb.AddRequest_Prometheus:
+		err = validateHTTPAuth(config.Prometheus.Auth)
+		if err != nil {
+			return nil, err
+		}
+		err = job.ValidatePrometheusConfig(config.Prometheus)
+		if err != nil {
+			return nil, err
+		}
+		schedulerConfig = &scheduler_config_storage.SchedulerConfig{
+			ID:       schld.GetIDBson(),
+			Name:     rq.Name,
+			Type:     apiPb.SchedulerType_PROMETHEUS,
+			Status:   apiPb.SchedulerStatus_STOPPED,
+			Interval: rq.Interval,
+			Timeout:  rq.Timeout,
+			PrometheusConfig: &scheduler_config_storage.PrometheusConfig{
+				URL:     config.Prometheus.Url,
+				Headers: config.Prometheus.Headers,
+				Rules:   config.Prometheus.Rules,
+				Auth:    helpers.HTTPAuthToDb(config.Prometheus.Auth),
+			},
+		}
 
 	default:
 		return nil, errInvalidTypeError
@@ -337,14 +585,80 @@ func (s *server) Add(ctx context.Context, rq *apiPb.AddRequest) (*apiPb.AddRespo
 	}, nil
 }
 
+// Private key of client certificate should not be stored in plain text
+func validateHTTPClient(config *apiPb.HttpClientConfig) error {
+	if config.GetClientKey() != "" && !secret_storage.HasReference(config.GetClientKey()) {
+		return errClientKeyNotSecret
+	}
+	return nil
+}
+
+// Auth with unknown type would fail on every run of the check
+func validateHTTPAuth(config *apiPb.HttpAuthConfig) error {
+	if config == nil {
+		return nil
+	}
+	if _, ok := apiPb.HttpAuthConfig_Type_name[int32(config.Type)]; !ok || config.Type == apiPb.HttpAuthConfig_AUTH_TYPE_UNSPECIFIED {
+		return errAuthTypeInvalid
+	}
+	return nil
+}
+
+func (s *server) SetSecret(ctx context.Context, rq *apiPb.SetSecretRequest) (*empty.Empty, error) {
+	if s.secretStorage == nil {
+		return nil, errSecretsNotConfigured
+	}
+	err := s.secretStorage.Set(ctx, rq.Name, rq.Value)
+	if err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+func (s *server) RemoveSecret(ctx context.Context, rq *apiPb.SecretRequest) (*empty.Empty, error) {
+	if s.secretStorage == nil {
+		return nil, errSecretsNotConfigured
+	}
+	err := s.secretStorage.Remove(ctx, rq.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+func (s *server) GetSecretList(ctx context.Context, rq *empty.Empty) (*apiPb.GetSecretListResponse, error) {
+	if s.secretStorage == nil {
+		return nil, errSecretsNotConfigured
+	}
+	names, err := s.secretStorage.GetNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &apiPb.GetSecretListResponse{
+		Names: names,
+	}, nil
+}
+
+func (s *server) GetStorageStatus(ctx context.Context, rq *empty.Empty) (*apiPb.GetStorageStatusResponse, error) {
+	if s.storageStatus == nil {
+		return &apiPb.GetStorageStatusResponse{}, nil
+	}
+	return s.storageStatus.GetStatus(), nil
+}
+
+// New secretStorage can be nil, then secrets api returns error
 func New(
 	schedulerStorage scheduler_storage.SchedulerStorage,
 	jobExecutor job_executor.JobExecutor,
 	configStorage scheduler_config_storage.Storage,
+	secretStorage secret_storage.SecretStorage,
+	storageStatus storage.StatusReporter,
 ) apiPb.SchedulersExecutorServer {
 	return &server{
 		schedulerStorage: schedulerStorage,
 		jobExecutor:      jobExecutor,
 		configStorage:    configStorage,
+		secretStorage:    secretStorage,
+		storageStatus:    storageStatus,
 	}
 }
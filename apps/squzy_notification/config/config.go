@@ -14,10 +14,18 @@ const (
 	ENV_MONGO_METHOD_COLLECTION = "MONGO_METHOD_COLLECTION"
 	ENV_DASHBOARD_HOST          = "DASHBOARD_HOST"
 
+	ENV_SECRETS_KEY              = "SQUZY_SECRETS_KEY"
+	ENV_SECRETS_KEY_FILE         = "SQUZY_SECRETS_KEY_FILE"
+	ENV_MONGO_SECRETS_DB         = "MONGO_SECRETS_DB"
+	ENV_MONGO_SECRETS_COLLECTION = "MONGO_SECRETS_COLLECTION"
+
 	defaultPort             int32 = 9098
 	defaultMongoDb                = "notification_manager"
 	defaultListCollection         = "list"
 	defaultMethodCollection       = "methods"
+	// Secrets are managed by squzy monitoring
+	defaultSecretsDb         = "squzy_monitoring"
+	defaultSecretsCollection = "secrets"
 )
 
 type Config interface {
@@ -28,6 +36,11 @@ type Config interface {
 	GetNotificationListCollection() string
 	GetStorageHost() string
 	GetDashboardHost() string
+	// Base64 encoded 32 bytes key, secrets are disabled if key and key file are empty
+	GetSecretsKey() string
+	GetSecretsKeyFile() string
+	GetMongoSecretsDB() string
+	GetMongoSecretsCollection() string
 }
 
 type cfg struct {
@@ -38,6 +51,10 @@ type cfg struct {
 	notificationListCollection   string
 	notificationMethodCollection string
 	dashboardHost                string
+	secretsKey                   string
+	secretsKeyFile               string
+	secretsDB                    string
+	secretsCollection            string
 }
 
 func (c *cfg) GetDashboardHost() string {
@@ -68,6 +85,22 @@ func (c *cfg) GetNotificationListCollection() string {
 	return c.notificationListCollection
 }
 
+func (c *cfg) GetSecretsKey() string {
+	return c.secretsKey
+}
+
+func (c *cfg) GetSecretsKeyFile() string {
+	return c.secretsKeyFile
+}
+
+func (c *cfg) GetMongoSecretsDB() string {
+	return c.secretsDB
+}
+
+func (c *cfg) GetMongoSecretsCollection() string {
+	return c.secretsCollection
+}
+
 func New() Config {
 	// Read port
 	portValue := os.Getenv(ENV_PORT)
@@ -91,6 +124,14 @@ func New() Config {
 	if methodCollection == "" {
 		methodCollection = defaultMethodCollection
 	}
+	secretsDb := os.Getenv(ENV_MONGO_SECRETS_DB)
+	if secretsDb == "" {
+		secretsDb = defaultSecretsDb
+	}
+	secretsCollection := os.Getenv(ENV_MONGO_SECRETS_COLLECTION)
+	if secretsCollection == "" {
+		secretsCollection = defaultSecretsCollection
+	}
 
 	return &cfg{
 		port:                         port,
@@ -100,5 +141,9 @@ func New() Config {
 		notificationListCollection:   listCollection,
 		notificationMethodCollection: methodCollection,
 		dashboardHost:                os.Getenv(ENV_DASHBOARD_HOST),
+		secretsKey:                   os.Getenv(ENV_SECRETS_KEY),
+		secretsKeyFile:               os.Getenv(ENV_SECRETS_KEY_FILE),
+		secretsDB:                    secretsDb,
+		secretsCollection:            secretsCollection,
 	}
 }
@@ -75,3 +75,22 @@ func TestCfg_GetDashboardHost(t *testing.T) {
 		assert.Equal(t, s.GetDashboardHost(), "localhost:9090")
 	})
 }
+
+func TestCfg_GetSecrets(t *testing.T) {
+	t.Run("Should: get default secrets collection", func(t *testing.T) {
+		s := New()
+		assert.Equal(t, s.GetMongoSecretsDB(), defaultSecretsDb)
+		assert.Equal(t, s.GetMongoSecretsCollection(), defaultSecretsCollection)
+	})
+	t.Run("Should: get secrets from env", func(t *testing.T) {
+		assert.Nil(t, os.Setenv(ENV_SECRETS_KEY, "key"))
+		assert.Nil(t, os.Setenv(ENV_SECRETS_KEY_FILE, "/run/secrets/key"))
+		assert.Nil(t, os.Setenv(ENV_MONGO_SECRETS_DB, "monitoring"))
+		assert.Nil(t, os.Setenv(ENV_MONGO_SECRETS_COLLECTION, "credentials"))
+		s := New()
+		assert.Equal(t, s.GetSecretsKey(), "key")
+		assert.Equal(t, s.GetSecretsKeyFile(), "/run/secrets/key")
+		assert.Equal(t, s.GetMongoSecretsDB(), "monitoring")
+		assert.Equal(t, s.GetMongoSecretsCollection(), "credentials")
+	})
+}
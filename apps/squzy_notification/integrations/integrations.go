@@ -9,6 +9,8 @@ import (
 	"github.com/squzy/squzy/apps/squzy_notification/config"
 	"github.com/squzy/squzy/apps/squzy_notification/database"
 	"github.com/squzy/squzy/internal/httptools"
+	"github.com/squzy/squzy/internal/logger"
+	secret_storage "github.com/squzy/squzy/internal/secret-storage"
 	api "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
 	"net/http"
 	"time"
@@ -31,9 +33,23 @@ type WebhookRequest struct {
 type integrations struct {
 	httpTools httptools.HTTPTool
 	cfg       config.Config
+	secrets   secret_storage.SecretStorage
+}
+
+// url replaces {{secret "name"}} references, url is returned as is if secrets are not configured
+func (i *integrations) url(ctx context.Context, url string) (string, error) {
+	if i.secrets == nil {
+		return url, nil
+	}
+	return secret_storage.Resolve(ctx, i.secrets, url)
 }
 
 func (i *integrations) Slack(ctx context.Context, ruleName string, incident *api.Incident, config *database.SlackConfig) {
+	url, err := i.url(ctx, config.Url)
+	if err != nil {
+		logger.Errorf("Could not resolve slack url of incident %s: %s", incident.Id, err.Error())
+		return
+	}
 	createdAt, updatedAt := getIncidentTime(incident)
 	msg := &slack.WebhookMessage{
 		Attachments: []slack.Attachment{
@@ -72,10 +88,15 @@ func (i *integrations) Slack(ctx context.Context, ruleName string, incident *api
 			},
 		},
 	}
-	_ = slack.PostWebhookContext(ctx, config.Url, msg)
+	_ = slack.PostWebhookContext(ctx, url, msg)
 }
 
 func (i *integrations) Webhook(ctx context.Context, ruleName string, incident *api.Incident, config *database.WebHookConfig) {
+	url, err := i.url(ctx, config.Url)
+	if err != nil {
+		logger.Errorf("Could not resolve webhook url of incident %s: %s", incident.Id, err.Error())
+		return
+	}
 	createdAt, updatedAt := getIncidentTime(incident)
 	webHook := &WebhookRequest{
 		Id:        incident.Id,
@@ -87,7 +108,7 @@ func (i *integrations) Webhook(ctx context.Context, ruleName string, incident *a
 	}
 	// Skip error because we create that structure
 	body, _ := json.Marshal(webHook)
-	req, _ := http.NewRequest(http.MethodPost, config.Url, bytes.NewBuffer(body))
+	req, _ := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(body))
 
 	_, _, _ = i.httpTools.SendRequest(req.WithContext(ctx))
 }
@@ -107,9 +128,11 @@ func getIncidentTime(incident *api.Incident) (createdAt string, updatedAt string
 	return createdAt, updatedAt
 }
 
-func New(httpTools httptools.HTTPTool, cfg config.Config) Integrations {
+// New secrets can be nil, then urls are used without resolving
+func New(httpTools httptools.HTTPTool, cfg config.Config, secrets secret_storage.SecretStorage) Integrations {
 	return &integrations{
 		httpTools: httpTools,
 		cfg:       cfg,
+		secrets:   secrets,
 	}
 }
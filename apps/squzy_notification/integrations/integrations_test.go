@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"github.com/squzy/squzy/apps/squzy_notification/database"
+	"github.com/squzy/squzy/internal/httptools"
 	api "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
 	"github.com/stretchr/testify/assert"
 	timestamp "google.golang.org/protobuf/types/known/timestamppb"
@@ -43,6 +44,54 @@ func (m mockCfg) GetDashboardHost() string {
 	return ""
 }
 
+func (m mockCfg) GetSecretsKey() string {
+	panic("implement me")
+}
+
+func (m mockCfg) GetSecretsKeyFile() string {
+	panic("implement me")
+}
+
+func (m mockCfg) GetMongoSecretsDB() string {
+	panic("implement me")
+}
+
+func (m mockCfg) GetMongoSecretsCollection() string {
+	panic("implement me")
+}
+
+type mockSecrets struct {
+}
+
+func (m mockSecrets) Set(ctx context.Context, name string, value string) error {
+	panic("implement me")
+}
+
+func (m mockSecrets) Get(ctx context.Context, name string) (string, error) {
+	if name == "webhook" {
+		return "localhost:9090", nil
+	}
+	return "", errors.New("")
+}
+
+func (m mockSecrets) Remove(ctx context.Context, name string) error {
+	panic("implement me")
+}
+
+func (m mockSecrets) GetNames(ctx context.Context) ([]string, error) {
+	panic("implement me")
+}
+
+type mockRequests struct {
+	mock
+	urls []string
+}
+
+func (m *mockRequests) SendRequest(req *http.Request) (int, []byte, error) {
+	m.urls = append(m.urls, req.URL.String())
+	return 0, []byte{}, nil
+}
+
 type mock struct {
 }
 
@@ -69,6 +118,14 @@ func (m mockError) CreateRequest(method string, url string, headers *map[string]
 	panic("implement me")
 }
 
+func (m mockError) WithOptions(options *httptools.ClientOptions) (httptools.HTTPTool, error) {
+	return m, nil
+}
+
+func (m mockError) WithAuth(auth *httptools.AuthOptions) httptools.HTTPTool {
+	return m
+}
+
 func (m mock) SendRequest(req *http.Request) (int, []byte, error) {
 	return 0, []byte{}, nil
 }
@@ -89,16 +146,24 @@ func (m mock) CreateRequest(method string, url string, headers *map[string]strin
 	panic("implement me")
 }
 
+func (m mock) WithOptions(options *httptools.ClientOptions) (httptools.HTTPTool, error) {
+	return m, nil
+}
+
+func (m mock) WithAuth(auth *httptools.AuthOptions) httptools.HTTPTool {
+	return m
+}
+
 func TestNew(t *testing.T) {
 	t.Run("Shuld: not be nil", func(t *testing.T) {
-		s := New(nil, nil)
+		s := New(nil, nil, nil)
 		assert.NotNil(t, s)
 	})
 }
 
 func TestIntegrations_Webhook(t *testing.T) {
 	t.Run("Should: not throw panic", func(t *testing.T) {
-		s := New(&mock{}, &mockCfg{})
+		s := New(&mock{}, &mockCfg{}, nil)
 		assert.NotPanics(t, func() {
 			s.Webhook(context.Background(), "", &api.Incident{
 				Histories: []*api.Incident_HistoryItem{
@@ -111,7 +176,7 @@ func TestIntegrations_Webhook(t *testing.T) {
 		})
 	})
 	t.Run("Should: not throw panic", func(t *testing.T) {
-		s := New(&mock{}, &mockCfg{})
+		s := New(&mock{}, &mockCfg{}, nil)
 		assert.NotPanics(t, func() {
 			s.Webhook(context.Background(), "", &api.Incident{
 				Histories: []*api.Incident_HistoryItem{
@@ -124,7 +189,7 @@ func TestIntegrations_Webhook(t *testing.T) {
 		})
 	})
 	t.Run("Should: not throw panic", func(t *testing.T) {
-		s := New(&mockError{}, &mockCfg{})
+		s := New(&mockError{}, &mockCfg{}, nil)
 		assert.NotPanics(t, func() {
 			s.Webhook(context.Background(), "", &api.Incident{
 				Histories: []*api.Incident_HistoryItem{
@@ -138,9 +203,28 @@ func TestIntegrations_Webhook(t *testing.T) {
 	})
 }
 
+func TestIntegrations_WebhookSecrets(t *testing.T) {
+	t.Run("Should: send request to resolved url", func(t *testing.T) {
+		tools := &mockRequests{}
+		s := New(tools, &mockCfg{}, &mockSecrets{})
+		s.Webhook(context.Background(), "", &api.Incident{}, &database.WebHookConfig{
+			Url: `http://{{secret "webhook"}}/hook`,
+		})
+		assert.Equal(t, []string{"http://localhost:9090/hook"}, tools.urls)
+	})
+	t.Run("Should: not send request if secret not exist", func(t *testing.T) {
+		tools := &mockRequests{}
+		s := New(tools, &mockCfg{}, &mockSecrets{})
+		s.Webhook(context.Background(), "", &api.Incident{}, &database.WebHookConfig{
+			Url: `http://{{secret "missing"}}/hook`,
+		})
+		assert.Equal(t, 0, len(tools.urls))
+	})
+}
+
 func TestIntegrations_Slack(t *testing.T) {
 	t.Run("Should: not throw panic", func(t *testing.T) {
-		s := New(&mock{}, &mockCfg{})
+		s := New(&mock{}, &mockCfg{}, nil)
 		assert.NotPanics(t, func() {
 			s.Slack(context.Background(), "", &api.Incident{
 				Histories: []*api.Incident_HistoryItem{
@@ -152,4 +236,12 @@ func TestIntegrations_Slack(t *testing.T) {
 			}, &database.SlackConfig{})
 		})
 	})
+	t.Run("Should: not throw panic if secret not exist", func(t *testing.T) {
+		s := New(&mock{}, &mockCfg{}, &mockSecrets{})
+		assert.NotPanics(t, func() {
+			s.Slack(context.Background(), "", &api.Incident{}, &database.SlackConfig{
+				Url: `{{secret "missing"}}`,
+			})
+		})
+	})
 }
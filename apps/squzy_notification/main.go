@@ -13,6 +13,7 @@ import (
 	"github.com/squzy/squzy/internal/helpers"
 	"github.com/squzy/squzy/internal/httptools"
 	"github.com/squzy/squzy/internal/logger"
+	secret_storage "github.com/squzy/squzy/internal/secret-storage"
 	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -44,13 +45,27 @@ func main() {
 		_ = conn.Close()
 	}()
 	storageClient := apiPb.NewStorageClient(conn)
+	secretsKey, err := secret_storage.LoadKey(cfg.GetSecretsKey(), cfg.GetSecretsKeyFile())
+	if err != nil {
+		logger.Fatal(err.Error())
+	}
+	var secretStorage secret_storage.SecretStorage
+	if secretsKey != nil {
+		secretStorage, err = secret_storage.New(
+			mongo_helper.New(client.Database(cfg.GetMongoSecretsDB()).Collection(cfg.GetMongoSecretsCollection())),
+			secretsKey,
+		)
+		if err != nil {
+			logger.Fatal(err.Error())
+		}
+	}
 	logger.Fatal(
 		application.New(
 			server.New(
 				database.NewList(mongo_helper.New(client.Database(cfg.GetMongoDB()).Collection(cfg.GetNotificationListCollection()))),
 				database.NewMethods(mongo_helper.New(client.Database(cfg.GetMongoDB()).Collection(cfg.GetNotificationMethodCollection()))),
 				storageClient,
-				integrations.New(tools, cfg),
+				integrations.New(tools, cfg, secretStorage),
 			),
 		).Run(cfg.GetPort()).Error())
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/squzy/squzy/apps/squzy_notification/integrations"
 	"github.com/squzy/squzy/internal/helpers"
 	"github.com/squzy/squzy/internal/logger"
+	secret_storage "github.com/squzy/squzy/internal/secret-storage"
 	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"golang.org/x/sync/errgroup"
@@ -25,6 +26,7 @@ var (
 	errTypeNotExist = errors.New("notification type not exist")
 )
 
+// Urls of methods contain tokens, so only secrets references are returned as is
 func dbMethodToProto(method *database.NotificationMethod) (*apiPb.NotificationMethod, error) {
 	switch method.Type {
 	case apiPb.NotificationMethodType_NOTIFICATION_METHOD_WEBHOOK:
@@ -35,7 +37,7 @@ func dbMethodToProto(method *database.NotificationMethod) (*apiPb.NotificationMe
 			Type:   apiPb.NotificationMethodType_NOTIFICATION_METHOD_WEBHOOK,
 			Method: &apiPb.NotificationMethod_Webhook{
 				Webhook: &apiPb.WebHookMethod{
-					Url: method.WebHook.Url,
+					Url: secret_storage.Redact(method.WebHook.Url),
 				},
 			},
 		}, nil
@@ -47,7 +49,7 @@ func dbMethodToProto(method *database.NotificationMethod) (*apiPb.NotificationMe
 			Type:   apiPb.NotificationMethodType_NOTIFICATION_METHOD_SLACK,
 			Method: &apiPb.NotificationMethod_Slack{
 				Slack: &apiPb.SlackMethod{
-					Url: method.Slack.Url,
+					Url: secret_storage.Redact(method.Slack.Url),
 				},
 			},
 		}, nil
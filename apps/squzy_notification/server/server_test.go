@@ -133,6 +133,10 @@ func (m mockStorageError) SaveResponseFromScheduler(ctx context.Context, in *api
 	panic("implement me")
 }
 
+func (m mockStorageError) SaveResponsesFromScheduler(ctx context.Context, in *api.SchedulerResponseBatch, opts ...grpc.CallOption) (*empty.Empty, error) {
+	panic("implement me")
+}
+
 func (m mockStorageError) SaveResponseFromAgent(ctx context.Context, in *api.Metric, opts ...grpc.CallOption) (*empty.Empty, error) {
 	panic("implement me")
 }
@@ -149,6 +153,22 @@ func (m mockStorageError) GetSchedulerUptime(ctx context.Context, in *api.GetSch
 	panic("implement me")
 }
 
+func (m mockStorageError) GetSchedulerUptimeSeries(ctx context.Context, in *api.GetSchedulerUptimeSeriesRequest, opts ...grpc.CallOption) (*api.GetSchedulerUptimeSeriesResponse, error) {
+	panic("implement me")
+}
+
+func (m mockStorageError) GetSchedulerOutages(ctx context.Context, in *api.GetSchedulerOutagesRequest, opts ...grpc.CallOption) (*api.GetSchedulerOutagesResponse, error) {
+	panic("implement me")
+}
+
+func (m mockStorageError) GetAgentSeries(ctx context.Context, in *api.GetAgentSeriesRequest, opts ...grpc.CallOption) (*api.GetAgentSeriesResponse, error) {
+	panic("implement me")
+}
+
+func (m mockStorageError) Subscribe(ctx context.Context, in *api.SubscribeRequest, opts ...grpc.CallOption) (api.Storage_SubscribeClient, error) {
+	panic("implement me")
+}
+
 func (m mockStorageError) GetAgentInformation(ctx context.Context, in *api.GetAgentInformationRequest, opts ...grpc.CallOption) (*api.GetAgentInformationResponse, error) {
 	panic("implement me")
 }
@@ -165,6 +185,10 @@ func (m mockStorageError) GetTransactionById(ctx context.Context, in *api.GetTra
 	panic("implement me")
 }
 
+func (m mockStorageError) GetTransactionTree(ctx context.Context, in *api.GetTransactionByIdRequest, opts ...grpc.CallOption) (*api.GetTransactionTreeResponse, error) {
+	panic("implement me")
+}
+
 func (m mockStorageError) SaveIncident(ctx context.Context, in *api.Incident, opts ...grpc.CallOption) (*empty.Empty, error) {
 	panic("implement me")
 }
@@ -192,6 +216,10 @@ func (m mockStorageOk) SaveResponseFromScheduler(ctx context.Context, in *api.Sc
 	panic("implement me")
 }
 
+func (m mockStorageOk) SaveResponsesFromScheduler(ctx context.Context, in *api.SchedulerResponseBatch, opts ...grpc.CallOption) (*empty.Empty, error) {
+	panic("implement me")
+}
+
 func (m mockStorageOk) SaveResponseFromAgent(ctx context.Context, in *api.Metric, opts ...grpc.CallOption) (*empty.Empty, error) {
 	panic("implement me")
 }
@@ -208,6 +236,22 @@ func (m mockStorageOk) GetSchedulerUptime(ctx context.Context, in *api.GetSchedu
 	panic("implement me")
 }
 
+func (m mockStorageOk) GetSchedulerUptimeSeries(ctx context.Context, in *api.GetSchedulerUptimeSeriesRequest, opts ...grpc.CallOption) (*api.GetSchedulerUptimeSeriesResponse, error) {
+	panic("implement me")
+}
+
+func (m mockStorageOk) GetSchedulerOutages(ctx context.Context, in *api.GetSchedulerOutagesRequest, opts ...grpc.CallOption) (*api.GetSchedulerOutagesResponse, error) {
+	panic("implement me")
+}
+
+func (m mockStorageOk) GetAgentSeries(ctx context.Context, in *api.GetAgentSeriesRequest, opts ...grpc.CallOption) (*api.GetAgentSeriesResponse, error) {
+	panic("implement me")
+}
+
+func (m mockStorageOk) Subscribe(ctx context.Context, in *api.SubscribeRequest, opts ...grpc.CallOption) (api.Storage_SubscribeClient, error) {
+	panic("implement me")
+}
+
 func (m mockStorageOk) GetAgentInformation(ctx context.Context, in *api.GetAgentInformationRequest, opts ...grpc.CallOption) (*api.GetAgentInformationResponse, error) {
 	panic("implement me")
 }
@@ -224,6 +268,10 @@ func (m mockStorageOk) GetTransactionById(ctx context.Context, in *api.GetTransa
 	panic("implement me")
 }
 
+func (m mockStorageOk) GetTransactionTree(ctx context.Context, in *api.GetTransactionByIdRequest, opts ...grpc.CallOption) (*api.GetTransactionTreeResponse, error) {
+	panic("implement me")
+}
+
 func (m mockStorageOk) SaveIncident(ctx context.Context, in *api.Incident, opts ...grpc.CallOption) (*empty.Empty, error) {
 	panic("implement me")
 }
@@ -541,6 +589,25 @@ func TestServer_DeleteById(t *testing.T) {
 	})
 }
 
+func TestDbMethodToProto(t *testing.T) {
+	t.Run("Should: hide url", func(t *testing.T) {
+		method, err := dbMethodToProto(&database.NotificationMethod{
+			Type:  api.NotificationMethodType_NOTIFICATION_METHOD_SLACK,
+			Slack: &database.SlackConfig{Url: "https://hooks.slack.com/services/token"},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, "******", method.GetSlack().Url)
+	})
+	t.Run("Should: keep secret reference", func(t *testing.T) {
+		method, err := dbMethodToProto(&database.NotificationMethod{
+			Type:    api.NotificationMethodType_NOTIFICATION_METHOD_WEBHOOK,
+			WebHook: &database.WebHookConfig{Url: `{{secret "webhook"}}`},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, `{{secret "webhook"}}`, method.GetWebhook().Url)
+	})
+}
+
 func TestServer_GetById(t *testing.T) {
 	t.Run("Should: throw error because not bson", func(t *testing.T) {
 		s := New(nil, nil, nil, nil)
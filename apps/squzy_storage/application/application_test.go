@@ -3,6 +3,7 @@ package application
 import (
 	"context"
 	empty "google.golang.org/protobuf/types/known/emptypb"
+	"github.com/squzy/squzy/apps/squzy_storage/config"
 	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
 	"github.com/stretchr/testify/assert"
 	"net"
@@ -49,6 +50,26 @@ func (*configErrorMock) WithDbLogs() bool {
 	return false
 }
 
+func (*configErrorMock) WithAutoMigrate() bool {
+	return true
+}
+
+func (*configErrorMock) GetRollupInterval() time.Duration {
+	return time.Minute
+}
+
+func (*configErrorMock) GetRetention() config.Retention {
+	return config.Retention{}
+}
+
+func (*configErrorMock) GetDbType() string {
+	return config.DbTypePostgres
+}
+
+func (*configErrorMock) GetDbPath() string {
+	return ""
+}
+
 type configMock struct {
 }
 
@@ -72,6 +93,26 @@ func (*configMock) WithDbLogs() bool {
 	return false
 }
 
+func (*configMock) WithAutoMigrate() bool {
+	return true
+}
+
+func (*configMock) GetRollupInterval() time.Duration {
+	return time.Minute
+}
+
+func (*configMock) GetRetention() config.Retention {
+	return config.Retention{}
+}
+
+func (*configMock) GetDbType() string {
+	return config.DbTypePostgres
+}
+
+func (*configMock) GetDbPath() string {
+	return ""
+}
+
 func (*configMock) GetDbPort() string {
 	panic("implement me!")
 }
@@ -115,10 +156,30 @@ func (s mockApiStorage) GetSchedulerUptime(ctx context.Context, in *apiPb.GetSch
 	panic("implement me")
 }
 
+func (s mockApiStorage) GetSchedulerUptimeSeries(ctx context.Context, in *apiPb.GetSchedulerUptimeSeriesRequest) (*apiPb.GetSchedulerUptimeSeriesResponse, error) {
+	panic("implement me")
+}
+
+func (s mockApiStorage) GetSchedulerOutages(ctx context.Context, in *apiPb.GetSchedulerOutagesRequest) (*apiPb.GetSchedulerOutagesResponse, error) {
+	panic("implement me")
+}
+
+func (s mockApiStorage) GetAgentSeries(ctx context.Context, in *apiPb.GetAgentSeriesRequest) (*apiPb.GetAgentSeriesResponse, error) {
+	panic("implement me")
+}
+
+func (s mockApiStorage) Subscribe(request *apiPb.SubscribeRequest, srv apiPb.Storage_SubscribeServer) error {
+	panic("implement me")
+}
+
 func (m mockApiStorage) SaveResponseFromScheduler(ctx context.Context, response *apiPb.SchedulerResponse) (*empty.Empty, error) {
 	panic("implement me")
 }
 
+func (m mockApiStorage) SaveResponsesFromScheduler(ctx context.Context, response *apiPb.SchedulerResponseBatch) (*empty.Empty, error) {
+	panic("implement me")
+}
+
 func (m mockApiStorage) SaveResponseFromAgent(ctx context.Context, metric *apiPb.Metric) (*empty.Empty, error) {
 	panic("implement me")
 }
@@ -147,6 +208,10 @@ func (m mockApiStorage) GetTransactionById(ctx context.Context, request *apiPb.G
 	panic("implement me")
 }
 
+func (m mockApiStorage) GetTransactionTree(ctx context.Context, request *apiPb.GetTransactionByIdRequest) (*apiPb.GetTransactionTreeResponse, error) {
+	panic("implement me")
+}
+
 func TestNewServer(t *testing.T) {
 	t.Run("Should: work", func(t *testing.T) {
 		s := NewApplication(nil, nil)
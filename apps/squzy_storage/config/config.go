@@ -3,11 +3,14 @@ package config
 import (
 	"os"
 	"strconv"
+	"time"
 )
 
 const (
 	ENV_PORT = "PORT"
 
+	ENV_DB_TYPE     = "DB_TYPE"
+	ENV_DB_PATH     = "DB_PATH"
 	ENV_DB_HOST     = "DB_HOST"
 	ENV_DB_PORT     = "DB_PORT"
 	ENV_DB_NAME     = "DB_NAME"
@@ -15,14 +18,30 @@ const (
 	ENV_DB_PASSWORD = "DB_PASSWORD"
 	ENV_DB_LOGS     = "DB_LOGS"
 
+	ENV_DB_AUTO_MIGRATE = "DB_AUTO_MIGRATE"
+
 	ENV_INCIDENT_SERVER_HOST = "INCIDENT_SERVER_HOST"
 	ENV_ENABLE_INCIDENT      = "ENABLE_INCIDENT"
 
-	defaultPort int32 = 9090
+	ENV_ROLLUP_INTERVAL               = "ROLLUP_INTERVAL"
+	ENV_RETENTION_SNAPSHOTS_DAYS      = "RETENTION_SNAPSHOTS_DAYS"
+	ENV_RETENTION_STAT_REQUESTS_DAYS  = "RETENTION_STAT_REQUESTS_DAYS"
+	ENV_RETENTION_TRANSACTIONS_DAYS   = "RETENTION_TRANSACTIONS_DAYS"
+	ENV_RETENTION_HOURLY_ROLLUPS_DAYS = "RETENTION_HOURLY_ROLLUPS_DAYS"
+	ENV_RETENTION_DAILY_ROLLUPS_DAYS  = "RETENTION_DAILY_ROLLUPS_DAYS"
+
+	DbTypePostgres = "postgres"
+	DbTypeSqlite   = "sqlite"
+
+	defaultDbPath               = "squzy.db"
+	defaultPort           int32 = 9090
+	defaultRollupInterval       = time.Minute * 5
 )
 
 type cfg struct {
 	port           int32
+	dbType         string
+	dbPath         string
 	dbHost         string
 	dbPort         string
 	dbName         string
@@ -31,12 +50,32 @@ type cfg struct {
 	incidentServer string
 	withIncident   bool
 	withDbLogs     bool
+	autoMigrate    bool
+	rollupInterval time.Duration
+	retention      Retention
+}
+
+// Zero duration means that data is kept forever
+type Retention struct {
+	Snapshots     time.Duration
+	StatRequests  time.Duration
+	Transactions  time.Duration
+	HourlyRollups time.Duration
+	DailyRollups  time.Duration
 }
 
 func (c *cfg) GetPort() int32 {
 	return c.port
 }
 
+func (c *cfg) GetDbType() string {
+	return c.dbType
+}
+
+func (c *cfg) GetDbPath() string {
+	return c.dbPath
+}
+
 func (c *cfg) GetDbHost() string {
 	return c.dbHost
 }
@@ -69,8 +108,23 @@ func (c *cfg) WithDbLogs() bool {
 	return c.withDbLogs
 }
 
+func (c *cfg) WithAutoMigrate() bool {
+	return c.autoMigrate
+}
+
+func (c *cfg) GetRollupInterval() time.Duration {
+	return c.rollupInterval
+}
+
+func (c *cfg) GetRetention() Retention {
+	return c.retention
+}
+
 type Config interface {
 	GetPort() int32
+	// Postgres is used by default, sqlite stores everything in file by path
+	GetDbType() string
+	GetDbPath() string
 	GetDbHost() string
 	GetDbPort() string
 	GetDbName() string
@@ -79,6 +133,10 @@ type Config interface {
 	GetIncidentServerAddress() string
 	WithIncident() bool
 	WithDbLogs() bool
+	// Schema is migrated to the latest version on start
+	WithAutoMigrate() bool
+	GetRollupInterval() time.Duration
+	GetRetention() Retention
 }
 
 func New() Config {
@@ -108,8 +166,37 @@ func New() Config {
 			withDbLog = value
 		}
 	}
+
+	autoMigrate := true
+	autoMigrateValue := os.Getenv(ENV_DB_AUTO_MIGRATE)
+	if autoMigrateValue != "" {
+		value, err := strconv.ParseBool(autoMigrateValue)
+		if err == nil {
+			autoMigrate = value
+		}
+	}
+
+	dbType := DbTypePostgres
+	if os.Getenv(ENV_DB_TYPE) == DbTypeSqlite {
+		dbType = DbTypeSqlite
+	}
+	dbPath := os.Getenv(ENV_DB_PATH)
+	if dbPath == "" {
+		dbPath = defaultDbPath
+	}
+
+	rollupInterval := defaultRollupInterval
+	rollupIntervalValue := os.Getenv(ENV_ROLLUP_INTERVAL)
+	if rollupIntervalValue != "" {
+		i, err := strconv.ParseInt(rollupIntervalValue, 10, 32)
+		if err == nil && i > 0 {
+			rollupInterval = time.Second * time.Duration(i)
+		}
+	}
 	return &cfg{
 		port:           port,
+		dbType:         dbType,
+		dbPath:         dbPath,
 		dbHost:         os.Getenv(ENV_DB_HOST),
 		dbPort:         os.Getenv(ENV_DB_PORT),
 		dbName:         os.Getenv(ENV_DB_NAME),
@@ -118,5 +205,26 @@ func New() Config {
 		incidentServer: os.Getenv(ENV_INCIDENT_SERVER_HOST),
 		withIncident:   withIncident,
 		withDbLogs:     withDbLog,
+		autoMigrate:    autoMigrate,
+		rollupInterval: rollupInterval,
+		retention: Retention{
+			Snapshots:     getRetention(ENV_RETENTION_SNAPSHOTS_DAYS),
+			StatRequests:  getRetention(ENV_RETENTION_STAT_REQUESTS_DAYS),
+			Transactions:  getRetention(ENV_RETENTION_TRANSACTIONS_DAYS),
+			HourlyRollups: getRetention(ENV_RETENTION_HOURLY_ROLLUPS_DAYS),
+			DailyRollups:  getRetention(ENV_RETENTION_DAILY_ROLLUPS_DAYS),
+		},
+	}
+}
+
+func getRetention(env string) time.Duration {
+	value := os.Getenv(env)
+	if value == "" {
+		return 0
+	}
+	days, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || days < 0 {
+		return 0
 	}
+	return time.Hour * 24 * time.Duration(days)
 }
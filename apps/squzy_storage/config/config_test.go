@@ -4,12 +4,15 @@ import (
 	"github.com/stretchr/testify/assert"
 	"os"
 	"testing"
+	"time"
 )
 
 func TestNew(t *testing.T) {
 	t.Run("Shoud: return default value", func(t *testing.T) {
 		s := New()
 		assert.Equal(t, s.GetPort(), defaultPort)
+		assert.Equal(t, s.GetDbType(), DbTypePostgres)
+		assert.Equal(t, s.GetDbPath(), defaultDbPath)
 		assert.Equal(t, s.GetDbHost(), "")
 		assert.Equal(t, s.GetDbPort(), "")
 		assert.Equal(t, s.GetDbName(), "")
@@ -18,6 +21,9 @@ func TestNew(t *testing.T) {
 		assert.Equal(t, s.GetIncidentServerAddress(), "")
 		assert.Equal(t, s.WithIncident(), false)
 		assert.Equal(t, s.WithDbLogs(), false)
+		assert.Equal(t, s.WithAutoMigrate(), true)
+		assert.Equal(t, s.GetRollupInterval(), defaultRollupInterval)
+		assert.Equal(t, s.GetRetention(), Retention{})
 	})
 }
 
@@ -36,6 +42,22 @@ func TestCfg_GetPort(t *testing.T) {
 	})
 }
 
+func TestCfg_GetDbType(t *testing.T) {
+	t.Run("Should: return from env", func(t *testing.T) {
+		err := os.Setenv(ENV_DB_TYPE, "sqlite")
+		if err != nil {
+			assert.NotNil(t, nil)
+		}
+		err = os.Setenv(ENV_DB_PATH, "/data/squzy.db")
+		if err != nil {
+			assert.NotNil(t, nil)
+		}
+		s := New()
+		assert.Equal(t, s.GetDbType(), DbTypeSqlite)
+		assert.Equal(t, s.GetDbPath(), "/data/squzy.db")
+	})
+}
+
 func TestCfg_GetDbHost(t *testing.T) {
 	t.Run("Should: return from env", func(t *testing.T) {
 		err := os.Setenv(ENV_DB_HOST, "dbhost")
@@ -123,3 +145,41 @@ func TestCfg_WithDbLogs(t *testing.T) {
 		assert.Equal(t, s.WithDbLogs(), true)
 	})
 }
+
+func TestCfg_WithAutoMigrate(t *testing.T) {
+	t.Run("Should: return from env", func(t *testing.T) {
+		err := os.Setenv(ENV_DB_AUTO_MIGRATE, "false")
+		if err != nil {
+			assert.NotNil(t, nil)
+		}
+		s := New()
+		assert.Equal(t, s.WithAutoMigrate(), false)
+	})
+}
+
+func TestCfg_GetRollupInterval(t *testing.T) {
+	t.Run("Should: return from env", func(t *testing.T) {
+		err := os.Setenv(ENV_ROLLUP_INTERVAL, "60")
+		if err != nil {
+			assert.NotNil(t, nil)
+		}
+		s := New()
+		assert.Equal(t, s.GetRollupInterval(), time.Minute)
+	})
+}
+
+func TestCfg_GetRetention(t *testing.T) {
+	t.Run("Should: return from env", func(t *testing.T) {
+		err := os.Setenv(ENV_RETENTION_SNAPSHOTS_DAYS, "30")
+		if err != nil {
+			assert.NotNil(t, nil)
+		}
+		err = os.Setenv(ENV_RETENTION_DAILY_ROLLUPS_DAYS, "wrong")
+		if err != nil {
+			assert.NotNil(t, nil)
+		}
+		s := New()
+		assert.Equal(t, s.GetRetention().Snapshots, time.Hour*24*30)
+		assert.Equal(t, s.GetRetention().DailyRollups, time.Duration(0))
+	})
+}
@@ -5,39 +5,64 @@ import (
 	"github.com/jinzhu/gorm"
 	"github.com/squzy/squzy/apps/squzy_storage/application"
 	"github.com/squzy/squzy/apps/squzy_storage/config"
+	"github.com/squzy/squzy/apps/squzy_storage/migrate"
+	"github.com/squzy/squzy/apps/squzy_storage/retention"
 	"github.com/squzy/squzy/apps/squzy_storage/server"
+	"github.com/squzy/squzy/apps/squzy_storage/stream"
 	_ "github.com/squzy/squzy/apps/squzy_storage/version"
 	"github.com/squzy/squzy/internal/database"
+	"github.com/squzy/squzy/internal/database/sqlite"
 	"github.com/squzy/squzy/internal/grpctools"
 	"github.com/squzy/squzy/internal/logger"
 	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
 	"google.golang.org/grpc"
+	"os"
 )
 
 func main() {
 	tools := grpctools.New()
 	cfg := config.New()
-	postgresDb, err := gorm.Open(
-		"postgres",
-		fmt.Sprintf("host=%s port=%s dbname=%s user=%s  password=%s connect_timeout=10 sslmode=disable",
-			cfg.GetDbHost(),
-			cfg.GetDbPort(),
-			cfg.GetDbName(),
-			cfg.GetDbUser(),
-			cfg.GetDbPassword(),
-		))
-
-	if err != nil {
-		logger.Fatal(err.Error())
+	var db database.Database
+	if cfg.GetDbType() == config.DbTypeSqlite {
+		sqliteDb, err := sqlite.Open(cfg.GetDbPath())
+		if err != nil {
+			logger.Fatal(err.Error())
+		}
+		db = database.NewSqlite(sqliteDb.LogMode(cfg.WithDbLogs()))
+	} else {
+		postgresDb, err := gorm.Open(
+			"postgres",
+			fmt.Sprintf("host=%s port=%s dbname=%s user=%s  password=%s connect_timeout=10 sslmode=disable",
+				cfg.GetDbHost(),
+				cfg.GetDbPort(),
+				cfg.GetDbName(),
+				cfg.GetDbUser(),
+				cfg.GetDbPassword(),
+			))
+		if err != nil {
+			logger.Fatal(err.Error())
+		}
+		db = database.New(postgresDb.LogMode(cfg.WithDbLogs()))
 	}
 
-	db := database.New(postgresDb.LogMode(cfg.WithDbLogs()))
+	if len(os.Args) > 1 && os.Args[1] == migrate.Command {
+		err := migrate.Run(db, os.Args[2:])
+		if err != nil {
+			logger.Fatal(err.Error())
+		}
+		return
+	}
 
-	err = db.Migrate()
-	if err != nil {
-		logger.Fatal(err.Error())
+	if cfg.WithAutoMigrate() {
+		err := db.Migrate()
+		if err != nil {
+			logger.Fatal(err.Error())
+		}
 	}
 
+	retentionJob := retention.New(db, cfg.GetRetention(), cfg.GetRollupInterval())
+	defer retentionJob.Close()
+
 	incidentConn, err := tools.GetConnection(cfg.GetIncidentServerAddress(), 0, grpc.WithInsecure())
 	if err != nil {
 		logger.Fatal(err.Error())
@@ -47,7 +72,7 @@ func main() {
 	}()
 	incidentClient := apiPb.NewIncidentServerClient(incidentConn)
 
-	apiService := server.NewServer(db, incidentClient, cfg)
+	apiService := server.NewServer(db, incidentClient, cfg, stream.New())
 	storageServ := application.NewApplication(cfg, apiService)
 	logger.Fatal(storageServ.Run().Error())
 }
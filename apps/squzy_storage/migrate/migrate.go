@@ -0,0 +1,47 @@
+package migrate
+
+import (
+	"flag"
+	"github.com/squzy/squzy/internal/logger"
+)
+
+const (
+	Command = "migrate"
+
+	latestVersion = -1
+)
+
+type Migrator interface {
+	Migrate() error
+	MigrateTo(version uint) error
+	GetSchemaVersion() (uint, error)
+}
+
+// Run handles `migrate [-to version] [-status]` command, without flags schema is migrated to the latest version
+func Run(db Migrator, args []string) error {
+	flags := flag.NewFlagSet(Command, flag.ContinueOnError)
+	to := flags.Int("to", latestVersion, "version of schema to migrate up or down, latest by default")
+	status := flags.Bool("status", false, "print current version of schema")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if !*status {
+		var err error
+		if *to <= latestVersion {
+			err = db.Migrate()
+		} else {
+			err = db.MigrateTo(uint(*to))
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	version, err := db.GetSchemaVersion()
+	if err != nil {
+		return err
+	}
+	logger.Infof("Schema version: %d", version)
+	return nil
+}
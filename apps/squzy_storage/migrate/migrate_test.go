@@ -0,0 +1,48 @@
+package migrate
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type migratorMock struct {
+	version uint
+	err     error
+}
+
+func (m *migratorMock) Migrate() error {
+	m.version = 4
+	return m.err
+}
+
+func (m *migratorMock) MigrateTo(version uint) error {
+	m.version = version
+	return m.err
+}
+
+func (m *migratorMock) GetSchemaVersion() (uint, error) {
+	return m.version, nil
+}
+
+func TestRun(t *testing.T) {
+	t.Run("Should: migrate to latest version", func(t *testing.T) {
+		m := &migratorMock{}
+		assert.NoError(t, Run(m, nil))
+		assert.Equal(t, uint(4), m.version)
+	})
+	t.Run("Should: migrate to version", func(t *testing.T) {
+		m := &migratorMock{version: 4}
+		assert.NoError(t, Run(m, []string{"-to", "0"}))
+		assert.Equal(t, uint(0), m.version)
+	})
+	t.Run("Should: only print version", func(t *testing.T) {
+		m := &migratorMock{version: 2}
+		assert.NoError(t, Run(m, []string{"-status"}))
+		assert.Equal(t, uint(2), m.version)
+	})
+	t.Run("Should: return error", func(t *testing.T) {
+		assert.Error(t, Run(&migratorMock{err: errors.New("error")}, nil))
+		assert.Error(t, Run(&migratorMock{}, []string{"-unknown"}))
+	})
+}
@@ -0,0 +1,105 @@
+package retention
+
+import (
+	"github.com/squzy/squzy/apps/squzy_storage/config"
+	"github.com/squzy/squzy/internal/logger"
+	"time"
+)
+
+const (
+	hourlyResolution = time.Hour
+	dailyResolution  = time.Hour * 24
+
+	// Raw data of the last day should be kept till it is rolled up daily
+	minRawRetention = time.Hour * 24 * 2
+)
+
+type Database interface {
+	Rollup(resolution time.Duration, until time.Time) error
+	DeleteSnapshots(before time.Time) error
+	DeleteStatRequests(before time.Time) error
+	DeleteTransactionInfos(before time.Time) error
+	DeleteRollups(resolution time.Duration, before time.Time) error
+}
+
+// Job periodically rolls raw data up by hours and days and removes data which is older than retention
+type Job interface {
+	Execute(now time.Time) error
+	Close()
+}
+
+type job struct {
+	db        Database
+	retention config.Retention
+	done      chan struct{}
+}
+
+func New(db Database, retention config.Retention, interval time.Duration) Job {
+	j := &job{
+		db:        db,
+		retention: retention,
+		done:      make(chan struct{}),
+	}
+	go j.loop(interval)
+	return j
+}
+
+func (j *job) Close() {
+	close(j.done)
+}
+
+// Raw data is not removed if rollup was failed, so it will be rolled up by next execution
+func (j *job) Execute(now time.Time) error {
+	for _, resolution := range []time.Duration{hourlyResolution, dailyResolution} {
+		if err := j.db.Rollup(resolution, now); err != nil {
+			return err
+		}
+	}
+
+	var lastErr error
+	deletes := []struct {
+		retention time.Duration
+		delete    func(time.Time) error
+	}{
+		{rawRetention(j.retention.Snapshots), j.db.DeleteSnapshots},
+		{rawRetention(j.retention.StatRequests), j.db.DeleteStatRequests},
+		{rawRetention(j.retention.Transactions), j.db.DeleteTransactionInfos},
+		{j.retention.HourlyRollups, func(before time.Time) error {
+			return j.db.DeleteRollups(hourlyResolution, before)
+		}},
+		{j.retention.DailyRollups, func(before time.Time) error {
+			return j.db.DeleteRollups(dailyResolution, before)
+		}},
+	}
+	for _, d := range deletes {
+		if d.retention == 0 {
+			continue
+		}
+		if err := d.delete(now.Add(-d.retention)); err != nil {
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+func (j *job) loop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-j.done:
+			return
+		case now := <-ticker.C:
+			if err := j.Execute(now); err != nil {
+				logger.Errorf("Could not apply retention: %s", err.Error())
+			}
+		}
+	}
+}
+
+func rawRetention(retention time.Duration) time.Duration {
+	if retention != 0 && retention < minRawRetention {
+		return minRawRetention
+	}
+	return retention
+}
@@ -0,0 +1,97 @@
+package retention
+
+import (
+	"errors"
+	"github.com/squzy/squzy/apps/squzy_storage/config"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type dbMock struct {
+	rollupErr   error
+	deleteErr   error
+	resolutions []time.Duration
+	deleted     map[string]time.Time
+}
+
+func (m *dbMock) Rollup(resolution time.Duration, until time.Time) error {
+	m.resolutions = append(m.resolutions, resolution)
+	return m.rollupErr
+}
+
+func (m *dbMock) DeleteSnapshots(before time.Time) error {
+	m.deleted["snapshots"] = before
+	return m.deleteErr
+}
+
+func (m *dbMock) DeleteStatRequests(before time.Time) error {
+	m.deleted["statRequests"] = before
+	return m.deleteErr
+}
+
+func (m *dbMock) DeleteTransactionInfos(before time.Time) error {
+	m.deleted["transactions"] = before
+	return m.deleteErr
+}
+
+func (m *dbMock) DeleteRollups(resolution time.Duration, before time.Time) error {
+	m.deleted[resolution.String()] = before
+	return m.deleteErr
+}
+
+func newDbMock() *dbMock {
+	return &dbMock{
+		deleted: map[string]time.Time{},
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Run("Should: create new job", func(t *testing.T) {
+		j := New(newDbMock(), config.Retention{}, time.Minute)
+		assert.NotNil(t, j)
+		j.Close()
+	})
+}
+
+func TestJob_Execute(t *testing.T) {
+	now := time.Now()
+	day := time.Hour * 24
+	t.Run("Should: rollup and keep data without retention", func(t *testing.T) {
+		db := newDbMock()
+		j := &job{db: db}
+		assert.Nil(t, j.Execute(now))
+		assert.Equal(t, []time.Duration{time.Hour, day}, db.resolutions)
+		assert.Empty(t, db.deleted)
+	})
+	t.Run("Should: delete data older than retention", func(t *testing.T) {
+		db := newDbMock()
+		j := &job{db: db, retention: config.Retention{
+			Snapshots:     day * 10,
+			StatRequests:  time.Hour,
+			HourlyRollups: day * 30,
+			DailyRollups:  day * 365,
+		}}
+		assert.Nil(t, j.Execute(now))
+		assert.Equal(t, map[string]time.Time{
+			"snapshots":        now.Add(-day * 10),
+			"statRequests":     now.Add(-minRawRetention),
+			time.Hour.String(): now.Add(-day * 30),
+			day.String():       now.Add(-day * 365),
+		}, db.deleted)
+	})
+	t.Run("Should: not delete if rollup failed", func(t *testing.T) {
+		db := newDbMock()
+		db.rollupErr = errors.New("error")
+		j := &job{db: db, retention: config.Retention{Snapshots: day}}
+		assert.NotNil(t, j.Execute(now))
+		assert.Empty(t, db.deleted)
+	})
+	t.Run("Should: return error if delete failed", func(t *testing.T) {
+		db := newDbMock()
+		db.deleteErr = errors.New("error")
+		j := &job{db: db, retention: config.Retention{Snapshots: day, Transactions: day}}
+		assert.NotNil(t, j.Execute(now))
+		assert.Len(t, db.deleted, 2)
+	})
+}
@@ -4,12 +4,15 @@ import (
 	"context"
 	"errors"
 	"github.com/squzy/squzy/apps/squzy_storage/config"
+	"github.com/squzy/squzy/apps/squzy_storage/stream"
 	"github.com/squzy/squzy/internal/database"
 	"github.com/squzy/squzy/internal/helpers"
+	"github.com/squzy/squzy/internal/logger"
 	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
 	"google.golang.org/grpc/codes"
 	grpcStatus "google.golang.org/grpc/status"
 	empty "google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"time"
 )
 
@@ -21,60 +24,99 @@ type server struct {
 	database       database.Database
 	incidentClient apiPb.IncidentServerClient
 	cfg            config.Config
+	broker         stream.Broker
 }
 
-func NewServer(db database.Database, incidentClient apiPb.IncidentServerClient, cfg config.Config) apiPb.StorageServer {
+var (
+	errStreamIsNotAvailable = "stream is not available"
+)
+
+func NewServer(db database.Database, incidentClient apiPb.IncidentServerClient, cfg config.Config, broker stream.Broker) apiPb.StorageServer {
 	return &server{
 		database:       db,
 		incidentClient: incidentClient,
 		cfg:            cfg,
+		broker:         broker,
 	}
 }
 
 func (s *server) SaveResponseFromScheduler(ctx context.Context, request *apiPb.SchedulerResponse) (*empty.Empty, error) {
 	err := s.database.InsertSnapshot(request)
-	defer func() {
-		if request == nil {
-			return
+	if err != nil {
+		return nil, grpcStatus.Errorf(codes.Internal, err.Error())
+	}
+	s.processSnapshot(request)
+	return &empty.Empty{}, nil
+}
+
+// Snapshots which are not valid are skipped, retry of the batch would only duplicate saved snapshots
+func (s *server) SaveResponsesFromScheduler(ctx context.Context, request *apiPb.SchedulerResponseBatch) (*empty.Empty, error) {
+	responses := request.GetResponses()
+	err := s.database.InsertSnapshots(responses)
+	failed := map[int]bool{}
+	var insertErr *database.InsertSnapshotsError
+	if errors.As(err, &insertErr) {
+		for _, i := range insertErr.Failed {
+			failed[i] = true
 		}
-		s.SendRecordToIncident(&apiPb.StorageRecord{
-			Record: &apiPb.StorageRecord_Snapshot{
-				Snapshot: &apiPb.SchedulerSnapshotWithId{
-					Snapshot: request.GetSnapshot(),
-					Id:       request.GetSchedulerId(),
-				},
-			},
-		})
-	}()
+		logger.Errorf("Snapshots %v of batch are not saved: %s", insertErr.Failed, err.Error())
+		err = nil
+	}
 	if err != nil {
 		return nil, grpcStatus.Errorf(codes.Internal, err.Error())
 	}
+	for i, response := range responses {
+		if failed[i] {
+			continue
+		}
+		s.processSnapshot(response)
+	}
 	return &empty.Empty{}, nil
 }
 
+// Saved snapshot is published to subscribers and sent to incident server
+func (s *server) processSnapshot(response *apiPb.SchedulerResponse) {
+	if response == nil {
+		return
+	}
+	s.publishSnapshot(response)
+	s.SendRecordToIncident(&apiPb.StorageRecord{
+		Record: &apiPb.StorageRecord_Snapshot{
+			Snapshot: &apiPb.SchedulerSnapshotWithId{
+				Snapshot: response.GetSnapshot(),
+				Id:       response.GetSchedulerId(),
+			},
+		},
+	})
+}
+
 func (s *server) SaveResponseFromAgent(ctx context.Context, request *apiPb.Metric) (*empty.Empty, error) {
 	err := s.database.InsertStatRequest(request)
-	defer func() {
-		if request == nil {
-			return
-		}
+	if err != nil {
+		return nil, grpcStatus.Errorf(codes.Internal, err.Error())
+	}
+	if request != nil {
+		s.publish(&apiPb.StreamEvent{
+			Type:    apiPb.StreamEventType_STREAM_EVENT_AGENT_METRIC,
+			OwnerId: request.GetAgentId(),
+			Time:    request.GetTime(),
+			Event:   &apiPb.StreamEvent_AgentMetric{AgentMetric: request},
+		})
 		s.SendRecordToIncident(&apiPb.StorageRecord{
 			Record: &apiPb.StorageRecord_AgentMetric{
 				AgentMetric: request,
 			},
 		})
-	}()
-	if err != nil {
-		return nil, grpcStatus.Errorf(codes.Internal, err.Error())
 	}
 	return &empty.Empty{}, nil
 }
 
 func (s *server) GetSchedulerInformation(ctx context.Context, request *apiPb.GetSchedulerInformationRequest) (*apiPb.GetSchedulerInformationResponse, error) {
-	snapshots, count, err := s.database.GetSnapshots(request)
+	snapshots, count, cursors, err := s.database.GetSnapshots(request)
 	return &apiPb.GetSchedulerInformationResponse{
 		Snapshots: snapshots,
 		Count:     count,
+		Cursors:   cursors,
 	}, wrapError(err)
 }
 
@@ -83,49 +125,76 @@ func (s *server) GetSchedulerUptime(ctx context.Context, request *apiPb.GetSched
 	return response, wrapError(err)
 }
 
+func (s *server) GetSchedulerUptimeSeries(ctx context.Context, request *apiPb.GetSchedulerUptimeSeriesRequest) (*apiPb.GetSchedulerUptimeSeriesResponse, error) {
+	response, err := s.database.GetSnapshotsUptimeSeries(request)
+	return response, wrapError(err)
+}
+
+func (s *server) GetSchedulerOutages(ctx context.Context, request *apiPb.GetSchedulerOutagesRequest) (*apiPb.GetSchedulerOutagesResponse, error) {
+	response, err := s.database.GetSnapshotsOutages(request)
+	return response, wrapError(err)
+}
+
+func (s *server) GetAgentSeries(ctx context.Context, request *apiPb.GetAgentSeriesRequest) (*apiPb.GetAgentSeriesResponse, error) {
+	response, err := s.database.GetStatRequestSeries(request)
+	return response, wrapError(err)
+}
+
 func (s *server) GetAgentInformation(ctx context.Context, request *apiPb.GetAgentInformationRequest) (*apiPb.GetAgentInformationResponse, error) {
 	var res []*apiPb.GetAgentInformationResponse_Statistic
 	var count int32
+	var cursors *apiPb.PageCursors
 	var err error
 	switch request.GetType() {
 	case apiPb.TypeAgentStat_ALL:
-		res, count, err = s.database.GetStatRequest(request.GetAgentId(), request.GetPagination(), request.GetTimeRange())
+		res, count, cursors, err = s.database.GetStatRequest(request.GetAgentId(), request.GetPagination(), request.GetTimeRange())
 	case apiPb.TypeAgentStat_CPU:
-		res, count, err = s.database.GetCPUInfo(request.GetAgentId(), request.GetPagination(), request.GetTimeRange())
+		res, count, cursors, err = s.database.GetCPUInfo(request.GetAgentId(), request.GetPagination(), request.GetTimeRange())
 	case apiPb.TypeAgentStat_MEMORY:
-		res, count, err = s.database.GetMemoryInfo(request.GetAgentId(), request.GetPagination(), request.GetTimeRange())
+		res, count, cursors, err = s.database.GetMemoryInfo(request.GetAgentId(), request.GetPagination(), request.GetTimeRange())
 	case apiPb.TypeAgentStat_DISK:
-		res, count, err = s.database.GetDiskInfo(request.GetAgentId(), request.GetPagination(), request.GetTimeRange())
+		res, count, cursors, err = s.database.GetDiskInfo(request.GetAgentId(), request.GetPagination(), request.GetTimeRange())
 	case apiPb.TypeAgentStat_NET:
-		res, count, err = s.database.GetNetInfo(request.GetAgentId(), request.GetPagination(), request.GetTimeRange())
+		res, count, cursors, err = s.database.GetNetInfo(request.GetAgentId(), request.GetPagination(), request.GetTimeRange())
 	default:
 		err = errors.New("invalid type")
 	}
 	return &apiPb.GetAgentInformationResponse{
-		Stats: res,
-		Count: count,
+		Stats:   res,
+		Count:   count,
+		Cursors: cursors,
 	}, wrapError(err)
 }
 
 func (s *server) SaveTransaction(ctx context.Context, req *apiPb.TransactionInfo) (*empty.Empty, error) {
+	err := s.database.InsertTransactionInfo(req)
 	defer func() {
 		if req == nil {
 			return
 		}
+		if err == nil {
+			s.publish(&apiPb.StreamEvent{
+				Type:    apiPb.StreamEventType_STREAM_EVENT_TRANSACTION,
+				OwnerId: req.GetApplicationId(),
+				Time:    req.GetEndTime(),
+				Event:   &apiPb.StreamEvent_Transaction{Transaction: req},
+			})
+		}
 		s.SendRecordToIncident(&apiPb.StorageRecord{
 			Record: &apiPb.StorageRecord_Transaction{
 				Transaction: req,
 			},
 		})
 	}()
-	return &empty.Empty{}, wrapError(s.database.InsertTransactionInfo(req))
+	return &empty.Empty{}, wrapError(err)
 }
 
 func (s *server) GetTransactions(ctx context.Context, request *apiPb.GetTransactionsRequest) (*apiPb.GetTransactionsResponse, error) {
-	transactions, count, err := s.database.GetTransactionInfo(request)
+	transactions, count, cursors, err := s.database.GetTransactionInfo(request)
 	return &apiPb.GetTransactionsResponse{
 		Count:        count,
 		Transactions: transactions,
+		Cursors:      cursors,
 	}, wrapError(err)
 }
 
@@ -137,6 +206,11 @@ func (s *server) GetTransactionById(ctx context.Context, request *apiPb.GetTrans
 	}, wrapError(err)
 }
 
+func (s *server) GetTransactionTree(ctx context.Context, request *apiPb.GetTransactionByIdRequest) (*apiPb.GetTransactionTreeResponse, error) {
+	response, err := s.database.GetTransactionTree(request)
+	return response, wrapError(err)
+}
+
 func (s *server) GetTransactionsGroup(ctx context.Context, request *apiPb.GetTransactionGroupRequest) (*apiPb.GetTransactionGroupResponse, error) {
 	res, err := s.database.GetTransactionGroup(request)
 	return &apiPb.GetTransactionGroupResponse{
@@ -152,11 +226,22 @@ func wrapError(err error) error {
 }
 
 func (s *server) SaveIncident(ctx context.Context, request *apiPb.Incident) (*empty.Empty, error) {
-	return &empty.Empty{}, s.database.InsertIncident(request)
+	err := s.database.InsertIncident(request)
+	if err == nil {
+		s.publishIncident(request)
+	}
+	return &empty.Empty{}, err
 }
 
+// Database returns incident before update, so changed incident is read again for subscribers
 func (s *server) UpdateIncidentStatus(ctx context.Context, request *apiPb.UpdateIncidentStatusRequest) (*apiPb.Incident, error) {
-	return s.database.UpdateIncidentStatus(request.GetIncidentId(), request.GetStatus())
+	incident, err := s.database.UpdateIncidentStatus(request.GetIncidentId(), request.GetStatus())
+	if err == nil && s.broker != nil {
+		if updated, getErr := s.database.GetIncidentById(request.GetIncidentId()); getErr == nil {
+			s.publishIncident(updated)
+		}
+	}
+	return incident, err
 }
 
 func (s *server) GetIncidentById(ctx context.Context, request *apiPb.IncidentIdRequest) (*apiPb.Incident, error) {
@@ -168,13 +253,14 @@ func (s *server) GetIncidentByRuleId(ctx context.Context, request *apiPb.RuleIdR
 }
 
 func (s *server) GetIncidentsList(ctx context.Context, request *apiPb.GetIncidentsListRequest) (*apiPb.GetIncidentsListResponse, error) {
-	incidents, count, err := s.database.GetIncidents(request)
+	incidents, count, cursors, err := s.database.GetIncidents(request)
 	if err != nil {
 		return nil, err
 	}
 	return &apiPb.GetIncidentsListResponse{
 		Count:     count,
 		Incidents: incidents,
+		Cursors:   cursors,
 	}, nil
 }
 
@@ -189,3 +275,59 @@ func (s *server) SendRecordToIncident(rq *apiPb.StorageRecord) {
 	defer cancel()
 	_, _ = s.incidentClient.ProcessRecordFromStorage(ctx, rq)
 }
+
+func (s *server) Subscribe(request *apiPb.SubscribeRequest, srv apiPb.Storage_SubscribeServer) error {
+	if s.broker == nil {
+		return grpcStatus.Errorf(codes.Unavailable, errStreamIsNotAvailable)
+	}
+	subscription := s.broker.Subscribe(request)
+	defer subscription.Close()
+	for {
+		select {
+		case <-srv.Context().Done():
+			return nil
+		case event, ok := <-subscription.Events():
+			if !ok {
+				return nil
+			}
+			if err := srv.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *server) publish(event *apiPb.StreamEvent) {
+	if s.broker == nil {
+		return
+	}
+	s.broker.Publish(event)
+}
+
+func (s *server) publishSnapshot(response *apiPb.SchedulerResponse) {
+	if response == nil {
+		return
+	}
+	s.publish(&apiPb.StreamEvent{
+		Type:    apiPb.StreamEventType_STREAM_EVENT_SNAPSHOT,
+		OwnerId: response.GetSchedulerId(),
+		Time:    response.GetSnapshot().GetMeta().GetEndTime(),
+		Event:   &apiPb.StreamEvent_Snapshot{Snapshot: response},
+	})
+}
+
+func (s *server) publishIncident(incident *apiPb.Incident) {
+	if incident == nil {
+		return
+	}
+	eventTime := timestamppb.Now()
+	if histories := incident.GetHistories(); len(histories) > 0 && histories[len(histories)-1].GetTimestamp() != nil {
+		eventTime = histories[len(histories)-1].GetTimestamp()
+	}
+	s.publish(&apiPb.StreamEvent{
+		Type:    apiPb.StreamEventType_STREAM_EVENT_INCIDENT,
+		OwnerId: incident.GetRuleId(),
+		Time:    eventTime,
+		Event:   &apiPb.StreamEvent_Incident{Incident: incident},
+	})
+}
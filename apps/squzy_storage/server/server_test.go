@@ -4,15 +4,37 @@ import (
 	"context"
 	"errors"
 	empty "google.golang.org/protobuf/types/known/emptypb"
+	"github.com/squzy/squzy/apps/squzy_storage/config"
+	"github.com/squzy/squzy/apps/squzy_storage/stream"
+	"github.com/squzy/squzy/internal/database"
 	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
 	"testing"
+	"time"
 )
 
 type mockClient struct {
 }
 
+type mockClientCount struct {
+	mockClient
+	records []*apiPb.StorageRecord
+}
+
+func (m *mockClientCount) ProcessRecordFromStorage(ctx context.Context, in *apiPb.StorageRecord, opts ...grpc.CallOption) (*empty.Empty, error) {
+	m.records = append(m.records, in)
+	return &empty.Empty{}, nil
+}
+
+type dbInvalidSnapshotsMock struct {
+	dbMock
+}
+
+func (*dbInvalidSnapshotsMock) InsertSnapshots(data []*apiPb.SchedulerResponse) error {
+	return &database.InsertSnapshotsError{Failed: []int{0}}
+}
+
 func (m mockClient) CreateRule(ctx context.Context, in *apiPb.CreateRuleRequest, opts ...grpc.CallOption) (*apiPb.Rule, error) {
 	panic("implement me")
 }
@@ -88,6 +110,26 @@ func (m mockConfigDisable) WithDbLogs() bool {
 	return false
 }
 
+func (m mockConfigDisable) WithAutoMigrate() bool {
+	return true
+}
+
+func (m mockConfigDisable) GetRollupInterval() time.Duration {
+	return time.Minute
+}
+
+func (m mockConfigDisable) GetRetention() config.Retention {
+	return config.Retention{}
+}
+
+func (m mockConfigDisable) GetDbType() string {
+	return config.DbTypePostgres
+}
+
+func (m mockConfigDisable) GetDbPath() string {
+	return ""
+}
+
 func (m mockConfigDisable) WithIncident() bool {
 	return false
 }
@@ -131,6 +173,26 @@ func (m mockConfigEnable) WithDbLogs() bool {
 	return true
 }
 
+func (m mockConfigEnable) WithAutoMigrate() bool {
+	return true
+}
+
+func (m mockConfigEnable) GetRollupInterval() time.Duration {
+	return time.Minute
+}
+
+func (m mockConfigEnable) GetRetention() config.Retention {
+	return config.Retention{}
+}
+
+func (m mockConfigEnable) GetDbType() string {
+	return config.DbTypePostgres
+}
+
+func (m mockConfigEnable) GetDbPath() string {
+	return ""
+}
+
 type dbErrorMock struct {
 }
 
@@ -150,62 +212,110 @@ func (mock *dbErrorMock) UpdateIncidentStatus(id string, status apiPb.IncidentSt
 	return nil, errors.New("ERROR")
 }
 
-func (mock *dbErrorMock) GetIncidents(request *apiPb.GetIncidentsListRequest) ([]*apiPb.Incident, int64, error) {
-	return nil, 0, errors.New("ERROR")
+func (mock *dbErrorMock) GetIncidents(request *apiPb.GetIncidentsListRequest) ([]*apiPb.Incident, int64, *apiPb.PageCursors, error) {
+	return nil, 0, nil, errors.New("ERROR")
 }
 
 func (*dbErrorMock) Migrate() error {
 	return nil
 }
 
+func (*dbErrorMock) MigrateTo(version uint) error {
+	return nil
+}
+
+func (*dbErrorMock) GetSchemaVersion() (uint, error) {
+	return 0, nil
+}
+
+func (*dbErrorMock) Rollup(resolution time.Duration, until time.Time) error {
+	return errors.New("error")
+}
+
+func (*dbErrorMock) DeleteSnapshots(before time.Time) error {
+	return errors.New("error")
+}
+
+func (*dbErrorMock) DeleteStatRequests(before time.Time) error {
+	return errors.New("error")
+}
+
+func (*dbErrorMock) DeleteTransactionInfos(before time.Time) error {
+	return errors.New("error")
+}
+
+func (*dbErrorMock) DeleteRollups(resolution time.Duration, before time.Time) error {
+	return errors.New("error")
+}
+
 func (*dbErrorMock) InsertSnapshot(data *apiPb.SchedulerResponse) error {
 	return errors.New("error")
 }
 
-func (*dbErrorMock) GetSnapshots(*apiPb.GetSchedulerInformationRequest) ([]*apiPb.SchedulerSnapshot, int32, error) {
-	return nil, -1, errors.New("error")
+func (*dbErrorMock) InsertSnapshots(data []*apiPb.SchedulerResponse) error {
+	return errors.New("error")
+}
+
+func (*dbErrorMock) GetSnapshots(*apiPb.GetSchedulerInformationRequest) ([]*apiPb.SchedulerSnapshot, int32, *apiPb.PageCursors, error) {
+	return nil, -1, nil, errors.New("error")
 }
 
 func (*dbErrorMock) GetSnapshotsUptime(request *apiPb.GetSchedulerUptimeRequest) (*apiPb.GetSchedulerUptimeResponse, error) {
 	return nil, errors.New("error")
 }
 
+func (*dbErrorMock) GetSnapshotsUptimeSeries(request *apiPb.GetSchedulerUptimeSeriesRequest) (*apiPb.GetSchedulerUptimeSeriesResponse, error) {
+	return nil, errors.New("error")
+}
+
+func (*dbErrorMock) GetSnapshotsOutages(request *apiPb.GetSchedulerOutagesRequest) (*apiPb.GetSchedulerOutagesResponse, error) {
+	return nil, errors.New("error")
+}
+
 func (*dbErrorMock) InsertStatRequest(data *apiPb.Metric) error {
 	return errors.New("error")
 }
 
-func (*dbErrorMock) GetStatRequest(id string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, error) {
-	return nil, -1, errors.New("error")
+func (*dbErrorMock) GetStatRequest(id string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, *apiPb.PageCursors, error) {
+	return nil, -1, nil, errors.New("error")
+}
+
+func (*dbErrorMock) GetCPUInfo(id string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, *apiPb.PageCursors, error) {
+	return nil, -1, nil, errors.New("error")
 }
 
-func (*dbErrorMock) GetCPUInfo(id string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, error) {
-	return nil, -1, errors.New("error")
+func (*dbErrorMock) GetMemoryInfo(id string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, *apiPb.PageCursors, error) {
+	return nil, -1, nil, errors.New("error")
 }
 
-func (*dbErrorMock) GetMemoryInfo(id string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, error) {
-	return nil, -1, errors.New("error")
+func (*dbErrorMock) GetDiskInfo(id string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, *apiPb.PageCursors, error) {
+	return nil, -1, nil, errors.New("error")
 }
 
-func (*dbErrorMock) GetDiskInfo(id string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, error) {
-	return nil, -1, errors.New("error")
+func (mock *dbErrorMock) GetNetInfo(id string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, *apiPb.PageCursors, error) {
+	return nil, -1, nil, errors.New("error")
 }
 
-func (mock *dbErrorMock) GetNetInfo(id string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, error) {
-	return nil, -1, errors.New("error")
+func (*dbErrorMock) GetStatRequestSeries(request *apiPb.GetAgentSeriesRequest) (*apiPb.GetAgentSeriesResponse, error) {
+	return nil, errors.New("error")
 }
 
 func (*dbErrorMock) InsertTransactionInfo(data *apiPb.TransactionInfo) error {
 	return errors.New("error")
 }
 
-func (*dbErrorMock) GetTransactionInfo(request *apiPb.GetTransactionsRequest) ([]*apiPb.TransactionInfo, int64, error) {
-	return nil, -1, errors.New("error")
+func (*dbErrorMock) GetTransactionInfo(request *apiPb.GetTransactionsRequest) ([]*apiPb.TransactionInfo, int64, *apiPb.PageCursors, error) {
+	return nil, -1, nil, errors.New("error")
 }
 
 func (*dbErrorMock) GetTransactionByID(request *apiPb.GetTransactionByIdRequest) (*apiPb.TransactionInfo, []*apiPb.TransactionInfo, error) {
 	return nil, nil, errors.New("error")
 }
 
+func (*dbErrorMock) GetTransactionTree(request *apiPb.GetTransactionByIdRequest) (*apiPb.GetTransactionTreeResponse, error) {
+	return nil, errors.New("error")
+}
+
 func (*dbErrorMock) GetTransactionGroup(request *apiPb.GetTransactionGroupRequest) (map[string]*apiPb.TransactionGroup, error) {
 	return nil, errors.New("error")
 }
@@ -229,69 +339,136 @@ func (mock *dbMock) UpdateIncidentStatus(id string, status apiPb.IncidentStatus)
 	return &apiPb.Incident{}, nil
 }
 
-func (mock *dbMock) GetIncidents(request *apiPb.GetIncidentsListRequest) ([]*apiPb.Incident, int64, error) {
-	return nil, 0, nil
+func (mock *dbMock) GetIncidents(request *apiPb.GetIncidentsListRequest) ([]*apiPb.Incident, int64, *apiPb.PageCursors, error) {
+	return nil, 0, nil, nil
 }
 
 func (*dbMock) Migrate() error {
 	return nil
 }
 
+func (*dbMock) MigrateTo(version uint) error {
+	return nil
+}
+
+func (*dbMock) GetSchemaVersion() (uint, error) {
+	return 0, nil
+}
+
+func (*dbMock) Rollup(resolution time.Duration, until time.Time) error {
+	return nil
+}
+
+func (*dbMock) DeleteSnapshots(before time.Time) error {
+	return nil
+}
+
+func (*dbMock) DeleteStatRequests(before time.Time) error {
+	return nil
+}
+
+func (*dbMock) DeleteTransactionInfos(before time.Time) error {
+	return nil
+}
+
+func (*dbMock) DeleteRollups(resolution time.Duration, before time.Time) error {
+	return nil
+}
+
 func (*dbMock) InsertSnapshot(data *apiPb.SchedulerResponse) error {
 	return nil
 }
 
-func (*dbMock) GetSnapshots(*apiPb.GetSchedulerInformationRequest) ([]*apiPb.SchedulerSnapshot, int32, error) {
-	return nil, -1, nil
+func (*dbMock) InsertSnapshots(data []*apiPb.SchedulerResponse) error {
+	return nil
+}
+
+func (*dbMock) GetSnapshots(*apiPb.GetSchedulerInformationRequest) ([]*apiPb.SchedulerSnapshot, int32, *apiPb.PageCursors, error) {
+	return nil, -1, nil, nil
 }
 
 func (*dbMock) GetSnapshotsUptime(request *apiPb.GetSchedulerUptimeRequest) (*apiPb.GetSchedulerUptimeResponse, error) {
 	return nil, nil
 }
 
+func (*dbMock) GetSnapshotsUptimeSeries(request *apiPb.GetSchedulerUptimeSeriesRequest) (*apiPb.GetSchedulerUptimeSeriesResponse, error) {
+	return nil, nil
+}
+
+func (*dbMock) GetSnapshotsOutages(request *apiPb.GetSchedulerOutagesRequest) (*apiPb.GetSchedulerOutagesResponse, error) {
+	return nil, nil
+}
+
 func (*dbMock) InsertStatRequest(data *apiPb.Metric) error {
 	return nil
 }
 
-func (*dbMock) GetStatRequest(id string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, error) {
-	return nil, -1, nil
+func (*dbMock) GetStatRequest(id string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, *apiPb.PageCursors, error) {
+	return nil, -1, nil, nil
 }
 
-func (*dbMock) GetCPUInfo(id string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, error) {
-	return nil, -1, nil
+func (*dbMock) GetCPUInfo(id string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, *apiPb.PageCursors, error) {
+	return nil, -1, nil, nil
 }
 
-func (*dbMock) GetMemoryInfo(id string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, error) {
-	return nil, -1, nil
+func (*dbMock) GetMemoryInfo(id string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, *apiPb.PageCursors, error) {
+	return nil, -1, nil, nil
 }
 
-func (*dbMock) GetDiskInfo(id string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, error) {
-	return nil, -1, nil
+func (*dbMock) GetDiskInfo(id string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, *apiPb.PageCursors, error) {
+	return nil, -1, nil, nil
 }
 
-func (*dbMock) GetNetInfo(id string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, error) {
-	return nil, -1, nil
+func (*dbMock) GetNetInfo(id string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, *apiPb.PageCursors, error) {
+	return nil, -1, nil, nil
+}
+
+func (*dbMock) GetStatRequestSeries(request *apiPb.GetAgentSeriesRequest) (*apiPb.GetAgentSeriesResponse, error) {
+	return nil, nil
 }
 
 func (*dbMock) InsertTransactionInfo(data *apiPb.TransactionInfo) error {
 	return nil
 }
 
-func (*dbMock) GetTransactionInfo(request *apiPb.GetTransactionsRequest) ([]*apiPb.TransactionInfo, int64, error) {
-	return nil, -1, nil
+func (*dbMock) GetTransactionInfo(request *apiPb.GetTransactionsRequest) ([]*apiPb.TransactionInfo, int64, *apiPb.PageCursors, error) {
+	return nil, -1, nil, nil
 }
 
 func (*dbMock) GetTransactionByID(request *apiPb.GetTransactionByIdRequest) (*apiPb.TransactionInfo, []*apiPb.TransactionInfo, error) {
 	return nil, nil, nil
 }
 
+func (*dbMock) GetTransactionTree(request *apiPb.GetTransactionByIdRequest) (*apiPb.GetTransactionTreeResponse, error) {
+	return &apiPb.GetTransactionTreeResponse{}, nil
+}
+
 func (*dbMock) GetTransactionGroup(request *apiPb.GetTransactionGroupRequest) (map[string]*apiPb.TransactionGroup, error) {
 	return nil, nil
 }
 
+type subscribeServerMock struct {
+	grpc.ServerStream
+	ctx     context.Context
+	sendErr error
+	events  chan *apiPb.StreamEvent
+}
+
+func (m *subscribeServerMock) Context() context.Context {
+	return m.ctx
+}
+
+func (m *subscribeServerMock) Send(event *apiPb.StreamEvent) error {
+	if m.sendErr != nil {
+		return m.sendErr
+	}
+	m.events <- event
+	return nil
+}
+
 func TestNewService(t *testing.T) {
 	t.Run("Should: return no nil", func(t *testing.T) {
-		assert.NotNil(t, NewServer(nil, nil, nil))
+		assert.NotNil(t, NewServer(nil, nil, nil, nil))
 	})
 }
 
@@ -304,6 +481,17 @@ func TestService_SaveResponseFromScheduler(t *testing.T) {
 		_, err := s.SaveResponseFromScheduler(context.Background(), nil)
 		assert.Error(t, err)
 	})
+	t.Run("Should: not send snapshot to incident because it is not saved", func(t *testing.T) {
+		client := &mockClientCount{}
+		s := server{
+			database:       &dbErrorMock{},
+			incidentClient: client,
+			cfg:            mockConfigEnable{},
+		}
+		_, err := s.SaveResponseFromScheduler(context.Background(), &apiPb.SchedulerResponse{SchedulerId: "1"})
+		assert.Error(t, err)
+		assert.Empty(t, client.records)
+	})
 	t.Run("Should: return no error", func(t *testing.T) {
 		s := server{
 			database: &dbMock{},
@@ -324,6 +512,61 @@ func TestService_SaveResponseFromScheduler(t *testing.T) {
 	})
 }
 
+func TestService_SaveResponsesFromScheduler(t *testing.T) {
+	t.Run("Should: return error", func(t *testing.T) {
+		s := server{
+			database: &dbErrorMock{},
+			cfg:      mockConfigEnable{},
+		}
+		_, err := s.SaveResponsesFromScheduler(context.Background(), nil)
+		assert.Error(t, err)
+	})
+	t.Run("Should: not send snapshots to incident because they are not saved", func(t *testing.T) {
+		client := &mockClientCount{}
+		s := server{
+			database:       &dbErrorMock{},
+			incidentClient: client,
+			cfg:            mockConfigEnable{},
+		}
+		_, err := s.SaveResponsesFromScheduler(context.Background(), &apiPb.SchedulerResponseBatch{
+			Responses: []*apiPb.SchedulerResponse{{SchedulerId: "1"}},
+		})
+		assert.Error(t, err)
+		assert.Empty(t, client.records)
+	})
+	t.Run("Should: send only saved snapshots to incident", func(t *testing.T) {
+		client := &mockClientCount{}
+		s := server{
+			database:       &dbInvalidSnapshotsMock{},
+			incidentClient: client,
+			cfg:            mockConfigEnable{},
+		}
+		_, err := s.SaveResponsesFromScheduler(context.Background(), &apiPb.SchedulerResponseBatch{
+			Responses: []*apiPb.SchedulerResponse{{SchedulerId: "1"}, {SchedulerId: "2"}},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(client.records))
+		assert.Equal(t, "2", client.records[0].GetSnapshot().GetId())
+	})
+	t.Run("Should: return no error", func(t *testing.T) {
+		s := server{
+			database: &dbMock{},
+			cfg:      mockConfigEnable{},
+		}
+		_, err := s.SaveResponsesFromScheduler(context.Background(), &apiPb.SchedulerResponseBatch{
+			Responses: []*apiPb.SchedulerResponse{
+				{
+					Snapshot: &apiPb.SchedulerSnapshot{},
+				},
+				{
+					Snapshot: &apiPb.SchedulerSnapshot{},
+				},
+			},
+		})
+		assert.NoError(t, err)
+	})
+}
+
 func TestService_SaveResponseFromAgent(t *testing.T) {
 	t.Run("Should: return error", func(t *testing.T) {
 		s := server{
@@ -385,6 +628,57 @@ func TestService_GetSchedulerUptime(t *testing.T) {
 	})
 }
 
+func TestService_GetSchedulerUptimeSeries(t *testing.T) {
+	t.Run("Should: return error", func(t *testing.T) {
+		s := server{
+			database: &dbErrorMock{},
+		}
+		_, err := s.GetSchedulerUptimeSeries(context.Background(), &apiPb.GetSchedulerUptimeSeriesRequest{})
+		assert.Error(t, err)
+	})
+	t.Run("Should: return no error", func(t *testing.T) {
+		s := server{
+			database: &dbMock{},
+		}
+		_, err := s.GetSchedulerUptimeSeries(context.Background(), &apiPb.GetSchedulerUptimeSeriesRequest{})
+		assert.NoError(t, err)
+	})
+}
+
+func TestService_GetSchedulerOutages(t *testing.T) {
+	t.Run("Should: return error", func(t *testing.T) {
+		s := server{
+			database: &dbErrorMock{},
+		}
+		_, err := s.GetSchedulerOutages(context.Background(), &apiPb.GetSchedulerOutagesRequest{})
+		assert.Error(t, err)
+	})
+	t.Run("Should: return no error", func(t *testing.T) {
+		s := server{
+			database: &dbMock{},
+		}
+		_, err := s.GetSchedulerOutages(context.Background(), &apiPb.GetSchedulerOutagesRequest{})
+		assert.NoError(t, err)
+	})
+}
+
+func TestService_GetAgentSeries(t *testing.T) {
+	t.Run("Should: return error", func(t *testing.T) {
+		s := server{
+			database: &dbErrorMock{},
+		}
+		_, err := s.GetAgentSeries(context.Background(), &apiPb.GetAgentSeriesRequest{})
+		assert.Error(t, err)
+	})
+	t.Run("Should: return no error", func(t *testing.T) {
+		s := server{
+			database: &dbMock{},
+		}
+		_, err := s.GetAgentSeries(context.Background(), &apiPb.GetAgentSeriesRequest{})
+		assert.NoError(t, err)
+	})
+}
+
 func TestService_GetAgentInformation(t *testing.T) {
 	t.Run("Should: return error", func(t *testing.T) {
 		s := server{
@@ -455,6 +749,34 @@ func TestService_SaveTransaction(t *testing.T) {
 		_, err := s.SaveTransaction(context.Background(), &apiPb.TransactionInfo{})
 		assert.NoError(t, err)
 	})
+	t.Run("Should: publish transaction", func(t *testing.T) {
+		broker := stream.New()
+		sub := broker.Subscribe(&apiPb.SubscribeRequest{})
+		defer sub.Close()
+		s := server{
+			database: &dbMock{},
+			cfg:      mockConfigDisable{},
+			broker:   broker,
+		}
+		_, err := s.SaveTransaction(context.Background(), &apiPb.TransactionInfo{ApplicationId: "app"})
+		assert.NoError(t, err)
+		event := <-sub.Events()
+		assert.Equal(t, apiPb.StreamEventType_STREAM_EVENT_TRANSACTION, event.GetType())
+		assert.Equal(t, "app", event.GetOwnerId())
+	})
+	t.Run("Should: not publish transaction which was not saved", func(t *testing.T) {
+		broker := stream.New()
+		sub := broker.Subscribe(&apiPb.SubscribeRequest{})
+		defer sub.Close()
+		s := server{
+			database: &dbErrorMock{},
+			cfg:      mockConfigDisable{},
+			broker:   broker,
+		}
+		_, err := s.SaveTransaction(context.Background(), &apiPb.TransactionInfo{})
+		assert.Error(t, err)
+		assert.Len(t, sub.Events(), 0)
+	})
 }
 
 func TestService_GetTransactions(t *testing.T) {
@@ -491,6 +813,23 @@ func TestService_GetTransactionById(t *testing.T) {
 	})
 }
 
+func TestService_GetTransactionTree(t *testing.T) {
+	t.Run("Should: return error", func(t *testing.T) {
+		s := server{
+			database: &dbErrorMock{},
+		}
+		_, err := s.GetTransactionTree(context.Background(), &apiPb.GetTransactionByIdRequest{})
+		assert.Error(t, err)
+	})
+	t.Run("Should: return no error", func(t *testing.T) {
+		s := server{
+			database: &dbMock{},
+		}
+		_, err := s.GetTransactionTree(context.Background(), &apiPb.GetTransactionByIdRequest{})
+		assert.NoError(t, err)
+	})
+}
+
 func TestService_GetTransactionsGroup(t *testing.T) {
 	t.Run("Should: return error", func(t *testing.T) {
 		s := server{
@@ -516,6 +855,20 @@ func TestServer_SaveIncident(t *testing.T) {
 		_, err := s.SaveIncident(context.Background(), nil)
 		assert.Error(t, err)
 	})
+	t.Run("Should: publish incident", func(t *testing.T) {
+		broker := stream.New()
+		sub := broker.Subscribe(&apiPb.SubscribeRequest{})
+		defer sub.Close()
+		s := server{
+			database: &dbMock{},
+			broker:   broker,
+		}
+		_, err := s.SaveIncident(context.Background(), &apiPb.Incident{RuleId: "rule"})
+		assert.NoError(t, err)
+		event := <-sub.Events()
+		assert.Equal(t, apiPb.StreamEventType_STREAM_EVENT_INCIDENT, event.GetType())
+		assert.Equal(t, "rule", event.GetOwnerId())
+	})
 }
 
 func TestServer_UpdateIncidentStatus(t *testing.T) {
@@ -526,6 +879,18 @@ func TestServer_UpdateIncidentStatus(t *testing.T) {
 		_, err := s.UpdateIncidentStatus(context.Background(), nil)
 		assert.Error(t, err)
 	})
+	t.Run("Should: publish updated incident", func(t *testing.T) {
+		broker := stream.New()
+		sub := broker.Subscribe(&apiPb.SubscribeRequest{})
+		defer sub.Close()
+		s := server{
+			database: &dbMock{},
+			broker:   broker,
+		}
+		_, err := s.UpdateIncidentStatus(context.Background(), &apiPb.UpdateIncidentStatusRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, apiPb.StreamEventType_STREAM_EVENT_INCIDENT, (<-sub.Events()).GetType())
+	})
 }
 
 func TestServer_GetIncidentById(t *testing.T) {
@@ -599,3 +964,54 @@ func TestServer_SendRecordToIncident(t *testing.T) {
 	})
 
 }
+
+func TestServer_Subscribe(t *testing.T) {
+	t.Run("Should: return error if stream is not available", func(t *testing.T) {
+		s := server{}
+		err := s.Subscribe(&apiPb.SubscribeRequest{}, &subscribeServerMock{ctx: context.Background()})
+		assert.Error(t, err)
+	})
+	t.Run("Should: send published events till context is done", func(t *testing.T) {
+		s := server{
+			database: &dbMock{},
+			cfg:      mockConfigDisable{},
+			broker:   stream.New(),
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		srv := &subscribeServerMock{ctx: ctx, events: make(chan *apiPb.StreamEvent, 1)}
+		done := make(chan error)
+		go func() {
+			done <- s.Subscribe(&apiPb.SubscribeRequest{
+				Types: []apiPb.StreamEventType{apiPb.StreamEventType_STREAM_EVENT_AGENT_METRIC},
+			}, srv)
+		}()
+		assert.Eventually(t, func() bool {
+			_, _ = s.SaveResponseFromAgent(context.Background(), &apiPb.Metric{AgentId: "agent"})
+			return len(srv.events) > 0
+		}, time.Second, time.Millisecond*10)
+		assert.Equal(t, "agent", (<-srv.events).GetOwnerId())
+		cancel()
+		assert.NoError(t, <-done)
+	})
+	t.Run("Should: return error if send failed", func(t *testing.T) {
+		s := server{
+			database: &dbMock{},
+			cfg:      mockConfigDisable{},
+			broker:   stream.New(),
+		}
+		srv := &subscribeServerMock{ctx: context.Background(), sendErr: errors.New("send")}
+		done := make(chan error)
+		go func() {
+			done <- s.Subscribe(&apiPb.SubscribeRequest{}, srv)
+		}()
+		assert.Eventually(t, func() bool {
+			_, _ = s.SaveResponseFromScheduler(context.Background(), &apiPb.SchedulerResponse{SchedulerId: "id"})
+			select {
+			case err := <-done:
+				return err != nil
+			default:
+				return false
+			}
+		}, time.Second, time.Millisecond*10)
+	})
+}
@@ -0,0 +1,105 @@
+package stream
+
+import (
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"sync"
+)
+
+const (
+	subscriptionBufferSize = 256
+)
+
+// Broker fans out saved records to all subscribers which are interested in them
+type Broker interface {
+	// Publish never blocks, events are dropped for subscribers which do not read them in time
+	Publish(event *apiPb.StreamEvent)
+	Subscribe(request *apiPb.SubscribeRequest) Subscription
+}
+
+type Subscription interface {
+	Events() <-chan *apiPb.StreamEvent
+	Close()
+}
+
+type broker struct {
+	mutex         sync.RWMutex
+	subscriptions map[*subscription]struct{}
+}
+
+type subscription struct {
+	broker   *broker
+	types    map[apiPb.StreamEventType]struct{}
+	ownerIds map[string]struct{}
+	events   chan *apiPb.StreamEvent
+	once     sync.Once
+}
+
+func New() Broker {
+	return &broker{
+		subscriptions: map[*subscription]struct{}{},
+	}
+}
+
+func (b *broker) Publish(event *apiPb.StreamEvent) {
+	if event == nil {
+		return
+	}
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+	for sub := range b.subscriptions {
+		if !sub.match(event) {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+		}
+	}
+}
+
+func (b *broker) Subscribe(request *apiPb.SubscribeRequest) Subscription {
+	sub := &subscription{
+		broker:   b,
+		types:    map[apiPb.StreamEventType]struct{}{},
+		ownerIds: map[string]struct{}{},
+		events:   make(chan *apiPb.StreamEvent, subscriptionBufferSize),
+	}
+	for _, t := range request.GetTypes() {
+		sub.types[t] = struct{}{}
+	}
+	for _, id := range request.GetOwnerIds() {
+		sub.ownerIds[id] = struct{}{}
+	}
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.subscriptions[sub] = struct{}{}
+	return sub
+}
+
+func (s *subscription) Events() <-chan *apiPb.StreamEvent {
+	return s.events
+}
+
+// Channel is closed under the broker lock, so Publish never sends into closed channel
+func (s *subscription) Close() {
+	s.once.Do(func() {
+		s.broker.mutex.Lock()
+		defer s.broker.mutex.Unlock()
+		delete(s.broker.subscriptions, s)
+		close(s.events)
+	})
+}
+
+func (s *subscription) match(event *apiPb.StreamEvent) bool {
+	if len(s.types) > 0 {
+		if _, ok := s.types[event.GetType()]; !ok {
+			return false
+		}
+	}
+	if len(s.ownerIds) > 0 {
+		if _, ok := s.ownerIds[event.GetOwnerId()]; !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,66 @@
+package stream
+
+import (
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("Should: create broker", func(t *testing.T) {
+		assert.NotNil(t, New())
+	})
+}
+
+func TestBroker_Publish(t *testing.T) {
+	t.Run("Should: deliver event to subscriber without filters", func(t *testing.T) {
+		b := New()
+		sub := b.Subscribe(&apiPb.SubscribeRequest{})
+		defer sub.Close()
+		event := &apiPb.StreamEvent{Type: apiPb.StreamEventType_STREAM_EVENT_SNAPSHOT, OwnerId: "1"}
+		b.Publish(event)
+		assert.Equal(t, event, <-sub.Events())
+	})
+	t.Run("Should: filter events by type and owner", func(t *testing.T) {
+		b := New()
+		sub := b.Subscribe(&apiPb.SubscribeRequest{
+			Types:    []apiPb.StreamEventType{apiPb.StreamEventType_STREAM_EVENT_INCIDENT},
+			OwnerIds: []string{"rule"},
+		})
+		defer sub.Close()
+		b.Publish(&apiPb.StreamEvent{Type: apiPb.StreamEventType_STREAM_EVENT_SNAPSHOT, OwnerId: "rule"})
+		b.Publish(&apiPb.StreamEvent{Type: apiPb.StreamEventType_STREAM_EVENT_INCIDENT, OwnerId: "other"})
+		expected := &apiPb.StreamEvent{Type: apiPb.StreamEventType_STREAM_EVENT_INCIDENT, OwnerId: "rule"}
+		b.Publish(expected)
+		assert.Equal(t, expected, <-sub.Events())
+		assert.Len(t, sub.Events(), 0)
+	})
+	t.Run("Should: drop events when subscriber is slow", func(t *testing.T) {
+		b := New()
+		sub := b.Subscribe(&apiPb.SubscribeRequest{})
+		defer sub.Close()
+		for i := 0; i < subscriptionBufferSize+10; i++ {
+			b.Publish(&apiPb.StreamEvent{})
+		}
+		assert.Len(t, sub.Events(), subscriptionBufferSize)
+	})
+	t.Run("Should: ignore nil event", func(t *testing.T) {
+		b := New()
+		sub := b.Subscribe(&apiPb.SubscribeRequest{})
+		defer sub.Close()
+		b.Publish(nil)
+		assert.Len(t, sub.Events(), 0)
+	})
+}
+
+func TestSubscription_Close(t *testing.T) {
+	t.Run("Should: close channel and stop delivery", func(t *testing.T) {
+		b := New()
+		sub := b.Subscribe(&apiPb.SubscribeRequest{})
+		sub.Close()
+		sub.Close()
+		b.Publish(&apiPb.StreamEvent{})
+		_, ok := <-sub.Events()
+		assert.False(t, ok)
+	})
+}
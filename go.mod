@@ -4,10 +4,14 @@ go 1.16
 
 require (
 	github.com/DATA-DOG/go-sqlmock v1.4.1
+	github.com/andybalholm/cascadia v1.3.1
+	github.com/antchfx/htmlquery v1.2.3
 	github.com/antonmedv/expr v1.8.8
 	github.com/araddon/dateparse v0.0.0-20200409225146-d820a6159ab1
 	github.com/gin-gonic/gin v1.7.7
+	github.com/go-redis/redis/v8 v8.11.5
 	github.com/google/uuid v1.3.0
+	github.com/gorilla/websocket v1.4.2
 	github.com/grpc-ecosystem/go-grpc-middleware v1.3.0
 	github.com/jinzhu/gorm v1.9.12
 	github.com/shirou/gopsutil/v3 v3.21.12
@@ -17,8 +21,10 @@ require (
 	github.com/stretchr/testify v1.7.0
 	github.com/tidwall/gjson v1.13.0
 	go.mongodb.org/mongo-driver v1.8.2
+	go.opentelemetry.io/proto/otlp v0.19.0
 	go.uber.org/atomic v1.4.0
 	go.uber.org/zap v1.10.0
+	golang.org/x/net v0.0.0-20210916014120-12bc252f5db8
 	golang.org/x/sync v0.0.0-20201020160332-67f06af15bc9
 	google.golang.org/appengine v1.6.6 // indirect
 	google.golang.org/genproto v0.0.0-20220114231437-d2e6a121cae0 // indirect
@@ -26,3 +32,5 @@ require (
 	google.golang.org/protobuf v1.27.1
 	gopkg.in/yaml.v2 v2.4.0 // indirect
 )
+
+replace github.com/squzy/squzy_generated => ./third_party/squzy_generated
@@ -0,0 +1,97 @@
+package content_storage
+
+import (
+	"context"
+	"github.com/squzy/mongo_helper"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	contentStateKey = "contentState"
+	// Content bigger than limit is not stored, only hash is compared then
+	maxContentSize = 64 * 1024
+)
+
+// State of the previous run, it is kept with scheduler config so it survives restarts
+type State struct {
+	Hash    string `bson:"hash"`
+	Content string `bson:"content,omitempty"`
+}
+
+// ContentStorage keeps normalized content of the previous run per scheduler
+type ContentStorage interface {
+	// Returns nil if scheduler has not been run yet
+	Get(ctx context.Context, schedulerID string) (*State, error)
+	Set(ctx context.Context, schedulerID string, hash string, content string) error
+	Remove(ctx context.Context, schedulerID string) error
+}
+
+type storage struct {
+	connector mongo_helper.Connector
+}
+
+type document struct {
+	ContentState *State `bson:"contentState,omitempty"`
+}
+
+func (s *storage) Get(ctx context.Context, schedulerID string) (*State, error) {
+	id, err := primitive.ObjectIDFromHex(schedulerID)
+	if err != nil {
+		return nil, err
+	}
+	doc := &document{}
+	err = s.connector.FindOne(ctx, bson.M{
+		"_id": id,
+	}, doc, options.FindOne().SetProjection(bson.M{
+		contentStateKey: 1,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return doc.ContentState, nil
+}
+
+func (s *storage) Set(ctx context.Context, schedulerID string, hash string, content string) error {
+	id, err := primitive.ObjectIDFromHex(schedulerID)
+	if err != nil {
+		return err
+	}
+	if len(content) > maxContentSize {
+		content = ""
+	}
+	_, err = s.connector.UpdateOne(ctx, bson.M{
+		"_id": id,
+	}, bson.M{
+		"$set": bson.M{
+			contentStateKey: &State{
+				Hash:    hash,
+				Content: content,
+			},
+		},
+	})
+	return err
+}
+
+func (s *storage) Remove(ctx context.Context, schedulerID string) error {
+	id, err := primitive.ObjectIDFromHex(schedulerID)
+	if err != nil {
+		return err
+	}
+	_, err = s.connector.UpdateOne(ctx, bson.M{
+		"_id": id,
+	}, bson.M{
+		"$unset": bson.M{
+			contentStateKey: "",
+		},
+	})
+	return err
+}
+
+// New storage works with collection of scheduler configs
+func New(connector mongo_helper.Connector) ContentStorage {
+	return &storage{
+		connector: connector,
+	}
+}
@@ -0,0 +1,154 @@
+package content_storage
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"strings"
+	"testing"
+)
+
+var (
+	basicError = errors.New("")
+)
+
+type mockOk struct {
+	state  *State
+	update interface{}
+}
+
+func (m *mockOk) InsertOne(ctx context.Context, document interface{}, opts ...*options.InsertOneOptions) (*mongo.InsertOneResult, error) {
+	panic("implement me")
+}
+
+func (m *mockOk) FindOne(ctx context.Context, filter interface{}, structToDeserialize interface{}, opts ...*options.FindOneOptions) error {
+	structToDeserialize.(*document).ContentState = m.state
+	return nil
+}
+
+func (m *mockOk) Delete(ctx context.Context, filter interface{}, opts ...*options.DeleteOptions) (*mongo.DeleteResult, error) {
+	panic("implement me")
+}
+
+func (m *mockOk) FindAll(ctx context.Context, predicate bson.M, structToDeserialize interface{}, opts ...*options.FindOptions) error {
+	panic("implement me")
+}
+
+func (m *mockOk) UpdateOne(ctx context.Context, filter interface{}, update interface{}, opts ...*options.UpdateOptions) (*mongo.UpdateResult, error) {
+	m.update = update
+	return nil, nil
+}
+
+type mockError struct {
+}
+
+func (m mockError) InsertOne(ctx context.Context, document interface{}, opts ...*options.InsertOneOptions) (*mongo.InsertOneResult, error) {
+	panic("implement me")
+}
+
+func (m mockError) FindOne(ctx context.Context, filter interface{}, structToDeserialize interface{}, opts ...*options.FindOneOptions) error {
+	return basicError
+}
+
+func (m mockError) Delete(ctx context.Context, filter interface{}, opts ...*options.DeleteOptions) (*mongo.DeleteResult, error) {
+	panic("implement me")
+}
+
+func (m mockError) FindAll(ctx context.Context, predicate bson.M, structToDeserialize interface{}, opts ...*options.FindOptions) error {
+	panic("implement me")
+}
+
+func (m mockError) UpdateOne(ctx context.Context, filter interface{}, update interface{}, opts ...*options.UpdateOptions) (*mongo.UpdateResult, error) {
+	return nil, basicError
+}
+
+func TestNew(t *testing.T) {
+	t.Run("Should: implement interface", func(t *testing.T) {
+		s := New(nil)
+		assert.Implements(t, (*ContentStorage)(nil), s)
+	})
+}
+
+func TestStorage_Get(t *testing.T) {
+	id := primitive.NewObjectID().Hex()
+	t.Run("Should: return error because id not valid", func(t *testing.T) {
+		s := New(&mockOk{})
+		_, err := s.Get(context.Background(), "id")
+		assert.NotNil(t, err)
+	})
+	t.Run("Should: return error from connector", func(t *testing.T) {
+		s := New(&mockError{})
+		_, err := s.Get(context.Background(), id)
+		assert.Equal(t, basicError, err)
+	})
+	t.Run("Should: return nil because scheduler has no state", func(t *testing.T) {
+		s := New(&mockOk{})
+		state, err := s.Get(context.Background(), id)
+		assert.Nil(t, err)
+		assert.Nil(t, state)
+	})
+	t.Run("Should: return saved state", func(t *testing.T) {
+		s := New(&mockOk{state: &State{Hash: "hash", Content: "content"}})
+		state, err := s.Get(context.Background(), id)
+		assert.Nil(t, err)
+		assert.Equal(t, &State{Hash: "hash", Content: "content"}, state)
+	})
+}
+
+func TestStorage_Set(t *testing.T) {
+	id := primitive.NewObjectID().Hex()
+	t.Run("Should: return error because id not valid", func(t *testing.T) {
+		s := New(&mockOk{})
+		assert.NotNil(t, s.Set(context.Background(), "id", "hash", "content"))
+	})
+	t.Run("Should: return error from connector", func(t *testing.T) {
+		s := New(&mockError{})
+		assert.Equal(t, basicError, s.Set(context.Background(), id, "hash", "content"))
+	})
+	t.Run("Should: save hash and content", func(t *testing.T) {
+		connector := &mockOk{}
+		s := New(connector)
+		assert.Nil(t, s.Set(context.Background(), id, "hash", "content"))
+		assert.Equal(t, bson.M{
+			"$set": bson.M{
+				contentStateKey: &State{Hash: "hash", Content: "content"},
+			},
+		}, connector.update)
+	})
+	t.Run("Should: save only hash because content too big", func(t *testing.T) {
+		connector := &mockOk{}
+		s := New(connector)
+		assert.Nil(t, s.Set(context.Background(), id, "hash", strings.Repeat("a", maxContentSize+1)))
+		assert.Equal(t, bson.M{
+			"$set": bson.M{
+				contentStateKey: &State{Hash: "hash"},
+			},
+		}, connector.update)
+	})
+}
+
+func TestStorage_Remove(t *testing.T) {
+	id := primitive.NewObjectID().Hex()
+	t.Run("Should: return error because id not valid", func(t *testing.T) {
+		s := New(&mockOk{})
+		assert.NotNil(t, s.Remove(context.Background(), "id"))
+	})
+	t.Run("Should: return error from connector", func(t *testing.T) {
+		s := New(&mockError{})
+		assert.Equal(t, basicError, s.Remove(context.Background(), id))
+	})
+	t.Run("Should: unset state", func(t *testing.T) {
+		connector := &mockOk{}
+		s := New(connector)
+		assert.Nil(t, s.Remove(context.Background(), id))
+		assert.Equal(t, bson.M{
+			"$unset": bson.M{
+				contentStateKey: "",
+			},
+		}, connector.update)
+	})
+}
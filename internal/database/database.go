@@ -3,29 +3,52 @@ package database
 import (
 	"github.com/jinzhu/gorm"
 	"github.com/squzy/squzy/internal/database/postgres"
+	"github.com/squzy/squzy/internal/database/sqlite"
 	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"time"
 )
 
+// InsertSnapshotsError tells indexes of snapshots in batch which were not saved
+type InsertSnapshotsError = postgres.InsertSnapshotsError
+
 type Database interface {
 	InsertSnapshot(data *apiPb.SchedulerResponse) error                                                    //TODO: fix
-	GetSnapshots(request *apiPb.GetSchedulerInformationRequest) ([]*apiPb.SchedulerSnapshot, int32, error) //TODO: fix
+	// All valid snapshots are inserted in one transaction, not valid snapshots are reported by InsertSnapshotsError
+	InsertSnapshots(data []*apiPb.SchedulerResponse) error
+	GetSnapshots(request *apiPb.GetSchedulerInformationRequest) ([]*apiPb.SchedulerSnapshot, int32, *apiPb.PageCursors, error) //TODO: fix
 	GetSnapshotsUptime(request *apiPb.GetSchedulerUptimeRequest) (*apiPb.GetSchedulerUptimeResponse, error)
+	GetSnapshotsUptimeSeries(request *apiPb.GetSchedulerUptimeSeriesRequest) (*apiPb.GetSchedulerUptimeSeriesResponse, error)
+	GetSnapshotsOutages(request *apiPb.GetSchedulerOutagesRequest) (*apiPb.GetSchedulerOutagesResponse, error)
 	InsertStatRequest(data *apiPb.Metric) error
-	GetStatRequest(id string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, error)
-	GetCPUInfo(id string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, error)
-	GetMemoryInfo(id string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, error)
-	GetDiskInfo(id string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, error)
-	GetNetInfo(id string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, error)
+	GetStatRequest(id string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, *apiPb.PageCursors, error)
+	GetCPUInfo(id string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, *apiPb.PageCursors, error)
+	GetMemoryInfo(id string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, *apiPb.PageCursors, error)
+	GetDiskInfo(id string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, *apiPb.PageCursors, error)
+	GetNetInfo(id string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, *apiPb.PageCursors, error)
+	// Aggregates metrics of agent by buckets
+	GetStatRequestSeries(request *apiPb.GetAgentSeriesRequest) (*apiPb.GetAgentSeriesResponse, error)
 	InsertTransactionInfo(data *apiPb.TransactionInfo) error
-	GetTransactionInfo(request *apiPb.GetTransactionsRequest) ([]*apiPb.TransactionInfo, int64, error)
+	GetTransactionInfo(request *apiPb.GetTransactionsRequest) ([]*apiPb.TransactionInfo, int64, *apiPb.PageCursors, error)
 	GetTransactionByID(request *apiPb.GetTransactionByIdRequest) (*apiPb.TransactionInfo, []*apiPb.TransactionInfo, error)
+	// Selects the whole trace by one recursive query
+	GetTransactionTree(request *apiPb.GetTransactionByIdRequest) (*apiPb.GetTransactionTreeResponse, error)
 	GetTransactionGroup(request *apiPb.GetTransactionGroupRequest) (map[string]*apiPb.TransactionGroup, error)
 	InsertIncident(*apiPb.Incident) error
 	GetIncidentById(id string) (*apiPb.Incident, error)
 	GetActiveIncidentByRuleId(ruleId string) (*apiPb.Incident, error)
 	UpdateIncidentStatus(id string, status apiPb.IncidentStatus) (*apiPb.Incident, error)
-	GetIncidents(request *apiPb.GetIncidentsListRequest) ([]*apiPb.Incident, int64, error)
+	GetIncidents(request *apiPb.GetIncidentsListRequest) ([]*apiPb.Incident, int64, *apiPb.PageCursors, error)
+	// Aggregates completed buckets of raw data with resolution till time
+	Rollup(resolution time.Duration, until time.Time) error
+	DeleteSnapshots(before time.Time) error
+	DeleteStatRequests(before time.Time) error
+	DeleteTransactionInfos(before time.Time) error
+	DeleteRollups(resolution time.Duration, before time.Time) error
+	// Applies all migrations which were not applied yet
 	Migrate() error
+	// Applies up or down migrations till version
+	MigrateTo(version uint) error
+	GetSchemaVersion() (uint, error)
 }
 
 func New(pgDb *gorm.DB) Database {
@@ -33,3 +56,7 @@ func New(pgDb *gorm.DB) Database {
 		Db: pgDb,
 	}
 }
+
+func NewSqlite(db *gorm.DB) Database {
+	return sqlite.New(db)
+}
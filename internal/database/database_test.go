@@ -11,3 +11,10 @@ func TestNew(t *testing.T) {
 		assert.NotNil(t, s)
 	})
 }
+
+func TestNewSqlite(t *testing.T) {
+	t.Run("Should: not return nil", func(t *testing.T) {
+		s := NewSqlite(nil)
+		assert.NotNil(t, s)
+	})
+}
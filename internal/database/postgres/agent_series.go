@@ -0,0 +1,345 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/jinzhu/gorm"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	timestamp "google.golang.org/protobuf/types/known/timestamppb"
+	"sort"
+	"time"
+)
+
+type agentCPUSeriesResult struct {
+	Bucket int64   `gorm:"column:bucket"`
+	Core   int     `gorm:"column:core"`
+	Avg    float64 `gorm:"column:avg"`
+	Max    float64 `gorm:"column:max"`
+}
+
+type agentMemorySeriesResult struct {
+	Bucket  int64   `gorm:"column:bucket"`
+	MemAvg  float64 `gorm:"column:memAvg"`
+	MemMax  float64 `gorm:"column:memMax"`
+	SwapAvg float64 `gorm:"column:swapAvg"`
+	SwapMax float64 `gorm:"column:swapMax"`
+}
+
+type agentDiskSeriesResult struct {
+	Bucket int64   `gorm:"column:bucket"`
+	Name   string  `gorm:"column:name"`
+	Avg    float64 `gorm:"column:avg"`
+	Max    float64 `gorm:"column:max"`
+}
+
+// Traffic and seconds are sums of deltas between neighbour samples of interface
+type agentNetSeriesResult struct {
+	Bucket  int64  `gorm:"column:bucket"`
+	Name    string `gorm:"column:name"`
+	Sent    int64  `gorm:"column:sent"`
+	Recv    int64  `gorm:"column:recv"`
+	Seconds int64  `gorm:"column:seconds"`
+}
+
+var (
+	agentSeriesFilterString       = fmt.Sprintf(`"%s"."deleted_at" IS NULL AND %s AND %s`, dbStatRequestCollection, agentIdFilterString, statRequestTimeFilterString)
+	agentSeriesRollupFilterString = fmt.Sprintf(`%s AND %s AND %s`, rollupAgentIdFilterString, rollupResolutionFilterString, rollupBucketFilterString)
+)
+
+// Hourly and daily series are read from rollups, buckets which were not rolled up yet are calculated by raw statistics
+func (p *Postgres) GetStatRequestSeries(request *apiPb.GetAgentSeriesRequest) (*apiPb.GetAgentSeriesResponse, error) {
+	timeFrom, timeTo, err := getTime(request.GetTimeRange())
+	if err != nil {
+		return nil, err
+	}
+	bucketSize := getAgentSeriesBucketSize(request.GetBucketSize(), timeTo.Sub(timeFrom))
+
+	var cpus []*agentCPUSeriesResult
+	var memory []*agentMemorySeriesResult
+	var disks []*agentDiskSeriesResult
+	var nets []*agentNetSeriesResult
+	rawFrom := timeFrom
+	if resolution := getAgentSeriesRollupResolution(bucketSize); resolution != 0 {
+		rollupFrom := time.Unix(timeFrom.Unix()/bucketSize*bucketSize, 0)
+		rollupTo, err := p.getAgentSeriesRollupsEnd(request.GetAgentId(), resolution, bucketSize, rollupFrom, timeTo)
+		if err != nil {
+			return nil, errorDataBase
+		}
+		if rollupTo.After(rollupFrom) {
+			cpus, memory, disks, nets, err = p.getAgentSeriesByRollups(request.GetAgentId(), resolution, bucketSize, rollupFrom, rollupTo)
+			if err != nil {
+				return nil, errorDataBase
+			}
+			rawFrom = rollupTo
+		}
+	}
+
+	if rawFrom.Before(timeTo) {
+		rawCpus, rawMemory, rawDisks, rawNets, err := p.getAgentSeriesByStatRequests(request.GetAgentId(), bucketSize, rawFrom, timeTo)
+		if err != nil {
+			return nil, errorDataBase
+		}
+		cpus = append(cpus, rawCpus...)
+		memory = append(memory, rawMemory...)
+		disks = append(disks, rawDisks...)
+		nets = append(nets, rawNets...)
+	}
+
+	return &apiPb.GetAgentSeriesResponse{
+		BucketSize: bucketSize,
+		Points:     convertToAgentSeries(cpus, memory, disks, nets),
+	}, nil
+}
+
+// Returns resolution of rollups which buckets of series are built from, zero if raw statistics should be used
+func getAgentSeriesRollupResolution(bucketSize int64) time.Duration {
+	for _, resolution := range []time.Duration{time.Hour * 24, time.Hour} {
+		if bucketSize%int64(resolution/time.Second) == 0 {
+			return resolution
+		}
+	}
+	return 0
+}
+
+// Returns end of the last series bucket, which is entirely rolled up
+func (p *Postgres) getAgentSeriesRollupsEnd(agentID string, resolution time.Duration, bucketSize int64, timeFrom, timeTo time.Time) (time.Time, error) {
+	seconds := int64(resolution / time.Second)
+	var last sql.NullInt64
+	err := p.Db.Table(dbStatRequestRollupCollection).
+		Where(agentSeriesRollupFilterString, agentID, seconds, timeFrom.UTC(), timeTo.UTC()).
+		Select(fmt.Sprintf(`MAX(%s)`, getEpochString(p.Db, rollupBucketTimeString))).
+		Row().
+		Scan(&last)
+	if err != nil || !last.Valid {
+		return timeFrom, err
+	}
+	end := time.Unix((last.Int64+seconds)/bucketSize*bucketSize, 0)
+	if end.After(timeTo) {
+		return timeTo, nil
+	}
+	return end, nil
+}
+
+func (p *Postgres) getAgentSeriesByRollups(agentID string, resolution time.Duration, bucketSize int64, timeFrom, timeTo time.Time) ([]*agentCPUSeriesResult, []*agentMemorySeriesResult, []*agentDiskSeriesResult, []*agentNetSeriesResult, error) {
+	// Sqlite compares time as text, so it should be in UTC
+	args := []interface{}{bucketSize, bucketSize, agentID, int64(resolution / time.Second), timeFrom.UTC(), timeTo.UTC()}
+	epoch := getEpochString(p.Db, rollupBucketTimeString)
+	bucket := fmt.Sprintf(`%s / ? * ?`, epoch)
+
+	var cpus []*agentCPUSeriesResult
+	err := p.Db.Raw(fmt.Sprintf(
+		`SELECT %s AS "bucket", "core", SUM("loadAvg" * "count") / SUM("count") AS "avg", MAX("loadMax") AS "max"
+		FROM "%s"
+		WHERE %s
+		GROUP BY "bucket", "core" ORDER BY "bucket", "core"`,
+		bucket, dbCPURollupCollection, agentSeriesRollupFilterString,
+	), args...).Scan(&cpus).Error
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	var memory []*agentMemorySeriesResult
+	err = p.Db.Raw(fmt.Sprintf(
+		`SELECT %s AS "bucket", SUM("memUsedPercentAvg" * "count") / SUM("count") AS "memAvg", MAX("memUsedPercentMax") AS "memMax",
+			SUM("swapUsedPercentAvg" * "count") / SUM("count") AS "swapAvg", MAX("swapUsedPercentMax") AS "swapMax"
+		FROM "%s"
+		WHERE %s
+		GROUP BY "bucket" ORDER BY "bucket"`,
+		bucket, dbStatRequestRollupCollection, agentSeriesRollupFilterString,
+	), args...).Scan(&memory).Error
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	var disks []*agentDiskSeriesResult
+	err = p.Db.Raw(fmt.Sprintf(
+		`SELECT %s AS "bucket", "name", AVG("usedPercentAvg") AS "avg", MAX("usedPercentMax") AS "max"
+		FROM "%s"
+		WHERE %s
+		GROUP BY "bucket", "name" ORDER BY "bucket"`,
+		bucket, dbDiskRollupCollection, agentSeriesRollupFilterString,
+	), args...).Scan(&disks).Error
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	// Rollups keep the last value of counters, so deltas are calculated between rollup buckets
+	var nets []*agentNetSeriesResult
+	err = p.Db.Raw(getNetSeriesString(
+		bucket, epoch, `"name"`, `"bytesSent"`, `"bytesRecv"`,
+		fmt.Sprintf(`"%s"`, dbNetRollupCollection), agentSeriesRollupFilterString,
+	), args...).Scan(&nets).Error
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	return cpus, memory, disks, nets, nil
+}
+
+func (p *Postgres) getAgentSeriesByStatRequests(agentID string, bucketSize int64, timeFrom, timeTo time.Time) ([]*agentCPUSeriesResult, []*agentMemorySeriesResult, []*agentDiskSeriesResult, []*agentNetSeriesResult, error) {
+	// Sqlite compares time as text, so it should be in UTC
+	args := []interface{}{bucketSize, bucketSize, agentID, timeFrom.UTC(), timeTo.UTC()}
+
+	epoch := getEpochString(p.Db, fmt.Sprintf(`"%s"."time"`, dbStatRequestCollection))
+	bucket := fmt.Sprintf(`%s / ? * ?`, epoch)
+
+	var cpus []*agentCPUSeriesResult
+	err := p.Db.Raw(fmt.Sprintf(
+		`SELECT "bucket", "core", AVG("load") AS "avg", MAX("load") AS "max" FROM (
+			SELECT %s AS "bucket", ROW_NUMBER() OVER (PARTITION BY "%s"."statRequestId" ORDER BY "%s"."id") - 1 AS "core", "%s"."load" AS "load"
+			FROM "%s" JOIN "%s" ON "%s"."statRequestId" = "%s"."id"
+			WHERE %s
+		) AS "cpu" GROUP BY "bucket", "core" ORDER BY "bucket", "core"`,
+		bucket, dbCPUInfoCollection, dbCPUInfoCollection, dbCPUInfoCollection,
+		dbStatRequestCollection, dbCPUInfoCollection, dbCPUInfoCollection, dbStatRequestCollection,
+		agentSeriesFilterString,
+	), args...).Scan(&cpus).Error
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	var memory []*agentMemorySeriesResult
+	err = p.Db.Raw(fmt.Sprintf(
+		`SELECT %s AS "bucket", COALESCE(AVG("mem"."usedPercent"), 0) AS "memAvg", COALESCE(MAX("mem"."usedPercent"), 0) AS "memMax",
+			COALESCE(AVG("swap"."usedPercent"), 0) AS "swapAvg", COALESCE(MAX("swap"."usedPercent"), 0) AS "swapMax"
+		FROM "%s" JOIN "%s" ON "%s"."statRequestId" = "%s"."id"
+		LEFT JOIN "%s" AS "mem" ON "mem"."memoryInfoId" = "%s"."id"
+		LEFT JOIN "%s" AS "swap" ON "swap"."memoryInfoId" = "%s"."id"
+		WHERE %s
+		GROUP BY "bucket" ORDER BY "bucket"`,
+		bucket,
+		dbStatRequestCollection, dbMemoryInfoCollection, dbMemoryInfoCollection, dbStatRequestCollection,
+		dbMemoryMemCollection, dbMemoryInfoCollection,
+		dbMemorySwapCollection, dbMemoryInfoCollection,
+		agentSeriesFilterString,
+	), args...).Scan(&memory).Error
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	var disks []*agentDiskSeriesResult
+	err = p.Db.Raw(fmt.Sprintf(
+		`SELECT %s AS "bucket", "%s"."name" AS "name", AVG("%s"."usedPercent") AS "avg", MAX("%s"."usedPercent") AS "max"
+		FROM "%s" JOIN "%s" ON "%s"."statRequestId" = "%s"."id"
+		WHERE %s
+		GROUP BY "bucket", "%s"."name" ORDER BY "bucket"`,
+		bucket, dbDiskInfoCollection, dbDiskInfoCollection, dbDiskInfoCollection,
+		dbStatRequestCollection, dbDiskInfoCollection, dbDiskInfoCollection, dbStatRequestCollection,
+		agentSeriesFilterString,
+		dbDiskInfoCollection,
+	), args...).Scan(&disks).Error
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	var nets []*agentNetSeriesResult
+	err = p.Db.Raw(getNetSeriesString(
+		bucket, epoch, fmt.Sprintf(`"%s"."name"`, dbNetInfoCollection),
+		fmt.Sprintf(`"%s"."bytesSent"`, dbNetInfoCollection), fmt.Sprintf(`"%s"."bytesRecv"`, dbNetInfoCollection),
+		fmt.Sprintf(`"%s" JOIN "%s" ON "%s"."statRequestId" = "%s"."id"`, dbStatRequestCollection, dbNetInfoCollection, dbNetInfoCollection, dbStatRequestCollection),
+		agentSeriesFilterString,
+	), args...).Scan(&nets).Error
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	return cpus, memory, disks, nets, nil
+}
+
+// Counters of interfaces are cumulative, so traffic of every sample is delta with the previous sample of interface,
+// counter which was reset gives zero delta. The first sample of time range has no previous one, so it is skipped
+func getNetSeriesString(bucket, epoch, name, sent, recv, from, filter string) string {
+	return fmt.Sprintf(
+		`SELECT "bucket", "name", SUM(CASE WHEN "sent" >= "prevSent" THEN "sent" - "prevSent" ELSE 0 END) AS "sent",
+			SUM(CASE WHEN "recv" >= "prevRecv" THEN "recv" - "prevRecv" ELSE 0 END) AS "recv",
+			SUM("time" - "prevTime") AS "seconds"
+		FROM (
+			SELECT %[1]s AS "bucket", %[3]s AS "name", %[2]s AS "time", %[4]s AS "sent", %[5]s AS "recv",
+				LAG(%[2]s) OVER (PARTITION BY %[3]s ORDER BY %[2]s) AS "prevTime",
+				LAG(%[4]s) OVER (PARTITION BY %[3]s ORDER BY %[2]s) AS "prevSent",
+				LAG(%[5]s) OVER (PARTITION BY %[3]s ORDER BY %[2]s) AS "prevRecv"
+			FROM %[6]s
+			WHERE %[7]s
+		) AS "samples"
+		WHERE "prevTime" IS NOT NULL
+		GROUP BY "bucket", "name" ORDER BY "bucket"`,
+		bucket, epoch, name, sent, recv, from, filter,
+	)
+}
+
+// Returns unix seconds of time column
+func getEpochString(db *gorm.DB, column string) string {
+	if db.Dialect().GetName() == "sqlite3" {
+		return fmt.Sprintf(`CAST(strftime('%%s', %s) AS INTEGER)`, column)
+	}
+	return fmt.Sprintf(`CAST(floor(extract(epoch from %s)) AS BIGINT)`, column)
+}
+
+// Bucket size is chosen as series resolution if it is not specified, it is increased till it fits max amount of points
+func getAgentSeriesBucketSize(bucketSize int64, duration time.Duration) int64 {
+	if bucketSize <= 0 {
+		bucketSize = int64(seriesResolutions[getSeriesResolution(apiPb.SeriesResolution_SERIES_RESOLUTION_UNSPECIFIED, 0, duration.Nanoseconds())] / time.Second)
+	}
+	minBucketSize := int64(duration/time.Second)/maxSeriesPoints + 1
+	if bucketSize < minBucketSize {
+		return minBucketSize
+	}
+	return bucketSize
+}
+
+func convertToAgentSeries(cpus []*agentCPUSeriesResult, memory []*agentMemorySeriesResult, disks []*agentDiskSeriesResult, nets []*agentNetSeriesResult) []*apiPb.AgentSeriesPoint {
+	points := map[int64]*apiPb.AgentSeriesPoint{}
+	getPoint := func(bucket int64) *apiPb.AgentSeriesPoint {
+		if point, ok := points[bucket]; ok {
+			return point
+		}
+		point := &apiPb.AgentSeriesPoint{
+			Time:       timestamp.New(time.Unix(bucket, 0)),
+			Disks:      map[string]*apiPb.AgentSeriesValue{},
+			Interfaces: map[string]*apiPb.AgentSeriesNetRate{},
+		}
+		points[bucket] = point
+		return point
+	}
+
+	// Cores are ordered by index
+	for _, cpu := range cpus {
+		point := getPoint(cpu.Bucket)
+		point.Cpus = append(point.Cpus, &apiPb.AgentSeriesValue{
+			Avg: cpu.Avg,
+			Max: cpu.Max,
+		})
+	}
+	for _, mem := range memory {
+		point := getPoint(mem.Bucket)
+		point.MemoryUsedPercent = &apiPb.AgentSeriesValue{
+			Avg: mem.MemAvg,
+			Max: mem.MemMax,
+		}
+		point.SwapUsedPercent = &apiPb.AgentSeriesValue{
+			Avg: mem.SwapAvg,
+			Max: mem.SwapMax,
+		}
+	}
+	for _, disk := range disks {
+		getPoint(disk.Bucket).Disks[disk.Name] = &apiPb.AgentSeriesValue{
+			Avg: disk.Avg,
+			Max: disk.Max,
+		}
+	}
+	for _, net := range nets {
+		rate := &apiPb.AgentSeriesNetRate{}
+		if net.Seconds > 0 {
+			rate.BytesSent = float64(net.Sent) / float64(net.Seconds)
+			rate.BytesRecv = float64(net.Recv) / float64(net.Seconds)
+		}
+		getPoint(net.Bucket).Interfaces[net.Name] = rate
+	}
+
+	res := make([]*apiPb.AgentSeriesPoint, 0, len(points))
+	for _, point := range points {
+		res = append(res, point)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Time.AsTime().Before(res[j].Time.AsTime())
+	})
+	return res
+}
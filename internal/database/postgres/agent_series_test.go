@@ -0,0 +1,195 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jinzhu/gorm"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	timestamp "google.golang.org/protobuf/types/known/timestamppb"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var (
+	postgrAgentSeries = &Postgres{}
+)
+
+type SuiteAgentSeries struct {
+	suite.Suite
+	DB   *gorm.DB
+	mock sqlmock.Sqlmock
+}
+
+func (s *SuiteAgentSeries) SetupSuite() {
+	var (
+		db  *sql.DB
+		err error
+	)
+
+	db, s.mock, err = sqlmock.New()
+	require.NoError(s.T(), err)
+
+	s.DB, err = gorm.Open("postgres", db)
+	require.NoError(s.T(), err)
+	postgrAgentSeries.Db = s.DB
+
+	s.DB.LogMode(true)
+}
+
+func (s *SuiteAgentSeries) Test_GetStatRequestSeries() {
+	s.mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(`JOIN "%s"`, dbCPUInfoCollection))).
+		WithArgs(int64(60), int64(60), "1", sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"bucket", "core", "avg", "max"}).AddRow(60, 0, 10, 20))
+	s.mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(`JOIN "%s"`, dbMemoryInfoCollection))).
+		WithArgs(int64(60), int64(60), "1", sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"bucket", "memAvg", "memMax"}).AddRow(60, 30, 40))
+	s.mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(`JOIN "%s"`, dbDiskInfoCollection))).
+		WithArgs(int64(60), int64(60), "1", sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"bucket", "name", "avg", "max"}).AddRow(120, "disk", 50, 60))
+	s.mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(`JOIN "%s"`, dbNetInfoCollection))).
+		WithArgs(int64(60), int64(60), "1", sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"bucket", "name", "sent", "recv", "seconds"}).
+			AddRow(60, "eth0", 100, 50, 50))
+
+	res, err := postgrAgentSeries.GetStatRequestSeries(&apiPb.GetAgentSeriesRequest{
+		AgentId: "1",
+		TimeRange: &apiPb.TimeFilter{
+			From: timestamp.New(time.Now().Add(-time.Hour)),
+		},
+	})
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(60), res.GetBucketSize())
+	require.Len(s.T(), res.GetPoints(), 2)
+	assert.Equal(s.T(), float64(20), res.GetPoints()[0].GetCpus()[0].GetMax())
+	assert.Equal(s.T(), float64(30), res.GetPoints()[0].GetMemoryUsedPercent().GetAvg())
+	assert.Equal(s.T(), float64(2), res.GetPoints()[0].GetInterfaces()["eth0"].GetBytesSent())
+	assert.Equal(s.T(), float64(1), res.GetPoints()[0].GetInterfaces()["eth0"].GetBytesRecv())
+	assert.Equal(s.T(), float64(60), res.GetPoints()[1].GetDisks()["disk"].GetMax())
+}
+
+func (s *SuiteAgentSeries) Test_GetStatRequestSeries_Rollups() {
+	from := time.Unix(3600*10, 0)
+	to := time.Unix(3600*12, 0)
+	s.mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(`FROM "%s"`, dbStatRequestRollupCollection))).
+		WithArgs("1", int64(3600), from.UTC(), to.UTC()).
+		WillReturnRows(sqlmock.NewRows([]string{"max"}).AddRow(3600 * 11))
+	s.mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(`FROM "%s"`, dbCPURollupCollection))).
+		WithArgs(int64(3600), int64(3600), "1", int64(3600), from.UTC(), to.UTC()).
+		WillReturnRows(sqlmock.NewRows([]string{"bucket", "core", "avg", "max"}).AddRow(3600*10, 0, 10, 20).AddRow(3600*10, 1, 5, 15))
+	s.mock.ExpectQuery(regexp.QuoteMeta(`MAX("swapUsedPercentMax") AS "swapMax"`)).
+		WithArgs(int64(3600), int64(3600), "1", int64(3600), from.UTC(), to.UTC()).
+		WillReturnRows(sqlmock.NewRows([]string{"bucket", "memAvg", "swapMax"}).AddRow(3600*10, 30, 40))
+	s.mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(`FROM "%s"`, dbDiskRollupCollection))).
+		WithArgs(int64(3600), int64(3600), "1", int64(3600), from.UTC(), to.UTC()).
+		WillReturnRows(sqlmock.NewRows([]string{"bucket", "name", "avg", "max"}).AddRow(3600*11, "disk", 50, 60))
+	s.mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(`FROM "%s"`, dbNetRollupCollection))).
+		WithArgs(int64(3600), int64(3600), "1", int64(3600), from.UTC(), to.UTC()).
+		WillReturnRows(sqlmock.NewRows([]string{"bucket", "name", "sent", "recv", "seconds"}).AddRow(3600*11, "eth0", 7200, 3600, 3600))
+
+	res, err := postgrAgentSeries.GetStatRequestSeries(&apiPb.GetAgentSeriesRequest{
+		AgentId: "1",
+		TimeRange: &apiPb.TimeFilter{
+			From: timestamp.New(from),
+			To:   timestamp.New(to),
+		},
+		BucketSize: 3600,
+	})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), res.GetPoints(), 2)
+	require.Len(s.T(), res.GetPoints()[0].GetCpus(), 2)
+	assert.Equal(s.T(), float64(20), res.GetPoints()[0].GetCpus()[0].GetMax())
+	assert.Equal(s.T(), float64(15), res.GetPoints()[0].GetCpus()[1].GetMax())
+	assert.Equal(s.T(), float64(30), res.GetPoints()[0].GetMemoryUsedPercent().GetAvg())
+	assert.Equal(s.T(), float64(40), res.GetPoints()[0].GetSwapUsedPercent().GetMax())
+	assert.Equal(s.T(), float64(60), res.GetPoints()[1].GetDisks()["disk"].GetMax())
+	assert.Equal(s.T(), float64(2), res.GetPoints()[1].GetInterfaces()["eth0"].GetBytesSent())
+}
+
+// Based on fact, that if request is not mocked, it will return error
+func (s *SuiteAgentSeries) Test_GetStatRequestSeries_Error() {
+	_, err := postgrAgentSeries.GetStatRequestSeries(&apiPb.GetAgentSeriesRequest{
+		AgentId: "1",
+	})
+	require.Error(s.T(), err)
+}
+
+func (s *SuiteAgentSeries) Test_GetStatRequestSeries_MemoryError() {
+	s.mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(`JOIN "%s"`, dbCPUInfoCollection))).
+		WillReturnRows(sqlmock.NewRows([]string{"bucket", "core", "avg", "max"}))
+
+	_, err := postgrAgentSeries.GetStatRequestSeries(&apiPb.GetAgentSeriesRequest{
+		AgentId: "1",
+	})
+	require.Error(s.T(), err)
+}
+
+func (s *SuiteAgentSeries) Test_GetStatRequestSeries_DiskError() {
+	s.mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(`JOIN "%s"`, dbCPUInfoCollection))).
+		WillReturnRows(sqlmock.NewRows([]string{"bucket", "core", "avg", "max"}))
+	s.mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(`JOIN "%s"`, dbMemoryInfoCollection))).
+		WillReturnRows(sqlmock.NewRows([]string{"bucket", "memAvg", "memMax"}))
+
+	_, err := postgrAgentSeries.GetStatRequestSeries(&apiPb.GetAgentSeriesRequest{
+		AgentId: "1",
+	})
+	require.Error(s.T(), err)
+}
+
+func (s *SuiteAgentSeries) Test_GetStatRequestSeries_NetError() {
+	s.mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(`JOIN "%s"`, dbCPUInfoCollection))).
+		WillReturnRows(sqlmock.NewRows([]string{"bucket", "core", "avg", "max"}))
+	s.mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(`JOIN "%s"`, dbMemoryInfoCollection))).
+		WillReturnRows(sqlmock.NewRows([]string{"bucket", "memAvg", "memMax"}))
+	s.mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(`JOIN "%s"`, dbDiskInfoCollection))).
+		WillReturnRows(sqlmock.NewRows([]string{"bucket", "name", "avg", "max"}))
+
+	_, err := postgrAgentSeries.GetStatRequestSeries(&apiPb.GetAgentSeriesRequest{
+		AgentId: "1",
+	})
+	require.Error(s.T(), err)
+}
+
+func (s *SuiteAgentSeries) Test_GetStatRequestSeries_TimeError() {
+	_, err := postgrAgentSeries.GetStatRequestSeries(&apiPb.GetAgentSeriesRequest{
+		AgentId: "1",
+		TimeRange: &apiPb.TimeFilter{
+			From: &timestamp.Timestamp{Seconds: -62135596801},
+		},
+	})
+	require.Error(s.T(), err)
+}
+
+func (s *SuiteAgentSeries) AfterTest(_, _ string) {
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func TestInitAgentSeries(t *testing.T) {
+	suite.Run(t, new(SuiteAgentSeries))
+}
+
+func TestGetAgentSeriesRollupResolution(t *testing.T) {
+	t.Run("Should: use rollups for hourly and daily buckets", func(t *testing.T) {
+		assert.Equal(t, day, getAgentSeriesRollupResolution(86400*2))
+		assert.Equal(t, time.Hour, getAgentSeriesRollupResolution(7200))
+		assert.Equal(t, time.Duration(0), getAgentSeriesRollupResolution(60))
+	})
+}
+
+func TestGetAgentSeriesBucketSize(t *testing.T) {
+	t.Run("Should: choose bucket size by range", func(t *testing.T) {
+		assert.Equal(t, int64(60), getAgentSeriesBucketSize(0, day))
+		assert.Equal(t, int64(3600), getAgentSeriesBucketSize(0, day*7))
+		assert.Equal(t, int64(86400), getAgentSeriesBucketSize(0, day*365))
+	})
+	t.Run("Should: keep requested bucket size", func(t *testing.T) {
+		assert.Equal(t, int64(5), getAgentSeriesBucketSize(5, time.Hour))
+	})
+	t.Run("Should: increase bucket size for too many points", func(t *testing.T) {
+		assert.Equal(t, int64(61), getAgentSeriesBucketSize(5, day*7))
+	})
+}
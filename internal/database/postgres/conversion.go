@@ -514,6 +514,12 @@ func convertFromGroupResult(group []*GroupResult, upTime int64) map[string]*apiP
 			MinTime:      minTime / 1000000,
 			MaxTime:      maxTime / 1000000,
 			Throughput:   getThroughput(v.Count, lowTime, upTime), //Take minutes and div by count
+			LatencyP50:   v.LatencyP50 / 1000000,
+			LatencyP90:   v.LatencyP90 / 1000000,
+			LatencyP95:   v.LatencyP95 / 1000000,
+			LatencyP99:   v.LatencyP99 / 1000000,
+			ErrorRate:    float64(v.ErrorCount) / float64(v.Count),
+			Apdex:        (float64(v.Satisfied) + float64(v.Tolerating)/2) / float64(v.Count),
 		}
 	}
 	return res
@@ -280,6 +280,27 @@ func TestConvertFromGroupResult(t *testing.T) {
 		}, time.Now().UnixNano())
 		assert.NotNil(t, res)
 	})
+	t.Run("Test: percentiles, error rate and apdex", func(t *testing.T) {
+		res := convertFromGroupResult([]*GroupResult{
+			{
+				Name:       "Name",
+				Count:      4,
+				Latency:    "10000.000",
+				MinTime:    "10000.000",
+				MaxTime:    "10000.000",
+				LowTime:    "10000.000",
+				ErrorCount: 1,
+				Satisfied:  2,
+				Tolerating: 1,
+				LatencyP50: 2000000,
+				LatencyP99: 5000000,
+			},
+		}, time.Now().UnixNano())
+		assert.Equal(t, float64(2), res["Name"].GetLatencyP50())
+		assert.Equal(t, float64(5), res["Name"].GetLatencyP99())
+		assert.Equal(t, 0.25, res["Name"].GetErrorRate())
+		assert.Equal(t, 0.625, res["Name"].GetApdex())
+	})
 }
 
 func TestGetThroughput(t *testing.T) {
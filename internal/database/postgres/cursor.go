@@ -0,0 +1,141 @@
+package postgres
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/jinzhu/gorm"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"reflect"
+	"time"
+)
+
+// Position of row in (time, id) keyset
+type cursor struct {
+	Time int64 `json:"t"`
+	ID   uint  `json:"i"`
+	// Cursor points to the page before the row
+	Before bool `json:"b,omitempty"`
+	// Page is ordered from the newest rows
+	Desc bool `json:"d,omitempty"`
+}
+
+// Keyset of table, time column holds unixNanos or timestamp
+type keyset struct {
+	timeColumn string
+	idColumn   string
+	timestamp  bool
+}
+
+const (
+	defaultCursorLimit = 100
+)
+
+var (
+	errorInvalidCursor = errors.New("INVALID_CURSOR")
+)
+
+func newKeyset(collection, timeColumn string, timestamp bool) *keyset {
+	return &keyset{
+		timeColumn: fmt.Sprintf(`"%s"."%s"`, collection, timeColumn),
+		idColumn:   fmt.Sprintf(`"%s"."id"`, collection),
+		timestamp:  timestamp,
+	}
+}
+
+func encodeCursor(c *cursor) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(value string) (*cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, errorInvalidCursor
+	}
+	c := &cursor{}
+	if err := json.Unmarshal(data, c); err != nil {
+		return nil, errorInvalidCursor
+	}
+	return c, nil
+}
+
+// Return cursor of pagination or nil if page is selected by offset
+func getCursor(pagination *apiPb.Pagination) (*cursor, error) {
+	if pagination.GetCursor() == "" {
+		return nil, nil
+	}
+	return decodeCursor(pagination.GetCursor())
+}
+
+func getCursorLimit(pagination *apiPb.Pagination) int {
+	if pagination.GetLimit() <= 0 {
+		return defaultCursorLimit
+	}
+	return int(pagination.GetLimit())
+}
+
+// Selects limit+1 rows after (or before) cursor, extra row shows that there is one more page
+func (k *keyset) apply(db *gorm.DB, c *cursor, limit int) *gorm.DB {
+	// Rows before cursor are selected in reverse order and reversed after select
+	scanDesc := c.Desc != c.Before
+	compare, direction := ">", ""
+	if scanDesc {
+		compare, direction = "<", descPrefix
+	}
+	var timeValue interface{} = c.Time
+	if k.timestamp {
+		timeValue = time.Unix(0, c.Time).UTC()
+	}
+	return db.
+		Where(fmt.Sprintf(`(%s, %s) %s (?, ?)`, k.timeColumn, k.idColumn, compare), timeValue, c.ID).
+		Order(k.timeColumn + direction).
+		Order(k.idColumn + direction).
+		Limit(limit + 1)
+}
+
+// Return cursors of page selected by cursor, first and last are keys of the page rows
+func getCursorPageCursors(c *cursor, hasMore bool, first, last *cursor) *apiPb.PageCursors {
+	if first == nil {
+		return &apiPb.PageCursors{}
+	}
+	// Page after cursor always has previous page and page before cursor always has next one
+	return getPageCursors(c.Desc, hasMore || c.Before, hasMore || !c.Before, first, last)
+}
+
+// Return cursors of page selected by offset
+func getOffsetPageCursors(desc bool, offset, size int, count int64, first, last *cursor) *apiPb.PageCursors {
+	if first == nil {
+		return &apiPb.PageCursors{}
+	}
+	return getPageCursors(desc, int64(offset+size) < count, offset > 0, first, last)
+}
+
+func getPageCursors(desc, hasNext, hasPrev bool, first, last *cursor) *apiPb.PageCursors {
+	cursors := &apiPb.PageCursors{}
+	if hasNext {
+		cursors.Next = encodeCursor(&cursor{Time: last.Time, ID: last.ID, Desc: desc})
+	}
+	if hasPrev {
+		cursors.Prev = encodeCursor(&cursor{Time: first.Time, ID: first.ID, Before: true, Desc: desc})
+	}
+	return cursors
+}
+
+// Trims extra row of cursor page and restores order of rows selected before cursor, return if there are more rows
+func trimCursorPage(rows interface{}, c *cursor, limit int) bool {
+	value := reflect.ValueOf(rows).Elem()
+	hasMore := value.Len() > limit
+	if hasMore {
+		// Rows are selected from the cursor in both directions, so extra row is always the last one
+		value.Set(value.Slice(0, limit))
+	}
+	if c.Before {
+		swap := reflect.Swapper(value.Interface())
+		for i, j := 0, value.Len()-1; i < j; i, j = i+1, j-1 {
+			swap(i, j)
+		}
+	}
+	return hasMore
+}
@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestCursor(t *testing.T) {
+	t.Run("Should: decode encoded cursor", func(t *testing.T) {
+		c := &cursor{Time: 100, ID: 5, Before: true, Desc: true}
+		res, err := decodeCursor(encodeCursor(c))
+		require.NoError(t, err)
+		assert.Equal(t, c, res)
+	})
+	t.Run("Should: return error for invalid base64", func(t *testing.T) {
+		_, err := decodeCursor("!!!")
+		assert.Equal(t, errorInvalidCursor, err)
+	})
+	t.Run("Should: return error for invalid json", func(t *testing.T) {
+		_, err := decodeCursor("bm90IGpzb24")
+		assert.Equal(t, errorInvalidCursor, err)
+	})
+	t.Run("Should: return nil cursor without cursor", func(t *testing.T) {
+		res, err := getCursor(&apiPb.Pagination{Page: 1, Limit: 10})
+		require.NoError(t, err)
+		assert.Nil(t, res)
+	})
+	t.Run("Should: return default limit", func(t *testing.T) {
+		assert.Equal(t, defaultCursorLimit, getCursorLimit(nil))
+		assert.Equal(t, 10, getCursorLimit(&apiPb.Pagination{Limit: 10}))
+	})
+}
+
+func TestTrimCursorPage(t *testing.T) {
+	t.Run("Should: trim extra row", func(t *testing.T) {
+		rows := []int{1, 2, 3}
+		assert.True(t, trimCursorPage(&rows, &cursor{}, 2))
+		assert.Equal(t, []int{1, 2}, rows)
+	})
+	t.Run("Should: reverse rows before cursor", func(t *testing.T) {
+		rows := []int{3, 2, 1}
+		assert.True(t, trimCursorPage(&rows, &cursor{Before: true}, 2))
+		assert.Equal(t, []int{2, 3}, rows)
+	})
+	t.Run("Should: keep last page", func(t *testing.T) {
+		rows := []int{1}
+		assert.False(t, trimCursorPage(&rows, &cursor{}, 2))
+		assert.Equal(t, []int{1}, rows)
+	})
+}
+
+func TestPageCursors(t *testing.T) {
+	first, last := &cursor{Time: 1, ID: 1}, &cursor{Time: 2, ID: 2}
+	t.Run("Should: return both cursors for page after cursor", func(t *testing.T) {
+		res := getCursorPageCursors(&cursor{Desc: true}, true, first, last)
+		next, err := decodeCursor(res.GetNext())
+		require.NoError(t, err)
+		assert.Equal(t, &cursor{Time: 2, ID: 2, Desc: true}, next)
+		prev, err := decodeCursor(res.GetPrev())
+		require.NoError(t, err)
+		assert.Equal(t, &cursor{Time: 1, ID: 1, Before: true, Desc: true}, prev)
+	})
+	t.Run("Should: not return next cursor for last page", func(t *testing.T) {
+		res := getCursorPageCursors(&cursor{}, false, first, last)
+		assert.Empty(t, res.GetNext())
+		assert.NotEmpty(t, res.GetPrev())
+	})
+	t.Run("Should: not return prev cursor for first page", func(t *testing.T) {
+		res := getCursorPageCursors(&cursor{Before: true}, false, first, last)
+		assert.NotEmpty(t, res.GetNext())
+		assert.Empty(t, res.GetPrev())
+	})
+	t.Run("Should: return empty cursors for empty page", func(t *testing.T) {
+		assert.Equal(t, &apiPb.PageCursors{}, getCursorPageCursors(&cursor{}, false, nil, nil))
+		assert.Equal(t, &apiPb.PageCursors{}, getOffsetPageCursors(false, 0, 0, 0, nil, nil))
+	})
+	t.Run("Should: return cursors of offset page", func(t *testing.T) {
+		res := getOffsetPageCursors(false, 2, 2, 10, first, last)
+		assert.NotEmpty(t, res.GetNext())
+		assert.NotEmpty(t, res.GetPrev())
+		res = getOffsetPageCursors(false, 0, 2, 2, first, last)
+		assert.Empty(t, res.GetNext())
+		assert.Empty(t, res.GetPrev())
+	})
+}
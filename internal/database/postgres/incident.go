@@ -39,6 +39,7 @@ var (
 	incidentRuleIdFilterString    = fmt.Sprintf(`"%s"."ruleId" = ?`, dbIncidentCollection)
 	incidentStatusString          = fmt.Sprintf(`"%s"."status"`, dbIncidentCollection)
 	incidentStartTimeFilterString = fmt.Sprintf(`"%s"."startTime" BETWEEN ? and ?`, dbIncidentCollection)
+	incidentKeyset                = newKeyset(dbIncidentCollection, "startTime", false)
 	statusString                  = "status"
 	endTimeString                 = "endTime"
 
@@ -119,10 +120,18 @@ func (p *Postgres) GetActiveIncidentByRuleId(ruleId string) (*apiPb.Incident, er
 	return convertFromIncident(&incident), nil
 }
 
-func (p *Postgres) GetIncidents(request *apiPb.GetIncidentsListRequest) ([]*apiPb.Incident, int64, error) {
+func (p *Postgres) GetIncidents(request *apiPb.GetIncidentsListRequest) ([]*apiPb.Incident, int64, *apiPb.PageCursors, error) {
 	timeFrom, timeTo, err := getTimeInt64(request.GetTimeRange())
 	if err != nil {
-		return nil, -1, err
+		return nil, -1, nil, err
+	}
+
+	c, err := getCursor(request.GetPagination())
+	if err != nil {
+		return nil, -1, nil, err
+	}
+	if c != nil {
+		return p.getIncidentsByCursor(request, timeFrom, timeTo, c)
 	}
 
 	var count int64
@@ -132,7 +141,7 @@ func (p *Postgres) GetIncidents(request *apiPb.GetIncidentsListRequest) ([]*apiP
 		Where(getIncidentRuleString(request.GetRuleId())).
 		Count(&count).Error
 	if err != nil {
-		return nil, -1, err
+		return nil, -1, nil, err
 	}
 
 	offset, limit := getOffsetAndLimit(count, request.GetPagination())
@@ -145,14 +154,43 @@ func (p *Postgres) GetIncidents(request *apiPb.GetIncidentsListRequest) ([]*apiP
 		Where(getIncidentStatusString(request.GetStatus())).
 		Where(getIncidentRuleString(request.GetRuleId())).
 		Order(getIncidentOrder(request.GetSort()) + getIncidentDirection(request.GetSort())).
+		Order(incidentKeyset.idColumn + getIncidentDirection(request.GetSort())).
 		Offset(offset).
 		Limit(limit).
 		Find(&incidents).Error
 	if err != nil {
-		return nil, -1, errorDataBase
+		return nil, -1, nil, errorDataBase
+	}
+
+	cursors := &apiPb.PageCursors{}
+	// Cursors can continue only the pages ordered by keyset
+	if getIncidentOrder(request.GetSort()) == incidentKeyset.timeColumn {
+		first, last := getIncidentKeys(incidents)
+		cursors = getOffsetPageCursors(getIncidentDirection(request.GetSort()) == descPrefix, offset, len(incidents), count, first, last)
+	}
+
+	return convertFromIncidents(incidents), count, cursors, nil
+}
+
+// Count is not calculated for pages selected by cursor
+func (p *Postgres) getIncidentsByCursor(request *apiPb.GetIncidentsListRequest, timeFrom, timeTo int64, c *cursor) ([]*apiPb.Incident, int64, *apiPb.PageCursors, error) {
+	limit := getCursorLimit(request.GetPagination())
+
+	var incidents []*Incident
+	query := p.Db.
+		Table(dbIncidentCollection).
+		Set("gorm:auto_preload", true).
+		Where(incidentStartTimeFilterString, timeFrom, timeTo).
+		Where(getIncidentStatusString(request.GetStatus())).
+		Where(getIncidentRuleString(request.GetRuleId()))
+	err := incidentKeyset.apply(query, c, limit).Find(&incidents).Error
+	if err != nil {
+		return nil, -1, nil, errorDataBase
 	}
 
-	return convertFromIncidents(incidents), count, nil
+	hasMore := trimCursorPage(&incidents, c, limit)
+	first, last := getIncidentKeys(incidents)
+	return convertFromIncidents(incidents), 0, getCursorPageCursors(c, hasMore, first, last), nil
 }
 
 func getIncidentStatusString(code apiPb.IncidentStatus) string {
@@ -169,6 +207,14 @@ func getIncidentRuleString(ruleId *wrappers.StringValue) string {
 	return fmt.Sprintf(`"%s"."ruleId" = '%s'`, dbIncidentCollection, ruleId.Value)
 }
 
+func getIncidentKeys(incidents []*Incident) (*cursor, *cursor) {
+	if len(incidents) == 0 {
+		return nil, nil
+	}
+	first, last := incidents[0], incidents[len(incidents)-1]
+	return &cursor{Time: first.StartTime, ID: first.ID}, &cursor{Time: last.StartTime, ID: last.ID}
+}
+
 func getIncidentOrder(request *apiPb.SortingIncidentList) string {
 	if request == nil {
 		return fmt.Sprintf(`"%s"."startTime"`, dbIncidentCollection)
@@ -224,13 +224,46 @@ func (s *SuiteIncident) Test_GetIncidents() {
 		WithArgs(sqlmock.AnyArg()).
 		WillReturnRows(rows)
 
-	_, _, err := postgrIncident.GetIncidents(&apiPb.GetIncidentsListRequest{})
+	_, _, _, err := postgrIncident.GetIncidents(&apiPb.GetIncidentsListRequest{})
 	require.NoError(s.T(), err)
 }
 
+func (s *SuiteIncident) Test_GetIncidents_byCursor() {
+	query := fmt.Sprintf(`SELECT * FROM "%s"`, dbIncidentCollection)
+	rows := sqlmock.NewRows([]string{"id", "startTime"}).AddRow(1, 10)
+	s.mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), int64(20), 2).
+		WillReturnRows(rows)
+
+	query = fmt.Sprintf(`SELECT * FROM "%s"`, dbIncidentHistoryCollection)
+	rows = sqlmock.NewRows([]string{"id"}).AddRow("1")
+	s.mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(sqlmock.AnyArg()).
+		WillReturnRows(rows)
+
+	res, _, cursors, err := postgrIncident.GetIncidents(&apiPb.GetIncidentsListRequest{
+		Pagination: &apiPb.Pagination{
+			Cursor: encodeCursor(&cursor{Time: 20, ID: 2, Desc: true}),
+		},
+	})
+	require.NoError(s.T(), err)
+	assert.Len(s.T(), res, 1)
+	assert.Empty(s.T(), cursors.GetNext())
+	assert.NotEmpty(s.T(), cursors.GetPrev())
+}
+
+func (s *SuiteIncident) Test_GetIncidents_cursorError() {
+	_, _, _, err := postgrIncident.GetIncidents(&apiPb.GetIncidentsListRequest{
+		Pagination: &apiPb.Pagination{
+			Cursor: "invalid",
+		},
+	})
+	require.Error(s.T(), err)
+}
+
 func (s *SuiteIncident) Test_GetIncidents_timeError() {
 	maxValidSeconds := 253402300800
-	_, _, err := postgrIncident.GetIncidents(&apiPb.GetIncidentsListRequest{
+	_, _, _, err := postgrIncident.GetIncidents(&apiPb.GetIncidentsListRequest{
 		TimeRange: &apiPb.TimeFilter{
 			From: &timestamp.Timestamp{Seconds: int64(maxValidSeconds), Nanos: 0},
 			To:   &timestamp.Timestamp{Seconds: int64(maxValidSeconds), Nanos: 0},
@@ -240,7 +273,7 @@ func (s *SuiteIncident) Test_GetIncidents_timeError() {
 }
 
 func (s *SuiteIncident) Test_GetIncidents_countError() {
-	_, _, err := postgrIncident.GetIncidents(&apiPb.GetIncidentsListRequest{})
+	_, _, _, err := postgrIncident.GetIncidents(&apiPb.GetIncidentsListRequest{})
 	require.Error(s.T(), err)
 }
 
@@ -251,7 +284,7 @@ func (s *SuiteIncident) Test_GetIncidents_selectIncidentError() {
 		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).
 		WillReturnRows(rows)
 
-	_, _, err := postgrIncident.GetIncidents(&apiPb.GetIncidentsListRequest{})
+	_, _, _, err := postgrIncident.GetIncidents(&apiPb.GetIncidentsListRequest{})
 	require.Error(s.T(), err)
 }
 
@@ -268,7 +301,7 @@ func (s *SuiteIncident) Test_GetIncidents_selectIncidentHistoryError() {
 		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).
 		WillReturnRows(rows)
 
-	_, _, err := postgrIncident.GetIncidents(&apiPb.GetIncidentsListRequest{})
+	_, _, _, err := postgrIncident.GetIncidents(&apiPb.GetIncidentsListRequest{})
 	require.Error(s.T(), err)
 }
 
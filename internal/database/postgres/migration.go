@@ -0,0 +1,430 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jinzhu/gorm"
+	"github.com/squzy/squzy/internal/logger"
+	"strings"
+	"time"
+)
+
+const (
+	dbSchemaVersionCollection = "schema_version"
+
+	// Any constant key, which is the same for all instances
+	migrationLockKey      = 7368209
+	migrationLockString   = `SELECT pg_advisory_lock($1)`
+	migrationUnlockString = `SELECT pg_advisory_unlock($1)`
+)
+
+var (
+	errUnknownSchemaVersion = errors.New("UNKNOWN_SCHEMA_VERSION")
+)
+
+type SchemaVersion struct {
+	Version   uint      `gorm:"column:version;primary_key;auto_increment:false"`
+	Name      string    `gorm:"column:name"`
+	AppliedAt time.Time `gorm:"column:appliedAt"`
+}
+
+func (SchemaVersion) TableName() string {
+	return dbSchemaVersionCollection
+}
+
+// Migrations are applied one-by-one in transaction together with version, new migrations should be added only to the end
+type migration struct {
+	version uint
+	name    string
+	up      func(tx *gorm.DB) error
+	down    func(tx *gorm.DB) error
+}
+
+type column struct {
+	table      string
+	name       string
+	columnType string
+}
+
+type index struct {
+	name    string
+	table   string
+	columns []string
+}
+
+var (
+	// Column types which differ between dialects are written as placeholders
+	dialectColumnTypes = map[string]*strings.Replacer{
+		"postgres": strings.NewReplacer("{id}", "serial PRIMARY KEY", "{time}", "timestamp with time zone", "{float}", "numeric", "{bytes}", "bytea"),
+		"sqlite3":  strings.NewReplacer("{id}", "integer PRIMARY KEY AUTOINCREMENT", "{time}", "datetime", "{float}", "real", "{bytes}", "blob"),
+	}
+
+	schemaVersionTableString = fmt.Sprintf(
+		`CREATE TABLE IF NOT EXISTS "%s" ("version" integer PRIMARY KEY, "name" text, "appliedAt" {time})`,
+		dbSchemaVersionCollection,
+	)
+
+	// Schema of every migration is frozen, so it should not be changed after release even if models are changed
+	migrations = []*migration{
+		{
+			version: 1,
+			name:    "initial schema",
+			up: execStatements(
+				`CREATE TABLE IF NOT EXISTS "snapshots" ("id" {id}, "created_at" {time}, "updated_at" {time}, "deleted_at" {time},
+					"schedulerId" text, "code" integer, "type" integer, "error" text,
+					"metaStartTime" bigint, "metaEndTime" bigint, "metaValue" {bytes})`,
+				`CREATE TABLE IF NOT EXISTS "stat_requests" ("id" {id}, "created_at" {time}, "updated_at" {time}, "deleted_at" {time},
+					"agentID" text, "agentName" text, "time" {time})`,
+				`CREATE TABLE IF NOT EXISTS "cpu_infos" ("id" {id}, "created_at" {time}, "updated_at" {time}, "deleted_at" {time},
+					"statRequestId" integer, "load" {float})`,
+				`CREATE TABLE IF NOT EXISTS "memory_infos" ("id" {id}, "created_at" {time}, "updated_at" {time}, "deleted_at" {time},
+					"statRequestId" integer)`,
+				`CREATE TABLE IF NOT EXISTS "memory_mems" ("id" {id}, "created_at" {time}, "updated_at" {time}, "deleted_at" {time},
+					"memoryInfoId" integer, "total" bigint, "used" bigint, "free" bigint, "shared" bigint, "usedPercent" {float})`,
+				`CREATE TABLE IF NOT EXISTS "memory_swaps" ("id" {id}, "created_at" {time}, "updated_at" {time}, "deleted_at" {time},
+					"memoryInfoId" integer, "total" bigint, "used" bigint, "free" bigint, "shared" bigint, "usedPercent" {float})`,
+				`CREATE TABLE IF NOT EXISTS "disk_infos" ("id" {id}, "created_at" {time}, "updated_at" {time}, "deleted_at" {time},
+					"statRequestId" integer, "name" text, "total" bigint, "free" bigint, "used" bigint, "usedPercent" {float})`,
+				`CREATE TABLE IF NOT EXISTS "net_infos" ("id" {id}, "created_at" {time}, "updated_at" {time}, "deleted_at" {time},
+					"statRequestId" integer, "name" text, "bytesSent" bigint, "bytesRecv" bigint, "packetsSent" bigint, "packetsRecv" bigint,
+					"errIn" bigint, "errOut" bigint, "dropIn" bigint, "dropOut" bigint)`,
+				`CREATE TABLE IF NOT EXISTS "transaction_infos" ("id" {id}, "created_at" {time}, "updated_at" {time}, "deleted_at" {time},
+					"transactionId" text, "applicationId" text, "parentId" text, "metaHost" text, "metaPath" text, "metaMethod" text,
+					"name" text, "startTime" bigint, "endTime" bigint, "transactionStatus" integer, "transactionType" integer, "error" text)`,
+				`CREATE TABLE IF NOT EXISTS "incidents" ("id" {id}, "created_at" {time}, "updated_at" {time}, "deleted_at" {time},
+					"incidentId" text, "status" integer, "ruleId" text, "startTime" bigint, "endTime" bigint)`,
+				`CREATE TABLE IF NOT EXISTS "incident_histories" ("id" {id}, "created_at" {time}, "updated_at" {time}, "deleted_at" {time},
+					"incidentId" integer, "status" integer, "time" bigint)`,
+				`CREATE INDEX IF NOT EXISTS "idx_snapshots_deleted_at" ON "snapshots" ("deleted_at")`,
+				`CREATE INDEX IF NOT EXISTS "idx_stat_requests_deleted_at" ON "stat_requests" ("deleted_at")`,
+				`CREATE INDEX IF NOT EXISTS "idx_cpu_infos_deleted_at" ON "cpu_infos" ("deleted_at")`,
+				`CREATE INDEX IF NOT EXISTS "idx_memory_infos_deleted_at" ON "memory_infos" ("deleted_at")`,
+				`CREATE INDEX IF NOT EXISTS "idx_memory_mems_deleted_at" ON "memory_mems" ("deleted_at")`,
+				`CREATE INDEX IF NOT EXISTS "idx_memory_swaps_deleted_at" ON "memory_swaps" ("deleted_at")`,
+				`CREATE INDEX IF NOT EXISTS "idx_disk_infos_deleted_at" ON "disk_infos" ("deleted_at")`,
+				`CREATE INDEX IF NOT EXISTS "idx_net_infos_deleted_at" ON "net_infos" ("deleted_at")`,
+				`CREATE INDEX IF NOT EXISTS "idx_transaction_infos_deleted_at" ON "transaction_infos" ("deleted_at")`,
+				`CREATE INDEX IF NOT EXISTS "idx_incidents_deleted_at" ON "incidents" ("deleted_at")`,
+				`CREATE INDEX IF NOT EXISTS "idx_incident_histories_deleted_at" ON "incident_histories" ("deleted_at")`,
+			),
+			down: dropTables(
+				"incident_histories",
+				"incidents",
+				"transaction_infos",
+				"net_infos",
+				"disk_infos",
+				"memory_swaps",
+				"memory_mems",
+				"memory_infos",
+				"cpu_infos",
+				"stat_requests",
+				"snapshots",
+			),
+		},
+		{
+			version: 2,
+			name:    "rollups",
+			up: execStatements(
+				`CREATE TABLE IF NOT EXISTS "snapshot_rollups" ("id" {id}, "updated_at" {time},
+					"schedulerId" text, "resolution" bigint, "bucketStart" bigint, "count" bigint, "okCount" bigint,
+					"latencyMin" {float}, "latencyAvg" {float}, "latencyMax" {float}, "latencyP95" {float})`,
+				`CREATE TABLE IF NOT EXISTS "stat_request_rollups" ("id" {id}, "updated_at" {time},
+					"agentID" text, "resolution" bigint, "bucketStart" {time}, "count" bigint, "cpuLoadAvg" {float}, "cpuLoadMax" {float},
+					"memTotal" bigint, "memUsed" bigint, "memFree" bigint, "memShared" bigint, "memUsedPercentAvg" {float}, "memUsedPercentMax" {float},
+					"swapTotal" bigint, "swapUsed" bigint, "swapFree" bigint, "swapShared" bigint, "swapUsedPercentAvg" {float})`,
+				`CREATE TABLE IF NOT EXISTS "disk_rollups" ("id" {id}, "updated_at" {time},
+					"agentID" text, "resolution" bigint, "bucketStart" {time}, "name" text,
+					"total" bigint, "free" bigint, "used" bigint, "usedPercentAvg" {float}, "usedPercentMax" {float})`,
+				`CREATE TABLE IF NOT EXISTS "net_rollups" ("id" {id}, "updated_at" {time},
+					"agentID" text, "resolution" bigint, "bucketStart" {time}, "name" text,
+					"bytesSent" bigint, "bytesRecv" bigint, "packetsSent" bigint, "packetsRecv" bigint,
+					"errIn" bigint, "errOut" bigint, "dropIn" bigint, "dropOut" bigint)`,
+				`CREATE TABLE IF NOT EXISTS "transaction_rollups" ("id" {id}, "updated_at" {time},
+					"applicationId" text, "name" text, "resolution" bigint, "bucketStart" bigint, "count" bigint, "successCount" bigint,
+					"latencyMin" {float}, "latencyAvg" {float}, "latencyMax" {float}, "latencyP95" {float})`,
+				`CREATE UNIQUE INDEX IF NOT EXISTS "idx_snapshot_rollups_bucket" ON "snapshot_rollups" ("schedulerId", "resolution", "bucketStart")`,
+				`CREATE UNIQUE INDEX IF NOT EXISTS "idx_stat_request_rollups_bucket" ON "stat_request_rollups" ("agentID", "resolution", "bucketStart")`,
+				`CREATE UNIQUE INDEX IF NOT EXISTS "idx_disk_rollups_bucket" ON "disk_rollups" ("agentID", "resolution", "bucketStart", "name")`,
+				`CREATE UNIQUE INDEX IF NOT EXISTS "idx_net_rollups_bucket" ON "net_rollups" ("agentID", "resolution", "bucketStart", "name")`,
+				`CREATE UNIQUE INDEX IF NOT EXISTS "idx_transaction_rollups_bucket" ON "transaction_rollups" ("applicationId", "name", "resolution", "bucketStart")`,
+			),
+			down: dropTables(
+				"transaction_rollups",
+				"net_rollups",
+				"disk_rollups",
+				"stat_request_rollups",
+				"snapshot_rollups",
+			),
+		},
+		{
+			version: 3,
+			name:    "time indexes",
+			up: createIndexes(
+				&index{"idx_snapshots_scheduler_time", dbSnapshotCollection, []string{"schedulerId", "metaStartTime"}},
+				&index{"idx_stat_requests_agent_time", dbStatRequestCollection, []string{"agentID", "time"}},
+				&index{"idx_transaction_infos_application_time", dbTransactionInfoCollection, []string{"applicationId", "startTime"}},
+			),
+			down: dropIndexes(
+				"idx_snapshots_scheduler_time",
+				"idx_stat_requests_agent_time",
+				"idx_transaction_infos_application_time",
+			),
+		},
+		{
+			version: 4,
+			name:    "relation indexes",
+			up: createIndexes(
+				&index{"idx_cpu_infos_stat_request", dbCPUInfoCollection, []string{"statRequestId"}},
+				&index{"idx_memory_infos_stat_request", dbMemoryInfoCollection, []string{"statRequestId"}},
+				&index{"idx_memory_mems_memory_info", dbMemoryMemCollection, []string{"memoryInfoId"}},
+				&index{"idx_memory_swaps_memory_info", dbMemorySwapCollection, []string{"memoryInfoId"}},
+				&index{"idx_disk_infos_stat_request", dbDiskInfoCollection, []string{"statRequestId"}},
+				&index{"idx_net_infos_stat_request", dbNetInfoCollection, []string{"statRequestId"}},
+				&index{"idx_transaction_infos_transaction", dbTransactionInfoCollection, []string{"transactionId"}},
+				&index{"idx_transaction_infos_parent", dbTransactionInfoCollection, []string{"parentId"}},
+				&index{"idx_incidents_incident", dbIncidentCollection, []string{"incidentId"}},
+				&index{"idx_incidents_rule", dbIncidentCollection, []string{"ruleId"}},
+				&index{"idx_incident_histories_incident", dbIncidentHistoryCollection, []string{"incidentId"}},
+			),
+			down: dropIndexes(
+				"idx_cpu_infos_stat_request",
+				"idx_memory_infos_stat_request",
+				"idx_memory_mems_memory_info",
+				"idx_memory_swaps_memory_info",
+				"idx_disk_infos_stat_request",
+				"idx_net_infos_stat_request",
+				"idx_transaction_infos_transaction",
+				"idx_transaction_infos_parent",
+				"idx_incidents_incident",
+				"idx_incidents_rule",
+				"idx_incident_histories_incident",
+			),
+		},
+		{
+			version: 5,
+			name:    "snapshot rollup percentiles",
+			up: addColumns(
+				&column{dbSnapshotRollupCollection, "latencyP50", "{float}"},
+				&column{dbSnapshotRollupCollection, "latencyP99", "{float}"},
+			),
+			down: dropColumns(dbSnapshotRollupCollection, "latencyP50", "latencyP99"),
+		},
+		{
+			version: 6,
+			name:    "cpu rollups",
+			// Rollups made before have only load averaged by all cpus, it is kept as the first core,
+			// maximum of swap was not kept, so the average is the best estimate for it
+			up: func(tx *gorm.DB) error {
+				err := addColumns(&column{dbStatRequestRollupCollection, "swapUsedPercentMax", "{float}"})(tx)
+				if err != nil {
+					return err
+				}
+				return execStatements(
+					`CREATE TABLE IF NOT EXISTS "cpu_rollups" ("id" {id}, "updated_at" {time},
+						"agentID" text, "resolution" bigint, "bucketStart" {time}, "core" integer, "count" bigint,
+						"loadAvg" {float}, "loadMax" {float})`,
+					`CREATE UNIQUE INDEX IF NOT EXISTS "idx_cpu_rollups_bucket" ON "cpu_rollups" ("agentID", "resolution", "bucketStart", "core")`,
+					`INSERT INTO "cpu_rollups" ("updated_at", "agentID", "resolution", "bucketStart", "core", "count", "loadAvg", "loadMax")
+						SELECT "updated_at", "agentID", "resolution", "bucketStart", 0, "count", "cpuLoadAvg", "cpuLoadMax"
+						FROM "stat_request_rollups"`,
+					`UPDATE "stat_request_rollups" SET "swapUsedPercentMax" = "swapUsedPercentAvg" WHERE "swapUsedPercentMax" IS NULL`,
+				)(tx)
+			},
+			down: func(tx *gorm.DB) error {
+				err := dropTables("cpu_rollups")(tx)
+				if err != nil {
+					return err
+				}
+				return dropColumns(dbStatRequestRollupCollection, "swapUsedPercentMax")(tx)
+			},
+		},
+	}
+)
+
+// Migrate applies all migrations which were not applied yet
+func (p *Postgres) Migrate() error {
+	return p.MigrateTo(migrations[len(migrations)-1].version)
+}
+
+// MigrateTo applies up migrations if version is greater than current one and down migrations otherwise,
+// migrations are applied under advisory lock, so several instances could be started at the same time
+func (p *Postgres) MigrateTo(version uint) error {
+	if version > migrations[len(migrations)-1].version {
+		return errUnknownSchemaVersion
+	}
+	unlock, err := p.lockMigrations()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	current, err := p.GetSchemaVersion()
+	if err != nil {
+		return err
+	}
+	for _, m := range migrations {
+		if m.version > current && m.version <= version {
+			if err := p.applyMigration(m, m.up, true); err != nil {
+				return err
+			}
+		}
+	}
+	for i := len(migrations) - 1; i >= 0; i-- {
+		m := migrations[i]
+		if m.version <= current && m.version > version {
+			if err := p.applyMigration(m, m.down, false); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Advisory lock belongs to session, so it is taken and released by the same connection.
+// Sqlite is used by single instance, so it is not locked
+func (p *Postgres) lockMigrations() (func(), error) {
+	if p.Db.Dialect().GetName() == "sqlite3" {
+		return func() {}, nil
+	}
+	ctx := context.Background()
+	conn, err := p.Db.DB().Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	_, err = conn.ExecContext(ctx, migrationLockString, migrationLockKey)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return func() {
+		_, err := conn.ExecContext(ctx, migrationUnlockString, migrationLockKey)
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		_ = conn.Close()
+	}, nil
+}
+
+// GetSchemaVersion returns version of the last applied migration, zero if nothing was applied
+func (p *Postgres) GetSchemaVersion() (uint, error) {
+	if err := execStatements(schemaVersionTableString)(p.Db); err != nil {
+		return 0, err
+	}
+	var current SchemaVersion
+	err := p.Db.Table(dbSchemaVersionCollection).Order(`"version" desc`).First(&current).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return current.Version, nil
+}
+
+func (p *Postgres) applyMigration(m *migration, apply func(tx *gorm.DB) error, up bool) error {
+	err := p.Db.Transaction(func(tx *gorm.DB) error {
+		if tx.Error != nil {
+			return tx.Error
+		}
+		if err := apply(tx); err != nil {
+			return err
+		}
+		if up {
+			return tx.Create(&SchemaVersion{
+				Version:   m.version,
+				Name:      m.name,
+				AppliedAt: time.Now().UTC(),
+			}).Error
+		}
+		return tx.Where(`"version" = ?`, m.version).Delete(&SchemaVersion{}).Error
+	})
+	if err != nil {
+		return fmt.Errorf("migration %d (%s): %w", m.version, m.name, err)
+	}
+	return nil
+}
+
+func execStatements(statements ...string) func(tx *gorm.DB) error {
+	return func(tx *gorm.DB) error {
+		columnTypes, ok := dialectColumnTypes[tx.Dialect().GetName()]
+		if !ok {
+			columnTypes = dialectColumnTypes["postgres"]
+		}
+		for _, statement := range statements {
+			if err := tx.Exec(columnTypes.Replace(statement)).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func dropTables(tables ...string) func(tx *gorm.DB) error {
+	return func(tx *gorm.DB) error {
+		for _, table := range tables {
+			if err := tx.Exec(fmt.Sprintf(`DROP TABLE IF EXISTS "%s"`, table)).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// Columns could already exist, if database was created by models before migrations were frozen
+func addColumns(columns ...*column) func(tx *gorm.DB) error {
+	return func(tx *gorm.DB) error {
+		for _, c := range columns {
+			if tx.Dialect().HasColumn(c.table, c.name) {
+				continue
+			}
+			err := execStatements(fmt.Sprintf(`ALTER TABLE "%s" ADD COLUMN "%s" %s`, c.table, c.name, c.columnType))(tx)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// Sqlite before 3.35 could not drop columns, they are kept because unused columns are harmless
+func dropColumns(table string, columns ...string) func(tx *gorm.DB) error {
+	return func(tx *gorm.DB) error {
+		if tx.Dialect().GetName() == "sqlite3" {
+			return nil
+		}
+		for _, column := range columns {
+			if err := tx.Exec(fmt.Sprintf(`ALTER TABLE "%s" DROP COLUMN "%s"`, table, column)).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func createIndexes(indexes ...*index) func(tx *gorm.DB) error {
+	return func(tx *gorm.DB) error {
+		for _, idx := range indexes {
+			columns := make([]string, len(idx.columns))
+			for i, column := range idx.columns {
+				columns[i] = fmt.Sprintf(`"%s"`, column)
+			}
+			query := fmt.Sprintf(`CREATE INDEX IF NOT EXISTS "%s" ON "%s" (%s)`, idx.name, idx.table, strings.Join(columns, ", "))
+			err := tx.Exec(query).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func dropIndexes(names ...string) func(tx *gorm.DB) error {
+	return func(tx *gorm.DB) error {
+		for _, name := range names {
+			if err := tx.Exec(fmt.Sprintf(`DROP INDEX IF EXISTS "%s"`, name)).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
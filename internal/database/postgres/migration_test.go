@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	"errors"
+	"fmt"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+)
+
+func TestPostgres_MigrateTo(t *testing.T) {
+	t.Run("Should: return error for unknown version", func(t *testing.T) {
+		err := postgrWrong.MigrateTo(migrations[len(migrations)-1].version + 1)
+		assert.Equal(t, errUnknownSchemaVersion, err)
+	})
+	t.Run("Should: return error", func(t *testing.T) {
+		err := postgrWrong.MigrateTo(0)
+		assert.Error(t, err)
+	})
+	t.Run("Should: release lock on error", func(t *testing.T) {
+		sqlDb, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		db, err := gorm.Open("postgres", sqlDb)
+		require.NoError(t, err)
+		mock.ExpectExec(regexp.QuoteMeta(migrationLockString)).
+			WithArgs(migrationLockKey).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(regexp.QuoteMeta(fmt.Sprintf(`CREATE TABLE IF NOT EXISTS "%s"`, dbSchemaVersionCollection))).
+			WillReturnError(errors.New(""))
+		mock.ExpectExec(regexp.QuoteMeta(migrationUnlockString)).
+			WithArgs(migrationLockKey).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		assert.Error(t, (&Postgres{Db: db}).MigrateTo(0))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestMigrations(t *testing.T) {
+	t.Run("Should: have ordered versions", func(t *testing.T) {
+		for i, m := range migrations {
+			assert.Equal(t, uint(i+1), m.version)
+			assert.NotNil(t, m.up)
+			assert.NotNil(t, m.down)
+		}
+	})
+}
+
+func TestExecStatements(t *testing.T) {
+	sqlDb, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	db, err := gorm.Open("postgres", sqlDb)
+	require.NoError(t, err)
+
+	t.Run("Should: replace column types of dialect", func(t *testing.T) {
+		mock.ExpectExec(regexp.QuoteMeta(`CREATE TABLE "table" ("id" serial PRIMARY KEY, "time" timestamp with time zone, "value" numeric)`)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		err := execStatements(`CREATE TABLE "table" ("id" {id}, "time" {time}, "value" {float})`)(db)
+		assert.NoError(t, err)
+	})
+	t.Run("Should: return error", func(t *testing.T) {
+		assert.Error(t, execStatements(`CREATE TABLE "table" ("id" {id})`)(db))
+	})
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateIndexes(t *testing.T) {
+	sqlDb, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	db, err := gorm.Open("postgres", sqlDb)
+	require.NoError(t, err)
+
+	t.Run("Should: create indexes", func(t *testing.T) {
+		mock.ExpectExec(regexp.QuoteMeta(`CREATE INDEX IF NOT EXISTS "idx" ON "table" ("a", "b")`)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		err := createIndexes(&index{"idx", "table", []string{"a", "b"}})(db)
+		assert.NoError(t, err)
+	})
+	t.Run("Should: drop indexes", func(t *testing.T) {
+		mock.ExpectExec(regexp.QuoteMeta(`DROP INDEX IF EXISTS "idx"`)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		err := dropIndexes("idx")(db)
+		assert.NoError(t, err)
+	})
+	t.Run("Should: return error", func(t *testing.T) {
+		assert.Error(t, createIndexes(&index{"idx", "table", []string{"a"}})(db))
+		assert.Error(t, dropIndexes("idx")(db))
+	})
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	timestamp "google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+type OutageResult struct {
+	StartTime   int64         `gorm:"column:startTime"`
+	EndTime     sql.NullInt64 `gorm:"column:endTime"`
+	LastEndTime int64         `gorm:"column:lastEndTime"`
+	FailedCount int64         `gorm:"column:failedCount"`
+	Error       string        `gorm:"column:error"`
+}
+
+var (
+	// Consecutive failed snapshots are islands with the same count of successful snapshots before them,
+	// outage is ended by start of the nearest successful snapshot after it. Both windows are running ones,
+	// so they are calculated in one pass, frame till the end of partition would be rescanned for every row
+	outagesString = fmt.Sprintf(
+		`SELECT MIN("metaStartTime") AS "startTime", MIN("nextOkTime") AS "endTime", MAX("metaEndTime") AS "lastEndTime",
+			COUNT(*) AS "failedCount", MIN("firstError") AS "error"
+		FROM (
+			SELECT "metaStartTime", "metaEndTime", "nextOkTime", "island",
+				FIRST_VALUE("error") OVER (PARTITION BY "island" ORDER BY "metaStartTime") AS "firstError"
+			FROM (
+				SELECT "metaStartTime", "metaEndTime", "code", "error",
+					SUM(CASE WHEN "code" = %d THEN 1 ELSE 0 END) OVER (ORDER BY "metaStartTime" ROWS UNBOUNDED PRECEDING) AS "island",
+					MIN(CASE WHEN "code" = %d THEN "metaStartTime" END) OVER (ORDER BY "metaStartTime" DESC ROWS UNBOUNDED PRECEDING) AS "nextOkTime"
+				FROM "%s"
+				WHERE "deleted_at" IS NULL AND "schedulerId" = ? AND "metaStartTime" >= ? AND "metaStartTime" < ?
+			) AS "checks"
+			WHERE "code" != %d
+		) AS "failed"
+		GROUP BY "island"
+		ORDER BY "startTime"`,
+		apiPb.SchedulerCode_OK, apiPb.SchedulerCode_OK, dbSnapshotCollection, apiPb.SchedulerCode_OK,
+	)
+)
+
+// Outages are calculated only by raw snapshots, so they are not available for time ranges removed by retention
+func (p *Postgres) GetSnapshotsOutages(request *apiPb.GetSchedulerOutagesRequest) (*apiPb.GetSchedulerOutagesResponse, error) {
+	timeFrom, timeTo, err := getTimeInt64(request.GetTimeRange())
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*OutageResult
+	err = p.Db.Raw(outagesString, request.GetSchedulerId(), timeFrom, timeTo).Scan(&results).Error
+	if err != nil {
+		return nil, errorDataBase
+	}
+
+	return &apiPb.GetSchedulerOutagesResponse{
+		Outages: convertToOutages(results),
+	}, nil
+}
+
+func convertToOutages(results []*OutageResult) []*apiPb.SchedulerOutage {
+	var outages []*apiPb.SchedulerOutage
+	for _, result := range results {
+		outage := &apiPb.SchedulerOutage{
+			StartTime:   timestamp.New(time.Unix(0, result.StartTime)),
+			Error:       result.Error,
+			FailedCount: result.FailedCount,
+		}
+		if result.EndTime.Valid {
+			outage.EndTime = timestamp.New(time.Unix(0, result.EndTime.Int64))
+			outage.Duration = result.EndTime.Int64 - result.StartTime
+		} else {
+			// Ongoing outage lasts at least till the last failed check
+			outage.Duration = result.LastEndTime - result.StartTime
+		}
+		outages = append(outages, outage)
+	}
+	return outages
+}
@@ -0,0 +1,116 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jinzhu/gorm"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	timestamp "google.golang.org/protobuf/types/known/timestamppb"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var (
+	postgrOutage = &Postgres{}
+)
+
+type SuiteOutage struct {
+	suite.Suite
+	DB   *gorm.DB
+	mock sqlmock.Sqlmock
+}
+
+func (s *SuiteOutage) SetupSuite() {
+	var (
+		db  *sql.DB
+		err error
+	)
+
+	db, s.mock, err = sqlmock.New()
+	require.NoError(s.T(), err)
+
+	s.DB, err = gorm.Open("postgres", db)
+	require.NoError(s.T(), err)
+	postgrOutage.Db = s.DB
+
+	s.DB.LogMode(true)
+}
+
+func (s *SuiteOutage) Test_GetSnapshotsOutages() {
+	s.mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(`FROM "%s"`, dbSnapshotCollection))).
+		WithArgs("1", int64(0), int64(100)).
+		WillReturnRows(sqlmock.NewRows([]string{"startTime", "endTime", "lastEndTime", "failedCount", "error"}).
+			AddRow(10, 20, 11, 1, "timeout"))
+
+	res, err := postgrOutage.GetSnapshotsOutages(&apiPb.GetSchedulerOutagesRequest{
+		SchedulerId: "1",
+		TimeRange: &apiPb.TimeFilter{
+			From: timestamp.New(time.Unix(0, 0)),
+			To:   timestamp.New(time.Unix(0, 100)),
+		},
+	})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), res.GetOutages(), 1)
+	assert.Equal(s.T(), int64(10), res.GetOutages()[0].GetDuration())
+	assert.Equal(s.T(), "timeout", res.GetOutages()[0].GetError())
+}
+
+// Based on fact, that if request is not mocked, it will return error
+func (s *SuiteOutage) Test_GetSnapshotsOutages_Error() {
+	_, err := postgrOutage.GetSnapshotsOutages(&apiPb.GetSchedulerOutagesRequest{
+		SchedulerId: "1",
+	})
+	require.Error(s.T(), err)
+}
+
+func (s *SuiteOutage) Test_GetSnapshotsOutages_TimeError() {
+	_, err := postgrOutage.GetSnapshotsOutages(&apiPb.GetSchedulerOutagesRequest{
+		SchedulerId: "1",
+		TimeRange: &apiPb.TimeFilter{
+			From: &timestamp.Timestamp{Seconds: -62135596801},
+		},
+	})
+	require.Error(s.T(), err)
+}
+
+func (s *SuiteOutage) AfterTest(_, _ string) {
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func TestInitOutage(t *testing.T) {
+	suite.Run(t, new(SuiteOutage))
+}
+
+func TestConvertToOutages(t *testing.T) {
+	t.Run("Should: return empty list without failed checks", func(t *testing.T) {
+		assert.Empty(t, convertToOutages(nil))
+	})
+	t.Run("Should: convert ended and ongoing outages", func(t *testing.T) {
+		res := convertToOutages([]*OutageResult{
+			{StartTime: 10, EndTime: sql.NullInt64{Int64: 30, Valid: true}, LastEndTime: 22, FailedCount: 2, Error: "first"},
+			{StartTime: 40, LastEndTime: 45, FailedCount: 1, Error: "third"},
+		})
+		require.Len(t, res, 2)
+		assert.Equal(t, time.Unix(0, 10).UTC(), res[0].GetStartTime().AsTime())
+		assert.Equal(t, time.Unix(0, 30).UTC(), res[0].GetEndTime().AsTime())
+		assert.Equal(t, int64(20), res[0].GetDuration())
+		assert.Equal(t, int64(2), res[0].GetFailedCount())
+		assert.Equal(t, "first", res[0].GetError())
+		assert.Nil(t, res[1].GetEndTime())
+		assert.Equal(t, int64(5), res[1].GetDuration())
+		assert.Equal(t, int64(1), res[1].GetFailedCount())
+	})
+}
+
+func TestOutagesString(t *testing.T) {
+	t.Run("Should: calculate windows by running frames", func(t *testing.T) {
+		assert.Contains(t, outagesString, `OVER (ORDER BY "metaStartTime" ROWS UNBOUNDED PRECEDING) AS "island"`)
+		assert.Contains(t, outagesString, `OVER (ORDER BY "metaStartTime" DESC ROWS UNBOUNDED PRECEDING) AS "nextOkTime"`)
+		assert.NotContains(t, outagesString, "UNBOUNDED FOLLOWING")
+	})
+}
@@ -28,29 +28,6 @@ var (
 	}
 )
 
-func (p *Postgres) Migrate() error {
-	models := []interface{}{
-		&Snapshot{},
-		&StatRequest{},
-		&CPUInfo{},
-		&MemoryInfo{},
-		&MemoryMem{},
-		&MemorySwap{},
-		&DiskInfo{},
-		&NetInfo{},
-		&TransactionInfo{},
-		&Incident{},
-		&IncidentHistory{},
-	}
-
-	var err error
-	for _, model := range models {
-		err = p.Db.AutoMigrate(model).Error // migrate models one-by-one
-	}
-
-	return err
-}
-
 func getTime(filter *apiPb.TimeFilter) (time.Time, time.Time, error) {
 	timeFrom := time.Unix(0, 0)
 	timeTo := time.Now()
@@ -0,0 +1,778 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/jinzhu/gorm"
+	"github.com/squzy/squzy/internal/logger"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"time"
+)
+
+const (
+	dbSnapshotRollupCollection    = "snapshot_rollups"
+	dbStatRequestRollupCollection = "stat_request_rollups"
+	dbDiskRollupCollection        = "disk_rollups"
+	dbNetRollupCollection         = "net_rollups"
+	dbCPURollupCollection         = "cpu_rollups"
+	dbTransactionRollupCollection = "transaction_rollups"
+
+	dbCPUInfoCollection    = "cpu_infos"
+	dbMemoryInfoCollection = "memory_infos"
+	dbMemoryMemCollection  = "memory_mems"
+	dbMemorySwapCollection = "memory_swaps"
+	dbDiskInfoCollection   = "disk_infos"
+	dbNetInfoCollection    = "net_infos"
+
+	// Explicit time ranges not shorter than that are read from rollups
+	hourlyRollupRange = time.Hour * 24 * 2
+	dailyRollupRange  = time.Hour * 24 * 60
+	// Buckets of that range are rolled up by one transaction
+	rollupChunkRange = time.Hour * 24
+)
+
+// Latency of snapshots is calculated only by successful checks, as uptime latency
+type SnapshotRollup struct {
+	ID          uint      `gorm:"primary_key"`
+	UpdatedAt   time.Time `gorm:"column:updated_at"`
+	SchedulerID string    `gorm:"column:schedulerId;unique_index:idx_snapshot_rollups_bucket"`
+	Resolution  int64     `gorm:"column:resolution;unique_index:idx_snapshot_rollups_bucket"`
+	BucketStart int64     `gorm:"column:bucketStart;unique_index:idx_snapshot_rollups_bucket"`
+	Count       int64     `gorm:"column:count"`
+	OkCount     int64     `gorm:"column:okCount"`
+	LatencyMin  float64   `gorm:"column:latencyMin"`
+	LatencyAvg  float64   `gorm:"column:latencyAvg"`
+	LatencyMax  float64   `gorm:"column:latencyMax"`
+	LatencyP50  float64   `gorm:"column:latencyP50"`
+	LatencyP95  float64   `gorm:"column:latencyP95"`
+	LatencyP99  float64   `gorm:"column:latencyP99"`
+}
+
+// Load of all cpus is averaged, load of every core is kept by cpu rollups
+type StatRequestRollup struct {
+	ID                 uint      `gorm:"primary_key"`
+	UpdatedAt          time.Time `gorm:"column:updated_at"`
+	AgentID            string    `gorm:"column:agentID;unique_index:idx_stat_request_rollups_bucket"`
+	Resolution         int64     `gorm:"column:resolution;unique_index:idx_stat_request_rollups_bucket"`
+	BucketStart        time.Time `gorm:"column:bucketStart;unique_index:idx_stat_request_rollups_bucket"`
+	Count              int64     `gorm:"column:count"`
+	CPULoadAvg         float64   `gorm:"column:cpuLoadAvg"`
+	CPULoadMax         float64   `gorm:"column:cpuLoadMax"`
+	MemTotal           uint64    `gorm:"column:memTotal"`
+	MemUsed            uint64    `gorm:"column:memUsed"`
+	MemFree            uint64    `gorm:"column:memFree"`
+	MemShared          uint64    `gorm:"column:memShared"`
+	MemUsedPercentAvg  float64   `gorm:"column:memUsedPercentAvg"`
+	MemUsedPercentMax  float64   `gorm:"column:memUsedPercentMax"`
+	SwapTotal          uint64    `gorm:"column:swapTotal"`
+	SwapUsed           uint64    `gorm:"column:swapUsed"`
+	SwapFree           uint64    `gorm:"column:swapFree"`
+	SwapShared         uint64    `gorm:"column:swapShared"`
+	SwapUsedPercentAvg float64   `gorm:"column:swapUsedPercentAvg"`
+	SwapUsedPercentMax float64   `gorm:"column:swapUsedPercentMax"`
+}
+
+// Core is the position of cpu in stat request, the same as in raw series
+type CPURollup struct {
+	ID          uint      `gorm:"primary_key"`
+	UpdatedAt   time.Time `gorm:"column:updated_at"`
+	AgentID     string    `gorm:"column:agentID;unique_index:idx_cpu_rollups_bucket"`
+	Resolution  int64     `gorm:"column:resolution;unique_index:idx_cpu_rollups_bucket"`
+	BucketStart time.Time `gorm:"column:bucketStart;unique_index:idx_cpu_rollups_bucket"`
+	Core        int       `gorm:"column:core;unique_index:idx_cpu_rollups_bucket"`
+	Count       int64     `gorm:"column:count"`
+	LoadAvg     float64   `gorm:"column:loadAvg"`
+	LoadMax     float64   `gorm:"column:loadMax"`
+}
+
+type DiskRollup struct {
+	ID             uint      `gorm:"primary_key"`
+	UpdatedAt      time.Time `gorm:"column:updated_at"`
+	AgentID        string    `gorm:"column:agentID;unique_index:idx_disk_rollups_bucket"`
+	Resolution     int64     `gorm:"column:resolution;unique_index:idx_disk_rollups_bucket"`
+	BucketStart    time.Time `gorm:"column:bucketStart;unique_index:idx_disk_rollups_bucket"`
+	Name           string    `gorm:"column:name;unique_index:idx_disk_rollups_bucket"`
+	Total          uint64    `gorm:"column:total"`
+	Free           uint64    `gorm:"column:free"`
+	Used           uint64    `gorm:"column:used"`
+	UsedPercentAvg float64   `gorm:"column:usedPercentAvg"`
+	UsedPercentMax float64   `gorm:"column:usedPercentMax"`
+}
+
+// Counters of interfaces are cumulative, so the last value of the bucket is kept
+type NetRollup struct {
+	ID          uint      `gorm:"primary_key"`
+	UpdatedAt   time.Time `gorm:"column:updated_at"`
+	AgentID     string    `gorm:"column:agentID;unique_index:idx_net_rollups_bucket"`
+	Resolution  int64     `gorm:"column:resolution;unique_index:idx_net_rollups_bucket"`
+	BucketStart time.Time `gorm:"column:bucketStart;unique_index:idx_net_rollups_bucket"`
+	Name        string    `gorm:"column:name;unique_index:idx_net_rollups_bucket"`
+	BytesSent   uint64    `gorm:"column:bytesSent"`
+	BytesRecv   uint64    `gorm:"column:bytesRecv"`
+	PacketsSent uint64    `gorm:"column:packetsSent"`
+	PacketsRecv uint64    `gorm:"column:packetsRecv"`
+	ErrIn       uint64    `gorm:"column:errIn"`
+	ErrOut      uint64    `gorm:"column:errOut"`
+	DropIn      uint64    `gorm:"column:dropIn"`
+	DropOut     uint64    `gorm:"column:dropOut"`
+}
+
+type TransactionRollup struct {
+	ID            uint      `gorm:"primary_key"`
+	UpdatedAt     time.Time `gorm:"column:updated_at"`
+	ApplicationId string    `gorm:"column:applicationId;unique_index:idx_transaction_rollups_bucket"`
+	Name          string    `gorm:"column:name;unique_index:idx_transaction_rollups_bucket"`
+	Resolution    int64     `gorm:"column:resolution;unique_index:idx_transaction_rollups_bucket"`
+	BucketStart   int64     `gorm:"column:bucketStart;unique_index:idx_transaction_rollups_bucket"`
+	Count         int64     `gorm:"column:count"`
+	SuccessCount  int64     `gorm:"column:successCount"`
+	LatencyMin    float64   `gorm:"column:latencyMin"`
+	LatencyAvg    float64   `gorm:"column:latencyAvg"`
+	LatencyMax    float64   `gorm:"column:latencyMax"`
+	LatencyP95    float64   `gorm:"column:latencyP95"`
+}
+
+type UptimeRollupResult struct {
+	Count      int64         `gorm:"column:count"`
+	OkCount    int64         `gorm:"column:okCount"`
+	LatencySum float64       `gorm:"column:latencySum"`
+	LastBucket sql.NullInt64 `gorm:"column:lastBucket"`
+}
+
+var (
+	snapshotLatencyString    = fmt.Sprintf(`"%s"."metaEndTime" - "%s"."metaStartTime"`, dbSnapshotCollection, dbSnapshotCollection)
+	snapshotOkFilterString   = fmt.Sprintf(`FILTER (WHERE "%s"."code" = %d)`, dbSnapshotCollection, apiPb.SchedulerCode_OK)
+	transactionLatencyString = fmt.Sprintf(`"%s"."endTime" - "%s"."startTime"`, dbTransactionInfoCollection, dbTransactionInfoCollection)
+
+	snapshotRollupString = fmt.Sprintf(
+		`INSERT INTO "%s" ("updated_at", "schedulerId", "resolution", "bucketStart", "count", "okCount", "latencyMin", "latencyAvg", "latencyMax", "latencyP50", "latencyP95", "latencyP99")
+		SELECT now(), "%s"."schedulerId", ?, ("%s"."metaStartTime" / ?) * ? AS "bucket", COUNT(*), COUNT(*) %s,
+			COALESCE(MIN(%s) %s, 0), COALESCE(AVG(%s) %s, 0), COALESCE(MAX(%s) %s, 0),
+			COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY %s) %s, 0),
+			COALESCE(percentile_cont(0.95) WITHIN GROUP (ORDER BY %s) %s, 0),
+			COALESCE(percentile_cont(0.99) WITHIN GROUP (ORDER BY %s) %s, 0)
+		FROM "%s"
+		WHERE "%s"."deleted_at" IS NULL AND "%s"."metaStartTime" >= ? AND "%s"."metaStartTime" < ?
+		GROUP BY "%s"."schedulerId", "bucket"
+		ON CONFLICT ("schedulerId", "resolution", "bucketStart") DO UPDATE SET
+			"updated_at" = EXCLUDED."updated_at", "count" = EXCLUDED."count", "okCount" = EXCLUDED."okCount",
+			"latencyMin" = EXCLUDED."latencyMin", "latencyAvg" = EXCLUDED."latencyAvg",
+			"latencyMax" = EXCLUDED."latencyMax", "latencyP50" = EXCLUDED."latencyP50",
+			"latencyP95" = EXCLUDED."latencyP95", "latencyP99" = EXCLUDED."latencyP99"`,
+		dbSnapshotRollupCollection,
+		dbSnapshotCollection, dbSnapshotCollection, snapshotOkFilterString,
+		snapshotLatencyString, snapshotOkFilterString,
+		snapshotLatencyString, snapshotOkFilterString,
+		snapshotLatencyString, snapshotOkFilterString,
+		snapshotLatencyString, snapshotOkFilterString,
+		snapshotLatencyString, snapshotOkFilterString,
+		snapshotLatencyString, snapshotOkFilterString,
+		dbSnapshotCollection,
+		dbSnapshotCollection, dbSnapshotCollection, dbSnapshotCollection,
+		dbSnapshotCollection,
+	)
+
+	statRequestBucketString = fmt.Sprintf(`to_timestamp(floor(extract(epoch from "%s"."time") / ?) * ?)`, dbStatRequestCollection)
+
+	// Load is averaged by cpus of every stat request of the chunk, so the whole cpu table is not aggregated on every run
+	statRequestCPUString = fmt.Sprintf(
+		`SELECT "%s"."statRequestId", AVG("%s"."load") AS "load"
+		FROM "%s" JOIN "%s" AS "cpuRequest" ON "cpuRequest"."id" = "%s"."statRequestId"
+		WHERE "cpuRequest"."deleted_at" IS NULL AND "cpuRequest"."time" >= ? AND "cpuRequest"."time" < ?
+		GROUP BY "%s"."statRequestId"`,
+		dbCPUInfoCollection, dbCPUInfoCollection,
+		dbCPUInfoCollection, dbStatRequestCollection, dbCPUInfoCollection,
+		dbCPUInfoCollection,
+	)
+
+	statRequestRollupString = fmt.Sprintf(
+		`INSERT INTO "%s" ("updated_at", "agentID", "resolution", "bucketStart", "count", "cpuLoadAvg", "cpuLoadMax",
+			"memTotal", "memUsed", "memFree", "memShared", "memUsedPercentAvg", "memUsedPercentMax",
+			"swapTotal", "swapUsed", "swapFree", "swapShared", "swapUsedPercentAvg", "swapUsedPercentMax")
+		SELECT now(), "%s"."agentID", ?, %s AS "bucket", COUNT(*), COALESCE(AVG("cpu"."load"), 0), COALESCE(MAX("cpu"."load"), 0),
+			COALESCE(AVG("mem"."total"), 0)::bigint, COALESCE(AVG("mem"."used"), 0)::bigint, COALESCE(AVG("mem"."free"), 0)::bigint,
+			COALESCE(AVG("mem"."shared"), 0)::bigint, COALESCE(AVG("mem"."usedPercent"), 0), COALESCE(MAX("mem"."usedPercent"), 0),
+			COALESCE(AVG("swap"."total"), 0)::bigint, COALESCE(AVG("swap"."used"), 0)::bigint, COALESCE(AVG("swap"."free"), 0)::bigint,
+			COALESCE(AVG("swap"."shared"), 0)::bigint, COALESCE(AVG("swap"."usedPercent"), 0), COALESCE(MAX("swap"."usedPercent"), 0)
+		FROM "%s"
+		LEFT JOIN (%s) AS "cpu" ON "cpu"."statRequestId" = "%s"."id"
+		LEFT JOIN "%s" ON "%s"."statRequestId" = "%s"."id"
+		LEFT JOIN "%s" AS "mem" ON "mem"."memoryInfoId" = "%s"."id"
+		LEFT JOIN "%s" AS "swap" ON "swap"."memoryInfoId" = "%s"."id"
+		WHERE "%s"."deleted_at" IS NULL AND "%s"."time" >= ? AND "%s"."time" < ?
+		GROUP BY "%s"."agentID", "bucket"
+		ON CONFLICT ("agentID", "resolution", "bucketStart") DO UPDATE SET
+			"updated_at" = EXCLUDED."updated_at", "count" = EXCLUDED."count",
+			"cpuLoadAvg" = EXCLUDED."cpuLoadAvg", "cpuLoadMax" = EXCLUDED."cpuLoadMax",
+			"memTotal" = EXCLUDED."memTotal", "memUsed" = EXCLUDED."memUsed", "memFree" = EXCLUDED."memFree",
+			"memShared" = EXCLUDED."memShared", "memUsedPercentAvg" = EXCLUDED."memUsedPercentAvg",
+			"memUsedPercentMax" = EXCLUDED."memUsedPercentMax",
+			"swapTotal" = EXCLUDED."swapTotal", "swapUsed" = EXCLUDED."swapUsed", "swapFree" = EXCLUDED."swapFree",
+			"swapShared" = EXCLUDED."swapShared", "swapUsedPercentAvg" = EXCLUDED."swapUsedPercentAvg",
+			"swapUsedPercentMax" = EXCLUDED."swapUsedPercentMax"`,
+		dbStatRequestRollupCollection,
+		dbStatRequestCollection, statRequestBucketString,
+		dbStatRequestCollection,
+		statRequestCPUString, dbStatRequestCollection,
+		dbMemoryInfoCollection, dbMemoryInfoCollection, dbStatRequestCollection,
+		dbMemoryMemCollection, dbMemoryInfoCollection,
+		dbMemorySwapCollection, dbMemoryInfoCollection,
+		dbStatRequestCollection, dbStatRequestCollection, dbStatRequestCollection,
+		dbStatRequestCollection,
+	)
+
+	cpuRollupString = fmt.Sprintf(
+		`INSERT INTO "%s" ("updated_at", "agentID", "resolution", "bucketStart", "core", "count", "loadAvg", "loadMax")
+		SELECT now(), "cpu"."agentID", ?, "cpu"."bucket", "cpu"."core", COUNT(*), AVG("cpu"."load"), MAX("cpu"."load")
+		FROM (
+			SELECT "%s"."agentID", %s AS "bucket", "%s"."load",
+				ROW_NUMBER() OVER (PARTITION BY "%s"."statRequestId" ORDER BY "%s"."id") - 1 AS "core"
+			FROM "%s"
+			JOIN "%s" ON "%s"."statRequestId" = "%s"."id"
+			WHERE "%s"."deleted_at" IS NULL AND "%s"."time" >= ? AND "%s"."time" < ?
+		) AS "cpu"
+		GROUP BY "cpu"."agentID", "cpu"."bucket", "cpu"."core"
+		ON CONFLICT ("agentID", "resolution", "bucketStart", "core") DO UPDATE SET
+			"updated_at" = EXCLUDED."updated_at", "count" = EXCLUDED."count",
+			"loadAvg" = EXCLUDED."loadAvg", "loadMax" = EXCLUDED."loadMax"`,
+		dbCPURollupCollection,
+		dbStatRequestCollection, statRequestBucketString, dbCPUInfoCollection,
+		dbCPUInfoCollection, dbCPUInfoCollection,
+		dbStatRequestCollection,
+		dbCPUInfoCollection, dbCPUInfoCollection, dbStatRequestCollection,
+		dbStatRequestCollection, dbStatRequestCollection, dbStatRequestCollection,
+	)
+
+	diskRollupString = fmt.Sprintf(
+		`INSERT INTO "%s" ("updated_at", "agentID", "resolution", "bucketStart", "name", "total", "free", "used", "usedPercentAvg", "usedPercentMax")
+		SELECT now(), "%s"."agentID", ?, %s AS "bucket", "%s"."name",
+			AVG("%s"."total")::bigint, AVG("%s"."free")::bigint, AVG("%s"."used")::bigint, AVG("%s"."usedPercent"), MAX("%s"."usedPercent")
+		FROM "%s"
+		JOIN "%s" ON "%s"."statRequestId" = "%s"."id"
+		WHERE "%s"."deleted_at" IS NULL AND "%s"."time" >= ? AND "%s"."time" < ?
+		GROUP BY "%s"."agentID", "bucket", "%s"."name"
+		ON CONFLICT ("agentID", "resolution", "bucketStart", "name") DO UPDATE SET
+			"updated_at" = EXCLUDED."updated_at", "total" = EXCLUDED."total", "free" = EXCLUDED."free", "used" = EXCLUDED."used",
+			"usedPercentAvg" = EXCLUDED."usedPercentAvg", "usedPercentMax" = EXCLUDED."usedPercentMax"`,
+		dbDiskRollupCollection,
+		dbStatRequestCollection, statRequestBucketString, dbDiskInfoCollection,
+		dbDiskInfoCollection, dbDiskInfoCollection, dbDiskInfoCollection, dbDiskInfoCollection, dbDiskInfoCollection,
+		dbStatRequestCollection,
+		dbDiskInfoCollection, dbDiskInfoCollection, dbStatRequestCollection,
+		dbStatRequestCollection, dbStatRequestCollection, dbStatRequestCollection,
+		dbStatRequestCollection, dbDiskInfoCollection,
+	)
+
+	netRollupString = fmt.Sprintf(
+		`INSERT INTO "%s" ("updated_at", "agentID", "resolution", "bucketStart", "name", "bytesSent", "bytesRecv", "packetsSent", "packetsRecv", "errIn", "errOut", "dropIn", "dropOut")
+		SELECT now(), "%s"."agentID", ?, %s AS "bucket", "%s"."name",
+			MAX("%s"."bytesSent"), MAX("%s"."bytesRecv"), MAX("%s"."packetsSent"), MAX("%s"."packetsRecv"),
+			MAX("%s"."errIn"), MAX("%s"."errOut"), MAX("%s"."dropIn"), MAX("%s"."dropOut")
+		FROM "%s"
+		JOIN "%s" ON "%s"."statRequestId" = "%s"."id"
+		WHERE "%s"."deleted_at" IS NULL AND "%s"."time" >= ? AND "%s"."time" < ?
+		GROUP BY "%s"."agentID", "bucket", "%s"."name"
+		ON CONFLICT ("agentID", "resolution", "bucketStart", "name") DO UPDATE SET
+			"updated_at" = EXCLUDED."updated_at", "bytesSent" = EXCLUDED."bytesSent", "bytesRecv" = EXCLUDED."bytesRecv",
+			"packetsSent" = EXCLUDED."packetsSent", "packetsRecv" = EXCLUDED."packetsRecv",
+			"errIn" = EXCLUDED."errIn", "errOut" = EXCLUDED."errOut", "dropIn" = EXCLUDED."dropIn", "dropOut" = EXCLUDED."dropOut"`,
+		dbNetRollupCollection,
+		dbStatRequestCollection, statRequestBucketString, dbNetInfoCollection,
+		dbNetInfoCollection, dbNetInfoCollection, dbNetInfoCollection, dbNetInfoCollection,
+		dbNetInfoCollection, dbNetInfoCollection, dbNetInfoCollection, dbNetInfoCollection,
+		dbStatRequestCollection,
+		dbNetInfoCollection, dbNetInfoCollection, dbStatRequestCollection,
+		dbStatRequestCollection, dbStatRequestCollection, dbStatRequestCollection,
+		dbStatRequestCollection, dbNetInfoCollection,
+	)
+
+	transactionRollupString = fmt.Sprintf(
+		`INSERT INTO "%s" ("updated_at", "applicationId", "name", "resolution", "bucketStart", "count", "successCount", "latencyMin", "latencyAvg", "latencyMax", "latencyP95")
+		SELECT now(), "%s"."applicationId", "%s"."name", ?, ("%s"."startTime" / ?) * ? AS "bucket", COUNT(*),
+			COUNT(*) FILTER (WHERE "%s"."transactionStatus" = %d),
+			MIN(%s), AVG(%s), MAX(%s), percentile_cont(0.95) WITHIN GROUP (ORDER BY %s)
+		FROM "%s"
+		WHERE "%s"."deleted_at" IS NULL AND "%s"."startTime" >= ? AND "%s"."startTime" < ?
+		GROUP BY "%s"."applicationId", "%s"."name", "bucket"
+		ON CONFLICT ("applicationId", "name", "resolution", "bucketStart") DO UPDATE SET
+			"updated_at" = EXCLUDED."updated_at", "count" = EXCLUDED."count", "successCount" = EXCLUDED."successCount",
+			"latencyMin" = EXCLUDED."latencyMin", "latencyAvg" = EXCLUDED."latencyAvg",
+			"latencyMax" = EXCLUDED."latencyMax", "latencyP95" = EXCLUDED."latencyP95"`,
+		dbTransactionRollupCollection,
+		dbTransactionInfoCollection, dbTransactionInfoCollection, dbTransactionInfoCollection,
+		dbTransactionInfoCollection, apiPb.TransactionStatus_TRANSACTION_SUCCESSFUL,
+		transactionLatencyString, transactionLatencyString, transactionLatencyString, transactionLatencyString,
+		dbTransactionInfoCollection,
+		dbTransactionInfoCollection, dbTransactionInfoCollection, dbTransactionInfoCollection,
+		dbTransactionInfoCollection, dbTransactionInfoCollection,
+	)
+
+	rollupResolutionFilterString = `"resolution" = ?`
+	rollupRawNotDeletedString    = `"deleted_at" IS NULL`
+	rollupBucketFilterString     = `"bucketStart" >= ? AND "bucketStart" < ?`
+)
+
+// Rollup aggregates raw data of completed buckets, aggregation starts from the last bucket of previous run
+// or from the oldest raw data on the first run, so raw data should not be deleted until it was rolled up by all resolutions.
+// Every chunk of buckets is committed separately, so the first run over big history does not hold one long transaction
+func (p *Postgres) Rollup(resolution time.Duration, until time.Time) error {
+	seconds := int64(resolution / time.Second)
+	nanos := resolution.Nanoseconds()
+	to := until.Truncate(resolution)
+
+	from, err := p.firstRollupBucket(dbSnapshotRollupCollection, `MAX("bucketStart")`, dbSnapshotCollection, `MIN("metaStartTime")`, seconds, time.Nanosecond)
+	if err != nil {
+		return err
+	}
+	err = p.rollupByChunks(from.Truncate(resolution), to, resolution, func(tx *gorm.DB, chunkFrom, chunkTo time.Time) error {
+		return tx.Exec(snapshotRollupString, seconds, nanos, nanos, chunkFrom.UnixNano(), chunkTo.UnixNano()).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	from, err = p.firstRollupBucket(dbTransactionRollupCollection, `MAX("bucketStart")`, dbTransactionInfoCollection, `MIN("startTime")`, seconds, time.Nanosecond)
+	if err != nil {
+		return err
+	}
+	err = p.rollupByChunks(from.Truncate(resolution), to, resolution, func(tx *gorm.DB, chunkFrom, chunkTo time.Time) error {
+		return tx.Exec(transactionRollupString, seconds, nanos, nanos, chunkFrom.UnixNano(), chunkTo.UnixNano()).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	from, err = p.firstRollupBucket(dbStatRequestRollupCollection, `MAX(extract(epoch from "bucketStart"))::bigint`, dbStatRequestCollection, `MIN(extract(epoch from "time"))::bigint`, seconds, time.Second)
+	if err != nil {
+		return err
+	}
+	return p.rollupByChunks(from.Truncate(resolution), to, resolution, func(tx *gorm.DB, chunkFrom, chunkTo time.Time) error {
+		err := tx.Exec(statRequestRollupString, seconds, seconds, seconds, chunkFrom, chunkTo, chunkFrom, chunkTo).Error
+		if err != nil {
+			return err
+		}
+		for _, query := range []string{cpuRollupString, diskRollupString, netRollupString} {
+			err = tx.Exec(query, seconds, seconds, seconds, chunkFrom, chunkTo).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Returns the last bucket of rollup table, or the oldest time of raw data if there are no rollups yet,
+// zero time is returned if there is no data at all. Selections return time in units
+func (p *Postgres) firstRollupBucket(table string, selection string, rawTable string, rawSelection string, resolution int64, unit time.Duration) (time.Time, error) {
+	var first sql.NullInt64
+	err := p.Db.Table(table).
+		Where(rollupResolutionFilterString, resolution).
+		Select(selection).
+		Row().
+		Scan(&first)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !first.Valid {
+		err = p.Db.Table(rawTable).
+			Where(rollupRawNotDeletedString).
+			Select(rawSelection).
+			Row().
+			Scan(&first)
+		if err != nil {
+			return time.Time{}, err
+		}
+	}
+	if !first.Valid {
+		return time.Time{}, nil
+	}
+	return time.Unix(0, first.Int64*int64(unit)), nil
+}
+
+func (p *Postgres) rollupByChunks(from time.Time, to time.Time, resolution time.Duration, rollup func(tx *gorm.DB, from time.Time, to time.Time) error) error {
+	if from.IsZero() {
+		return nil
+	}
+	chunk := rollupChunkRange
+	if resolution > chunk {
+		chunk = resolution
+	}
+	for from.Before(to) {
+		chunkTo := from.Add(chunk)
+		if chunkTo.After(to) {
+			chunkTo = to
+		}
+		err := p.Db.Transaction(func(tx *gorm.DB) error {
+			if tx.Error != nil {
+				return tx.Error
+			}
+			return rollup(tx, from, chunkTo)
+		})
+		if err != nil {
+			return err
+		}
+		from = chunkTo
+	}
+	return nil
+}
+
+// Returns resolution of rollups for time range, zero if raw data should be used
+func getRollupResolution(filter *apiPb.TimeFilter) time.Duration {
+	if filter.GetFrom() == nil {
+		return 0
+	}
+	timeFrom, timeTo, err := getTime(filter)
+	if err != nil {
+		return 0
+	}
+	switch {
+	case timeTo.Sub(timeFrom) >= dailyRollupRange:
+		return time.Hour * 24
+	case timeTo.Sub(timeFrom) >= hourlyRollupRange:
+		return time.Hour
+	default:
+		return 0
+	}
+}
+
+var (
+	rollupSchedulerIdFilterString = `"schedulerId" = ?`
+	rollupAgentIdFilterString     = `"agentID" = ?`
+	rollupBucketTimeString        = `"bucketStart"`
+	rollupCoreString              = `"core"`
+	statRequestRollupKeyset       = newKeyset(dbStatRequestRollupCollection, "bucketStart", true)
+
+	uptimeRollupSelectString = `COALESCE(SUM("count"), 0) AS "count", COALESCE(SUM("okCount"), 0) AS "okCount",
+		COALESCE(SUM("latencyAvg" * "okCount"), 0) AS "latencySum", MAX("bucketStart") AS "lastBucket"`
+	uptimeTailSelectString = fmt.Sprintf(
+		`COUNT(*) AS "count", COUNT(*) %s AS "okCount", COALESCE(SUM(%s) %s, 0) AS "latencySum"`,
+		snapshotOkFilterString, snapshotLatencyString, snapshotOkFilterString,
+	)
+
+	snapshotDeleteString    = fmt.Sprintf(`DELETE FROM "%s" WHERE "metaStartTime" < ?`, dbSnapshotCollection)
+	transactionDeleteString = fmt.Sprintf(`DELETE FROM "%s" WHERE "startTime" < ?`, dbTransactionInfoCollection)
+	statRequestIdsString    = fmt.Sprintf(`SELECT "id" FROM "%s" WHERE "time" < ?`, dbStatRequestCollection)
+	memoryInfoIdsString     = fmt.Sprintf(`SELECT "id" FROM "%s" WHERE "statRequestId" IN (%s)`, dbMemoryInfoCollection, statRequestIdsString)
+	statRequestDeleteString = []string{
+		fmt.Sprintf(`DELETE FROM "%s" WHERE "memoryInfoId" IN (%s)`, dbMemoryMemCollection, memoryInfoIdsString),
+		fmt.Sprintf(`DELETE FROM "%s" WHERE "memoryInfoId" IN (%s)`, dbMemorySwapCollection, memoryInfoIdsString),
+		fmt.Sprintf(`DELETE FROM "%s" WHERE "statRequestId" IN (%s)`, dbMemoryInfoCollection, statRequestIdsString),
+		fmt.Sprintf(`DELETE FROM "%s" WHERE "statRequestId" IN (%s)`, dbCPUInfoCollection, statRequestIdsString),
+		fmt.Sprintf(`DELETE FROM "%s" WHERE "statRequestId" IN (%s)`, dbDiskInfoCollection, statRequestIdsString),
+		fmt.Sprintf(`DELETE FROM "%s" WHERE "statRequestId" IN (%s)`, dbNetInfoCollection, statRequestIdsString),
+		fmt.Sprintf(`DELETE FROM "%s" WHERE "time" < ?`, dbStatRequestCollection),
+	}
+)
+
+// Uptime is calculated by rollups of buckets which are entirely inside time range, partial buckets at the head
+// and the tail of time range and buckets which were not rolled up yet are calculated by raw snapshots
+func (p *Postgres) getSnapshotsUptimeByRollups(request *apiPb.GetSchedulerUptimeRequest, resolution time.Duration) (*apiPb.GetSchedulerUptimeResponse, error) {
+	timeFrom, timeTo, err := getTimeInt64(request.GetTimeRange())
+	if err != nil {
+		return nil, err
+	}
+	bucket := resolution.Nanoseconds()
+	bucketsFrom := (timeFrom + bucket - 1) / bucket * bucket
+	bucketsTo := timeTo / bucket * bucket
+	if bucketsFrom >= bucketsTo {
+		headResult, err := p.getSnapshotsUptimeResult(request.GetSchedulerId(), timeFrom, timeTo)
+		if err != nil {
+			return nil, err
+		}
+		return convertFromUptimeRollupResult(headResult), nil
+	}
+
+	var rollupResult UptimeRollupResult
+	err = p.Db.Table(dbSnapshotRollupCollection).
+		Select(uptimeRollupSelectString).
+		Where(rollupSchedulerIdFilterString, request.GetSchedulerId()).
+		Where(rollupResolutionFilterString, int64(resolution/time.Second)).
+		Where(rollupBucketFilterString, bucketsFrom, bucketsTo).
+		Find(&rollupResult).Error
+	if err != nil {
+		return nil, err
+	}
+
+	headResult, err := p.getSnapshotsUptimeResult(request.GetSchedulerId(), timeFrom, bucketsFrom)
+	if err != nil {
+		return nil, err
+	}
+	tailFrom := bucketsFrom
+	if rollupResult.LastBucket.Valid {
+		tailFrom = rollupResult.LastBucket.Int64 + bucket
+	}
+	tailResult, err := p.getSnapshotsUptimeResult(request.GetSchedulerId(), tailFrom, timeTo)
+	if err != nil {
+		return nil, err
+	}
+	return convertFromUptimeRollupResult(headResult, &rollupResult, tailResult), nil
+}
+
+// Returns empty result without query if time range is empty
+func (p *Postgres) getSnapshotsUptimeResult(schedulerID string, timeFrom int64, timeTo int64) (*UptimeRollupResult, error) {
+	result := &UptimeRollupResult{}
+	if timeFrom >= timeTo {
+		return result, nil
+	}
+	err := p.Db.Table(dbSnapshotCollection).
+		Select(uptimeTailSelectString).
+		Where(schedulerIdFilterString, schedulerID).
+		Where(metaStartTimeFilterString, timeFrom, timeTo).
+		Find(result).Error
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func convertFromUptimeRollupResult(results ...*UptimeRollupResult) *apiPb.GetSchedulerUptimeResponse {
+	var count, okCount int64
+	var latencySum float64
+	for _, result := range results {
+		count += result.Count
+		okCount += result.OkCount
+		latencySum += result.LatencySum
+	}
+	if count == 0 || okCount == 0 {
+		return &apiPb.GetSchedulerUptimeResponse{
+			Uptime:  0,
+			Latency: 0,
+		}
+	}
+	return &apiPb.GetSchedulerUptimeResponse{
+		Uptime:  float64(okCount) / float64(count),
+		Latency: float64(int64(latencySum / float64(okCount))),
+	}
+}
+
+// Every rollup bucket is returned as one statistic with averaged values and averaged load of every core,
+// keys are the same as for preloading
+func (p *Postgres) getStatRequestsByRollups(agentID string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter, resolution time.Duration, keys ...string) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, *apiPb.PageCursors, error) {
+	timeFrom, timeTo, err := getTime(filter)
+	if err != nil {
+		return nil, -1, nil, err
+	}
+	seconds := int64(resolution / time.Second)
+	c, err := getCursor(pagination)
+	if err != nil {
+		return nil, -1, nil, err
+	}
+
+	var count int64
+	var rollups []*StatRequestRollup
+	var cursors *apiPb.PageCursors
+	query := p.Db.Table(dbStatRequestRollupCollection).
+		Where(rollupAgentIdFilterString, agentID).
+		Where(rollupResolutionFilterString, seconds).
+		Where(rollupBucketFilterString, timeFrom, timeTo)
+	if c != nil {
+		limit := getCursorLimit(pagination)
+		err = statRequestRollupKeyset.apply(query, c, limit).Find(&rollups).Error
+		if err != nil {
+			return nil, -1, nil, errorDataBase
+		}
+		hasMore := trimCursorPage(&rollups, c, limit)
+		first, last := getStatRequestRollupKeys(rollups)
+		cursors = getCursorPageCursors(c, hasMore, first, last)
+	} else {
+		err = query.Count(&count).Error
+		if err != nil {
+			return nil, -1, nil, err
+		}
+
+		offset, limit := getOffsetAndLimit(count, pagination)
+
+		err = query.
+			Order(rollupBucketTimeString).
+			Order(statRequestRollupKeyset.idColumn).
+			Offset(offset).
+			Limit(limit).
+			Find(&rollups).Error
+		if err != nil {
+			return nil, -1, nil, errorDataBase
+		}
+		first, last := getStatRequestRollupKeys(rollups)
+		cursors = getOffsetPageCursors(false, offset, len(rollups), count, first, last)
+	}
+	if len(rollups) == 0 {
+		return nil, int32(count), cursors, nil
+	}
+
+	statRequests := make([]*StatRequest, len(rollups))
+	byBucket := map[int64]*StatRequest{}
+	for i, rollup := range rollups {
+		statRequests[i] = convertFromStatRequestRollup(rollup, keys)
+		byBucket[rollup.BucketStart.Unix()] = statRequests[i]
+	}
+
+	// Page is ordered by bucket, so children are selected by the bucket range of the page
+	pageFrom := rollups[0].BucketStart
+	pageTo := rollups[len(rollups)-1].BucketStart.Add(time.Second)
+	for _, key := range keys {
+		switch key {
+		case cpuInfoKey:
+			var cpus []*CPURollup
+			err = p.Db.Table(dbCPURollupCollection).
+				Where(rollupAgentIdFilterString, agentID).
+				Where(rollupResolutionFilterString, seconds).
+				Where(rollupBucketFilterString, pageFrom, pageTo).
+				Order(rollupBucketTimeString).
+				Order(rollupCoreString).
+				Find(&cpus).Error
+			if err != nil {
+				return nil, -1, nil, errorDataBase
+			}
+			for _, cpu := range cpus {
+				if statRequest, ok := byBucket[cpu.BucketStart.Unix()]; ok {
+					statRequest.CPUInfo = append(statRequest.CPUInfo, &CPUInfo{
+						Load: cpu.LoadAvg,
+					})
+				}
+			}
+		case diskInfoKey:
+			var disks []*DiskRollup
+			err = p.Db.Table(dbDiskRollupCollection).
+				Where(rollupAgentIdFilterString, agentID).
+				Where(rollupResolutionFilterString, seconds).
+				Where(rollupBucketFilterString, pageFrom, pageTo).
+				Order(rollupBucketTimeString).
+				Find(&disks).Error
+			if err != nil {
+				return nil, -1, nil, errorDataBase
+			}
+			for _, disk := range disks {
+				if statRequest, ok := byBucket[disk.BucketStart.Unix()]; ok {
+					statRequest.DiskInfo = append(statRequest.DiskInfo, &DiskInfo{
+						Name:        disk.Name,
+						Total:       disk.Total,
+						Free:        disk.Free,
+						Used:        disk.Used,
+						UsedPercent: disk.UsedPercentAvg,
+					})
+				}
+			}
+		case netInfoKey:
+			var nets []*NetRollup
+			err = p.Db.Table(dbNetRollupCollection).
+				Where(rollupAgentIdFilterString, agentID).
+				Where(rollupResolutionFilterString, seconds).
+				Where(rollupBucketFilterString, pageFrom, pageTo).
+				Order(rollupBucketTimeString).
+				Find(&nets).Error
+			if err != nil {
+				return nil, -1, nil, errorDataBase
+			}
+			for _, net := range nets {
+				if statRequest, ok := byBucket[net.BucketStart.Unix()]; ok {
+					statRequest.NetInfo = append(statRequest.NetInfo, &NetInfo{
+						Name:        net.Name,
+						BytesSent:   net.BytesSent,
+						BytesRecv:   net.BytesRecv,
+						PacketsSent: net.PacketsSent,
+						PacketsRecv: net.PacketsRecv,
+						ErrIn:       net.ErrIn,
+						ErrOut:      net.ErrOut,
+						DropIn:      net.DropIn,
+						DropOut:     net.DropOut,
+					})
+				}
+			}
+		}
+	}
+
+	return ConvertFromPostgressStatRequests(statRequests), int32(count), cursors, nil
+}
+
+func getStatRequestRollupKeys(rollups []*StatRequestRollup) (*cursor, *cursor) {
+	if len(rollups) == 0 {
+		return nil, nil
+	}
+	first, last := rollups[0], rollups[len(rollups)-1]
+	return &cursor{Time: first.BucketStart.UnixNano(), ID: first.ID}, &cursor{Time: last.BucketStart.UnixNano(), ID: last.ID}
+}
+
+func convertFromStatRequestRollup(rollup *StatRequestRollup, keys []string) *StatRequest {
+	res := &StatRequest{
+		AgentID: rollup.AgentID,
+		Time:    rollup.BucketStart,
+	}
+	for _, key := range keys {
+		switch key {
+		case memoryInfoKey:
+			res.MemoryInfo = &MemoryInfo{
+				Mem: &MemoryMem{
+					Total:       rollup.MemTotal,
+					Used:        rollup.MemUsed,
+					Free:        rollup.MemFree,
+					Shared:      rollup.MemShared,
+					UsedPercent: rollup.MemUsedPercentAvg,
+				},
+				Swap: &MemorySwap{
+					Total:       rollup.SwapTotal,
+					Used:        rollup.SwapUsed,
+					Free:        rollup.SwapFree,
+					Shared:      rollup.SwapShared,
+					UsedPercent: rollup.SwapUsedPercentAvg,
+				},
+			}
+		}
+	}
+	return res
+}
+
+// Raw data is removed physically, because it is not needed after rollup
+func (p *Postgres) DeleteSnapshots(before time.Time) error {
+	if err := p.Db.Exec(snapshotDeleteString, before.UnixNano()).Error; err != nil {
+		logger.Error(err.Error())
+		return errorDataBase
+	}
+	return nil
+}
+
+func (p *Postgres) DeleteStatRequests(before time.Time) error {
+	err := p.Db.Transaction(func(tx *gorm.DB) error {
+		for _, query := range statRequestDeleteString {
+			if err := tx.Exec(query, before).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		return errorDataBase
+	}
+	return nil
+}
+
+func (p *Postgres) DeleteTransactionInfos(before time.Time) error {
+	if err := p.Db.Exec(transactionDeleteString, before.UnixNano()).Error; err != nil {
+		logger.Error(err.Error())
+		return errorDataBase
+	}
+	return nil
+}
+
+func (p *Postgres) DeleteRollups(resolution time.Duration, before time.Time) error {
+	seconds := int64(resolution / time.Second)
+	err := p.Db.Transaction(func(tx *gorm.DB) error {
+		for _, table := range []string{dbSnapshotRollupCollection, dbTransactionRollupCollection} {
+			err := tx.Exec(fmt.Sprintf(`DELETE FROM "%s" WHERE "resolution" = ? AND "bucketStart" < ?`, table), seconds, before.UnixNano()).Error
+			if err != nil {
+				return err
+			}
+		}
+		for _, table := range []string{dbStatRequestRollupCollection, dbCPURollupCollection, dbDiskRollupCollection, dbNetRollupCollection} {
+			err := tx.Exec(fmt.Sprintf(`DELETE FROM "%s" WHERE "resolution" = ? AND "bucketStart" < ?`, table), seconds, before).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		return errorDataBase
+	}
+	return nil
+}
@@ -0,0 +1,355 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jinzhu/gorm"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	timestamp "google.golang.org/protobuf/types/known/timestamppb"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var (
+	postgrRollup = &Postgres{}
+	day          = time.Hour * 24
+)
+
+type SuiteRollup struct {
+	suite.Suite
+	DB   *gorm.DB
+	mock sqlmock.Sqlmock
+}
+
+func (s *SuiteRollup) SetupSuite() {
+	var (
+		db  *sql.DB
+		err error
+	)
+
+	db, s.mock, err = sqlmock.New()
+	require.NoError(s.T(), err)
+
+	s.DB, err = gorm.Open("postgres", db)
+	require.NoError(s.T(), err)
+	postgrRollup.Db = s.DB
+
+	s.DB.LogMode(true)
+}
+
+func (s *SuiteRollup) Test_Rollup() {
+	until := time.Unix(0, 0).Add(day*10 + time.Minute*30)
+	to := until.Truncate(time.Hour)
+	first := to.Add(-time.Hour*30 + time.Minute)
+	s.mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(`FROM "%s"`, dbSnapshotRollupCollection))).
+		WithArgs(int64(3600)).
+		WillReturnRows(sqlmock.NewRows([]string{"max"}).AddRow(nil))
+	s.mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(`FROM "%s"`, dbSnapshotCollection))).
+		WillReturnRows(sqlmock.NewRows([]string{"min"}).AddRow(first.UnixNano()))
+	// The first run is split by chunks of one day
+	for _, chunk := range [][]time.Time{{to.Add(-time.Hour * 30), to.Add(-time.Hour * 6)}, {to.Add(-time.Hour * 6), to}} {
+		s.mock.ExpectBegin()
+		s.mock.ExpectExec(regexp.QuoteMeta(fmt.Sprintf(`INSERT INTO "%s"`, dbSnapshotRollupCollection))).
+			WithArgs(int64(3600), time.Hour.Nanoseconds(), time.Hour.Nanoseconds(), chunk[0].UnixNano(), chunk[1].UnixNano()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectCommit()
+	}
+	s.mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(`FROM "%s"`, dbTransactionRollupCollection))).
+		WithArgs(int64(3600)).
+		WillReturnRows(sqlmock.NewRows([]string{"max"}).AddRow(to.Add(-time.Hour).UnixNano()))
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(regexp.QuoteMeta(fmt.Sprintf(`INSERT INTO "%s"`, dbTransactionRollupCollection))).
+		WithArgs(int64(3600), time.Hour.Nanoseconds(), time.Hour.Nanoseconds(), to.Add(-time.Hour).UnixNano(), to.UnixNano()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectCommit()
+	s.mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(`FROM "%s"`, dbStatRequestRollupCollection))).
+		WithArgs(int64(3600)).
+		WillReturnRows(sqlmock.NewRows([]string{"max"}).AddRow(to.Add(-time.Hour).Unix()))
+	s.mock.ExpectBegin()
+	// Cpu is aggregated only for stat requests of the chunk
+	s.mock.ExpectExec(regexp.QuoteMeta(fmt.Sprintf(`INSERT INTO "%s"`, dbStatRequestRollupCollection))+`.*"cpuRequest"\."time" >= \$4 AND "cpuRequest"\."time" < \$5`).
+		WithArgs(int64(3600), int64(3600), int64(3600), to.Add(-time.Hour), to, to.Add(-time.Hour), to).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	for _, table := range []string{dbCPURollupCollection, dbDiskRollupCollection, dbNetRollupCollection} {
+		s.mock.ExpectExec(regexp.QuoteMeta(fmt.Sprintf(`INSERT INTO "%s"`, table))).
+			WithArgs(int64(3600), int64(3600), int64(3600), to.Add(-time.Hour), to).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	s.mock.ExpectCommit()
+
+	err := postgrRollup.Rollup(time.Hour, until)
+	require.NoError(s.T(), err)
+}
+
+func (s *SuiteRollup) Test_Rollup_Empty() {
+	for _, tables := range [][]string{
+		{dbSnapshotRollupCollection, dbSnapshotCollection},
+		{dbTransactionRollupCollection, dbTransactionInfoCollection},
+		{dbStatRequestRollupCollection, dbStatRequestCollection},
+	} {
+		s.mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(`FROM "%s"`, tables[0]))).
+			WithArgs(int64(3600)).
+			WillReturnRows(sqlmock.NewRows([]string{"max"}).AddRow(nil))
+		s.mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(`FROM "%s"`, tables[1]))).
+			WillReturnRows(sqlmock.NewRows([]string{"min"}).AddRow(nil))
+	}
+
+	err := postgrRollup.Rollup(time.Hour, time.Now())
+	require.NoError(s.T(), err)
+}
+
+func (s *SuiteRollup) Test_Rollup_InsertError() {
+	s.mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(`FROM "%s"`, dbSnapshotRollupCollection))).
+		WithArgs(int64(3600)).
+		WillReturnRows(sqlmock.NewRows([]string{"max"}).AddRow(time.Now().Add(-time.Hour * 2).UnixNano()))
+	s.mock.ExpectBegin()
+	s.mock.ExpectRollback()
+
+	err := postgrRollup.Rollup(time.Hour, time.Now())
+	require.Error(s.T(), err)
+}
+
+// Based on fact, that if request is not mocked, it will return error
+func (s *SuiteRollup) Test_Rollup_Error() {
+	err := postgrRollup.Rollup(time.Hour, time.Now())
+	require.Error(s.T(), err)
+}
+
+func (s *SuiteRollup) Test_DeleteSnapshots() {
+	before := time.Now()
+	s.mock.ExpectExec(regexp.QuoteMeta(fmt.Sprintf(`DELETE FROM "%s"`, dbSnapshotCollection))).
+		WithArgs(before.UnixNano()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := postgrRollup.DeleteSnapshots(before)
+	require.NoError(s.T(), err)
+}
+
+// Based on fact, that if request is not mocked, it will return error
+func (s *SuiteRollup) Test_DeleteSnapshots_Error() {
+	err := postgrRollup.DeleteSnapshots(time.Now())
+	require.Error(s.T(), err)
+}
+
+func (s *SuiteRollup) Test_DeleteStatRequests() {
+	before := time.Now()
+	s.mock.ExpectBegin()
+	for range statRequestDeleteString {
+		s.mock.ExpectExec(`DELETE FROM`).
+			WithArgs(before).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	s.mock.ExpectCommit()
+
+	err := postgrRollup.DeleteStatRequests(before)
+	require.NoError(s.T(), err)
+}
+
+// Based on fact, that if request is not mocked, it will return error
+func (s *SuiteRollup) Test_DeleteStatRequests_Error() {
+	err := postgrRollup.DeleteStatRequests(time.Now())
+	require.Error(s.T(), err)
+}
+
+func (s *SuiteRollup) Test_DeleteTransactionInfos() {
+	before := time.Now()
+	s.mock.ExpectExec(regexp.QuoteMeta(fmt.Sprintf(`DELETE FROM "%s"`, dbTransactionInfoCollection))).
+		WithArgs(before.UnixNano()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := postgrRollup.DeleteTransactionInfos(before)
+	require.NoError(s.T(), err)
+}
+
+// Based on fact, that if request is not mocked, it will return error
+func (s *SuiteRollup) Test_DeleteTransactionInfos_Error() {
+	err := postgrRollup.DeleteTransactionInfos(time.Now())
+	require.Error(s.T(), err)
+}
+
+func (s *SuiteRollup) Test_DeleteRollups() {
+	before := time.Now()
+	s.mock.ExpectBegin()
+	for _, table := range []string{dbSnapshotRollupCollection, dbTransactionRollupCollection} {
+		s.mock.ExpectExec(regexp.QuoteMeta(fmt.Sprintf(`DELETE FROM "%s"`, table))).
+			WithArgs(int64(86400), before.UnixNano()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	for _, table := range []string{dbStatRequestRollupCollection, dbCPURollupCollection, dbDiskRollupCollection, dbNetRollupCollection} {
+		s.mock.ExpectExec(regexp.QuoteMeta(fmt.Sprintf(`DELETE FROM "%s"`, table))).
+			WithArgs(int64(86400), before).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	s.mock.ExpectCommit()
+
+	err := postgrRollup.DeleteRollups(day, before)
+	require.NoError(s.T(), err)
+}
+
+// Based on fact, that if request is not mocked, it will return error
+func (s *SuiteRollup) Test_DeleteRollups_Error() {
+	err := postgrRollup.DeleteRollups(day, time.Now())
+	require.Error(s.T(), err)
+}
+
+func (s *SuiteRollup) Test_GetSnapshotsUptime() {
+	now := time.Now().Truncate(time.Hour).Add(time.Minute * 30)
+	from := now.Add(-day * 3)
+	to := now.Add(time.Hour)
+	lastBucket := now.Truncate(time.Hour).Add(-time.Hour)
+	s.mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(`FROM "%s"`, dbSnapshotRollupCollection))).
+		WithArgs("1", int64(3600), from.Truncate(time.Hour).Add(time.Hour).UnixNano(), to.Truncate(time.Hour).UnixNano()).
+		WillReturnRows(sqlmock.NewRows([]string{"count", "okCount", "latencySum", "lastBucket"}).AddRow(9, 8, 80, lastBucket.UnixNano()))
+	// Head of the first bucket, which is not entirely inside time range
+	s.mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(`FROM "%s"`, dbSnapshotCollection))).
+		WithArgs("1", from.UnixNano(), from.Truncate(time.Hour).Add(time.Hour).UnixNano()).
+		WillReturnRows(sqlmock.NewRows([]string{"count", "okCount", "latencySum"}).AddRow(1, 1, 10))
+	s.mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(`FROM "%s"`, dbSnapshotCollection))).
+		WithArgs("1", lastBucket.Add(time.Hour).UnixNano(), to.UnixNano()).
+		WillReturnRows(sqlmock.NewRows([]string{"count", "okCount", "latencySum"}).AddRow(1, 0, 0))
+
+	res, err := postgrRollup.GetSnapshotsUptime(&apiPb.GetSchedulerUptimeRequest{
+		SchedulerId: "1",
+		TimeRange: &apiPb.TimeFilter{
+			From: timestamp.New(from),
+			To:   timestamp.New(to),
+		},
+	})
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), float64(9)/float64(11), res.GetUptime())
+	assert.Equal(s.T(), float64(10), res.GetLatency())
+}
+
+// Based on fact, that if request is not mocked, it will return error
+func (s *SuiteRollup) Test_GetSnapshotsUptime_Error() {
+	_, err := postgrRollup.GetSnapshotsUptime(&apiPb.GetSchedulerUptimeRequest{
+		SchedulerId: "1",
+		TimeRange: &apiPb.TimeFilter{
+			From: timestamp.New(time.Now().Add(-day * 90)),
+		},
+	})
+	require.Error(s.T(), err)
+}
+
+func (s *SuiteRollup) Test_GetDiskInfo() {
+	now := time.Now()
+	bucket := now.Truncate(time.Hour)
+	s.mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(`SELECT count(*) FROM "%s"`, dbStatRequestRollupCollection))).
+		WithArgs("1", int64(3600), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	s.mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(`SELECT * FROM "%s"`, dbStatRequestRollupCollection))).
+		WithArgs("1", int64(3600), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"agentID", "bucketStart", "cpuLoadAvg"}).AddRow("1", bucket, 10))
+	s.mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(`SELECT * FROM "%s"`, dbDiskRollupCollection))).
+		WithArgs("1", int64(3600), bucket, bucket.Add(time.Second)).
+		WillReturnRows(sqlmock.NewRows([]string{"bucketStart", "name", "total"}).AddRow(bucket, "disk", 100))
+
+	res, count, _, err := postgrRollup.GetDiskInfo("1", nil, &apiPb.TimeFilter{
+		From: timestamp.New(now.Add(-day * 3)),
+		To:   timestamp.New(now),
+	})
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int32(1), count)
+	assert.Equal(s.T(), uint64(100), res[0].GetDiskInfo().GetDisks()["disk"].GetTotal())
+	assert.Nil(s.T(), res[0].GetCpuInfo())
+}
+
+func (s *SuiteRollup) Test_GetCPUInfo_ByCursor() {
+	now := time.Now()
+	bucket := now.Truncate(time.Hour)
+	s.mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(`SELECT * FROM "%s"`, dbStatRequestRollupCollection))).
+		WithArgs("1", int64(3600), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "agentID", "bucketStart", "cpuLoadAvg"}).AddRow(3, "1", bucket, 15))
+	s.mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(`SELECT * FROM "%s"`, dbCPURollupCollection))).
+		WithArgs("1", int64(3600), bucket, bucket.Add(time.Second)).
+		WillReturnRows(sqlmock.NewRows([]string{"bucketStart", "core", "loadAvg"}).AddRow(bucket, 0, 10).AddRow(bucket, 1, 20))
+
+	res, count, cursors, err := postgrRollup.GetCPUInfo("1", &apiPb.Pagination{
+		Cursor: encodeCursor(&cursor{Time: bucket.Add(-time.Hour).UnixNano(), ID: 2}),
+		Limit:  1,
+	}, &apiPb.TimeFilter{
+		From: timestamp.New(now.Add(-day * 3)),
+		To:   timestamp.New(now),
+	})
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int32(0), count)
+	require.Len(s.T(), res, 1)
+	require.Len(s.T(), res[0].GetCpuInfo().GetCpus(), 2)
+	assert.Equal(s.T(), float64(10), res[0].GetCpuInfo().GetCpus()[0].GetLoad())
+	assert.Equal(s.T(), float64(20), res[0].GetCpuInfo().GetCpus()[1].GetLoad())
+	assert.Empty(s.T(), cursors.GetNext())
+	assert.Equal(s.T(), encodeCursor(&cursor{Time: bucket.UnixNano(), ID: 3, Before: true}), cursors.GetPrev())
+}
+
+func (s *SuiteRollup) Test_GetStatRequest_Empty() {
+	s.mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(`SELECT count(*) FROM "%s"`, dbStatRequestRollupCollection))).
+		WithArgs("1", int64(86400), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	s.mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(`SELECT * FROM "%s"`, dbStatRequestRollupCollection))).
+		WithArgs("1", int64(86400), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"agentID"}))
+
+	res, count, _, err := postgrRollup.GetStatRequest("1", nil, &apiPb.TimeFilter{
+		From: timestamp.New(time.Now().Add(-day * 90)),
+	})
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int32(0), count)
+	assert.Empty(s.T(), res)
+}
+
+// Based on fact, that if request is not mocked, it will return error
+func (s *SuiteRollup) Test_GetCPUInfo_Error() {
+	_, _, _, err := postgrRollup.GetCPUInfo("1", nil, &apiPb.TimeFilter{
+		From: timestamp.New(time.Now().Add(-day * 3)),
+	})
+	require.Error(s.T(), err)
+}
+
+func (s *SuiteRollup) AfterTest(_, _ string) {
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func TestInitRollup(t *testing.T) {
+	suite.Run(t, new(SuiteRollup))
+}
+
+func TestGetRollupResolution(t *testing.T) {
+	now := time.Now()
+	t.Run("Should: use raw data without from", func(t *testing.T) {
+		assert.Equal(t, time.Duration(0), getRollupResolution(nil))
+		assert.Equal(t, time.Duration(0), getRollupResolution(&apiPb.TimeFilter{To: timestamp.New(now)}))
+	})
+	t.Run("Should: use raw data for short range", func(t *testing.T) {
+		assert.Equal(t, time.Duration(0), getRollupResolution(&apiPb.TimeFilter{From: timestamp.New(now.Add(-day))}))
+	})
+	t.Run("Should: use hourly rollups", func(t *testing.T) {
+		assert.Equal(t, time.Hour, getRollupResolution(&apiPb.TimeFilter{From: timestamp.New(now.Add(-day * 7))}))
+	})
+	t.Run("Should: use daily rollups", func(t *testing.T) {
+		assert.Equal(t, day, getRollupResolution(&apiPb.TimeFilter{
+			From: timestamp.New(now.Add(-day * 365)),
+			To:   timestamp.New(now),
+		}))
+	})
+}
+
+func TestConvertFromUptimeRollupResult(t *testing.T) {
+	t.Run("Should: return zero without data", func(t *testing.T) {
+		res := convertFromUptimeRollupResult(&UptimeRollupResult{}, &UptimeRollupResult{Count: 2})
+		assert.Equal(t, float64(0), res.GetUptime())
+		assert.Equal(t, float64(0), res.GetLatency())
+	})
+	t.Run("Should: combine results", func(t *testing.T) {
+		res := convertFromUptimeRollupResult(
+			&UptimeRollupResult{Count: 3, OkCount: 2, LatencySum: 30},
+			&UptimeRollupResult{Count: 1, OkCount: 1, LatencySum: 15},
+		)
+		assert.Equal(t, 0.75, res.GetUptime())
+		assert.Equal(t, float64(15), res.GetLatency())
+	})
+}
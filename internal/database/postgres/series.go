@@ -0,0 +1,150 @@
+package postgres
+
+import (
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	timestamp "google.golang.org/protobuf/types/known/timestamppb"
+	"math"
+	"sort"
+	"time"
+)
+
+const (
+	maxSeriesPoints = 10000
+)
+
+var (
+	seriesResolutions = map[apiPb.SeriesResolution]time.Duration{
+		apiPb.SeriesResolution_SERIES_RESOLUTION_MINUTE: time.Minute,
+		apiPb.SeriesResolution_SERIES_RESOLUTION_HOUR:   time.Hour,
+		apiPb.SeriesResolution_SERIES_RESOLUTION_DAY:    time.Hour * 24,
+	}
+)
+
+// Hourly and daily series are read from rollups, buckets which were not rolled up yet are calculated by raw snapshots
+func (p *Postgres) GetSnapshotsUptimeSeries(request *apiPb.GetSchedulerUptimeSeriesRequest) (*apiPb.GetSchedulerUptimeSeriesResponse, error) {
+	timeFrom, timeTo, err := getTimeInt64(request.GetTimeRange())
+	if err != nil {
+		return nil, err
+	}
+	resolution := getSeriesResolution(request.GetResolution(), timeFrom, timeTo)
+	bucket := seriesResolutions[resolution]
+
+	var points []*apiPb.UptimeSeriesPoint
+	rawFrom := timeFrom
+	if resolution != apiPb.SeriesResolution_SERIES_RESOLUTION_MINUTE {
+		var rollups []*SnapshotRollup
+		err = p.Db.Table(dbSnapshotRollupCollection).
+			Where(rollupSchedulerIdFilterString, request.GetSchedulerId()).
+			Where(rollupResolutionFilterString, int64(bucket/time.Second)).
+			Where(rollupBucketFilterString, timeFrom/bucket.Nanoseconds()*bucket.Nanoseconds(), timeTo).
+			Order(rollupBucketTimeString).
+			Find(&rollups).Error
+		if err != nil {
+			return nil, errorDataBase
+		}
+		for _, rollup := range rollups {
+			points = append(points, convertFromSnapshotRollup(rollup))
+		}
+		if len(rollups) > 0 {
+			rawFrom = rollups[len(rollups)-1].BucketStart + bucket.Nanoseconds()
+		}
+	}
+
+	if rawFrom < timeTo {
+		var snapshots []*Snapshot
+		err = p.Db.Table(dbSnapshotCollection).
+			Select(`"metaStartTime", "metaEndTime", "code"`).
+			Where(schedulerIdFilterString, request.GetSchedulerId()).
+			Where(metaStartTimeFilterString, rawFrom, timeTo).
+			Order(`"metaStartTime"`).
+			Find(&snapshots).Error
+		if err != nil {
+			return nil, errorDataBase
+		}
+		points = append(points, convertToUptimeSeries(snapshots, bucket)...)
+	}
+
+	return &apiPb.GetSchedulerUptimeSeriesResponse{
+		Resolution: resolution,
+		Points:     points,
+	}, nil
+}
+
+// Returns resolution chosen by time range if it is not specified, resolution is increased till it fits max amount of points
+func getSeriesResolution(resolution apiPb.SeriesResolution, timeFrom, timeTo int64) apiPb.SeriesResolution {
+	duration := time.Duration(timeTo - timeFrom)
+	if _, ok := seriesResolutions[resolution]; !ok {
+		switch {
+		case duration < hourlyRollupRange:
+			resolution = apiPb.SeriesResolution_SERIES_RESOLUTION_MINUTE
+		case duration < dailyRollupRange:
+			resolution = apiPb.SeriesResolution_SERIES_RESOLUTION_HOUR
+		default:
+			resolution = apiPb.SeriesResolution_SERIES_RESOLUTION_DAY
+		}
+	}
+	for resolution < apiPb.SeriesResolution_SERIES_RESOLUTION_DAY && duration/seriesResolutions[resolution] > maxSeriesPoints {
+		resolution++
+	}
+	return resolution
+}
+
+func convertFromSnapshotRollup(rollup *SnapshotRollup) *apiPb.UptimeSeriesPoint {
+	return &apiPb.UptimeSeriesPoint{
+		Time:       timestamp.New(time.Unix(0, rollup.BucketStart)),
+		Count:      rollup.Count,
+		Uptime:     float64(rollup.OkCount) / float64(rollup.Count),
+		LatencyP50: rollup.LatencyP50,
+		LatencyP95: rollup.LatencyP95,
+		LatencyP99: rollup.LatencyP99,
+	}
+}
+
+// Snapshots should be ordered by start time
+func convertToUptimeSeries(snapshots []*Snapshot, bucket time.Duration) []*apiPb.UptimeSeriesPoint {
+	var points []*apiPb.UptimeSeriesPoint
+	var latencies []float64
+	var count int64
+	var start int64
+	flush := func() {
+		if count == 0 {
+			return
+		}
+		sort.Float64s(latencies)
+		points = append(points, &apiPb.UptimeSeriesPoint{
+			Time:       timestamp.New(time.Unix(0, start)),
+			Count:      count,
+			Uptime:     float64(len(latencies)) / float64(count),
+			LatencyP50: percentile(latencies, 0.5),
+			LatencyP95: percentile(latencies, 0.95),
+			LatencyP99: percentile(latencies, 0.99),
+		})
+	}
+	for _, snapshot := range snapshots {
+		bucketStart := snapshot.MetaStartTime / bucket.Nanoseconds() * bucket.Nanoseconds()
+		if count == 0 || bucketStart != start {
+			flush()
+			start = bucketStart
+			count = 0
+			latencies = latencies[:0]
+		}
+		count++
+		if snapshot.Code == int32(apiPb.SchedulerCode_OK) {
+			latencies = append(latencies, float64(snapshot.MetaEndTime-snapshot.MetaStartTime))
+		}
+	}
+	flush()
+	return points
+}
+
+// Nearest-rank percentile of sorted values
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	return sorted[rank]
+}
@@ -0,0 +1,177 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jinzhu/gorm"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	timestamp "google.golang.org/protobuf/types/known/timestamppb"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var (
+	postgrSeries = &Postgres{}
+)
+
+type SuiteSeries struct {
+	suite.Suite
+	DB   *gorm.DB
+	mock sqlmock.Sqlmock
+}
+
+func (s *SuiteSeries) SetupSuite() {
+	var (
+		db  *sql.DB
+		err error
+	)
+
+	db, s.mock, err = sqlmock.New()
+	require.NoError(s.T(), err)
+
+	s.DB, err = gorm.Open("postgres", db)
+	require.NoError(s.T(), err)
+	postgrSeries.Db = s.DB
+
+	s.DB.LogMode(true)
+}
+
+func (s *SuiteSeries) Test_GetSnapshotsUptimeSeries_Minute() {
+	from := time.Unix(0, 0).Add(time.Hour)
+	s.mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(`FROM "%s"`, dbSnapshotCollection))).
+		WithArgs("1", from.UnixNano(), from.Add(time.Hour).UnixNano()).
+		WillReturnRows(sqlmock.NewRows([]string{"metaStartTime", "metaEndTime", "code"}).
+			AddRow(from.UnixNano(), from.UnixNano()+10, int32(apiPb.SchedulerCode_OK)).
+			AddRow(from.UnixNano()+20, from.UnixNano()+40, int32(apiPb.SchedulerCode_ERROR)))
+
+	res, err := postgrSeries.GetSnapshotsUptimeSeries(&apiPb.GetSchedulerUptimeSeriesRequest{
+		SchedulerId: "1",
+		TimeRange: &apiPb.TimeFilter{
+			From: timestamp.New(from),
+			To:   timestamp.New(from.Add(time.Hour)),
+		},
+	})
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), apiPb.SeriesResolution_SERIES_RESOLUTION_MINUTE, res.GetResolution())
+	require.Len(s.T(), res.GetPoints(), 1)
+	assert.Equal(s.T(), int64(2), res.GetPoints()[0].GetCount())
+	assert.Equal(s.T(), 0.5, res.GetPoints()[0].GetUptime())
+	assert.Equal(s.T(), float64(10), res.GetPoints()[0].GetLatencyP99())
+}
+
+func (s *SuiteSeries) Test_GetSnapshotsUptimeSeries_Hour() {
+	now := time.Now()
+	lastBucket := now.Truncate(time.Hour).Add(-time.Hour)
+	s.mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(`FROM "%s"`, dbSnapshotRollupCollection))).
+		WithArgs("1", int64(3600), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"bucketStart", "count", "okCount", "latencyP50", "latencyP95", "latencyP99"}).
+			AddRow(lastBucket.UnixNano(), 4, 3, 10, 20, 30))
+	s.mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(`FROM "%s"`, dbSnapshotCollection))).
+		WithArgs("1", lastBucket.Add(time.Hour).UnixNano(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"metaStartTime", "metaEndTime", "code"}))
+
+	res, err := postgrSeries.GetSnapshotsUptimeSeries(&apiPb.GetSchedulerUptimeSeriesRequest{
+		SchedulerId: "1",
+		TimeRange: &apiPb.TimeFilter{
+			From: timestamp.New(now.Add(-day * 3)),
+			To:   timestamp.New(now),
+		},
+	})
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), apiPb.SeriesResolution_SERIES_RESOLUTION_HOUR, res.GetResolution())
+	require.Len(s.T(), res.GetPoints(), 1)
+	assert.Equal(s.T(), 0.75, res.GetPoints()[0].GetUptime())
+	assert.Equal(s.T(), float64(20), res.GetPoints()[0].GetLatencyP95())
+}
+
+// Based on fact, that if request is not mocked, it will return error
+func (s *SuiteSeries) Test_GetSnapshotsUptimeSeries_Error() {
+	_, err := postgrSeries.GetSnapshotsUptimeSeries(&apiPb.GetSchedulerUptimeSeriesRequest{
+		SchedulerId: "1",
+		Resolution:  apiPb.SeriesResolution_SERIES_RESOLUTION_DAY,
+	})
+	require.Error(s.T(), err)
+}
+
+// Based on fact, that if request is not mocked, it will return error
+func (s *SuiteSeries) Test_GetSnapshotsUptimeSeries_RawError() {
+	_, err := postgrSeries.GetSnapshotsUptimeSeries(&apiPb.GetSchedulerUptimeSeriesRequest{
+		SchedulerId: "1",
+		Resolution:  apiPb.SeriesResolution_SERIES_RESOLUTION_MINUTE,
+		TimeRange: &apiPb.TimeFilter{
+			From: timestamp.New(time.Now().Add(-time.Hour)),
+		},
+	})
+	require.Error(s.T(), err)
+}
+
+func (s *SuiteSeries) Test_GetSnapshotsUptimeSeries_TimeError() {
+	_, err := postgrSeries.GetSnapshotsUptimeSeries(&apiPb.GetSchedulerUptimeSeriesRequest{
+		SchedulerId: "1",
+		TimeRange: &apiPb.TimeFilter{
+			From: &timestamp.Timestamp{Seconds: -62135596801},
+		},
+	})
+	require.Error(s.T(), err)
+}
+
+func (s *SuiteSeries) AfterTest(_, _ string) {
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func TestInitSeries(t *testing.T) {
+	suite.Run(t, new(SuiteSeries))
+}
+
+func TestGetSeriesResolution(t *testing.T) {
+	now := time.Now().UnixNano()
+	t.Run("Should: choose resolution by range", func(t *testing.T) {
+		assert.Equal(t, apiPb.SeriesResolution_SERIES_RESOLUTION_MINUTE, getSeriesResolution(apiPb.SeriesResolution_SERIES_RESOLUTION_UNSPECIFIED, now-day.Nanoseconds(), now))
+		assert.Equal(t, apiPb.SeriesResolution_SERIES_RESOLUTION_HOUR, getSeriesResolution(apiPb.SeriesResolution_SERIES_RESOLUTION_UNSPECIFIED, now-7*day.Nanoseconds(), now))
+		assert.Equal(t, apiPb.SeriesResolution_SERIES_RESOLUTION_DAY, getSeriesResolution(apiPb.SeriesResolution_SERIES_RESOLUTION_UNSPECIFIED, now-365*day.Nanoseconds(), now))
+	})
+	t.Run("Should: keep requested resolution", func(t *testing.T) {
+		assert.Equal(t, apiPb.SeriesResolution_SERIES_RESOLUTION_DAY, getSeriesResolution(apiPb.SeriesResolution_SERIES_RESOLUTION_DAY, now-day.Nanoseconds(), now))
+	})
+	t.Run("Should: increase resolution for too many points", func(t *testing.T) {
+		assert.Equal(t, apiPb.SeriesResolution_SERIES_RESOLUTION_HOUR, getSeriesResolution(apiPb.SeriesResolution_SERIES_RESOLUTION_MINUTE, now-30*day.Nanoseconds(), now))
+		assert.Equal(t, apiPb.SeriesResolution_SERIES_RESOLUTION_DAY, getSeriesResolution(apiPb.SeriesResolution_SERIES_RESOLUTION_MINUTE, now-3650*day.Nanoseconds(), now))
+	})
+}
+
+func TestConvertToUptimeSeries(t *testing.T) {
+	t.Run("Should: return empty series", func(t *testing.T) {
+		assert.Empty(t, convertToUptimeSeries(nil, time.Minute))
+	})
+	t.Run("Should: split snapshots by buckets", func(t *testing.T) {
+		minute := time.Minute.Nanoseconds()
+		var snapshots []*Snapshot
+		for i := int64(1); i <= 100; i++ {
+			snapshots = append(snapshots, &Snapshot{
+				Code:          int32(apiPb.SchedulerCode_OK),
+				MetaStartTime: minute,
+				MetaEndTime:   minute + i,
+			})
+		}
+		snapshots = append(snapshots, &Snapshot{
+			Code:          int32(apiPb.SchedulerCode_ERROR),
+			MetaStartTime: 3 * minute,
+			MetaEndTime:   3*minute + 1,
+		})
+		res := convertToUptimeSeries(snapshots, time.Minute)
+		require.Len(t, res, 2)
+		assert.Equal(t, int64(100), res[0].GetCount())
+		assert.Equal(t, float64(1), res[0].GetUptime())
+		assert.Equal(t, float64(50), res[0].GetLatencyP50())
+		assert.Equal(t, float64(95), res[0].GetLatencyP95())
+		assert.Equal(t, float64(99), res[0].GetLatencyP99())
+		assert.Equal(t, time.Unix(0, 3*minute).UTC(), res[1].GetTime().AsTime())
+		assert.Equal(t, float64(0), res[1].GetUptime())
+		assert.Equal(t, float64(0), res[1].GetLatencyP50())
+	})
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"github.com/jinzhu/gorm"
 	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"strings"
+	"time"
 )
 
 type Snapshot struct {
@@ -17,14 +19,33 @@ type Snapshot struct {
 	MetaValue     []byte `gorm:"column:metaValue"`
 }
 
+// InsertSnapshotsError tells indexes of snapshots in batch which were not saved, other snapshots are saved
+type InsertSnapshotsError struct {
+	Failed []int
+}
+
+func (e *InsertSnapshotsError) Error() string {
+	return fmt.Sprintf("%d snapshots of batch are not valid", len(e.Failed))
+}
+
 type UptimeResult struct {
 	Count   int64  `gorm:"column:count"`
 	Latency string `gorm:"column:latency"`
 }
 
+const (
+	// Postgres allows 65535 parameters in one statement
+	snapshotInsertChunkSize    = 1000
+	snapshotInsertColumnsCount = 9
+	snapshotInsertValues       = "(?,?,?,?,?,?,?,?,?)"
+)
+
 var (
+	snapshotInsertQuery = fmt.Sprintf(`INSERT INTO "%s" ("created_at","updated_at","schedulerId","code","type","error","metaStartTime","metaEndTime","metaValue") VALUES `, dbSnapshotCollection)
+
 	schedulerIdFilterString   = fmt.Sprintf(`"%s"."schedulerId" = ?`, dbSnapshotCollection)
 	metaStartTimeFilterString = fmt.Sprintf(`"%s"."metaStartTime" BETWEEN ? and ?`, dbSnapshotCollection)
+	snapshotKeyset            = newKeyset(dbSnapshotCollection, "metaStartTime", false)
 
 	snapOrderMap = map[apiPb.SortSchedulerList]string{
 		apiPb.SortSchedulerList_SORT_SCHEDULER_LIST_UNSPECIFIED: fmt.Sprintf(`"%s"."metaStartTime"`, dbSnapshotCollection),
@@ -45,10 +66,77 @@ func (p *Postgres) InsertSnapshot(data *apiPb.SchedulerResponse) error {
 	return nil
 }
 
-func (p *Postgres) GetSnapshots(request *apiPb.GetSchedulerInformationRequest) ([]*apiPb.SchedulerSnapshot, int32, error) {
+// InsertSnapshots saves valid snapshots by multi-row statements in one transaction,
+// snapshots which can not be converted are reported by InsertSnapshotsError
+func (p *Postgres) InsertSnapshots(data []*apiPb.SchedulerResponse) error {
+	snapshots := make([]*Snapshot, 0, len(data))
+	var failed []int
+	for i, response := range data {
+		snapshot, err := ConvertToPostgresSnapshot(response)
+		if err != nil {
+			failed = append(failed, i)
+			continue
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	if len(snapshots) > 0 {
+		err := p.Db.Transaction(func(tx *gorm.DB) error {
+			for start := 0; start < len(snapshots); start += snapshotInsertChunkSize {
+				end := start + snapshotInsertChunkSize
+				if end > len(snapshots) {
+					end = len(snapshots)
+				}
+				if err := insertSnapshots(tx, snapshots[start:end]); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return errorDataBase
+		}
+	}
+	if len(failed) > 0 {
+		return &InsertSnapshotsError{
+			Failed: failed,
+		}
+	}
+	return nil
+}
+
+func insertSnapshots(tx *gorm.DB, snapshots []*Snapshot) error {
+	now := time.Now()
+	values := make([]string, len(snapshots))
+	args := make([]interface{}, 0, len(snapshots)*snapshotInsertColumnsCount)
+	for i, snapshot := range snapshots {
+		values[i] = snapshotInsertValues
+		args = append(args,
+			now,
+			now,
+			snapshot.SchedulerID,
+			snapshot.Code,
+			snapshot.Type,
+			snapshot.Error,
+			snapshot.MetaStartTime,
+			snapshot.MetaEndTime,
+			snapshot.MetaValue,
+		)
+	}
+	return tx.Exec(snapshotInsertQuery+strings.Join(values, ","), args...).Error
+}
+
+func (p *Postgres) GetSnapshots(request *apiPb.GetSchedulerInformationRequest) ([]*apiPb.SchedulerSnapshot, int32, *apiPb.PageCursors, error) {
 	timeFrom, timeTo, err := getTimeInt64(request.GetTimeRange())
 	if err != nil {
-		return nil, -1, err
+		return nil, -1, nil, err
+	}
+
+	c, err := getCursor(request.GetPagination())
+	if err != nil {
+		return nil, -1, nil, err
+	}
+	if c != nil {
+		return p.getSnapshotsByCursor(request, timeFrom, timeTo, c)
 	}
 
 	var count int64
@@ -58,7 +146,7 @@ func (p *Postgres) GetSnapshots(request *apiPb.GetSchedulerInformationRequest) (
 		Where(getCodeString(request.GetStatus())).
 		Count(&count).Error
 	if err != nil {
-		return nil, -1, err
+		return nil, -1, nil, err
 	}
 
 	offset, limit := getOffsetAndLimit(count, request.GetPagination())
@@ -71,17 +159,50 @@ func (p *Postgres) GetSnapshots(request *apiPb.GetSchedulerInformationRequest) (
 		Where(metaStartTimeFilterString, timeFrom, timeTo).
 		Where(getCodeString(request.GetStatus())).
 		Order(getSnapshotOrder(request.GetSort()) + getSnapshotDirection(request.GetSort())).
+		Order(snapshotKeyset.idColumn + getSnapshotDirection(request.GetSort())).
 		Offset(offset).
 		Limit(limit).
 		Find(&dbSnapshots).Error
 	if err != nil {
-		return nil, -1, errorDataBase
+		return nil, -1, nil, errorDataBase
+	}
+
+	cursors := &apiPb.PageCursors{}
+	// Cursors can continue only the pages ordered by keyset
+	if getSnapshotOrder(request.GetSort()) == snapshotKeyset.timeColumn {
+		first, last := getSnapshotKeys(dbSnapshots)
+		cursors = getOffsetPageCursors(getSnapshotDirection(request.GetSort()) == descPrefix, offset, len(dbSnapshots), count, first, last)
 	}
 
-	return ConvertFromPostgresSnapshots(dbSnapshots), int32(count), nil
+	return ConvertFromPostgresSnapshots(dbSnapshots), int32(count), cursors, nil
+}
+
+// Count is not calculated for pages selected by cursor
+func (p *Postgres) getSnapshotsByCursor(request *apiPb.GetSchedulerInformationRequest, timeFrom, timeTo int64, c *cursor) ([]*apiPb.SchedulerSnapshot, int32, *apiPb.PageCursors, error) {
+	limit := getCursorLimit(request.GetPagination())
+
+	var dbSnapshots []*Snapshot
+	query := p.Db.
+		Table(dbSnapshotCollection).
+		Set("gorm:auto_preload", true).
+		Where(schedulerIdFilterString, request.GetSchedulerId()).
+		Where(metaStartTimeFilterString, timeFrom, timeTo).
+		Where(getCodeString(request.GetStatus()))
+	err := snapshotKeyset.apply(query, c, limit).Find(&dbSnapshots).Error
+	if err != nil {
+		return nil, -1, nil, errorDataBase
+	}
+
+	hasMore := trimCursorPage(&dbSnapshots, c, limit)
+	first, last := getSnapshotKeys(dbSnapshots)
+	return ConvertFromPostgresSnapshots(dbSnapshots), 0, getCursorPageCursors(c, hasMore, first, last), nil
 }
 
 func (p *Postgres) GetSnapshotsUptime(request *apiPb.GetSchedulerUptimeRequest) (*apiPb.GetSchedulerUptimeResponse, error) {
+	if resolution := getRollupResolution(request.GetTimeRange()); resolution != 0 {
+		return p.getSnapshotsUptimeByRollups(request, resolution)
+	}
+
 	timeFrom, timeTo, err := getTimeInt64(request.GetTimeRange())
 	if err != nil {
 		return nil, err
@@ -122,6 +243,14 @@ func getCodeString(code apiPb.SchedulerCode) string {
 	return fmt.Sprintf(`"%s"."code" = '%d'`, dbSnapshotCollection, code)
 }
 
+func getSnapshotKeys(snapshots []*Snapshot) (*cursor, *cursor) {
+	if len(snapshots) == 0 {
+		return nil, nil
+	}
+	first, last := snapshots[0], snapshots[len(snapshots)-1]
+	return &cursor{Time: first.MetaStartTime, ID: first.ID}, &cursor{Time: last.MetaStartTime, ID: last.ID}
+}
+
 func getSnapshotOrder(request *apiPb.SortingSchedulerList) string {
 	if request == nil {
 		return fmt.Sprintf(`"%s"."metaStartTime"`, dbSnapshotCollection)
@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"database/sql"
+	"database/sql/driver"
 	"fmt"
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/jinzhu/gorm"
@@ -73,6 +74,53 @@ func (s *SuiteSnapshot) Test_Snapshots() {
 	require.NoError(s.T(), err)
 }
 
+func (s *SuiteSnapshot) Test_SnapshotsBatch() {
+	args := make([]driver.Value, 0, snapshotInsertColumnsCount*2)
+	for i := 0; i < snapshotInsertColumnsCount*2; i++ {
+		args = append(args, sqlmock.AnyArg())
+	}
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(regexp.QuoteMeta(fmt.Sprintf(`INSERT INTO "%s" ("created_at","updated_at","schedulerId","code","type","error","metaStartTime","metaEndTime","metaValue") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9),($10,`, dbSnapshotCollection))).
+		WithArgs(args...).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	s.mock.ExpectCommit()
+
+	correctTime := timestamp.Now()
+	response := &apiPb.SchedulerResponse{
+		SchedulerId: "schId",
+		Snapshot: &apiPb.SchedulerSnapshot{
+			Meta: &apiPb.SchedulerSnapshot_MetaData{
+				StartTime: correctTime,
+				EndTime:   correctTime,
+			},
+		},
+	}
+
+	err := postgrSnapshot.InsertSnapshots([]*apiPb.SchedulerResponse{response, response})
+	require.NoError(s.T(), err)
+}
+
+func TestPostgres_InsertSnapshotsBatch(t *testing.T) {
+	t.Run("Should: return indexes of not valid snapshots", func(t *testing.T) {
+		err := postgrSnapshot.InsertSnapshots([]*apiPb.SchedulerResponse{{}, {}})
+		assert.Equal(t, &InsertSnapshotsError{Failed: []int{0, 1}}, err)
+	})
+	t.Run("Should: return error", func(t *testing.T) {
+		correctTime := timestamp.Now()
+		err := postgrWrongSnapshot.InsertSnapshots([]*apiPb.SchedulerResponse{
+			{
+				Snapshot: &apiPb.SchedulerSnapshot{
+					Meta: &apiPb.SchedulerSnapshot_MetaData{
+						StartTime: correctTime,
+						EndTime:   correctTime,
+					},
+				},
+			},
+		})
+		assert.Error(t, err)
+	})
+}
+
 func TestPostgres_InsertSnapshots(t *testing.T) {
 	t.Run("Should: return conv error", func(t *testing.T) {
 		err := postgrSnapshot.InsertSnapshot(&apiPb.SchedulerResponse{})
@@ -112,7 +160,7 @@ func (s *SuiteSnapshot) Test_GetSnapshots() {
 		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 		WillReturnRows(rows)
 
-	_, _, err := postgrSnapshot.GetSnapshots(&apiPb.GetSchedulerInformationRequest{
+	_, _, _, err := postgrSnapshot.GetSnapshots(&apiPb.GetSchedulerInformationRequest{
 		SchedulerId: id,
 		Sort: &apiPb.SortingSchedulerList{
 			SortBy:    -1,
@@ -122,6 +170,29 @@ func (s *SuiteSnapshot) Test_GetSnapshots() {
 	require.NoError(s.T(), err)
 }
 
+func (s *SuiteSnapshot) Test_GetSnapshots_OrderedById() {
+	var (
+		id = "1"
+	)
+
+	query := fmt.Sprintf(`SELECT count(*) FROM "%s"`, dbSnapshotCollection)
+	rows := sqlmock.NewRows([]string{"count"}).AddRow("1")
+	s.mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(id, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(rows)
+
+	query = fmt.Sprintf(`ORDER BY "%[1]s"."metaStartTime" desc,"%[1]s"."id" desc`, dbSnapshotCollection)
+	rows = sqlmock.NewRows([]string{"id"}).AddRow("1")
+	s.mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(rows)
+
+	_, _, _, err := postgrSnapshot.GetSnapshots(&apiPb.GetSchedulerInformationRequest{
+		SchedulerId: id,
+	})
+	require.NoError(s.T(), err)
+}
+
 func (s *SuiteSnapshot) Test_GetSnapshots_WithStatus() {
 	var (
 		id = "1"
@@ -139,7 +210,7 @@ func (s *SuiteSnapshot) Test_GetSnapshots_WithStatus() {
 		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 		WillReturnRows(rows)
 
-	_, _, err := postgrSnapshot.GetSnapshots(&apiPb.GetSchedulerInformationRequest{
+	_, _, _, err := postgrSnapshot.GetSnapshots(&apiPb.GetSchedulerInformationRequest{
 		SchedulerId: id,
 		Sort: &apiPb.SortingSchedulerList{
 			SortBy:    apiPb.SortSchedulerList_BY_LATENCY,
@@ -150,6 +221,42 @@ func (s *SuiteSnapshot) Test_GetSnapshots_WithStatus() {
 	require.NoError(s.T(), err)
 }
 
+func (s *SuiteSnapshot) Test_GetSnapshots_ByCursor() {
+	var (
+		id = "1"
+	)
+
+	query := fmt.Sprintf(`SELECT * FROM "%s"`, dbSnapshotCollection)
+	rows := sqlmock.NewRows([]string{"id", "metaStartTime"}).AddRow(2, 20).AddRow(1, 10)
+	s.mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(id, sqlmock.AnyArg(), sqlmock.AnyArg(), int64(30), 3).
+		WillReturnRows(rows)
+
+	res, count, cursors, err := postgrSnapshot.GetSnapshots(&apiPb.GetSchedulerInformationRequest{
+		SchedulerId: id,
+		Pagination: &apiPb.Pagination{
+			Cursor: encodeCursor(&cursor{Time: 30, ID: 3, Desc: true}),
+			Limit:  1,
+		},
+	})
+	require.NoError(s.T(), err)
+	assert.Len(s.T(), res, 1)
+	assert.Equal(s.T(), int32(0), count)
+	assert.Equal(s.T(), encodeCursor(&cursor{Time: 20, ID: 2, Desc: true}), cursors.GetNext())
+	assert.Equal(s.T(), encodeCursor(&cursor{Time: 20, ID: 2, Before: true, Desc: true}), cursors.GetPrev())
+}
+
+//Based on fact, that if request is not mocked, it will return error
+func (s *SuiteSnapshot) Test_GetSnapshots_ByCursor_Error() {
+	_, _, _, err := postgrSnapshot.GetSnapshots(&apiPb.GetSchedulerInformationRequest{
+		SchedulerId: "1",
+		Pagination: &apiPb.Pagination{
+			Cursor: encodeCursor(&cursor{Time: 30, ID: 3}),
+		},
+	})
+	require.Error(s.T(), err)
+}
+
 //Based on fact, that if request is not mocked, it will return error
 func (s *SuiteSnapshot) Test_GetSnapshots_Select_Error() {
 	var (
@@ -162,7 +269,7 @@ func (s *SuiteSnapshot) Test_GetSnapshots_Select_Error() {
 		WithArgs(id, sqlmock.AnyArg(), sqlmock.AnyArg()).
 		WillReturnRows(rows)
 
-	_, _, err := postgrSnapshot.GetSnapshots(
+	_, _, _, err := postgrSnapshot.GetSnapshots(
 		&apiPb.GetSchedulerInformationRequest{
 			SchedulerId: id,
 			Pagination: &apiPb.Pagination{
@@ -177,7 +284,7 @@ func TestPostgres_GetSnapshots(t *testing.T) {
 	//Time for invalid timestamp
 	maxValidSeconds := 253402300800
 	t.Run("Should: return error", func(t *testing.T) {
-		_, _, err := postgrWrongSnapshot.GetSnapshots(
+		_, _, _, err := postgrWrongSnapshot.GetSnapshots(
 			&apiPb.GetSchedulerInformationRequest{
 				SchedulerId: "",
 				Pagination:  nil,
@@ -189,9 +296,15 @@ func TestPostgres_GetSnapshots(t *testing.T) {
 		assert.Error(t, err)
 	})
 	t.Run("Should: return error", func(t *testing.T) {
-		_, _, err := postgrWrongSnapshot.GetSnapshots(&apiPb.GetSchedulerInformationRequest{})
+		_, _, _, err := postgrWrongSnapshot.GetSnapshots(&apiPb.GetSchedulerInformationRequest{})
 		assert.Error(t, err)
 	})
+	t.Run("Should: return error for invalid cursor", func(t *testing.T) {
+		_, _, _, err := postgrWrongSnapshot.GetSnapshots(&apiPb.GetSchedulerInformationRequest{
+			Pagination: &apiPb.Pagination{Cursor: "invalid"},
+		})
+		assert.Equal(t, errorInvalidCursor, err)
+	})
 }
 
 func (s *SuiteSnapshot) Test_GetSnapshotsUptime() {
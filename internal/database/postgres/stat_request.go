@@ -21,9 +21,10 @@ type StatRequest struct {
 }
 
 const (
-	cpuInfoKey  = "CPUInfo"
-	diskInfoKey = "DiskInfo"
-	netInfoKey  = "NetInfo"
+	cpuInfoKey    = "CPUInfo"
+	memoryInfoKey = "MemoryInfo"
+	diskInfoKey   = "DiskInfo"
+	netInfoKey    = "NetInfo"
 )
 
 type CPUInfo struct {
@@ -87,6 +88,7 @@ var (
 	agentIdFilterString         = fmt.Sprintf(`"%s"."agentID" = ?`, dbStatRequestCollection)
 	statRequestTimeFilterString = fmt.Sprintf(`"%s"."time" BETWEEN ? and ?`, dbStatRequestCollection)
 	statRequestTimeString       = "time"
+	statRequestKeyset           = newKeyset(dbStatRequestCollection, statRequestTimeString, true)
 )
 
 func (p *Postgres) InsertStatRequest(data *apiPb.Metric) error {
@@ -101,10 +103,22 @@ func (p *Postgres) InsertStatRequest(data *apiPb.Metric) error {
 	return nil
 }
 
-func (p *Postgres) GetStatRequest(agentID string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, error) {
+func (p *Postgres) GetStatRequest(agentID string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, *apiPb.PageCursors, error) {
+	if resolution := getRollupResolution(filter); resolution != 0 {
+		return p.getStatRequestsByRollups(agentID, pagination, filter, resolution, cpuInfoKey, memoryInfoKey, diskInfoKey, netInfoKey)
+	}
+
 	timeFrom, timeTo, err := getTime(filter)
 	if err != nil {
-		return nil, -1, err
+		return nil, -1, nil, err
+	}
+
+	c, err := getCursor(pagination)
+	if err != nil {
+		return nil, -1, nil, err
+	}
+	if c != nil {
+		return p.getStatRequestsByCursor(p.Db.Set("gorm:auto_preload", true), agentID, pagination, timeFrom, timeTo, c)
 	}
 
 	var count int64
@@ -113,7 +127,7 @@ func (p *Postgres) GetStatRequest(agentID string, pagination *apiPb.Pagination,
 		Where(statRequestTimeFilterString, timeFrom, timeTo).
 		Count(&count).Error
 	if err != nil {
-		return nil, -1, err
+		return nil, -1, nil, err
 	}
 
 	offset, limit := getOffsetAndLimit(count, pagination)
@@ -124,25 +138,39 @@ func (p *Postgres) GetStatRequest(agentID string, pagination *apiPb.Pagination,
 		Where(agentIdFilterString, agentID).
 		Where(statRequestTimeFilterString, timeFrom, timeTo).
 		Order(statRequestTimeString).
+		Order(statRequestKeyset.idColumn).
 		Offset(offset).
 		Limit(limit).
 		Find(&statRequests).Error
 
 	if err != nil {
-		return nil, -1, errorDataBase
+		return nil, -1, nil, errorDataBase
 	}
 
-	return ConvertFromPostgressStatRequests(statRequests), int32(count), nil
+	first, last := getStatRequestKeys(statRequests)
+	return ConvertFromPostgressStatRequests(statRequests), int32(count), getOffsetPageCursors(false, offset, len(statRequests), count, first, last), nil
 }
 
-func (p *Postgres) GetCPUInfo(agentID string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, error) {
+func (p *Postgres) GetCPUInfo(agentID string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, *apiPb.PageCursors, error) {
 	return p.getSpecialRecords(agentID, pagination, filter, cpuInfoKey)
 }
 
-func (p *Postgres) GetMemoryInfo(agentID string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, error) {
+func (p *Postgres) GetMemoryInfo(agentID string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, *apiPb.PageCursors, error) {
+	if resolution := getRollupResolution(filter); resolution != 0 {
+		return p.getStatRequestsByRollups(agentID, pagination, filter, resolution, memoryInfoKey)
+	}
+
 	timeFrom, timeTo, err := getTime(filter)
 	if err != nil {
-		return nil, -1, err
+		return nil, -1, nil, err
+	}
+
+	c, err := getCursor(pagination)
+	if err != nil {
+		return nil, -1, nil, err
+	}
+	if c != nil {
+		return p.getStatRequestsByCursor(p.Db.Preload(memoryInfoKey).Preload("MemoryInfo.Mem").Preload("MemoryInfo.Swap"), agentID, pagination, timeFrom, timeTo, c)
 	}
 
 	var count int64
@@ -151,43 +179,57 @@ func (p *Postgres) GetMemoryInfo(agentID string, pagination *apiPb.Pagination, f
 		Where(statRequestTimeFilterString, timeFrom, timeTo).
 		Count(&count).Error
 	if err != nil {
-		return nil, -1, err
+		return nil, -1, nil, err
 	}
 
 	offset, limit := getOffsetAndLimit(count, pagination)
 
 	var statRequests []*StatRequest
 	err = p.Db.
-		Preload("MemoryInfo").
+		Preload(memoryInfoKey).
 		Preload("MemoryInfo.Mem").
 		Preload("MemoryInfo.Swap").
 		Where(agentIdFilterString, agentID).
 		Where(statRequestTimeFilterString, timeFrom, timeTo).
 		Order(statRequestTimeString).
+		Order(statRequestKeyset.idColumn).
 		Offset(offset).
 		Limit(limit).
 		Find(&statRequests).
 		Error
 
 	if err != nil {
-		return nil, -1, errorDataBase
+		return nil, -1, nil, errorDataBase
 	}
 
-	return ConvertFromPostgressStatRequests(statRequests), int32(count), nil
+	first, last := getStatRequestKeys(statRequests)
+	return ConvertFromPostgressStatRequests(statRequests), int32(count), getOffsetPageCursors(false, offset, len(statRequests), count, first, last), nil
 }
 
-func (p *Postgres) GetDiskInfo(agentID string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, error) {
+func (p *Postgres) GetDiskInfo(agentID string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, *apiPb.PageCursors, error) {
 	return p.getSpecialRecords(agentID, pagination, filter, diskInfoKey)
 }
 
-func (p *Postgres) GetNetInfo(agentID string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, error) {
+func (p *Postgres) GetNetInfo(agentID string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, *apiPb.PageCursors, error) {
 	return p.getSpecialRecords(agentID, pagination, filter, netInfoKey)
 }
 
-func (p *Postgres) getSpecialRecords(agentID string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter, key string) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, error) {
+func (p *Postgres) getSpecialRecords(agentID string, pagination *apiPb.Pagination, filter *apiPb.TimeFilter, key string) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, *apiPb.PageCursors, error) {
+	if resolution := getRollupResolution(filter); resolution != 0 {
+		return p.getStatRequestsByRollups(agentID, pagination, filter, resolution, key)
+	}
+
 	timeFrom, timeTo, err := getTime(filter)
 	if err != nil {
-		return nil, -1, err
+		return nil, -1, nil, err
+	}
+
+	c, err := getCursor(pagination)
+	if err != nil {
+		return nil, -1, nil, err
+	}
+	if c != nil {
+		return p.getStatRequestsByCursor(p.Db.Preload(key), agentID, pagination, timeFrom, timeTo, c)
 	}
 
 	var count int64
@@ -196,7 +238,7 @@ func (p *Postgres) getSpecialRecords(agentID string, pagination *apiPb.Paginatio
 		Where(statRequestTimeFilterString, timeFrom, timeTo).
 		Count(&count).Error
 	if err != nil {
-		return nil, -1, err
+		return nil, -1, nil, err
 	}
 
 	offset, limit := getOffsetAndLimit(count, pagination)
@@ -207,14 +249,42 @@ func (p *Postgres) getSpecialRecords(agentID string, pagination *apiPb.Paginatio
 		Where(agentIdFilterString, agentID).
 		Where(statRequestTimeFilterString, timeFrom, timeTo).
 		Order(statRequestTimeString).
+		Order(statRequestKeyset.idColumn).
 		Offset(offset).
 		Limit(limit).
 		Find(&statRequests).
 		Error
 
 	if err != nil {
-		return nil, -1, errorDataBase
+		return nil, -1, nil, errorDataBase
+	}
+
+	first, last := getStatRequestKeys(statRequests)
+	return ConvertFromPostgressStatRequests(statRequests), int32(count), getOffsetPageCursors(false, offset, len(statRequests), count, first, last), nil
+}
+
+// Count is not calculated for pages selected by cursor, query has preloads of requested info
+func (p *Postgres) getStatRequestsByCursor(query *gorm.DB, agentID string, pagination *apiPb.Pagination, timeFrom, timeTo time.Time, c *cursor) ([]*apiPb.GetAgentInformationResponse_Statistic, int32, *apiPb.PageCursors, error) {
+	limit := getCursorLimit(pagination)
+
+	var statRequests []*StatRequest
+	query = query.
+		Where(agentIdFilterString, agentID).
+		Where(statRequestTimeFilterString, timeFrom, timeTo)
+	err := statRequestKeyset.apply(query, c, limit).Find(&statRequests).Error
+	if err != nil {
+		return nil, -1, nil, errorDataBase
 	}
 
-	return ConvertFromPostgressStatRequests(statRequests), int32(count), nil
+	hasMore := trimCursorPage(&statRequests, c, limit)
+	first, last := getStatRequestKeys(statRequests)
+	return ConvertFromPostgressStatRequests(statRequests), 0, getCursorPageCursors(c, hasMore, first, last), nil
+}
+
+func getStatRequestKeys(statRequests []*StatRequest) (*cursor, *cursor) {
+	if len(statRequests) == 0 {
+		return nil, nil
+	}
+	first, last := statRequests[0], statRequests[len(statRequests)-1]
+	return &cursor{Time: first.Time.UnixNano(), ID: first.ID}, &cursor{Time: last.Time.UnixNano(), ID: last.ID}
 }
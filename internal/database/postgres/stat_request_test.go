@@ -167,7 +167,7 @@ func (s *SuiteStatRequest) Test_GetStatRequest() {
 		WithArgs(sqlmock.AnyArg()).
 		WillReturnRows(rows)
 
-	_, _, err := postgrStatRequest.GetStatRequest(id, nil, nil)
+	_, _, _, err := postgrStatRequest.GetStatRequest(id, nil, nil)
 	require.NoError(s.T(), err)
 }
 
@@ -183,25 +183,52 @@ func (s *SuiteStatRequest) Test_GetStatRequest_Select_Error() {
 		WithArgs(id, sqlmock.AnyArg(), sqlmock.AnyArg()).
 		WillReturnRows(rows)
 
-	_, _, err := postgrStatRequest.GetStatRequest(id, &apiPb.Pagination{
+	_, _, _, err := postgrStatRequest.GetStatRequest(id, &apiPb.Pagination{
 		Page:  1, //random value
 		Limit: 2, //random value
 	}, nil)
 	require.Error(s.T(), err)
 }
 
+func (s *SuiteStatRequest) Test_GetStatRequest_ByCursor() {
+	var (
+		id = "1"
+	)
+
+	query := fmt.Sprintf(`SELECT * FROM "%s"`, dbStatRequestCollection)
+	rows := sqlmock.NewRows([]string{"id"})
+	s.mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(id, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), 2).
+		WillReturnRows(rows)
+
+	res, _, cursors, err := postgrStatRequest.GetCPUInfo(id, &apiPb.Pagination{
+		Cursor: encodeCursor(&cursor{Time: 20, ID: 2}),
+	}, nil)
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), res)
+	assert.Equal(s.T(), &apiPb.PageCursors{}, cursors)
+}
+
+//Based on fact, that if request is not mocked, it will return error
+func (s *SuiteStatRequest) Test_GetStatRequest_ByCursor_Error() {
+	_, _, _, err := postgrStatRequest.GetMemoryInfo("1", &apiPb.Pagination{
+		Cursor: encodeCursor(&cursor{Time: 20, ID: 2}),
+	}, nil)
+	require.Error(s.T(), err)
+}
+
 func TestPostgres_GetStatRequest(t *testing.T) {
 	//Time for invalid timestamp
 	maxValidSeconds := 253402300800
 	t.Run("Should: return error", func(t *testing.T) {
-		_, _, err := postgrWrongStatRequest.GetStatRequest("", nil, &apiPb.TimeFilter{
+		_, _, _, err := postgrWrongStatRequest.GetStatRequest("", nil, &apiPb.TimeFilter{
 			From: &timestamp.Timestamp{Seconds: int64(maxValidSeconds), Nanos: 0},
 			To:   &timestamp.Timestamp{Seconds: int64(maxValidSeconds), Nanos: 0},
 		})
 		assert.Error(t, err)
 	})
 	t.Run("Should: return error", func(t *testing.T) {
-		_, _, err := postgrWrongStatRequest.GetStatRequest("", nil, nil)
+		_, _, _, err := postgrWrongStatRequest.GetStatRequest("", nil, nil)
 		assert.Error(t, err)
 	})
 }
@@ -228,7 +255,7 @@ func (s *SuiteStatRequest) Test_GetCpuInfo() {
 		WithArgs(sqlmock.AnyArg()).
 		WillReturnRows(rows)
 
-	_, _, err := postgrStatRequest.GetCPUInfo(id, nil, nil)
+	_, _, _, err := postgrStatRequest.GetCPUInfo(id, nil, nil)
 	require.NoError(s.T(), err)
 }
 
@@ -239,7 +266,7 @@ func (s *SuiteStatRequest) Test_GetCpuInfo_Count_Error() {
 		id = "1"
 	)
 
-	_, _, err := postgrStatRequest.GetCPUInfo(id, nil, nil)
+	_, _, _, err := postgrStatRequest.GetCPUInfo(id, nil, nil)
 	require.Error(s.T(), err)
 }
 
@@ -256,7 +283,7 @@ func (s *SuiteStatRequest) Test_GetCpuInfo_Select_Error() {
 		WithArgs(id, sqlmock.AnyArg(), sqlmock.AnyArg()).
 		WillReturnRows(rows)
 
-	_, _, err := postgrStatRequest.GetCPUInfo(id, &apiPb.Pagination{
+	_, _, _, err := postgrStatRequest.GetCPUInfo(id, &apiPb.Pagination{
 		Page:  1, //random value
 		Limit: 2, //random value
 	}, nil)
@@ -268,7 +295,7 @@ func TestPostgres_GetCpuInfo(t *testing.T) {
 	//Time for invalid timestamp
 	maxValidSeconds := 253402300800
 	t.Run("Should: return error", func(t *testing.T) {
-		_, _, err := postgrWrongStatRequest.GetCPUInfo("", nil, &apiPb.TimeFilter{
+		_, _, _, err := postgrWrongStatRequest.GetCPUInfo("", nil, &apiPb.TimeFilter{
 			From: &timestamp.Timestamp{Seconds: int64(maxValidSeconds), Nanos: 0},
 			To:   &timestamp.Timestamp{Seconds: int64(maxValidSeconds), Nanos: 0},
 		})
@@ -298,7 +325,7 @@ func (s *SuiteStatRequest) Test_GetMemoryInfo() {
 		WithArgs(sqlmock.AnyArg()).
 		WillReturnRows(rows)
 
-	_, _, err := postgrStatRequest.GetMemoryInfo(id, nil, nil)
+	_, _, _, err := postgrStatRequest.GetMemoryInfo(id, nil, nil)
 	require.NoError(s.T(), err)
 }
 
@@ -314,7 +341,7 @@ func (s *SuiteStatRequest) Test_GetMemoryInfo_Select_Error() {
 		WithArgs(id, sqlmock.AnyArg(), sqlmock.AnyArg()).
 		WillReturnRows(rows)
 
-	_, _, err := postgrStatRequest.GetMemoryInfo(id, &apiPb.Pagination{
+	_, _, _, err := postgrStatRequest.GetMemoryInfo(id, &apiPb.Pagination{
 		Page:  1, //random value
 		Limit: 2, //random value
 	}, nil)
@@ -325,14 +352,14 @@ func TestPostgres_GetMemoryInfo(t *testing.T) {
 	//Time for invalid timestamp
 	maxValidSeconds := 253402300800
 	t.Run("Should: return error", func(t *testing.T) {
-		_, _, err := postgrWrongStatRequest.GetMemoryInfo("", nil, &apiPb.TimeFilter{
+		_, _, _, err := postgrWrongStatRequest.GetMemoryInfo("", nil, &apiPb.TimeFilter{
 			From: &timestamp.Timestamp{Seconds: int64(maxValidSeconds), Nanos: 0},
 			To:   &timestamp.Timestamp{Seconds: int64(maxValidSeconds), Nanos: 0},
 		})
 		assert.Error(t, err)
 	})
 	t.Run("Should: return error", func(t *testing.T) {
-		_, _, err := postgrWrongStatRequest.GetMemoryInfo("", nil, nil)
+		_, _, _, err := postgrWrongStatRequest.GetMemoryInfo("", nil, nil)
 		assert.Error(t, err)
 	})
 }
@@ -359,7 +386,7 @@ func (s *SuiteStatRequest) Test_GetDiskInfo() {
 		WithArgs(sqlmock.AnyArg()).
 		WillReturnRows(rows)
 
-	_, _, err := postgrStatRequest.GetDiskInfo(id, nil, nil)
+	_, _, _, err := postgrStatRequest.GetDiskInfo(id, nil, nil)
 	require.NoError(s.T(), err)
 }
 
@@ -385,7 +412,7 @@ func (s *SuiteStatRequest) Test_GetNetInfo() {
 		WithArgs(sqlmock.AnyArg()).
 		WillReturnRows(rows)
 
-	_, _, err := postgrStatRequest.GetNetInfo(id, nil, nil)
+	_, _, _, err := postgrStatRequest.GetNetInfo(id, nil, nil)
 	require.NoError(s.T(), err)
 }
 
@@ -5,7 +5,7 @@ import (
 	"github.com/jinzhu/gorm"
 	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
 	wrappers "google.golang.org/protobuf/types/known/wrapperspb"
-	"strings"
+	"time"
 )
 
 type TransactionInfo struct {
@@ -25,13 +25,25 @@ type TransactionInfo struct {
 }
 
 type GroupResult struct {
-	Name         string `gorm:"column:groupName"`
-	Count        int64  `gorm:"column:count"`
-	SuccessCount int64  `gorm:"column:successCount"`
-	Latency      string `gorm:"column:latency"`
-	MinTime      string `gorm:"column:minTime"`
-	MaxTime      string `gorm:"column:maxTime"`
-	LowTime      string `gorm:"column:lowTime"`
+	Name         string  `gorm:"column:groupName"`
+	Count        int64   `gorm:"column:count"`
+	SuccessCount int64   `gorm:"column:successCount"`
+	Latency      string  `gorm:"column:latency"`
+	MinTime      string  `gorm:"column:minTime"`
+	MaxTime      string  `gorm:"column:maxTime"`
+	LowTime      string  `gorm:"column:lowTime"`
+	ErrorCount   int64   `gorm:"column:errorCount"`
+	Satisfied    int64   `gorm:"column:satisfiedCount"`
+	Tolerating   int64   `gorm:"column:toleratingCount"`
+	LatencyP50   float64 `gorm:"column:latencyP50"`
+	LatencyP90   float64 `gorm:"column:latencyP90"`
+	LatencyP95   float64 `gorm:"column:latencyP95"`
+	LatencyP99   float64 `gorm:"column:latencyP99"`
+}
+
+type groupLatency struct {
+	Name    string `gorm:"column:groupName"`
+	Latency int64  `gorm:"column:latency"`
 }
 
 const (
@@ -40,11 +52,19 @@ const (
 	transMetaMethodStr      = "metaMethod"
 	transMetaPathStr        = "metaPath"
 	transTransactionTypeStr = "transactionType"
+
+	// Milliseconds
+	defaultApdexThreshold = 500
 )
 
 var (
-	applicationIdFilterString        = fmt.Sprintf(`"%s"."applicationId" = ?`, dbTransactionInfoCollection)
-	applicationStartTimeFilterString = fmt.Sprintf(`"%s"."startTime" BETWEEN ? and ?`, dbTransactionInfoCollection)
+	applicationIdFilterString          = fmt.Sprintf(`"%s"."applicationId" = ?`, dbTransactionInfoCollection)
+	applicationStartTimeFilterString   = fmt.Sprintf(`"%s"."startTime" BETWEEN ? and ?`, dbTransactionInfoCollection)
+	transactionKeyset                  = newKeyset(dbTransactionInfoCollection, "startTime", false)
+	transactionPercentilesSelectString = fmt.Sprintf(
+		`, percentile_cont(0.5) WITHIN GROUP (ORDER BY %s) as "latencyP50", percentile_cont(0.9) WITHIN GROUP (ORDER BY %s) as "latencyP90", percentile_cont(0.95) WITHIN GROUP (ORDER BY %s) as "latencyP95", percentile_cont(0.99) WITHIN GROUP (ORDER BY %s) as "latencyP99"`,
+		transactionLatencyString, transactionLatencyString, transactionLatencyString, transactionLatencyString,
+	)
 
 	transOrderMap = map[apiPb.SortTransactionList]string{
 		apiPb.SortTransactionList_SORT_TRANSACTION_LIST_UNSPECIFIED: fmt.Sprintf(`"%s"."startTime"`, dbTransactionInfoCollection),
@@ -73,10 +93,18 @@ func (p *Postgres) InsertTransactionInfo(data *apiPb.TransactionInfo) error {
 	return nil
 }
 
-func (p *Postgres) GetTransactionInfo(request *apiPb.GetTransactionsRequest) ([]*apiPb.TransactionInfo, int64, error) {
+func (p *Postgres) GetTransactionInfo(request *apiPb.GetTransactionsRequest) ([]*apiPb.TransactionInfo, int64, *apiPb.PageCursors, error) {
 	timeFrom, timeTo, err := getTimeInt64(request.TimeRange)
 	if err != nil {
-		return nil, -1, err
+		return nil, -1, nil, err
+	}
+
+	c, err := getCursor(request.GetPagination())
+	if err != nil {
+		return nil, -1, nil, err
+	}
+	if c != nil {
+		return p.getTransactionInfoByCursor(request, timeFrom, timeTo, c)
 	}
 
 	var count int64
@@ -91,12 +119,11 @@ func (p *Postgres) GetTransactionInfo(request *apiPb.GetTransactionsRequest) ([]
 		Where(getTransactionStatusWhere(request.GetStatus())).
 		Count(&count).Error
 	if err != nil {
-		return nil, -1, err
+		return nil, -1, nil, err
 	}
 
 	offset, limit := getOffsetAndLimit(count, request.GetPagination())
 
-	//TODO: order
 	var statRequests []*TransactionInfo
 	err = p.Db.Table(dbTransactionInfoCollection).
 		Where(applicationIdFilterString, request.GetApplicationId()).
@@ -107,17 +134,49 @@ func (p *Postgres) GetTransactionInfo(request *apiPb.GetTransactionsRequest) ([]
 		Where(getTransactionsByString(transMetaMethodStr, request.GetMethod())).
 		Where(getTransactionTypeWhere(request.GetType())).
 		Where(getTransactionStatusWhere(request.GetStatus())).
-		Order(getTransactionOrder(request.GetSort()) + getTransactionDirection(request.GetSort())). //TODO
+		Order(getTransactionOrder(request.GetSort()) + getTransactionDirection(request.GetSort())).
+		Order(transactionKeyset.idColumn + getTransactionDirection(request.GetSort())).
 		Offset(offset).
 		Limit(limit).
 		Find(&statRequests).
 		Error
 
 	if err != nil {
-		return nil, -1, errorDataBase
+		return nil, -1, nil, errorDataBase
+	}
+
+	cursors := &apiPb.PageCursors{}
+	// Cursors can continue only the pages ordered by keyset
+	if getTransactionOrder(request.GetSort()) == transactionKeyset.timeColumn {
+		first, last := getTransactionKeys(statRequests)
+		cursors = getOffsetPageCursors(getTransactionDirection(request.GetSort()) == descPrefix, offset, len(statRequests), count, first, last)
+	}
+
+	return convertFromTransactions(statRequests), count, cursors, nil
+}
+
+// Count is not calculated for pages selected by cursor
+func (p *Postgres) getTransactionInfoByCursor(request *apiPb.GetTransactionsRequest, timeFrom, timeTo int64, c *cursor) ([]*apiPb.TransactionInfo, int64, *apiPb.PageCursors, error) {
+	limit := getCursorLimit(request.GetPagination())
+
+	var transactions []*TransactionInfo
+	query := p.Db.Table(dbTransactionInfoCollection).
+		Where(applicationIdFilterString, request.GetApplicationId()).
+		Where(applicationStartTimeFilterString, timeFrom, timeTo).
+		Where(getTransactionsByString(transMetaHostStr, request.GetHost())).
+		Where(getTransactionsByString(transNameStr, request.GetName())).
+		Where(getTransactionsByString(transMetaPathStr, request.GetPath())).
+		Where(getTransactionsByString(transMetaMethodStr, request.GetMethod())).
+		Where(getTransactionTypeWhere(request.GetType())).
+		Where(getTransactionStatusWhere(request.GetStatus()))
+	err := transactionKeyset.apply(query, c, limit).Find(&transactions).Error
+	if err != nil {
+		return nil, -1, nil, errorDataBase
 	}
 
-	return convertFromTransactions(statRequests), count, nil
+	hasMore := trimCursorPage(&transactions, c, limit)
+	first, last := getTransactionKeys(transactions)
+	return convertFromTransactions(transactions), 0, getCursorPageCursors(c, hasMore, first, last), nil
 }
 
 func (p *Postgres) GetTransactionByID(request *apiPb.GetTransactionByIdRequest) (*apiPb.TransactionInfo, []*apiPb.TransactionInfo, error) {
@@ -130,7 +189,7 @@ func (p *Postgres) GetTransactionByID(request *apiPb.GetTransactionByIdRequest)
 		return nil, nil, err
 	}
 
-	children, err := p.GetTransactionChildren(transaction.TransactionId, "")
+	children, err := p.GetTransactionChildren(transaction.TransactionId)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -138,33 +197,6 @@ func (p *Postgres) GetTransactionByID(request *apiPb.GetTransactionByIdRequest)
 	return convertFromTransaction(&transaction), convertFromTransactions(children), nil
 }
 
-//passedString is used in order to prevent cycles
-func (p *Postgres) GetTransactionChildren(transactionId, passedString string) ([]*TransactionInfo, error) {
-	if strings.Contains(passedString, transactionId) {
-		return nil, nil
-	}
-
-	var childrenTransactions []*TransactionInfo
-	err := p.Db.Table(dbTransactionInfoCollection).
-		Where(fmt.Sprintf(`"%s"."parentId" = ?`, dbTransactionInfoCollection), transactionId).
-		Find(&childrenTransactions).
-		Error
-	if err != nil {
-		return nil, errorDataBase
-	}
-
-	res := childrenTransactions
-	for _, v := range childrenTransactions {
-		subchildren, err := p.GetTransactionChildren(v.TransactionId, passedString+" "+v.ParentId)
-		if err != nil {
-			return nil, errorDataBase
-		}
-		res = append(res, subchildren...)
-	}
-
-	return res, nil
-}
-
 func (p *Postgres) GetTransactionGroup(request *apiPb.GetTransactionGroupRequest) (map[string]*apiPb.TransactionGroup, error) {
 	timeFrom, timeTo, err := getTimeInt64(request.TimeRange)
 	if err != nil {
@@ -185,14 +217,17 @@ func (p *Postgres) GetTransactionGroup(request *apiPb.GetTransactionGroupRequest
 		dbTransactionInfoCollection,
 	)
 
+	selectString += getTransactionApdexSelect(request.GetApdexThreshold())
+	// Percentile is not supported by sqlite, so it is calculated after grouping
+	isSqlite := p.Db.Dialect().GetName() == "sqlite3"
+	if !isSqlite {
+		selectString += transactionPercentilesSelectString
+	}
+
 	//TODO: order
 	var groupResult []*GroupResult
-	err = p.Db.Table(dbTransactionInfoCollection).
+	err = p.getTransactionGroupQuery(request, timeFrom, timeTo).
 		Select(selectString).
-		Where(applicationIdFilterString, request.GetApplicationId()).
-		Where(applicationStartTimeFilterString, timeFrom, timeTo).
-		Where(getTransactionTypeWhere(request.GetType())).
-		Where(getTransactionStatusWhere(request.GetStatus())).
 		Group(getTransactionsGroupBy(request.GetGroupType())).
 		Find(&groupResult).
 		Error
@@ -200,9 +235,73 @@ func (p *Postgres) GetTransactionGroup(request *apiPb.GetTransactionGroupRequest
 		return nil, errorDataBase
 	}
 
+	if isSqlite {
+		err = p.setGroupPercentiles(request, timeFrom, timeTo, groupResult)
+		if err != nil {
+			return nil, errorDataBase
+		}
+	}
+
 	return convertFromGroupResult(groupResult, timeTo), nil
 }
 
+func (p *Postgres) getTransactionGroupQuery(request *apiPb.GetTransactionGroupRequest, timeFrom, timeTo int64) *gorm.DB {
+	return p.Db.Table(dbTransactionInfoCollection).
+		Where(applicationIdFilterString, request.GetApplicationId()).
+		Where(applicationStartTimeFilterString, timeFrom, timeTo).
+		Where(getTransactionTypeWhere(request.GetType())).
+		Where(getTransactionStatusWhere(request.GetStatus()))
+}
+
+// Latencies are selected ordered, so the nearest rank is taken for each group
+func (p *Postgres) setGroupPercentiles(request *apiPb.GetTransactionGroupRequest, timeFrom, timeTo int64, groups []*GroupResult) error {
+	groupBy := getTransactionsGroupBy(request.GetGroupType())
+	var latencies []*groupLatency
+	err := p.getTransactionGroupQuery(request, timeFrom, timeTo).
+		Select(fmt.Sprintf(`%s as "groupName", %s as "latency"`, groupBy, transactionLatencyString)).
+		Order(fmt.Sprintf(`%s, %s`, groupBy, transactionLatencyString)).
+		Find(&latencies).
+		Error
+	if err != nil {
+		return err
+	}
+
+	byGroup := map[string][]float64{}
+	for _, v := range latencies {
+		byGroup[v.Name] = append(byGroup[v.Name], float64(v.Latency))
+	}
+	for _, group := range groups {
+		sorted := byGroup[group.Name]
+		group.LatencyP50 = percentile(sorted, 0.5)
+		group.LatencyP90 = percentile(sorted, 0.9)
+		group.LatencyP95 = percentile(sorted, 0.95)
+		group.LatencyP99 = percentile(sorted, 0.99)
+	}
+	return nil
+}
+
+// Successful transactions are satisfied till threshold and tolerating till four thresholds, failed ones are frustrated
+func getTransactionApdexSelect(threshold float64) string {
+	if threshold <= 0 {
+		threshold = defaultApdexThreshold
+	}
+	thresholdNanos := int64(threshold * float64(time.Millisecond))
+	return fmt.Sprintf(
+		`, COUNT(CASE WHEN "%s"."transactionStatus" = '%d' THEN 1 ELSE NULL END) as "errorCount", COUNT(CASE WHEN "%s"."transactionStatus" = '%d' AND %s <= %d THEN 1 ELSE NULL END) as "satisfiedCount", COUNT(CASE WHEN "%s"."transactionStatus" = '%d' AND %s > %d AND %s <= %d THEN 1 ELSE NULL END) as "toleratingCount"`,
+		dbTransactionInfoCollection, apiPb.TransactionStatus_TRANSACTION_FAILED,
+		dbTransactionInfoCollection, apiPb.TransactionStatus_TRANSACTION_SUCCESSFUL, transactionLatencyString, thresholdNanos,
+		dbTransactionInfoCollection, apiPb.TransactionStatus_TRANSACTION_SUCCESSFUL, transactionLatencyString, thresholdNanos, transactionLatencyString, 4*thresholdNanos,
+	)
+}
+
+func getTransactionKeys(transactions []*TransactionInfo) (*cursor, *cursor) {
+	if len(transactions) == 0 {
+		return nil, nil
+	}
+	first, last := transactions[0], transactions[len(transactions)-1]
+	return &cursor{Time: first.StartTime, ID: first.ID}, &cursor{Time: last.StartTime, ID: last.ID}
+}
+
 func getTransactionOrder(request *apiPb.SortingTransactionList) string {
 	if request == nil {
 		return fmt.Sprintf(`"%s"."startTime"`, dbTransactionInfoCollection)
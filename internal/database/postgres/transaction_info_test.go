@@ -94,7 +94,7 @@ func (s *SuiteTransInfo) Test_GetTransactionInfo() {
 		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 		WillReturnRows(rows)
 
-	_, _, err := postgrTransInfo.GetTransactionInfo(
+	_, _, _, err := postgrTransInfo.GetTransactionInfo(
 		&apiPb.GetTransactionsRequest{
 			ApplicationId: id,
 			Host:          &wrappers.StringValue{Value: "q"},
@@ -106,13 +106,49 @@ func (s *SuiteTransInfo) Test_GetTransactionInfo() {
 	require.NoError(s.T(), err)
 }
 
+func (s *SuiteTransInfo) Test_GetTransactionInfo_ByCursor() {
+	var (
+		id = "1"
+	)
+
+	query := fmt.Sprintf(`SELECT * FROM "%s"`, dbTransactionInfoCollection)
+	rows := sqlmock.NewRows([]string{"id", "startTime"}).AddRow(1, 10)
+	s.mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(id, sqlmock.AnyArg(), sqlmock.AnyArg(), int64(20), 2).
+		WillReturnRows(rows)
+
+	res, _, cursors, err := postgrTransInfo.GetTransactionInfo(
+		&apiPb.GetTransactionsRequest{
+			ApplicationId: id,
+			Pagination: &apiPb.Pagination{
+				Cursor: encodeCursor(&cursor{Time: 20, ID: 2, Before: true}),
+			},
+		})
+	require.NoError(s.T(), err)
+	assert.Len(s.T(), res, 1)
+	assert.NotEmpty(s.T(), cursors.GetNext())
+	assert.Empty(s.T(), cursors.GetPrev())
+}
+
+//Based on fact, that if request is not mocked, it will return error
+func (s *SuiteTransInfo) Test_GetTransactionInfo_ByCursor_Error() {
+	_, _, _, err := postgrTransInfo.GetTransactionInfo(
+		&apiPb.GetTransactionsRequest{
+			ApplicationId: "1",
+			Pagination: &apiPb.Pagination{
+				Cursor: encodeCursor(&cursor{Time: 20, ID: 2}),
+			},
+		})
+	require.Error(s.T(), err)
+}
+
 //Based on fact, that if request is not mocked, it will return error
 func (s *SuiteTransInfo) Test_GetTransactionInfo_CountError() {
 	var (
 		id = "1"
 	)
 
-	_, _, err := postgrTransInfo.GetTransactionInfo(
+	_, _, _, err := postgrTransInfo.GetTransactionInfo(
 		&apiPb.GetTransactionsRequest{
 			ApplicationId: id,
 			Type:          1,
@@ -134,7 +170,7 @@ func (s *SuiteTransInfo) Test_GetTransactionInfo_SelectError() {
 		WithArgs(id, sqlmock.AnyArg(), sqlmock.AnyArg()).
 		WillReturnRows(rows)
 
-	_, _, err := postgrTransInfo.GetTransactionInfo(
+	_, _, _, err := postgrTransInfo.GetTransactionInfo(
 		&apiPb.GetTransactionsRequest{
 			ApplicationId: id,
 			Type:          1,
@@ -148,7 +184,7 @@ func TestPostgres_GetTransactionInfo(t *testing.T) {
 	//Time for invalid timestamp
 	maxValidSeconds := 253402300800
 	t.Run("Should: return error", func(t *testing.T) {
-		_, _, err := postgrWrongTransInfo.GetTransactionInfo(
+		_, _, _, err := postgrWrongTransInfo.GetTransactionInfo(
 			&apiPb.GetTransactionsRequest{
 				TimeRange: &apiPb.TimeFilter{
 					From: &timestamp.Timestamp{Seconds: int64(maxValidSeconds), Nanos: 0},
@@ -170,10 +206,9 @@ func (s *SuiteTransInfo) Test_GetTransactionByID() {
 		WithArgs(sqlmock.AnyArg()).
 		WillReturnRows(rows)
 
-	query = fmt.Sprintf(`SELECT * FROM "%s"`, dbTransactionInfoCollection)
 	rows = sqlmock.NewRows([]string{"transactionId", "parentId"})
-	s.mock.ExpectQuery(regexp.QuoteMeta(query)).
-		WithArgs(sqlmock.AnyArg()).
+	s.mock.ExpectQuery(regexp.QuoteMeta(`WITH RECURSIVE "tree"`)).
+		WithArgs(id).
 		WillReturnRows(rows)
 
 	_, _, err := postgrTransInfo.GetTransactionByID(
@@ -216,27 +251,18 @@ func (s *SuiteTransInfo) Test_GetTransactionByID_Error() {
 }
 
 func (s *SuiteTransInfo) Test_GetTransactionChildren() {
-	query := fmt.Sprintf(`SELECT * FROM "%s"`, dbTransactionInfoCollection)
-	rows := sqlmock.NewRows([]string{"transactionId", "parentId"}).AddRow("1", "0")
-	s.mock.ExpectQuery(regexp.QuoteMeta(query)).
-		WithArgs(sqlmock.AnyArg()).
+	rows := sqlmock.NewRows([]string{"transactionId", "parentId"}).
+		AddRow("1", "0").
+		AddRow("2", "1").
+		//Cycle back to the parent
+		AddRow("0", "2")
+	s.mock.ExpectQuery(regexp.QuoteMeta(`WITH RECURSIVE "tree"`)).
+		WithArgs("0").
 		WillReturnRows(rows)
 
-	query = fmt.Sprintf(`SELECT * FROM "%s"`, dbTransactionInfoCollection)
-	rows = sqlmock.NewRows([]string{"transactionId", "parentId"}).AddRow("2", "1")
-	s.mock.ExpectQuery(regexp.QuoteMeta(query)).
-		WithArgs(sqlmock.AnyArg()).
-		WillReturnRows(rows)
-
-	//Check for sycle
-	query = fmt.Sprintf(`SELECT * FROM "%s"`, dbTransactionInfoCollection)
-	rows = sqlmock.NewRows([]string{"transactionId", "parentId"}).AddRow("1", "0")
-	s.mock.ExpectQuery(regexp.QuoteMeta(query)).
-		WithArgs(sqlmock.AnyArg()).
-		WillReturnRows(rows)
-
-	_, err := postgrTransInfo.GetTransactionChildren("0", "")
+	res, err := postgrTransInfo.GetTransactionChildren("0")
 	require.NoError(s.T(), err)
+	assert.Len(s.T(), res, 2)
 }
 
 //Based on fact, that if request is not mocked, it will return error
@@ -245,25 +271,7 @@ func (s *SuiteTransInfo) Test_GetTransactionChildren_Error() {
 		id = "1"
 	)
 
-	_, err := postgrTransInfo.GetTransactionChildren(id, "")
-	require.Error(s.T(), err)
-}
-
-//Based on fact, that if request is not mocked, it will return error
-func (s *SuiteTransInfo) Test_GetTransactionChildren_SubchildrenError() {
-	query := fmt.Sprintf(`SELECT * FROM "%s"`, dbTransactionInfoCollection)
-	rows := sqlmock.NewRows([]string{"transactionId", "parentId"}).AddRow("1", "0")
-	s.mock.ExpectQuery(regexp.QuoteMeta(query)).
-		WithArgs(sqlmock.AnyArg()).
-		WillReturnRows(rows)
-
-	query = fmt.Sprintf(`SELECT * FROM "%s"`, dbTransactionInfoCollection)
-	rows = sqlmock.NewRows([]string{"transactionId", "parentId"}).AddRow("2", "1")
-	s.mock.ExpectQuery(regexp.QuoteMeta(query)).
-		WithArgs(sqlmock.AnyArg()).
-		WillReturnRows(rows)
-
-	_, err := postgrTransInfo.GetTransactionChildren("0", "")
+	_, err := postgrTransInfo.GetTransactionChildren(id)
 	require.Error(s.T(), err)
 }
 
@@ -287,6 +295,24 @@ func (s *SuiteTransInfo) Test_GetTransactionGroup() {
 	require.NoError(s.T(), err)
 }
 
+func (s *SuiteTransInfo) Test_GetTransactionGroup_Apdex() {
+	query := `COUNT(CASE WHEN "transaction_infos"."transactionStatus" = '1' AND "transaction_infos"."endTime" - "transaction_infos"."startTime" <= 100000000 THEN 1 ELSE NULL END) as "satisfiedCount"`
+	rows := sqlmock.NewRows([]string{"groupName", "count", "latency", "minTime", "maxTime", "lowTime", "satisfiedCount", "latencyP90"}).
+		AddRow("name", 2, "10", "10", "10", "10", 1, 3000000)
+	s.mock.ExpectQuery(regexp.QuoteMeta(query) + ".*" + regexp.QuoteMeta(`percentile_cont(0.9) WITHIN GROUP`)).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(rows)
+
+	res, err := postgrTransInfo.GetTransactionGroup(&apiPb.GetTransactionGroupRequest{
+		ApplicationId:  "1",
+		GroupType:      2,
+		ApdexThreshold: 100,
+	})
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 0.5, res["name"].GetApdex())
+	assert.Equal(s.T(), float64(3), res["name"].GetLatencyP90())
+}
+
 //Based on fact, that if request is not mocked, it will return error
 func (s *SuiteTransInfo) Test_GetTransactionGroup_Error() {
 	_, err := postgrTransInfo.GetTransactionGroup(&apiPb.GetTransactionGroupRequest{
@@ -0,0 +1,125 @@
+package postgres
+
+import (
+	"fmt"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+)
+
+var (
+	// UNION drops rows which were already selected, so cycles of parentId do not make recursion endless
+	transactionTreeQueryString = `WITH RECURSIVE "tree" AS (
+	SELECT * FROM "%[1]s" WHERE "%[1]s"."%[2]s" = ? AND "%[1]s"."deleted_at" IS NULL
+	UNION
+	SELECT "%[1]s".* FROM "%[1]s" INNER JOIN "tree" ON "%[1]s"."parentId" = "tree"."transactionId" WHERE "%[1]s"."deleted_at" IS NULL
+) SELECT * FROM "tree" ORDER BY "startTime", "id"`
+	transactionTreeByIdQueryString       = fmt.Sprintf(transactionTreeQueryString, dbTransactionInfoCollection, "transactionId")
+	transactionTreeByParentIdQueryString = fmt.Sprintf(transactionTreeQueryString, dbTransactionInfoCollection, "parentId")
+)
+
+// Return all descendants of transaction ordered by start time
+func (p *Postgres) GetTransactionChildren(transactionId string) ([]*TransactionInfo, error) {
+	var transactions []*TransactionInfo
+	err := p.Db.Raw(transactionTreeByParentIdQueryString, transactionId).Scan(&transactions).Error
+	if err != nil {
+		return nil, errorDataBase
+	}
+
+	res := make([]*TransactionInfo, 0, len(transactions))
+	for _, transaction := range transactions {
+		// Transaction can be reached again only by cycle
+		if transaction.TransactionId == transactionId {
+			continue
+		}
+		res = append(res, transaction)
+	}
+	return res, nil
+}
+
+func (p *Postgres) GetTransactionTree(request *apiPb.GetTransactionByIdRequest) (*apiPb.GetTransactionTreeResponse, error) {
+	var transactions []*TransactionInfo
+	err := p.Db.Raw(transactionTreeByIdQueryString, request.GetTransactionId()).Scan(&transactions).Error
+	if err != nil {
+		return nil, errorDataBase
+	}
+	return buildTransactionTree(request.GetTransactionId(), transactions), nil
+}
+
+// Transactions are ordered by start time, so children of every node are ordered as well
+func buildTransactionTree(transactionId string, transactions []*TransactionInfo) *apiPb.GetTransactionTreeResponse {
+	var root *TransactionInfo
+	for _, transaction := range transactions {
+		if transaction.TransactionId == transactionId {
+			root = transaction
+			break
+		}
+	}
+	if root == nil {
+		return &apiPb.GetTransactionTreeResponse{}
+	}
+
+	// Child can start before parent because of clock skew, so nodes are linked after all of them are created
+	nodes := map[string]*apiPb.TransactionTreeNode{}
+	var unique []*TransactionInfo
+	for _, transaction := range transactions {
+		if _, ok := nodes[transaction.TransactionId]; ok {
+			continue
+		}
+		nodes[transaction.TransactionId] = newTransactionTreeNode(transaction, root)
+		unique = append(unique, transaction)
+	}
+	for _, transaction := range unique {
+		if transaction.TransactionId == root.TransactionId {
+			continue
+		}
+		if parent, ok := nodes[transaction.ParentId]; ok {
+			parent.Children = append(parent.Children, nodes[transaction.TransactionId])
+		}
+	}
+
+	rootNode := nodes[root.TransactionId]
+	count, end := walkTransactionTree(rootNode, 0)
+	return &apiPb.GetTransactionTreeResponse{
+		Root:     rootNode,
+		Duration: end,
+		Count:    count,
+	}
+}
+
+func newTransactionTreeNode(transaction, root *TransactionInfo) *apiPb.TransactionTreeNode {
+	return &apiPb.TransactionTreeNode{
+		Transaction: convertFromTransaction(transaction),
+		Offset:      transaction.StartTime - root.StartTime,
+		Duration:    transaction.EndTime - transaction.StartTime,
+	}
+}
+
+// Sets depth and self time of nodes, return count of nodes and the latest end offset of subtree
+func walkTransactionTree(node *apiPb.TransactionTreeNode, depth int32) (int32, int64) {
+	node.Depth = depth
+	count, end := int32(1), node.GetOffset()+node.GetDuration()
+
+	// Self time is duration without union of children intervals, children can run in parallel
+	var covered int64
+	coveredTill := node.GetOffset()
+	for _, child := range node.GetChildren() {
+		childCount, childEnd := walkTransactionTree(child, depth+1)
+		count += childCount
+		if childEnd > end {
+			end = childEnd
+		}
+
+		from, till := child.GetOffset(), child.GetOffset()+child.GetDuration()
+		if from < coveredTill {
+			from = coveredTill
+		}
+		if till > node.GetOffset()+node.GetDuration() {
+			till = node.GetOffset() + node.GetDuration()
+		}
+		if till > from {
+			covered += till - from
+			coveredTill = till
+		}
+	}
+	node.SelfTime = node.GetDuration() - covered
+	return count, end
+}
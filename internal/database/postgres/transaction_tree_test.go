@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"github.com/DATA-DOG/go-sqlmock"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+)
+
+func (s *SuiteTransInfo) Test_GetTransactionTree() {
+	rows := sqlmock.NewRows([]string{"transactionId", "parentId", "startTime", "endTime"}).
+		AddRow("1", "", 0, 100).
+		AddRow("2", "1", 10, 60)
+	s.mock.ExpectQuery(regexp.QuoteMeta(`WITH RECURSIVE "tree"`)).
+		WithArgs("1").
+		WillReturnRows(rows)
+
+	res, err := postgrTransInfo.GetTransactionTree(&apiPb.GetTransactionByIdRequest{TransactionId: "1"})
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int32(2), res.GetCount())
+	assert.Equal(s.T(), "2", res.GetRoot().GetChildren()[0].GetTransaction().GetId())
+}
+
+// Based on fact, that if request is not mocked, it will return error
+func (s *SuiteTransInfo) Test_GetTransactionTree_Error() {
+	_, err := postgrTransInfo.GetTransactionTree(&apiPb.GetTransactionByIdRequest{TransactionId: "1"})
+	require.Error(s.T(), err)
+}
+
+func TestBuildTransactionTree(t *testing.T) {
+	t.Run("Should: return empty tree without root", func(t *testing.T) {
+		res := buildTransactionTree("1", []*TransactionInfo{
+			{TransactionId: "2", ParentId: "1"},
+		})
+		assert.Nil(t, res.GetRoot())
+		assert.Equal(t, int32(0), res.GetCount())
+	})
+	t.Run("Should: build nested tree", func(t *testing.T) {
+		res := buildTransactionTree("1", []*TransactionInfo{
+			// Child with clock skew started before root
+			{TransactionId: "4", ParentId: "1", StartTime: 995, EndTime: 1005},
+			{TransactionId: "1", ParentId: "0", StartTime: 1000, EndTime: 1100},
+			{TransactionId: "2", ParentId: "1", StartTime: 1010, EndTime: 1050},
+			{TransactionId: "3", ParentId: "2", StartTime: 1020, EndTime: 1030},
+			// Parallel child overlaps previous one
+			{TransactionId: "5", ParentId: "1", StartTime: 1040, EndTime: 1120},
+			// Duplicate of transaction is skipped
+			{TransactionId: "3", ParentId: "2", StartTime: 1025, EndTime: 1030},
+		})
+		assert.Equal(t, int32(5), res.GetCount())
+		assert.Equal(t, int64(120), res.GetDuration())
+
+		root := res.GetRoot()
+		assert.Equal(t, "1", root.GetTransaction().GetId())
+		assert.Equal(t, int32(0), root.GetDepth())
+		require.Len(t, root.GetChildren(), 3)
+		assert.Equal(t, "4", root.GetChildren()[0].GetTransaction().GetId())
+		assert.Equal(t, int64(-5), root.GetChildren()[0].GetOffset())
+		// Children cover 0-5, 10-50 and 50-100 of root
+		assert.Equal(t, int64(5), root.GetSelfTime())
+
+		child := root.GetChildren()[1]
+		assert.Equal(t, int64(10), child.GetOffset())
+		assert.Equal(t, int64(40), child.GetDuration())
+		assert.Equal(t, int64(30), child.GetSelfTime())
+		require.Len(t, child.GetChildren(), 1)
+		assert.Equal(t, int32(2), child.GetChildren()[0].GetDepth())
+		assert.Equal(t, int64(10), child.GetChildren()[0].GetSelfTime())
+	})
+	t.Run("Should: not attach root to its child on cycle", func(t *testing.T) {
+		res := buildTransactionTree("1", []*TransactionInfo{
+			{TransactionId: "1", ParentId: "2", StartTime: 0, EndTime: 10},
+			{TransactionId: "2", ParentId: "1", StartTime: 1, EndTime: 5},
+		})
+		assert.Equal(t, int32(2), res.GetCount())
+		assert.Empty(t, res.GetRoot().GetChildren()[0].GetChildren())
+	})
+}
@@ -0,0 +1,91 @@
+package sqlite
+
+import (
+	"github.com/squzy/squzy/internal/database/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func hasIndex(t *testing.T, s *Sqlite, name string) bool {
+	var count int
+	require.NoError(t, s.Db.Table("sqlite_master").Where("type = 'index' AND name = ?", name).Count(&count).Error)
+	return count == 1
+}
+
+func TestSqlite_Migrate(t *testing.T) {
+	db, err := Open(":memory:")
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+	s := New(db)
+
+	t.Run("Should: apply all migrations", func(t *testing.T) {
+		require.NoError(t, s.Migrate())
+		version, err := s.GetSchemaVersion()
+		require.NoError(t, err)
+		assert.Equal(t, uint(6), version)
+		assert.True(t, hasIndex(t, s, "idx_snapshots_scheduler_time"))
+		assert.True(t, hasIndex(t, s, "idx_cpu_infos_stat_request"))
+		assert.True(t, s.Db.HasTable(&postgres.CPURollup{}))
+	})
+	t.Run("Should: not apply migrations twice", func(t *testing.T) {
+		require.NoError(t, s.Migrate())
+		var count int
+		require.NoError(t, s.Db.Model(&postgres.SchemaVersion{}).Count(&count).Error)
+		assert.Equal(t, 6, count)
+	})
+	t.Run("Should: rollback migrations", func(t *testing.T) {
+		require.NoError(t, s.MigrateTo(2))
+		version, err := s.GetSchemaVersion()
+		require.NoError(t, err)
+		assert.Equal(t, uint(2), version)
+		assert.False(t, hasIndex(t, s, "idx_snapshots_scheduler_time"))
+		assert.True(t, s.Db.HasTable(&postgres.SnapshotRollup{}))
+		assert.False(t, s.Db.HasTable(&postgres.CPURollup{}))
+
+		require.NoError(t, s.MigrateTo(0))
+		assert.False(t, s.Db.HasTable(&postgres.Snapshot{}))
+		assert.False(t, s.Db.HasTable(&postgres.SnapshotRollup{}))
+	})
+	t.Run("Should: migrate database created without versions", func(t *testing.T) {
+		require.NoError(t, s.Db.AutoMigrate(&postgres.Snapshot{}, &postgres.StatRequest{}).Error)
+		require.NoError(t, s.Migrate())
+		version, err := s.GetSchemaVersion()
+		require.NoError(t, err)
+		assert.Equal(t, uint(6), version)
+	})
+	t.Run("Should: return error for unknown version", func(t *testing.T) {
+		assert.Error(t, s.MigrateTo(100))
+	})
+}
+
+func TestSqlite_MigrateCPURollups(t *testing.T) {
+	db, err := Open(":memory:")
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+	s := New(db)
+	require.NoError(t, s.MigrateTo(5))
+	require.NoError(t, s.Db.Exec(
+		`INSERT INTO "stat_request_rollups" ("agentID", "resolution", "bucketStart", "count", "cpuLoadAvg", "cpuLoadMax", "swapUsedPercentAvg")
+		VALUES ('1', 3600, '2020-01-01 00:00:00+00:00', 10, 15, 30, 5)`,
+	).Error)
+
+	t.Run("Should: keep load of old rollups as the first core", func(t *testing.T) {
+		require.NoError(t, s.Migrate())
+		var cpus []*postgres.CPURollup
+		require.NoError(t, s.Db.Find(&cpus).Error)
+		require.Len(t, cpus, 1)
+		assert.Equal(t, 0, cpus[0].Core)
+		assert.Equal(t, int64(10), cpus[0].Count)
+		assert.Equal(t, float64(15), cpus[0].LoadAvg)
+		assert.Equal(t, float64(30), cpus[0].LoadMax)
+
+		var rollup postgres.StatRequestRollup
+		require.NoError(t, s.Db.First(&rollup).Error)
+		assert.Equal(t, float64(5), rollup.SwapUsedPercentMax)
+	})
+}
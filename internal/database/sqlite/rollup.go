@@ -0,0 +1,313 @@
+package sqlite
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/jinzhu/gorm"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"time"
+)
+
+const (
+	dbSnapshotCollection          = "snapshots"
+	dbTransactionInfoCollection   = "transaction_infos"
+	dbStatRequestCollection       = "stat_requests"
+	dbCPUInfoCollection           = "cpu_infos"
+	dbMemoryInfoCollection        = "memory_infos"
+	dbMemoryMemCollection         = "memory_mems"
+	dbMemorySwapCollection        = "memory_swaps"
+	dbDiskInfoCollection          = "disk_infos"
+	dbNetInfoCollection           = "net_infos"
+	dbSnapshotRollupCollection    = "snapshot_rollups"
+	dbStatRequestRollupCollection = "stat_request_rollups"
+	dbDiskRollupCollection        = "disk_rollups"
+	dbNetRollupCollection         = "net_rollups"
+	dbCPURollupCollection         = "cpu_rollups"
+	dbTransactionRollupCollection = "transaction_rollups"
+
+	// The same format as go-sqlite3 uses for time in UTC
+	timeFormatString = `'%Y-%m-%d %H:%M:%S+00:00'`
+
+	// Buckets of that range are rolled up by one transaction
+	rollupChunkRange = time.Hour * 24
+)
+
+var (
+	// Percentile is not supported by sqlite, so the nearest rank is taken by window functions
+	snapshotRollupString = fmt.Sprintf(
+		`INSERT INTO "%s" ("updated_at", "schedulerId", "resolution", "bucketStart", "count", "okCount", "latencyMin", "latencyAvg", "latencyMax", "latencyP50", "latencyP95", "latencyP99")
+		SELECT strftime(%s, 'now'), "schedulerId", ?, "bucket", COUNT(*), COUNT(*) FILTER (WHERE "ok"),
+			COALESCE(MIN("latency") FILTER (WHERE "ok"), 0), COALESCE(AVG("latency") FILTER (WHERE "ok"), 0),
+			COALESCE(MAX("latency") FILTER (WHERE "ok"), 0),
+			COALESCE(MIN("latency") FILTER (WHERE "ok" AND "rank" >= 0.5 * "total"), 0),
+			COALESCE(MIN("latency") FILTER (WHERE "ok" AND "rank" >= 0.95 * "total"), 0),
+			COALESCE(MIN("latency") FILTER (WHERE "ok" AND "rank" >= 0.99 * "total"), 0)
+		FROM (
+			SELECT "schedulerId", "bucket", "ok", "latency",
+				ROW_NUMBER() OVER (PARTITION BY "schedulerId", "bucket", "ok" ORDER BY "latency") AS "rank",
+				COUNT(*) OVER (PARTITION BY "schedulerId", "bucket", "ok") AS "total"
+			FROM (
+				SELECT "schedulerId", ("metaStartTime" / ?) * ? AS "bucket", "code" = %d AS "ok", "metaEndTime" - "metaStartTime" AS "latency"
+				FROM "%s"
+				WHERE "deleted_at" IS NULL AND "metaStartTime" >= ? AND "metaStartTime" < ?
+			)
+		)
+		WHERE true
+		GROUP BY "schedulerId", "bucket"
+		ON CONFLICT ("schedulerId", "resolution", "bucketStart") DO UPDATE SET
+			"updated_at" = excluded."updated_at", "count" = excluded."count", "okCount" = excluded."okCount",
+			"latencyMin" = excluded."latencyMin", "latencyAvg" = excluded."latencyAvg",
+			"latencyMax" = excluded."latencyMax", "latencyP50" = excluded."latencyP50",
+			"latencyP95" = excluded."latencyP95", "latencyP99" = excluded."latencyP99"`,
+		dbSnapshotRollupCollection, timeFormatString, apiPb.SchedulerCode_OK, dbSnapshotCollection,
+	)
+
+	transactionRollupString = fmt.Sprintf(
+		`INSERT INTO "%s" ("updated_at", "applicationId", "name", "resolution", "bucketStart", "count", "successCount", "latencyMin", "latencyAvg", "latencyMax", "latencyP95")
+		SELECT strftime(%s, 'now'), "applicationId", "name", ?, "bucket", COUNT(*), COUNT(*) FILTER (WHERE "success"),
+			MIN("latency"), AVG("latency"), MAX("latency"), MIN("latency") FILTER (WHERE "rank" >= 0.95 * "total")
+		FROM (
+			SELECT "applicationId", "name", "bucket", "success", "latency",
+				ROW_NUMBER() OVER (PARTITION BY "applicationId", "name", "bucket" ORDER BY "latency") AS "rank",
+				COUNT(*) OVER (PARTITION BY "applicationId", "name", "bucket") AS "total"
+			FROM (
+				SELECT "applicationId", "name", ("startTime" / ?) * ? AS "bucket", "transactionStatus" = %d AS "success", "endTime" - "startTime" AS "latency"
+				FROM "%s"
+				WHERE "deleted_at" IS NULL AND "startTime" >= ? AND "startTime" < ?
+			)
+		)
+		WHERE true
+		GROUP BY "applicationId", "name", "bucket"
+		ON CONFLICT ("applicationId", "name", "resolution", "bucketStart") DO UPDATE SET
+			"updated_at" = excluded."updated_at", "count" = excluded."count", "successCount" = excluded."successCount",
+			"latencyMin" = excluded."latencyMin", "latencyAvg" = excluded."latencyAvg",
+			"latencyMax" = excluded."latencyMax", "latencyP95" = excluded."latencyP95"`,
+		dbTransactionRollupCollection, timeFormatString, apiPb.TransactionStatus_TRANSACTION_SUCCESSFUL, dbTransactionInfoCollection,
+	)
+
+	statRequestBucketString = fmt.Sprintf(
+		`strftime(%s, (CAST(strftime('%%s', "%s"."time") AS INTEGER) / ?) * ?, 'unixepoch')`,
+		timeFormatString, dbStatRequestCollection,
+	)
+	statRequestFilterString = fmt.Sprintf(
+		`"%s"."deleted_at" IS NULL AND "%s"."time" >= ? AND "%s"."time" < ?`,
+		dbStatRequestCollection, dbStatRequestCollection, dbStatRequestCollection,
+	)
+
+	// Load is averaged by cpus of every stat request of the chunk, so the whole cpu table is not aggregated on every run
+	statRequestCPUString = fmt.Sprintf(
+		`SELECT "%s"."statRequestId", AVG("%s"."load") AS "load"
+		FROM "%s" JOIN "%s" AS "cpuRequest" ON "cpuRequest"."id" = "%s"."statRequestId"
+		WHERE "cpuRequest"."deleted_at" IS NULL AND "cpuRequest"."time" >= ? AND "cpuRequest"."time" < ?
+		GROUP BY "%s"."statRequestId"`,
+		dbCPUInfoCollection, dbCPUInfoCollection,
+		dbCPUInfoCollection, dbStatRequestCollection, dbCPUInfoCollection,
+		dbCPUInfoCollection,
+	)
+
+	statRequestRollupString = fmt.Sprintf(
+		`INSERT INTO "%s" ("updated_at", "agentID", "resolution", "bucketStart", "count", "cpuLoadAvg", "cpuLoadMax",
+			"memTotal", "memUsed", "memFree", "memShared", "memUsedPercentAvg", "memUsedPercentMax",
+			"swapTotal", "swapUsed", "swapFree", "swapShared", "swapUsedPercentAvg", "swapUsedPercentMax")
+		SELECT strftime(%s, 'now'), "%s"."agentID", ?, %s AS "bucket", COUNT(*), COALESCE(AVG("cpu"."load"), 0), COALESCE(MAX("cpu"."load"), 0),
+			CAST(COALESCE(AVG("mem"."total"), 0) AS INTEGER), CAST(COALESCE(AVG("mem"."used"), 0) AS INTEGER),
+			CAST(COALESCE(AVG("mem"."free"), 0) AS INTEGER), CAST(COALESCE(AVG("mem"."shared"), 0) AS INTEGER),
+			COALESCE(AVG("mem"."usedPercent"), 0), COALESCE(MAX("mem"."usedPercent"), 0),
+			CAST(COALESCE(AVG("swap"."total"), 0) AS INTEGER), CAST(COALESCE(AVG("swap"."used"), 0) AS INTEGER),
+			CAST(COALESCE(AVG("swap"."free"), 0) AS INTEGER), CAST(COALESCE(AVG("swap"."shared"), 0) AS INTEGER),
+			COALESCE(AVG("swap"."usedPercent"), 0), COALESCE(MAX("swap"."usedPercent"), 0)
+		FROM "%s"
+		LEFT JOIN (%s) AS "cpu" ON "cpu"."statRequestId" = "%s"."id"
+		LEFT JOIN "%s" ON "%s"."statRequestId" = "%s"."id"
+		LEFT JOIN "%s" AS "mem" ON "mem"."memoryInfoId" = "%s"."id"
+		LEFT JOIN "%s" AS "swap" ON "swap"."memoryInfoId" = "%s"."id"
+		WHERE %s
+		GROUP BY "%s"."agentID", "bucket"
+		ON CONFLICT ("agentID", "resolution", "bucketStart") DO UPDATE SET
+			"updated_at" = excluded."updated_at", "count" = excluded."count",
+			"cpuLoadAvg" = excluded."cpuLoadAvg", "cpuLoadMax" = excluded."cpuLoadMax",
+			"memTotal" = excluded."memTotal", "memUsed" = excluded."memUsed", "memFree" = excluded."memFree",
+			"memShared" = excluded."memShared", "memUsedPercentAvg" = excluded."memUsedPercentAvg",
+			"memUsedPercentMax" = excluded."memUsedPercentMax",
+			"swapTotal" = excluded."swapTotal", "swapUsed" = excluded."swapUsed", "swapFree" = excluded."swapFree",
+			"swapShared" = excluded."swapShared", "swapUsedPercentAvg" = excluded."swapUsedPercentAvg",
+			"swapUsedPercentMax" = excluded."swapUsedPercentMax"`,
+		dbStatRequestRollupCollection,
+		timeFormatString, dbStatRequestCollection, statRequestBucketString,
+		dbStatRequestCollection,
+		statRequestCPUString, dbStatRequestCollection,
+		dbMemoryInfoCollection, dbMemoryInfoCollection, dbStatRequestCollection,
+		dbMemoryMemCollection, dbMemoryInfoCollection,
+		dbMemorySwapCollection, dbMemoryInfoCollection,
+		statRequestFilterString,
+		dbStatRequestCollection,
+	)
+
+	cpuRollupString = fmt.Sprintf(
+		`INSERT INTO "%s" ("updated_at", "agentID", "resolution", "bucketStart", "core", "count", "loadAvg", "loadMax")
+		SELECT strftime(%s, 'now'), "agentID", ?, "bucket", "core", COUNT(*), AVG("load"), MAX("load")
+		FROM (
+			SELECT "%s"."agentID", %s AS "bucket", "%s"."load",
+				ROW_NUMBER() OVER (PARTITION BY "%s"."statRequestId" ORDER BY "%s"."id") - 1 AS "core"
+			FROM "%s"
+			JOIN "%s" ON "%s"."statRequestId" = "%s"."id"
+			WHERE %s
+		)
+		WHERE true
+		GROUP BY "agentID", "bucket", "core"
+		ON CONFLICT ("agentID", "resolution", "bucketStart", "core") DO UPDATE SET
+			"updated_at" = excluded."updated_at", "count" = excluded."count",
+			"loadAvg" = excluded."loadAvg", "loadMax" = excluded."loadMax"`,
+		dbCPURollupCollection,
+		timeFormatString,
+		dbStatRequestCollection, statRequestBucketString, dbCPUInfoCollection,
+		dbCPUInfoCollection, dbCPUInfoCollection,
+		dbStatRequestCollection,
+		dbCPUInfoCollection, dbCPUInfoCollection, dbStatRequestCollection,
+		statRequestFilterString,
+	)
+
+	diskRollupString = fmt.Sprintf(
+		`INSERT INTO "%s" ("updated_at", "agentID", "resolution", "bucketStart", "name", "total", "free", "used", "usedPercentAvg", "usedPercentMax")
+		SELECT strftime(%s, 'now'), "%s"."agentID", ?, %s AS "bucket", "%s"."name",
+			CAST(AVG("%s"."total") AS INTEGER), CAST(AVG("%s"."free") AS INTEGER), CAST(AVG("%s"."used") AS INTEGER),
+			AVG("%s"."usedPercent"), MAX("%s"."usedPercent")
+		FROM "%s"
+		JOIN "%s" ON "%s"."statRequestId" = "%s"."id"
+		WHERE %s
+		GROUP BY "%s"."agentID", "bucket", "%s"."name"
+		ON CONFLICT ("agentID", "resolution", "bucketStart", "name") DO UPDATE SET
+			"updated_at" = excluded."updated_at", "total" = excluded."total", "free" = excluded."free", "used" = excluded."used",
+			"usedPercentAvg" = excluded."usedPercentAvg", "usedPercentMax" = excluded."usedPercentMax"`,
+		dbDiskRollupCollection,
+		timeFormatString, dbStatRequestCollection, statRequestBucketString, dbDiskInfoCollection,
+		dbDiskInfoCollection, dbDiskInfoCollection, dbDiskInfoCollection, dbDiskInfoCollection, dbDiskInfoCollection,
+		dbStatRequestCollection,
+		dbDiskInfoCollection, dbDiskInfoCollection, dbStatRequestCollection,
+		statRequestFilterString,
+		dbStatRequestCollection, dbDiskInfoCollection,
+	)
+
+	netRollupString = fmt.Sprintf(
+		`INSERT INTO "%s" ("updated_at", "agentID", "resolution", "bucketStart", "name", "bytesSent", "bytesRecv", "packetsSent", "packetsRecv", "errIn", "errOut", "dropIn", "dropOut")
+		SELECT strftime(%s, 'now'), "%s"."agentID", ?, %s AS "bucket", "%s"."name",
+			MAX("%s"."bytesSent"), MAX("%s"."bytesRecv"), MAX("%s"."packetsSent"), MAX("%s"."packetsRecv"),
+			MAX("%s"."errIn"), MAX("%s"."errOut"), MAX("%s"."dropIn"), MAX("%s"."dropOut")
+		FROM "%s"
+		JOIN "%s" ON "%s"."statRequestId" = "%s"."id"
+		WHERE %s
+		GROUP BY "%s"."agentID", "bucket", "%s"."name"
+		ON CONFLICT ("agentID", "resolution", "bucketStart", "name") DO UPDATE SET
+			"updated_at" = excluded."updated_at", "bytesSent" = excluded."bytesSent", "bytesRecv" = excluded."bytesRecv",
+			"packetsSent" = excluded."packetsSent", "packetsRecv" = excluded."packetsRecv",
+			"errIn" = excluded."errIn", "errOut" = excluded."errOut", "dropIn" = excluded."dropIn", "dropOut" = excluded."dropOut"`,
+		dbNetRollupCollection,
+		timeFormatString, dbStatRequestCollection, statRequestBucketString, dbNetInfoCollection,
+		dbNetInfoCollection, dbNetInfoCollection, dbNetInfoCollection, dbNetInfoCollection,
+		dbNetInfoCollection, dbNetInfoCollection, dbNetInfoCollection, dbNetInfoCollection,
+		dbStatRequestCollection,
+		dbNetInfoCollection, dbNetInfoCollection, dbStatRequestCollection,
+		statRequestFilterString,
+		dbStatRequestCollection, dbNetInfoCollection,
+	)
+)
+
+// Rollup has the same behaviour as postgres one
+func (s *Sqlite) Rollup(resolution time.Duration, until time.Time) error {
+	seconds := int64(resolution / time.Second)
+	nanos := resolution.Nanoseconds()
+	to := until.Truncate(resolution).UTC()
+
+	from, err := s.firstRollupBucket(dbSnapshotRollupCollection, `MAX("bucketStart")`, dbSnapshotCollection, `MIN("metaStartTime")`, seconds, time.Nanosecond)
+	if err != nil {
+		return err
+	}
+	err = s.rollupByChunks(from.Truncate(resolution), to, resolution, func(tx *gorm.DB, chunkFrom, chunkTo time.Time) error {
+		return tx.Exec(snapshotRollupString, seconds, nanos, nanos, chunkFrom.UnixNano(), chunkTo.UnixNano()).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	from, err = s.firstRollupBucket(dbTransactionRollupCollection, `MAX("bucketStart")`, dbTransactionInfoCollection, `MIN("startTime")`, seconds, time.Nanosecond)
+	if err != nil {
+		return err
+	}
+	err = s.rollupByChunks(from.Truncate(resolution), to, resolution, func(tx *gorm.DB, chunkFrom, chunkTo time.Time) error {
+		return tx.Exec(transactionRollupString, seconds, nanos, nanos, chunkFrom.UnixNano(), chunkTo.UnixNano()).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	from, err = s.firstRollupBucket(dbStatRequestRollupCollection, `MAX(CAST(strftime('%s', "bucketStart") AS INTEGER))`, dbStatRequestCollection, `MIN(CAST(strftime('%s', "time") AS INTEGER))`, seconds, time.Second)
+	if err != nil {
+		return err
+	}
+	return s.rollupByChunks(from.Truncate(resolution), to, resolution, func(tx *gorm.DB, chunkFrom, chunkTo time.Time) error {
+		err := tx.Exec(statRequestRollupString, seconds, seconds, seconds, chunkFrom.UTC(), chunkTo.UTC(), chunkFrom.UTC(), chunkTo.UTC()).Error
+		if err != nil {
+			return err
+		}
+		for _, query := range []string{cpuRollupString, diskRollupString, netRollupString} {
+			err = tx.Exec(query, seconds, seconds, seconds, chunkFrom.UTC(), chunkTo.UTC()).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *Sqlite) firstRollupBucket(table string, selection string, rawTable string, rawSelection string, resolution int64, unit time.Duration) (time.Time, error) {
+	var first sql.NullInt64
+	err := s.Db.Table(table).
+		Where(`"resolution" = ?`, resolution).
+		Select(selection).
+		Row().
+		Scan(&first)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !first.Valid {
+		err = s.Db.Table(rawTable).
+			Where(`"deleted_at" IS NULL`).
+			Select(rawSelection).
+			Row().
+			Scan(&first)
+		if err != nil {
+			return time.Time{}, err
+		}
+	}
+	if !first.Valid {
+		return time.Time{}, nil
+	}
+	return time.Unix(0, first.Int64*int64(unit)).UTC(), nil
+}
+
+func (s *Sqlite) rollupByChunks(from time.Time, to time.Time, resolution time.Duration, rollup func(tx *gorm.DB, from time.Time, to time.Time) error) error {
+	if from.IsZero() {
+		return nil
+	}
+	chunk := rollupChunkRange
+	if resolution > chunk {
+		chunk = resolution
+	}
+	for from.Before(to) {
+		chunkTo := from.Add(chunk)
+		if chunkTo.After(to) {
+			chunkTo = to
+		}
+		err := s.Db.Transaction(func(tx *gorm.DB) error {
+			if tx.Error != nil {
+				return tx.Error
+			}
+			return rollup(tx, from, chunkTo)
+		})
+		if err != nil {
+			return err
+		}
+		from = chunkTo
+	}
+	return nil
+}
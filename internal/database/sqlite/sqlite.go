@@ -0,0 +1,40 @@
+package sqlite
+
+import (
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+	"github.com/squzy/squzy/internal/database/postgres"
+	"time"
+)
+
+// Sqlite uses the same gorm models and queries as postgres, only specific sql is overridden.
+// Timestamps are stored as text, so all of them should be in UTC
+type Sqlite struct {
+	*postgres.Postgres
+}
+
+func New(db *gorm.DB) *Sqlite {
+	return &Sqlite{
+		Postgres: &postgres.Postgres{
+			Db: db,
+		},
+	}
+}
+
+// Open creates database file if it does not exist, sqlite allows only one writer, so only one connection is used
+func Open(path string) (*gorm.DB, error) {
+	db, err := gorm.Open("sqlite3", path+"?_busy_timeout=5000&_loc=UTC")
+	if err != nil {
+		return nil, err
+	}
+	db.DB().SetMaxOpenConns(1)
+	return db, nil
+}
+
+func (s *Sqlite) DeleteStatRequests(before time.Time) error {
+	return s.Postgres.DeleteStatRequests(before.UTC())
+}
+
+func (s *Sqlite) DeleteRollups(resolution time.Duration, before time.Time) error {
+	return s.Postgres.DeleteRollups(resolution, before.UTC())
+}
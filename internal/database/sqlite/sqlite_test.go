@@ -0,0 +1,468 @@
+package sqlite
+
+import (
+	"fmt"
+	"github.com/jinzhu/gorm"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	timestamp "google.golang.org/protobuf/types/known/timestamppb"
+	"testing"
+	"time"
+)
+
+var (
+	day = time.Hour * 24
+)
+
+func newTestDb(t *testing.T) *Sqlite {
+	db, err := Open(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+	s := New(db)
+	require.NoError(t, s.Migrate())
+	return s
+}
+
+func snapshot(schedulerID string, code apiPb.SchedulerCode, start time.Time, latency time.Duration) *apiPb.SchedulerResponse {
+	return &apiPb.SchedulerResponse{
+		SchedulerId: schedulerID,
+		Snapshot: &apiPb.SchedulerSnapshot{
+			Code: code,
+			Type: apiPb.SchedulerType_HTTP,
+			Meta: &apiPb.SchedulerSnapshot_MetaData{
+				StartTime: timestamp.New(start),
+				EndTime:   timestamp.New(start.Add(latency)),
+			},
+		},
+	}
+}
+
+func metric(agentID string, t time.Time, load float64) *apiPb.Metric {
+	return &apiPb.Metric{
+		AgentId: agentID,
+		CpuInfo: &apiPb.CpuInfo{
+			Cpus: []*apiPb.CpuInfo_CPU{{Load: load}, {Load: load * 2}},
+		},
+		MemoryInfo: &apiPb.MemoryInfo{
+			Mem:  &apiPb.MemoryInfo_Memory{Total: 100, Used: 50, UsedPercent: 50},
+			Swap: &apiPb.MemoryInfo_Memory{Total: 10, UsedPercent: load},
+		},
+		DiskInfo: &apiPb.DiskInfo{
+			Disks: map[string]*apiPb.DiskInfo_Disk{"disk": {Total: 100, UsedPercent: load}},
+		},
+		NetInfo: &apiPb.NetInfo{
+			Interfaces: map[string]*apiPb.NetInfo_Interface{"eth0": {BytesSent: uint64(load)}},
+		},
+		Time: timestamp.New(t),
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Run("Should: not return nil", func(t *testing.T) {
+		assert.NotNil(t, New(&gorm.DB{}))
+	})
+}
+
+func TestSqlite_Snapshots(t *testing.T) {
+	s := newTestDb(t)
+	now := time.Now().UTC()
+	require.NoError(t, s.InsertSnapshots([]*apiPb.SchedulerResponse{
+		snapshot("1", apiPb.SchedulerCode_OK, now.Add(-time.Minute*2), time.Second),
+		snapshot("1", apiPb.SchedulerCode_OK, now.Add(-time.Minute), time.Second*3),
+		snapshot("1", apiPb.SchedulerCode_ERROR, now, time.Second*10),
+		snapshot("2", apiPb.SchedulerCode_OK, now, time.Second),
+	}))
+
+	t.Run("Should: return snapshots", func(t *testing.T) {
+		res, count, _, err := s.GetSnapshots(&apiPb.GetSchedulerInformationRequest{
+			SchedulerId: "1",
+			Status:      apiPb.SchedulerCode_OK,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), count)
+		assert.Len(t, res, 2)
+	})
+	t.Run("Should: return uptime", func(t *testing.T) {
+		res, err := s.GetSnapshotsUptime(&apiPb.GetSchedulerUptimeRequest{
+			SchedulerId: "1",
+		})
+		require.NoError(t, err)
+		assert.InDelta(t, 2.0/3.0, res.GetUptime(), 0.0001)
+		assert.Equal(t, float64(time.Second*2), res.GetLatency())
+	})
+	t.Run("Should: return ongoing outage", func(t *testing.T) {
+		res, err := s.GetSnapshotsOutages(&apiPb.GetSchedulerOutagesRequest{
+			SchedulerId: "1",
+		})
+		require.NoError(t, err)
+		require.Len(t, res.GetOutages(), 1)
+		assert.Equal(t, now, res.GetOutages()[0].GetStartTime().AsTime())
+		assert.Nil(t, res.GetOutages()[0].GetEndTime())
+		assert.Equal(t, (time.Second * 10).Nanoseconds(), res.GetOutages()[0].GetDuration())
+		assert.Equal(t, int64(1), res.GetOutages()[0].GetFailedCount())
+	})
+	t.Run("Should: page snapshots by cursor", func(t *testing.T) {
+		res, _, cursors, err := s.GetSnapshots(&apiPb.GetSchedulerInformationRequest{
+			SchedulerId: "1",
+			Pagination:  &apiPb.Pagination{Page: 1, Limit: 2},
+		})
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		assert.Equal(t, now, res[0].GetMeta().GetStartTime().AsTime())
+		assert.Empty(t, cursors.GetPrev())
+
+		res, count, cursors, err := s.GetSnapshots(&apiPb.GetSchedulerInformationRequest{
+			SchedulerId: "1",
+			Pagination:  &apiPb.Pagination{Cursor: cursors.GetNext(), Limit: 2},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(0), count)
+		require.Len(t, res, 1)
+		assert.Equal(t, now.Add(-time.Minute*2), res[0].GetMeta().GetStartTime().AsTime())
+		assert.Empty(t, cursors.GetNext())
+
+		res, _, cursors, err = s.GetSnapshots(&apiPb.GetSchedulerInformationRequest{
+			SchedulerId: "1",
+			Pagination:  &apiPb.Pagination{Cursor: cursors.GetPrev(), Limit: 2},
+		})
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		assert.Equal(t, now, res[0].GetMeta().GetStartTime().AsTime())
+		assert.Equal(t, now.Add(-time.Minute), res[1].GetMeta().GetStartTime().AsTime())
+		assert.NotEmpty(t, cursors.GetNext())
+		assert.Empty(t, cursors.GetPrev())
+	})
+	t.Run("Should: delete old snapshots", func(t *testing.T) {
+		require.NoError(t, s.DeleteSnapshots(now))
+		_, count, _, err := s.GetSnapshots(&apiPb.GetSchedulerInformationRequest{
+			SchedulerId: "1",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), count)
+	})
+}
+
+func TestSqlite_StatRequests(t *testing.T) {
+	s := newTestDb(t)
+	now := time.Now().UTC()
+	require.NoError(t, s.InsertStatRequest(metric("1", now.Add(-time.Minute), 10)))
+	require.NoError(t, s.InsertStatRequest(metric("1", now, 20)))
+
+	t.Run("Should: return statistics", func(t *testing.T) {
+		res, count, _, err := s.GetStatRequest("1", nil, &apiPb.TimeFilter{
+			From: timestamp.New(now.Add(-time.Hour)),
+			To:   timestamp.New(now.Add(time.Second)),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), count)
+		assert.Len(t, res[0].GetCpuInfo().GetCpus(), 2)
+		assert.Equal(t, uint64(100), res[1].GetMemoryInfo().GetMem().GetTotal())
+		assert.Equal(t, uint64(100), res[1].GetDiskInfo().GetDisks()["disk"].GetTotal())
+	})
+	t.Run("Should: return series", func(t *testing.T) {
+		bucket := time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)
+		require.NoError(t, s.InsertStatRequest(metric("2", bucket, 10)))
+		require.NoError(t, s.InsertStatRequest(metric("2", bucket.Add(time.Second*30), 20)))
+		require.NoError(t, s.InsertStatRequest(metric("2", bucket.Add(time.Hour), 30)))
+
+		res, err := s.GetStatRequestSeries(&apiPb.GetAgentSeriesRequest{
+			AgentId: "2",
+			TimeRange: &apiPb.TimeFilter{
+				From: timestamp.New(bucket.Add(-time.Hour)),
+				To:   timestamp.New(bucket.Add(time.Hour * 2)),
+			},
+			BucketSize: 3600,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(3600), res.GetBucketSize())
+		require.Len(t, res.GetPoints(), 2)
+		point := res.GetPoints()[0]
+		assert.Equal(t, bucket, point.GetTime().AsTime())
+		require.Len(t, point.GetCpus(), 2)
+		assert.Equal(t, float64(15), point.GetCpus()[0].GetAvg())
+		assert.Equal(t, float64(40), point.GetCpus()[1].GetMax())
+		assert.Equal(t, float64(50), point.GetMemoryUsedPercent().GetAvg())
+		assert.Equal(t, float64(20), point.GetDisks()["disk"].GetMax())
+		assert.InDelta(t, 1.0/3.0, point.GetInterfaces()["eth0"].GetBytesSent(), 0.0001)
+		assert.InDelta(t, 10.0/3570.0, res.GetPoints()[1].GetInterfaces()["eth0"].GetBytesSent(), 0.0001)
+	})
+	t.Run("Should: page statistics by cursor", func(t *testing.T) {
+		res, _, cursors, err := s.GetCPUInfo("1", &apiPb.Pagination{Page: 1, Limit: 1}, nil)
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, now.Add(-time.Minute), res[0].GetTime().AsTime())
+
+		res, _, cursors, err = s.GetCPUInfo("1", &apiPb.Pagination{Cursor: cursors.GetNext(), Limit: 1}, nil)
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, now, res[0].GetTime().AsTime())
+		assert.Empty(t, cursors.GetNext())
+
+		res, _, cursors, err = s.GetCPUInfo("1", &apiPb.Pagination{Cursor: cursors.GetPrev(), Limit: 1}, nil)
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, now.Add(-time.Minute), res[0].GetTime().AsTime())
+		assert.Empty(t, cursors.GetPrev())
+	})
+	t.Run("Should: delete old statistics", func(t *testing.T) {
+		require.NoError(t, s.DeleteStatRequests(now))
+		_, count, _, err := s.GetCPUInfo("1", nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), count)
+	})
+}
+
+func TestSqlite_Transactions(t *testing.T) {
+	s := newTestDb(t)
+	now := time.Now().UTC()
+	for i, status := range []apiPb.TransactionStatus{apiPb.TransactionStatus_TRANSACTION_SUCCESSFUL, apiPb.TransactionStatus_TRANSACTION_FAILED} {
+		require.NoError(t, s.InsertTransactionInfo(&apiPb.TransactionInfo{
+			Id:            []string{"1", "2"}[i],
+			ApplicationId: "app",
+			ParentId:      []string{"", "1"}[i],
+			Name:          "name",
+			StartTime:     timestamp.New(now),
+			EndTime:       timestamp.New(now.Add(time.Second * time.Duration(i+1))),
+			Status:        status,
+			Type:          apiPb.TransactionType_TRANSACTION_TYPE_HTTP,
+		}))
+	}
+
+	t.Run("Should: return transaction with children", func(t *testing.T) {
+		res, children, err := s.GetTransactionByID(&apiPb.GetTransactionByIdRequest{TransactionId: "1"})
+		require.NoError(t, err)
+		assert.Equal(t, "1", res.GetId())
+		assert.Len(t, children, 1)
+	})
+	t.Run("Should: return transaction tree", func(t *testing.T) {
+		require.NoError(t, s.InsertTransactionInfo(&apiPb.TransactionInfo{
+			Id:            "3",
+			ApplicationId: "app",
+			ParentId:      "2",
+			Name:          "name",
+			StartTime:     timestamp.New(now.Add(time.Millisecond * 100)),
+			EndTime:       timestamp.New(now.Add(time.Millisecond * 600)),
+		}))
+		res, err := s.GetTransactionTree(&apiPb.GetTransactionByIdRequest{TransactionId: "1"})
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), res.GetCount())
+		assert.Equal(t, (time.Second * 2).Nanoseconds(), res.GetDuration())
+		require.Len(t, res.GetRoot().GetChildren(), 1)
+		child := res.GetRoot().GetChildren()[0]
+		assert.Equal(t, "2", child.GetTransaction().GetId())
+		assert.Equal(t, (time.Millisecond * 1500).Nanoseconds(), child.GetSelfTime())
+		require.Len(t, child.GetChildren(), 1)
+		assert.Equal(t, (time.Millisecond * 100).Nanoseconds(), child.GetChildren()[0].GetOffset())
+
+		_, children, err := s.GetTransactionByID(&apiPb.GetTransactionByIdRequest{TransactionId: "1"})
+		require.NoError(t, err)
+		assert.Len(t, children, 2)
+	})
+	t.Run("Should: return group", func(t *testing.T) {
+		res, err := s.GetTransactionGroup(&apiPb.GetTransactionGroupRequest{
+			ApplicationId: "app",
+			GroupType:     apiPb.GroupTransaction_BY_NAME,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), res["name"].GetCount())
+		assert.Equal(t, 0.5, res["name"].GetSuccessRatio())
+		assert.Equal(t, float64(2000), res["name"].GetMaxTime())
+	})
+	t.Run("Should: return group percentiles and apdex", func(t *testing.T) {
+		res, err := s.GetTransactionGroup(&apiPb.GetTransactionGroupRequest{
+			ApplicationId: "app",
+			GroupType:     apiPb.GroupTransaction_BY_NAME,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, float64(1000), res["name"].GetLatencyP50())
+		assert.Equal(t, float64(2000), res["name"].GetLatencyP99())
+		assert.Equal(t, 0.5, res["name"].GetErrorRate())
+		assert.Equal(t, 0.25, res["name"].GetApdex())
+
+		res, err = s.GetTransactionGroup(&apiPb.GetTransactionGroupRequest{
+			ApplicationId:  "app",
+			GroupType:      apiPb.GroupTransaction_BY_NAME,
+			ApdexThreshold: 1000,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 0.5, res["name"].GetApdex())
+	})
+	t.Run("Should: delete old transactions", func(t *testing.T) {
+		require.NoError(t, s.DeleteTransactionInfos(now.Add(time.Nanosecond)))
+		_, count, _, err := s.GetTransactionInfo(&apiPb.GetTransactionsRequest{ApplicationId: "app"})
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), count)
+	})
+}
+
+func TestSqlite_Incidents(t *testing.T) {
+	s := newTestDb(t)
+	require.NoError(t, s.InsertIncident(&apiPb.Incident{
+		Id:     "1",
+		Status: apiPb.IncidentStatus_INCIDENT_STATUS_OPENED,
+		RuleId: "rule",
+		Histories: []*apiPb.Incident_HistoryItem{
+			{Status: apiPb.IncidentStatus_INCIDENT_STATUS_OPENED, Timestamp: timestamp.Now()},
+		},
+	}))
+
+	res, err := s.GetActiveIncidentByRuleId("rule")
+	require.NoError(t, err)
+	assert.Equal(t, "1", res.GetId())
+
+	_, err = s.UpdateIncidentStatus("1", apiPb.IncidentStatus_INCIDENT_STATUS_CLOSED)
+	require.NoError(t, err)
+
+	res, err = s.GetIncidentById("1")
+	require.NoError(t, err)
+	assert.Equal(t, apiPb.IncidentStatus_INCIDENT_STATUS_CLOSED, res.GetStatus())
+	assert.Len(t, res.GetHistories(), 2)
+}
+
+func TestSqlite_GetSnapshotsOutages(t *testing.T) {
+	s := newTestDb(t)
+	now := time.Now().UTC().Truncate(time.Second).Add(-time.Hour)
+	for i, code := range []apiPb.SchedulerCode{
+		apiPb.SchedulerCode_OK,
+		apiPb.SchedulerCode_ERROR,
+		apiPb.SchedulerCode_ERROR,
+		apiPb.SchedulerCode_OK,
+		apiPb.SchedulerCode_OK,
+		apiPb.SchedulerCode_ERROR,
+	} {
+		check := snapshot("1", code, now.Add(time.Minute*time.Duration(i)), time.Second)
+		if code == apiPb.SchedulerCode_ERROR {
+			check.Snapshot.Error = &apiPb.SchedulerSnapshot_Error{Message: fmt.Sprintf("error %d", i)}
+		}
+		require.NoError(t, s.InsertSnapshot(check))
+	}
+
+	res, err := s.GetSnapshotsOutages(&apiPb.GetSchedulerOutagesRequest{
+		SchedulerId: "1",
+	})
+	require.NoError(t, err)
+	require.Len(t, res.GetOutages(), 2)
+	assert.Equal(t, now.Add(time.Minute), res.GetOutages()[0].GetStartTime().AsTime())
+	assert.Equal(t, now.Add(time.Minute*3), res.GetOutages()[0].GetEndTime().AsTime())
+	assert.Equal(t, (time.Minute * 2).Nanoseconds(), res.GetOutages()[0].GetDuration())
+	assert.Equal(t, int64(2), res.GetOutages()[0].GetFailedCount())
+	assert.Equal(t, "error 1", res.GetOutages()[0].GetError())
+	assert.Nil(t, res.GetOutages()[1].GetEndTime())
+	assert.Equal(t, time.Second.Nanoseconds(), res.GetOutages()[1].GetDuration())
+	assert.Equal(t, int64(1), res.GetOutages()[1].GetFailedCount())
+}
+
+func TestSqlite_Rollup(t *testing.T) {
+	s := newTestDb(t)
+	now := time.Now().UTC()
+	bucket := now.Truncate(time.Hour).Add(-time.Hour * 2)
+	for i := 0; i < 20; i++ {
+		require.NoError(t, s.InsertSnapshot(snapshot("1", apiPb.SchedulerCode_OK, bucket.Add(time.Minute*time.Duration(i)), time.Second*time.Duration(i+1))))
+		require.NoError(t, s.InsertStatRequest(metric("1", bucket.Add(time.Minute*time.Duration(i)), float64(i))))
+	}
+	require.NoError(t, s.InsertSnapshot(snapshot("1", apiPb.SchedulerCode_ERROR, bucket.Add(time.Hour), time.Second)))
+
+	require.NoError(t, s.Rollup(time.Hour, now))
+	// Second run should update the last buckets
+	require.NoError(t, s.Rollup(time.Hour, now))
+
+	var snapshotRollups []*struct {
+		Count      int64
+		OkCount    int64   `gorm:"column:okCount"`
+		LatencyP95 float64 `gorm:"column:latencyP95"`
+	}
+	require.NoError(t, s.Db.Table(dbSnapshotRollupCollection).Order(`"bucketStart"`).Find(&snapshotRollups).Error)
+	require.Len(t, snapshotRollups, 2)
+	assert.Equal(t, int64(20), snapshotRollups[0].Count)
+	assert.Equal(t, int64(20), snapshotRollups[0].OkCount)
+	assert.Equal(t, float64(time.Second*19), snapshotRollups[0].LatencyP95)
+	assert.Equal(t, int64(0), snapshotRollups[1].OkCount)
+
+	t.Run("Should: return uptime by rollups", func(t *testing.T) {
+		res, err := s.GetSnapshotsUptime(&apiPb.GetSchedulerUptimeRequest{
+			SchedulerId: "1",
+			TimeRange: &apiPb.TimeFilter{
+				From: timestamp.New(now.Add(-day * 3)),
+				To:   timestamp.New(now),
+			},
+		})
+		require.NoError(t, err)
+		assert.InDelta(t, 20.0/21.0, res.GetUptime(), 0.0001)
+		assert.Equal(t, float64(time.Millisecond*10500), res.GetLatency())
+	})
+	t.Run("Should: return agent series by rollups", func(t *testing.T) {
+		res, err := s.GetStatRequestSeries(&apiPb.GetAgentSeriesRequest{
+			AgentId: "1",
+			TimeRange: &apiPb.TimeFilter{
+				From: timestamp.New(now.Add(-day * 3)),
+				To:   timestamp.New(now),
+			},
+			BucketSize: 3600,
+		})
+		require.NoError(t, err)
+		require.Len(t, res.GetPoints(), 1)
+		assert.Equal(t, bucket, res.GetPoints()[0].GetTime().AsTime())
+		require.Len(t, res.GetPoints()[0].GetCpus(), 2)
+		assert.Equal(t, 9.5, res.GetPoints()[0].GetCpus()[0].GetAvg())
+		assert.Equal(t, float64(38), res.GetPoints()[0].GetCpus()[1].GetMax())
+		assert.Equal(t, float64(50), res.GetPoints()[0].GetMemoryUsedPercent().GetAvg())
+		assert.Equal(t, float64(19), res.GetPoints()[0].GetSwapUsedPercent().GetMax())
+		assert.Equal(t, float64(19), res.GetPoints()[0].GetDisks()["disk"].GetMax())
+	})
+	t.Run("Should: return uptime series by rollups", func(t *testing.T) {
+		res, err := s.GetSnapshotsUptimeSeries(&apiPb.GetSchedulerUptimeSeriesRequest{
+			SchedulerId: "1",
+			TimeRange: &apiPb.TimeFilter{
+				From: timestamp.New(now.Add(-day * 3)),
+				To:   timestamp.New(now),
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, apiPb.SeriesResolution_SERIES_RESOLUTION_HOUR, res.GetResolution())
+		require.Len(t, res.GetPoints(), 2)
+		assert.Equal(t, bucket, res.GetPoints()[0].GetTime().AsTime())
+		assert.Equal(t, float64(1), res.GetPoints()[0].GetUptime())
+		assert.Equal(t, float64(time.Second*10), res.GetPoints()[0].GetLatencyP50())
+		assert.Equal(t, float64(time.Second*20), res.GetPoints()[0].GetLatencyP99())
+		assert.Equal(t, float64(0), res.GetPoints()[1].GetUptime())
+	})
+	t.Run("Should: return uptime series by snapshots", func(t *testing.T) {
+		res, err := s.GetSnapshotsUptimeSeries(&apiPb.GetSchedulerUptimeSeriesRequest{
+			SchedulerId: "1",
+			TimeRange: &apiPb.TimeFilter{
+				From: timestamp.New(bucket),
+				To:   timestamp.New(bucket.Add(time.Hour * 2)),
+			},
+			Resolution: apiPb.SeriesResolution_SERIES_RESOLUTION_MINUTE,
+		})
+		require.NoError(t, err)
+		require.Len(t, res.GetPoints(), 21)
+		assert.Equal(t, int64(1), res.GetPoints()[0].GetCount())
+		assert.Equal(t, float64(time.Second), res.GetPoints()[0].GetLatencyP95())
+	})
+	t.Run("Should: return agent history by rollups", func(t *testing.T) {
+		res, count, _, err := s.GetStatRequest("1", nil, &apiPb.TimeFilter{
+			From: timestamp.New(now.Add(-day * 3)),
+			To:   timestamp.New(now),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), count)
+		require.Len(t, res[0].GetCpuInfo().GetCpus(), 2)
+		assert.InDelta(t, 9.5, res[0].GetCpuInfo().GetCpus()[0].GetLoad(), 0.0001)
+		assert.InDelta(t, 19, res[0].GetCpuInfo().GetCpus()[1].GetLoad(), 0.0001)
+		assert.Equal(t, uint64(100), res[0].GetMemoryInfo().GetMem().GetTotal())
+		assert.InDelta(t, 9.5, res[0].GetDiskInfo().GetDisks()["disk"].GetUsedPercent(), 0.0001)
+		assert.Equal(t, uint64(19), res[0].GetNetInfo().GetInterfaces()["eth0"].GetBytesSent())
+	})
+	t.Run("Should: delete rollups", func(t *testing.T) {
+		require.NoError(t, s.DeleteRollups(time.Hour, now))
+		_, count, _, err := s.GetCPUInfo("1", nil, &apiPb.TimeFilter{
+			From: timestamp.New(now.Add(-day * 3)),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(0), count)
+	})
+}
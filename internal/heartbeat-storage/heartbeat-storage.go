@@ -0,0 +1,74 @@
+package heartbeat_storage
+
+import (
+	"sync"
+	"time"
+)
+
+type HeartbeatStorage interface {
+	// Returns time of the last ping, if scheduler has not pinged yet passed time is saved as the last ping
+	LastPing(schedulerID string, now time.Time) time.Time
+	// Save start of the job
+	Start(schedulerID string, now time.Time)
+	// Save ping and return start of the job if start ping was received before
+	Finish(schedulerID string, now time.Time) (time.Time, bool)
+	Remove(schedulerID string)
+}
+
+type storage struct {
+	kv    map[string]*StorageItem
+	mutex sync.Mutex
+}
+
+type StorageItem struct {
+	lastPing time.Time
+	start    *time.Time
+}
+
+func (s *storage) item(schedulerID string, now time.Time) *StorageItem {
+	value, exist := s.kv[schedulerID]
+	if !exist {
+		value = &StorageItem{
+			lastPing: now,
+		}
+		s.kv[schedulerID] = value
+	}
+	return value
+}
+
+func (s *storage) LastPing(schedulerID string, now time.Time) time.Time {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.item(schedulerID, now).lastPing
+}
+
+func (s *storage) Start(schedulerID string, now time.Time) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.item(schedulerID, now).start = &now
+}
+
+func (s *storage) Finish(schedulerID string, now time.Time) (time.Time, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	value := s.item(schedulerID, now)
+	value.lastPing = now
+	start := value.start
+	value.start = nil
+	if start == nil {
+		return now, false
+	}
+	return *start, true
+}
+
+func (s *storage) Remove(schedulerID string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.kv, schedulerID)
+}
+
+func New() HeartbeatStorage {
+	return &storage{
+		kv: make(map[string]*StorageItem),
+	}
+}
@@ -0,0 +1,60 @@
+package heartbeat_storage
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("Should: implement interface", func(t *testing.T) {
+		s := New()
+		assert.Implements(t, (*HeartbeatStorage)(nil), s)
+	})
+}
+
+func TestStorage_LastPing(t *testing.T) {
+	t.Run("Should: save first call as last ping", func(t *testing.T) {
+		s := New()
+		now := time.Now()
+		assert.Equal(t, now, s.LastPing("id", now))
+		assert.Equal(t, now, s.LastPing("id", now.Add(time.Minute)))
+	})
+	t.Run("Should: return time of the last finish", func(t *testing.T) {
+		s := New()
+		now := time.Now()
+		s.LastPing("id", now)
+		s.Finish("id", now.Add(time.Minute))
+		assert.Equal(t, now.Add(time.Minute), s.LastPing("id", now.Add(time.Hour)))
+	})
+}
+
+func TestStorage_Finish(t *testing.T) {
+	t.Run("Should: return start of the job", func(t *testing.T) {
+		s := New()
+		now := time.Now()
+		s.Start("id", now)
+		start, ok := s.Finish("id", now.Add(time.Second))
+		assert.True(t, ok)
+		assert.Equal(t, now, start)
+		_, ok = s.Finish("id", now.Add(time.Minute))
+		assert.False(t, ok)
+	})
+	t.Run("Should: not change last ping on start", func(t *testing.T) {
+		s := New()
+		now := time.Now()
+		s.Finish("id", now)
+		s.Start("id", now.Add(time.Minute))
+		assert.Equal(t, now, s.LastPing("id", now.Add(time.Hour)))
+	})
+}
+
+func TestStorage_Remove(t *testing.T) {
+	t.Run("Should: remove scheduler", func(t *testing.T) {
+		s := New()
+		now := time.Now()
+		s.Finish("id", now)
+		s.Remove("id")
+		assert.Equal(t, now.Add(time.Hour), s.LastPing("id", now.Add(time.Hour)))
+	})
+}
@@ -61,3 +61,97 @@ func SelectorsToProto(selectors []*scheduler_config_storage.Selectors) []*apiPb.
 	}
 	return arr
 }
+
+func AssertionsToDb(assertions []*apiPb.DatabaseConfig_Assertion) []*scheduler_config_storage.Assertion {
+	arr := []*scheduler_config_storage.Assertion{}
+	for _, v := range assertions {
+		arr = append(arr, &scheduler_config_storage.Assertion{
+			Operator: v.Operator,
+			Value:    v.Value,
+		})
+	}
+	return arr
+}
+
+func AssertionsToProto(assertions []*scheduler_config_storage.Assertion) []*apiPb.DatabaseConfig_Assertion {
+	arr := []*apiPb.DatabaseConfig_Assertion{}
+	for _, v := range assertions {
+		arr = append(arr, &apiPb.DatabaseConfig_Assertion{
+			Operator: v.Operator,
+			Value:    v.Value,
+		})
+	}
+	return arr
+}
+
+func HTTPClientToDb(config *apiPb.HttpClientConfig) *scheduler_config_storage.HTTPClientConfig {
+	if config == nil {
+		return nil
+	}
+	return &scheduler_config_storage.HTTPClientConfig{
+		ProxyURL:           config.ProxyUrl,
+		CABundle:           config.CaBundle,
+		ClientCert:         config.ClientCert,
+		ClientKey:          config.ClientKey,
+		InsecureSkipVerify: config.InsecureSkipVerify,
+		DisableHTTP2:       config.DisableHttp2,
+		SourceIP:           config.SourceIp,
+	}
+}
+
+func HTTPClientToProto(config *scheduler_config_storage.HTTPClientConfig) *apiPb.HttpClientConfig {
+	if config == nil {
+		return nil
+	}
+	return &apiPb.HttpClientConfig{
+		ProxyUrl:           config.ProxyURL,
+		CaBundle:           config.CABundle,
+		ClientCert:         config.ClientCert,
+		ClientKey:          config.ClientKey,
+		InsecureSkipVerify: config.InsecureSkipVerify,
+		DisableHttp2:       config.DisableHTTP2,
+		SourceIp:           config.SourceIP,
+	}
+}
+
+func HTTPAuthToDb(config *apiPb.HttpAuthConfig) *scheduler_config_storage.HTTPAuthConfig {
+	if config == nil {
+		return nil
+	}
+	return &scheduler_config_storage.HTTPAuthConfig{
+		Type:         config.Type,
+		Username:     config.Username,
+		Password:     config.Password,
+		Token:        config.Token,
+		TokenURL:     config.TokenUrl,
+		ClientID:     config.ClientId,
+		ClientSecret: config.ClientSecret,
+		Scopes:       config.Scopes,
+		AccessKey:    config.AccessKey,
+		SecretKey:    config.SecretKey,
+		SessionToken: config.SessionToken,
+		Region:       config.Region,
+		Service:      config.Service,
+	}
+}
+
+func HTTPAuthToProto(config *scheduler_config_storage.HTTPAuthConfig) *apiPb.HttpAuthConfig {
+	if config == nil {
+		return nil
+	}
+	return &apiPb.HttpAuthConfig{
+		Type:         config.Type,
+		Username:     config.Username,
+		Password:     config.Password,
+		Token:        config.Token,
+		TokenUrl:     config.TokenURL,
+		ClientId:     config.ClientID,
+		ClientSecret: config.ClientSecret,
+		Scopes:       config.Scopes,
+		AccessKey:    config.AccessKey,
+		SecretKey:    config.SecretKey,
+		SessionToken: config.SessionToken,
+		Region:       config.Region,
+		Service:      config.Service,
+	}
+}
@@ -80,3 +80,103 @@ func TestSelectorsToProto(t *testing.T) {
 		}))
 	})
 }
+
+func TestAssertionsToDb(t *testing.T) {
+	t.Run("Should: convert correct", func(t *testing.T) {
+		assert.EqualValues(t, []*scheduler_config_storage.Assertion{
+			{
+				Operator: apiPb.DatabaseConfig_LESS,
+				Value:    "5",
+			},
+		}, AssertionsToDb([]*apiPb.DatabaseConfig_Assertion{
+			{
+				Operator: apiPb.DatabaseConfig_LESS,
+				Value:    "5",
+			},
+		}))
+	})
+}
+
+func TestAssertionsToProto(t *testing.T) {
+	t.Run("Should: convert correct", func(t *testing.T) {
+		assert.EqualValues(t, []*apiPb.DatabaseConfig_Assertion{
+			{
+				Operator: apiPb.DatabaseConfig_LESS,
+				Value:    "5",
+			},
+		}, AssertionsToProto([]*scheduler_config_storage.Assertion{
+			{
+				Operator: apiPb.DatabaseConfig_LESS,
+				Value:    "5",
+			},
+		}))
+	})
+}
+
+func TestHTTPClientToDb(t *testing.T) {
+	t.Run("Should: return nil", func(t *testing.T) {
+		assert.Nil(t, HTTPClientToDb(nil))
+	})
+	t.Run("Should: convert correct", func(t *testing.T) {
+		assert.EqualValues(t, &scheduler_config_storage.HTTPClientConfig{
+			ProxyURL:     "http://proxy:3128",
+			DisableHTTP2: true,
+			SourceIP:     "127.0.0.1",
+		}, HTTPClientToDb(&apiPb.HttpClientConfig{
+			ProxyUrl:     "http://proxy:3128",
+			DisableHttp2: true,
+			SourceIp:     "127.0.0.1",
+		}))
+	})
+}
+
+func TestHTTPClientToProto(t *testing.T) {
+	t.Run("Should: return nil", func(t *testing.T) {
+		assert.Nil(t, HTTPClientToProto(nil))
+	})
+	t.Run("Should: convert correct", func(t *testing.T) {
+		assert.EqualValues(t, &apiPb.HttpClientConfig{
+			CaBundle:           "ca",
+			InsecureSkipVerify: true,
+		}, HTTPClientToProto(&scheduler_config_storage.HTTPClientConfig{
+			CABundle:           "ca",
+			InsecureSkipVerify: true,
+		}))
+	})
+}
+
+func TestHTTPAuthToDb(t *testing.T) {
+	t.Run("Should: return nil", func(t *testing.T) {
+		assert.Nil(t, HTTPAuthToDb(nil))
+	})
+	t.Run("Should: convert correct", func(t *testing.T) {
+		assert.EqualValues(t, &scheduler_config_storage.HTTPAuthConfig{
+			Type:     apiPb.HttpAuthConfig_OAUTH2_CLIENT_CREDENTIALS,
+			TokenURL: "http://token",
+			ClientID: "id",
+			Scopes:   []string{"read"},
+		}, HTTPAuthToDb(&apiPb.HttpAuthConfig{
+			Type:     apiPb.HttpAuthConfig_OAUTH2_CLIENT_CREDENTIALS,
+			TokenUrl: "http://token",
+			ClientId: "id",
+			Scopes:   []string{"read"},
+		}))
+	})
+}
+
+func TestHTTPAuthToProto(t *testing.T) {
+	t.Run("Should: return nil", func(t *testing.T) {
+		assert.Nil(t, HTTPAuthToProto(nil))
+	})
+	t.Run("Should: convert correct", func(t *testing.T) {
+		assert.EqualValues(t, &apiPb.HttpAuthConfig{
+			Type:     apiPb.HttpAuthConfig_BASIC,
+			Username: "user",
+			Password: "pass",
+		}, HTTPAuthToProto(&scheduler_config_storage.HTTPAuthConfig{
+			Type:     apiPb.HttpAuthConfig_BASIC,
+			Username: "user",
+			Password: "pass",
+		}))
+	})
+}
@@ -0,0 +1,320 @@
+package httptools
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"golang.org/x/sync/singleflight"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+type AuthType int32
+
+const (
+	AuthBasic AuthType = iota + 1
+	AuthBearer
+	AuthOAuth2ClientCredentials
+	AuthAwsSigV4
+)
+
+const (
+	authorizationHeaderKey = "Authorization"
+	amzDateHeaderKey       = "X-Amz-Date"
+	amzTokenHeaderKey      = "X-Amz-Security-Token"
+	amzAlgorithm           = "AWS4-HMAC-SHA256"
+	amzDateFormat          = "20060102T150405Z"
+	amzShortDateFormat     = "20060102"
+	// Token is refreshed when it expires earlier than that
+	tokenRefreshBefore = time.Second * 30
+	// Used when token endpoint does not return expires_in
+	tokenDefaultLifetime = time.Hour
+)
+
+var (
+	errAuthTypeNotSupported = errors.New("AUTH_TYPE_NOT_SUPPORTED")
+	errTokenNotReceived     = errors.New("OAUTH2_TOKEN_NOT_RECEIVED")
+
+	tokenEndpointErrorFn = func(statusCode int) error {
+		return fmt.Errorf("token endpoint returned status code %d", statusCode)
+	}
+
+	// Replaced in tests
+	now = time.Now
+)
+
+// AuthOptions of request, scopes are separated by space
+type AuthOptions struct {
+	Type         AuthType
+	Username     string
+	Password     string
+	Token        string
+	TokenURL     string
+	ClientID     string
+	ClientSecret string
+	Scopes       string
+	AccessKey    string
+	SecretKey    string
+	SessionToken string
+	Region       string
+	Service      string
+}
+
+type oauthToken struct {
+	value  string
+	expiry time.Time
+}
+
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+type authorizer struct {
+	mu     sync.Mutex
+	tokens map[string]*oauthToken
+	group  singleflight.Group
+}
+
+func newAuthorizer() *authorizer {
+	return &authorizer{
+		tokens: make(map[string]*oauthToken),
+	}
+}
+
+func (a *authorizer) authorize(client *http.Client, req *http.Request, auth *AuthOptions) error {
+	switch auth.Type {
+	case AuthBasic:
+		req.SetBasicAuth(auth.Username, auth.Password)
+		return nil
+	case AuthBearer:
+		req.Header.Set(authorizationHeaderKey, "Bearer "+auth.Token)
+		return nil
+	case AuthOAuth2ClientCredentials:
+		token, err := a.token(client, req, auth)
+		if err != nil {
+			return err
+		}
+		req.Header.Set(authorizationHeaderKey, "Bearer "+token)
+		return nil
+	case AuthAwsSigV4:
+		return signAwsV4(req, auth, now().UTC())
+	default:
+		return errAuthTypeNotSupported
+	}
+}
+
+// Token is requested once for all concurrent requests with the same credentials
+func (a *authorizer) token(client *http.Client, req *http.Request, auth *AuthOptions) (string, error) {
+	key := tokenKey(auth)
+	a.mu.Lock()
+	cached, exist := a.tokens[key]
+	a.mu.Unlock()
+	if exist && now().Add(tokenRefreshBefore).Before(cached.expiry) {
+		return cached.value, nil
+	}
+	value, err, _ := a.group.Do(key, func() (interface{}, error) {
+		token, err := fetchToken(client, req, auth)
+		if err != nil {
+			return nil, err
+		}
+		a.mu.Lock()
+		// Tokens of rotated secrets are never requested again, so they are removed when they expire
+		current := now()
+		for cachedKey, cachedToken := range a.tokens {
+			if !current.Before(cachedToken.expiry) {
+				delete(a.tokens, cachedKey)
+			}
+		}
+		a.tokens[key] = token
+		a.mu.Unlock()
+		return token.value, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return value.(string), nil
+}
+
+// Token could be revoked before it expires, so it is removed when target rejects request authorized by it.
+// Token is compared with header of the request, so token received by concurrent request is kept
+func (a *authorizer) invalidate(req *http.Request, auth *AuthOptions) {
+	if auth.Type != AuthOAuth2ClientCredentials {
+		return
+	}
+	key := tokenKey(auth)
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if cached, exist := a.tokens[key]; exist && req.Header.Get(authorizationHeaderKey) == "Bearer "+cached.value {
+		delete(a.tokens, key)
+	}
+}
+
+// Secret is a part of the key, so token of the old secret is not used after it is rotated, secret is hashed to not keep it in the key
+func tokenKey(auth *AuthOptions) string {
+	secret := sha256.Sum256([]byte(auth.ClientSecret))
+	return strings.Join([]string{auth.TokenURL, auth.ClientID, auth.Scopes, hex.EncodeToString(secret[:])}, "\x00")
+}
+
+func fetchToken(client *http.Client, req *http.Request, auth *AuthOptions) (*oauthToken, error) {
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	if auth.Scopes != "" {
+		form.Set("scope", auth.Scopes)
+	}
+	tokenReq, err := http.NewRequestWithContext(req.Context(), http.MethodPost, auth.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	tokenReq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	tokenReq.Header.Set(userAgentHeaderKey, req.Header.Get(userAgentHeaderKey))
+	tokenReq.SetBasicAuth(url.QueryEscape(auth.ClientID), url.QueryEscape(auth.ClientSecret))
+	resp, err := client.Do(tokenReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, tokenEndpointErrorFn(resp.StatusCode)
+	}
+	body := &tokenResponse{}
+	err = json.Unmarshal(data, body)
+	if err != nil {
+		return nil, err
+	}
+	if body.AccessToken == "" {
+		return nil, errTokenNotReceived
+	}
+	lifetime := tokenDefaultLifetime
+	if body.ExpiresIn > 0 {
+		lifetime = time.Duration(body.ExpiresIn) * time.Second
+	}
+	return &oauthToken{
+		value:  body.AccessToken,
+		expiry: now().Add(lifetime),
+	}, nil
+}
+
+// Signature Version 4 of the request, signed headers are host and x-amz-*
+func signAwsV4(req *http.Request, auth *AuthOptions, t time.Time) error {
+	payload := []byte{}
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return err
+		}
+		payload, err = ioutil.ReadAll(body)
+		if err != nil {
+			return err
+		}
+	}
+	amzDate := t.Format(amzDateFormat)
+	shortDate := t.Format(amzShortDateFormat)
+	req.Header.Set(amzDateHeaderKey, amzDate)
+	if auth.SessionToken != "" {
+		req.Header.Set(amzTokenHeaderKey, auth.SessionToken)
+	}
+
+	headers := map[string]string{
+		"host": req.URL.Host,
+	}
+	for key, values := range req.Header {
+		lower := strings.ToLower(key)
+		if strings.HasPrefix(lower, "x-amz-") {
+			headers[lower] = strings.TrimSpace(strings.Join(values, ","))
+		}
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders bytes.Buffer
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		hashHex(payload),
+	}, "\n")
+
+	scope := strings.Join([]string{shortDate, auth.Region, auth.Service, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{
+		amzAlgorithm,
+		amzDate,
+		scope,
+		hashHex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+auth.SecretKey), shortDate)
+	key = hmacSHA256(key, auth.Region)
+	key = hmacSHA256(key, auth.Service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set(authorizationHeaderKey, fmt.Sprintf(
+		"%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		amzAlgorithm,
+		auth.AccessKey,
+		scope,
+		signedHeaders,
+		signature,
+	))
+	return nil
+}
+
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	parts := []string{}
+	for _, key := range keys {
+		values := query[key]
+		sort.Strings(values)
+		for _, value := range values {
+			parts = append(parts, awsEscape(key)+"="+awsEscape(value))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// Same as url.QueryEscape, but space is encoded as %20 and ~ is not encoded
+func awsEscape(value string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(url.QueryEscape(value), "+", "%20"), "%7E", "~")
+}
+
+func hashHex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,217 @@
+package httptools
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestAuthorizer_Authorize(t *testing.T) {
+	t.Run("Should: set basic auth", func(t *testing.T) {
+		req := newRequest(http.MethodGet, "http://localhost", nil)
+		err := newAuthorizer().authorize(http.DefaultClient, req, &AuthOptions{Type: AuthBasic, Username: "user", Password: "pass"})
+		assert.Equal(t, nil, err)
+		username, password, ok := req.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "user", username)
+		assert.Equal(t, "pass", password)
+	})
+	t.Run("Should: set bearer token", func(t *testing.T) {
+		req := newRequest(http.MethodGet, "http://localhost", nil)
+		err := newAuthorizer().authorize(http.DefaultClient, req, &AuthOptions{Type: AuthBearer, Token: "token"})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, "Bearer token", req.Header.Get(authorizationHeaderKey))
+	})
+	t.Run("Should: return error because type not supported", func(t *testing.T) {
+		req := newRequest(http.MethodGet, "http://localhost", nil)
+		err := newAuthorizer().authorize(http.DefaultClient, req, &AuthOptions{})
+		assert.Equal(t, errAuthTypeNotSupported, err)
+	})
+}
+
+func TestAuthorizer_OAuth2(t *testing.T) {
+	t.Run("Should: cache token until it is about to expire", func(t *testing.T) {
+		mu := sync.Mutex{}
+		calls := 0
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			calls++
+			mu.Unlock()
+			clientID, clientSecret, _ := r.BasicAuth()
+			assert.Equal(t, "id", clientID)
+			assert.Equal(t, "secret", clientSecret)
+			assert.Equal(t, "client_credentials", r.FormValue("grant_type"))
+			assert.Equal(t, "read write", r.FormValue("scope"))
+			_, _ = w.Write([]byte(`{"access_token":"token","token_type":"bearer","expires_in":60}`))
+		}))
+		defer ts.Close()
+		current := time.Now()
+		now = func() time.Time {
+			return current
+		}
+		defer func() {
+			now = time.Now
+		}()
+		a := newAuthorizer()
+		auth := &AuthOptions{Type: AuthOAuth2ClientCredentials, TokenURL: ts.URL, ClientID: "id", ClientSecret: "secret", Scopes: "read write"}
+		for i := 0; i < 3; i++ {
+			req := newRequest(http.MethodGet, "http://localhost", nil)
+			assert.Equal(t, nil, a.authorize(http.DefaultClient, req, auth))
+			assert.Equal(t, "Bearer token", req.Header.Get(authorizationHeaderKey))
+		}
+		assert.Equal(t, 1, calls)
+		current = current.Add(time.Second * 45)
+		assert.Equal(t, nil, a.authorize(http.DefaultClient, newRequest(http.MethodGet, "http://localhost", nil), auth))
+		assert.Equal(t, 2, calls)
+	})
+	t.Run("Should: request new token when secret is rotated", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, clientSecret, _ := r.BasicAuth()
+			_, _ = w.Write([]byte(`{"access_token":"` + clientSecret + `"}`))
+		}))
+		defer ts.Close()
+		a := newAuthorizer()
+		req := newRequest(http.MethodGet, "http://localhost", nil)
+		assert.Equal(t, nil, a.authorize(http.DefaultClient, req, &AuthOptions{Type: AuthOAuth2ClientCredentials, TokenURL: ts.URL, ClientID: "id", ClientSecret: "old"}))
+		assert.Equal(t, "Bearer old", req.Header.Get(authorizationHeaderKey))
+		req = newRequest(http.MethodGet, "http://localhost", nil)
+		assert.Equal(t, nil, a.authorize(http.DefaultClient, req, &AuthOptions{Type: AuthOAuth2ClientCredentials, TokenURL: ts.URL, ClientID: "id", ClientSecret: "new"}))
+		assert.Equal(t, "Bearer new", req.Header.Get(authorizationHeaderKey))
+		assert.NotContains(t, tokenKey(&AuthOptions{ClientSecret: "new"}), "new")
+	})
+	t.Run("Should: remove expired tokens when new token is stored", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, clientSecret, _ := r.BasicAuth()
+			_, _ = w.Write([]byte(`{"access_token":"` + clientSecret + `","expires_in":60}`))
+		}))
+		defer ts.Close()
+		current := time.Now()
+		now = func() time.Time {
+			return current
+		}
+		defer func() {
+			now = time.Now
+		}()
+		a := newAuthorizer()
+		assert.Equal(t, nil, a.authorize(http.DefaultClient, newRequest(http.MethodGet, "http://localhost", nil), &AuthOptions{Type: AuthOAuth2ClientCredentials, TokenURL: ts.URL, ClientID: "id", ClientSecret: "old"}))
+		current = current.Add(time.Minute)
+		assert.Equal(t, nil, a.authorize(http.DefaultClient, newRequest(http.MethodGet, "http://localhost", nil), &AuthOptions{Type: AuthOAuth2ClientCredentials, TokenURL: ts.URL, ClientID: "id", ClientSecret: "new"}))
+		assert.Len(t, a.tokens, 1)
+		assert.Contains(t, a.tokens, tokenKey(&AuthOptions{TokenURL: ts.URL, ClientID: "id", ClientSecret: "new"}))
+	})
+	t.Run("Should: remove token rejected by target", func(t *testing.T) {
+		calls := 0
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			_, _ = w.Write([]byte(`{"access_token":"token` + strconv.Itoa(calls) + `"}`))
+		}))
+		defer ts.Close()
+		a := newAuthorizer()
+		auth := &AuthOptions{Type: AuthOAuth2ClientCredentials, TokenURL: ts.URL, ClientID: "id"}
+		rejected := newRequest(http.MethodGet, "http://localhost", nil)
+		assert.Equal(t, nil, a.authorize(http.DefaultClient, rejected, auth))
+		a.invalidate(rejected, auth)
+		req := newRequest(http.MethodGet, "http://localhost", nil)
+		assert.Equal(t, nil, a.authorize(http.DefaultClient, req, auth))
+		assert.Equal(t, "Bearer token2", req.Header.Get(authorizationHeaderKey))
+		// Token received after rejected request is kept
+		a.invalidate(rejected, auth)
+		assert.Contains(t, a.tokens, tokenKey(auth))
+	})
+	t.Run("Should: return error because token endpoint failed", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		defer ts.Close()
+		err := newAuthorizer().authorize(http.DefaultClient, newRequest(http.MethodGet, "http://localhost", nil), &AuthOptions{Type: AuthOAuth2ClientCredentials, TokenURL: ts.URL})
+		assert.Equal(t, tokenEndpointErrorFn(http.StatusUnauthorized), err)
+	})
+	t.Run("Should: return error because token not received", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{}`))
+		}))
+		defer ts.Close()
+		err := newAuthorizer().authorize(http.DefaultClient, newRequest(http.MethodGet, "http://localhost", nil), &AuthOptions{Type: AuthOAuth2ClientCredentials, TokenURL: ts.URL})
+		assert.Equal(t, errTokenNotReceived, err)
+	})
+}
+
+func TestSignAwsV4(t *testing.T) {
+	t.Run("Should: sign request same as AWS test suite", func(t *testing.T) {
+		req := newRequest(http.MethodGet, "https://example.amazonaws.com/", nil)
+		signTime, _ := time.Parse(amzDateFormat, "20150830T123600Z")
+		err := signAwsV4(req, &AuthOptions{
+			Type:      AuthAwsSigV4,
+			AccessKey: "AKIDEXAMPLE",
+			SecretKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY",
+			Region:    "us-east-1",
+			Service:   "service",
+		}, signTime)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, "20150830T123600Z", req.Header.Get(amzDateHeaderKey))
+		assert.Equal(
+			t,
+			"AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, SignedHeaders=host;x-amz-date, Signature=5fa00fa31553b73ebf1942676e86291e8372ff2a2260956d9b8aae1d763fbf31",
+			req.Header.Get(authorizationHeaderKey),
+		)
+	})
+	t.Run("Should: sign session token and query", func(t *testing.T) {
+		req := newRequest(http.MethodGet, "https://example.amazonaws.com/?b=2&a=1", nil)
+		err := signAwsV4(req, &AuthOptions{Type: AuthAwsSigV4, SessionToken: "session"}, time.Now())
+		assert.Equal(t, nil, err)
+		assert.Equal(t, "session", req.Header.Get(amzTokenHeaderKey))
+		assert.Contains(t, req.Header.Get(authorizationHeaderKey), "SignedHeaders=host;x-amz-date;x-amz-security-token,")
+	})
+}
+
+func TestHttpTool_WithAuth(t *testing.T) {
+	t.Run("Should: return same tool because auth is nil", func(t *testing.T) {
+		j := New("")
+		assert.Equal(t, j, j.WithAuth(nil))
+	})
+	t.Run("Should: authenticate request", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get(authorizationHeaderKey) != "Bearer token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer ts.Close()
+		j := New("").WithAuth(&AuthOptions{Type: AuthBearer, Token: "token"})
+		code, _, err := j.SendRequestTimeout(newRequest(http.MethodGet, ts.URL, nil), time.Second)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, http.StatusOK, code)
+	})
+	t.Run("Should: request new token after target returned unauthorized", func(t *testing.T) {
+		calls := 0
+		tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			_, _ = w.Write([]byte(`{"access_token":"token` + strconv.Itoa(calls) + `"}`))
+		}))
+		defer tokenServer.Close()
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get(authorizationHeaderKey) != "Bearer token2" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer ts.Close()
+		j := New("").WithAuth(&AuthOptions{Type: AuthOAuth2ClientCredentials, TokenURL: tokenServer.URL})
+		code, _, _ := j.SendRequestTimeout(newRequest(http.MethodGet, ts.URL, nil), time.Second)
+		assert.Equal(t, http.StatusUnauthorized, code)
+		code, _, err := j.SendRequestTimeout(newRequest(http.MethodGet, ts.URL, nil), time.Second)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, http.StatusOK, code)
+	})
+	t.Run("Should: return error because authentication failed", func(t *testing.T) {
+		j := New("").WithAuth(&AuthOptions{})
+		_, _, err := j.SendRequest(newRequest(http.MethodGet, "http://localhost", nil))
+		assert.Equal(t, errAuthTypeNotSupported, err)
+	})
+}
@@ -2,17 +2,41 @@ package httptools
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"net/http"
+	"net/url"
 	"github.com/squzy/squzy/internal/helpers"
+	"sync"
 	"time"
 )
 
 type httpTool struct {
-	userAgent string
-	client    *http.Client
+	userAgent  string
+	client     *http.Client
+	clients    *clientCache
+	auth       *AuthOptions
+	authorizer *authorizer
+}
+
+// ClientOptions is comparable, so it is used as key of the client cache
+type ClientOptions struct {
+	ProxyURL           string
+	CABundle           string
+	ClientCert         string
+	ClientKey          string
+	InsecureSkipVerify bool
+	DisableHTTP2       bool
+	SourceIP           string
+}
+
+type clientCache struct {
+	mu      sync.Mutex
+	clients map[ClientOptions]*http.Client
 }
 
 const (
@@ -22,6 +46,7 @@ const (
 	userAgentPrefix                 = "Squzy_monitoring"
 	logHeader                       = "Squzy_scheduler_id"
 	userAgentHeaderKey              = "User-Agent"
+	dialKeepAlive                   = 30 * time.Second
 )
 
 var (
@@ -37,6 +62,9 @@ var (
 		)
 	}
 	defaultTimeout = helpers.DurationFromSecond(RequestTimeout)
+
+	errCABundleNotValid = errors.New("CA_BUNDLE_NOT_VALID")
+	errSourceIPNotValid = errors.New("SOURCE_IP_NOT_VALID")
 )
 
 type HTTPTool interface {
@@ -45,6 +73,53 @@ type HTTPTool interface {
 	SendRequestWithStatusCode(req *http.Request, expectedCode int) (int, []byte, error)
 	SendRequestTimeoutStatusCode(req *http.Request, timeout time.Duration, expectedCode int) (int, []byte, error)
 	CreateRequest(method string, url string, headers *map[string]string, schedulerID string) *http.Request
+	// Returns tool which sends requests with client of the options, tool itself is returned if options are empty
+	WithOptions(options *ClientOptions) (HTTPTool, error)
+	// Returns tool which authenticates every request, tool itself is returned if auth is nil
+	WithAuth(auth *AuthOptions) HTTPTool
+}
+
+// ClientRemover is implemented by tools which cache clients per options
+type ClientRemover interface {
+	// Drops client of the options and closes its idle connections, should be called when scheduler is removed
+	RemoveClient(options *ClientOptions)
+}
+
+func (h *httpTool) WithOptions(options *ClientOptions) (HTTPTool, error) {
+	if options == nil || *options == (ClientOptions{}) {
+		return h, nil
+	}
+	client, err := h.clients.get(*options)
+	if err != nil {
+		return nil, err
+	}
+	return &httpTool{
+		userAgent:  h.userAgent,
+		client:     client,
+		clients:    h.clients,
+		auth:       h.auth,
+		authorizer: h.authorizer,
+	}, nil
+}
+
+func (h *httpTool) RemoveClient(options *ClientOptions) {
+	if options == nil {
+		return
+	}
+	h.clients.remove(*options)
+}
+
+func (h *httpTool) WithAuth(auth *AuthOptions) HTTPTool {
+	if auth == nil {
+		return h
+	}
+	return &httpTool{
+		userAgent:  h.userAgent,
+		client:     h.client,
+		clients:    h.clients,
+		auth:       auth,
+		authorizer: h.authorizer,
+	}
 }
 
 func (h *httpTool) CreateRequest(method string, url string, headers *map[string]string, logID string) *http.Request {
@@ -68,11 +143,11 @@ func (h *httpTool) CreateRequest(method string, url string, headers *map[string]
 }
 
 func (h *httpTool) SendRequest(req *http.Request) (int, []byte, error) {
-	return sendReq(h.client, req, false, 0)
+	return h.send(h.client, req, false, 0)
 }
 
 func (h *httpTool) SendRequestWithStatusCode(req *http.Request, expectedCode int) (int, []byte, error) {
-	return sendReq(h.client, req, true, expectedCode)
+	return h.send(h.client, req, true, expectedCode)
 }
 
 func (h *httpTool) SendRequestTimeout(req *http.Request, timeout time.Duration) (int, []byte, error) {
@@ -86,12 +161,30 @@ func (h *httpTool) SendRequestTimeoutStatusCode(req *http.Request, timeout time.
 func (h *httpTool) sendRequestTimeout(req *http.Request, timeout time.Duration, checkCode bool, code int) (int, []byte, error) {
 	// If timeout not present will be use method with custom http client
 	if timeout.Seconds() <= 0 {
-		return sendReq(h.client, req, checkCode, code)
+		return h.send(h.client, req, checkCode, code)
 	}
 	ctx, cancel := helpers.TimeoutContext(context.Background(), timeout)
 	defer cancel()
 	reqTimeout := req.WithContext(ctx)
-	return sendReq(http.DefaultClient, reqTimeout, checkCode, code)
+	// Timeout is set by context, transport is shared to reuse connections
+	client := &http.Client{
+		Transport: h.client.Transport,
+	}
+	return h.send(client, reqTimeout, checkCode, code)
+}
+
+func (h *httpTool) send(client *http.Client, req *http.Request, checkCode bool, statusCode int) (int, []byte, error) {
+	if h.auth != nil {
+		err := h.authorizer.authorize(client, req, h.auth)
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+	code, data, err := sendReq(client, req, checkCode, statusCode)
+	if code == http.StatusUnauthorized && h.auth != nil {
+		h.authorizer.invalidate(req, h.auth)
+	}
+	return code, data, err
 }
 
 func sendReq(client *http.Client, req *http.Request, checkCode bool, statusCode int) (int, []byte, error) {
@@ -125,6 +218,85 @@ func getUserAgent(version string) string {
 	return fmt.Sprintf("%s_%s", userAgentPrefix, version)
 }
 
+func (c *clientCache) get(options ClientOptions) (*http.Client, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if client, exist := c.clients[options]; exist {
+		return client, nil
+	}
+	client, err := newClient(options)
+	if err != nil {
+		return nil, err
+	}
+	c.clients[options] = client
+	return client, nil
+}
+
+// Client is created again on the next use, so removing client which is shared by several schedulers is safe
+func (c *clientCache) remove(options ClientOptions) {
+	c.mu.Lock()
+	client, exist := c.clients[options]
+	delete(c.clients, options)
+	c.mu.Unlock()
+	if exist {
+		client.CloseIdleConnections()
+	}
+}
+
+// Client starts from the default transport, so proxy from environment and timeouts are kept if options do not override them
+func newClient(options ClientOptions) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConnsPerHost = MaxIdleConnectionsPerHost
+	transport.MaxIdleConns = MaxIdleConnections
+	transport.ForceAttemptHTTP2 = !options.DisableHTTP2
+	transport.TLSClientConfig = &tls.Config{
+		InsecureSkipVerify: options.InsecureSkipVerify,
+	}
+	if options.DisableHTTP2 {
+		// Not nil empty map disables HTTP/2
+		transport.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+	}
+	if options.ProxyURL != "" {
+		proxyURL, err := url.Parse(options.ProxyURL)
+		if err != nil {
+			return nil, err
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+	if options.CABundle != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM([]byte(options.CABundle)) {
+			return nil, errCABundleNotValid
+		}
+		transport.TLSClientConfig.RootCAs = pool
+	}
+	if options.ClientCert != "" || options.ClientKey != "" {
+		cert, err := tls.X509KeyPair([]byte(options.ClientCert), []byte(options.ClientKey))
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig.Certificates = []tls.Certificate{cert}
+	}
+	if options.SourceIP != "" {
+		ip := net.ParseIP(options.SourceIP)
+		if ip == nil {
+			return nil, errSourceIPNotValid
+		}
+		transport.DialContext = (&net.Dialer{
+			LocalAddr: &net.TCPAddr{IP: ip},
+			Timeout:   defaultTimeout,
+			KeepAlive: dialKeepAlive,
+		}).DialContext
+	}
+	return &http.Client{
+		Transport: transport,
+		Timeout:   defaultTimeout,
+	}, nil
+}
+
 func New(userAgentVersion string) HTTPTool {
 	return &httpTool{
 		userAgent: getUserAgent(userAgentVersion),
@@ -135,5 +307,9 @@ func New(userAgentVersion string) HTTPTool {
 			},
 			Timeout: defaultTimeout,
 		},
+		clients: &clientCache{
+			clients: make(map[ClientOptions]*http.Client),
+		},
+		authorizer: newAuthorizer(),
 	}
 }
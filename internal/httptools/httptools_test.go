@@ -1,6 +1,7 @@
 package httptools
 
 import (
+	"encoding/pem"
 	"github.com/stretchr/testify/assert"
 	"io"
 	"math"
@@ -208,3 +209,89 @@ func TestHttpTool_SendRequestTimeoutStatusCode(t *testing.T) {
 		assert.Equal(t, []uint8([]byte(nil)), body)
 	})
 }
+
+func TestHttpTool_WithOptions(t *testing.T) {
+	t.Run("Should: return same tool because options empty", func(t *testing.T) {
+		j := New("")
+		c, err := j.WithOptions(nil)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, j, c)
+		c, err = j.WithOptions(&ClientOptions{})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, j, c)
+	})
+	t.Run("Should: cache client per options", func(t *testing.T) {
+		j := New("")
+		c1, _ := j.WithOptions(&ClientOptions{InsecureSkipVerify: true})
+		c2, _ := j.WithOptions(&ClientOptions{InsecureSkipVerify: true})
+		c3, _ := j.WithOptions(&ClientOptions{DisableHTTP2: true})
+		assert.Equal(t, c1.(*httpTool).client, c2.(*httpTool).client)
+		assert.NotEqual(t, c1.(*httpTool).client, c3.(*httpTool).client)
+	})
+	t.Run("Should: keep proxy from environment and timeouts of default transport", func(t *testing.T) {
+		c, _ := New("").WithOptions(&ClientOptions{InsecureSkipVerify: true})
+		transport := c.(*httpTool).client.Transport.(*http.Transport)
+		assert.NotNil(t, transport.Proxy)
+		assert.Equal(t, http.DefaultTransport.(*http.Transport).TLSHandshakeTimeout, transport.TLSHandshakeTimeout)
+		assert.Equal(t, http.DefaultTransport.(*http.Transport).IdleConnTimeout, transport.IdleConnTimeout)
+	})
+	t.Run("Should: create client again after it was removed", func(t *testing.T) {
+		j := New("")
+		options := &ClientOptions{InsecureSkipVerify: true}
+		c1, _ := j.WithOptions(options)
+		j.(ClientRemover).RemoveClient(options)
+		j.(ClientRemover).RemoveClient(nil)
+		c2, _ := j.WithOptions(options)
+		assert.False(t, c1.(*httpTool).client == c2.(*httpTool).client)
+	})
+	t.Run("Should: return error because options not valid", func(t *testing.T) {
+		j := New("")
+		_, err := j.WithOptions(&ClientOptions{CABundle: "ca"})
+		assert.Equal(t, errCABundleNotValid, err)
+		_, err = j.WithOptions(&ClientOptions{SourceIP: "ip"})
+		assert.Equal(t, errSourceIPNotValid, err)
+		_, err = j.WithOptions(&ClientOptions{ClientCert: "cert", ClientKey: "key"})
+		assert.NotEqual(t, nil, err)
+		_, err = j.WithOptions(&ClientOptions{ProxyURL: ":proxy"})
+		assert.NotEqual(t, nil, err)
+	})
+	t.Run("Should: trust server because of CA bundle", func(t *testing.T) {
+		ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(200)
+		}))
+		defer ts.Close()
+		j := New("")
+		_, _, err := j.SendRequest(newRequest(http.MethodGet, ts.URL, nil))
+		assert.NotEqual(t, nil, err)
+
+		ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})
+		c, err := j.WithOptions(&ClientOptions{CABundle: string(ca)})
+		assert.Equal(t, nil, err)
+		code, _, err := c.SendRequest(newRequest(http.MethodGet, ts.URL, nil))
+		assert.Equal(t, nil, err)
+		assert.Equal(t, http.StatusOK, code)
+	})
+	t.Run("Should: skip verify of server certificate", func(t *testing.T) {
+		ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(200)
+		}))
+		defer ts.Close()
+		c, _ := New("").WithOptions(&ClientOptions{InsecureSkipVerify: true, DisableHTTP2: true})
+		code, _, err := c.SendRequestTimeout(newRequest(http.MethodGet, ts.URL, nil), time.Second)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, http.StatusOK, code)
+	})
+	t.Run("Should: send request through proxy from source ip", func(t *testing.T) {
+		proxied := ""
+		proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			proxied = r.URL.String()
+			w.WriteHeader(202)
+		}))
+		defer proxy.Close()
+		c, _ := New("").WithOptions(&ClientOptions{ProxyURL: proxy.URL, SourceIP: "127.0.0.1"})
+		code, _, err := c.SendRequest(newRequest(http.MethodGet, "http://squzy.local/health", nil))
+		assert.Equal(t, nil, err)
+		assert.Equal(t, http.StatusAccepted, code)
+		assert.Equal(t, "http://squzy.local/health", proxied)
+	})
+}
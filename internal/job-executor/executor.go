@@ -3,6 +3,9 @@ package job_executor
 import (
 	"context"
 	"crypto/tls"
+	"errors"
+	content_storage "github.com/squzy/squzy/internal/content-storage"
+	heartbeat_storage "github.com/squzy/squzy/internal/heartbeat-storage"
 	"github.com/squzy/squzy/internal/httptools"
 	"github.com/squzy/squzy/internal/job"
 	"github.com/squzy/squzy/internal/logger"
@@ -12,7 +15,17 @@ import (
 	"github.com/squzy/squzy/internal/storage"
 	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// Ping errors are returned to the api, so they carry codes which could be mapped to http status
+	errSchedulerNotFound     = status.Error(codes.NotFound, "scheduler not found")
+	errSchedulerNotHeartbeat = status.Error(codes.FailedPrecondition, "scheduler is not heartbeat")
+	errSchedulerNotRunned    = status.Error(codes.FailedPrecondition, "scheduler is not runned")
 )
 
 type HTTPExecutor func(schedulerId string,
@@ -27,6 +40,41 @@ type SSLExpirationExecutor func(
 	cfg *tls.Config,
 ) job.CheckError
 
+type DatabaseExecutor func(
+	schedulerId string,
+	timeout int32,
+	config *scheduler_config_storage.DatabaseConfig,
+) job.CheckError
+
+type HeartbeatExecutor func(
+	schedulerId string,
+	interval int32,
+	config *scheduler_config_storage.HeartbeatConfig,
+	heartbeatStorage heartbeat_storage.HeartbeatStorage,
+) job.CheckError
+
+type HeartbeatPingExecutor func(
+	schedulerId string,
+	pingType apiPb.PingRequest_PingType,
+	message string,
+	heartbeatStorage heartbeat_storage.HeartbeatStorage,
+) job.CheckError
+
+type ContentChangeExecutor func(
+	schedulerId string,
+	timeout int32,
+	config *scheduler_config_storage.ContentChangeConfig,
+	httpTool httptools.HTTPTool,
+	contentStorage content_storage.ContentStorage,
+) job.CheckError
+
+type PrometheusExecutor func(
+	schedulerId string,
+	timeout int32,
+	config *scheduler_config_storage.PrometheusConfig,
+	httpTool httptools.HTTPTool,
+) job.CheckError
+
 type GrpcExecutor func(schedulerId string,
 	timeout int32,
 	config *scheduler_config_storage.GrpcConfig,
@@ -47,6 +95,13 @@ type HTTPValueExecutor func(
 	config *scheduler_config_storage.HTTPValueConfig,
 	httpTool httptools.HTTPTool) job.CheckError
 
+type WebSocketExecutor func(
+	schedulerId string,
+	timeout int32,
+	config *scheduler_config_storage.WebSocketConfig,
+	cfg *tls.Config,
+) job.CheckError
+
 type executor struct {
 	externalStorage    storage.Storage
 	siteMapStorage     sitemap_storage.SiteMapStorage
@@ -59,6 +114,14 @@ type executor struct {
 	execSiteMap        SiteMapExecutor
 	execHTTPValue      HTTPValueExecutor
 	execSSLExpiration  SSLExpirationExecutor
+	execWebSocket      WebSocketExecutor
+	execDatabase       DatabaseExecutor
+	heartbeatStorage   heartbeat_storage.HeartbeatStorage
+	execHeartbeat      HeartbeatExecutor
+	execHeartbeatPing  HeartbeatPingExecutor
+	contentStorage     content_storage.ContentStorage
+	execContentChange  ContentChangeExecutor
+	execPrometheus     PrometheusExecutor
 }
 
 func (e *executor) Execute(schedulerID primitive.ObjectID) {
@@ -91,13 +154,96 @@ func (e *executor) Execute(schedulerID primitive.ObjectID) {
 	case apiPb.SchedulerType_SSL_EXPIRATION:
 		_ = e.externalStorage.Write(e.execSSLExpiration(id, config.Timeout, config.SslExpirationConfig, nil))
 		logger.Infof("SSL Expiration job executed is used for scheduler id %s", schedulerID)
+	case apiPb.SchedulerType_WEBSOCKET:
+		_ = e.externalStorage.Write(e.execWebSocket(id, config.Timeout, config.WebSocketConfig, nil))
+		logger.Infof("WebSocket job executed is used for scheduler id %s", schedulerID)
+	case apiPb.SchedulerType_DATABASE:
+		_ = e.externalStorage.Write(e.execDatabase(id, config.Timeout, config.DatabaseConfig))
+		logger.Infof("Database job executed is used for scheduler id %s", schedulerID)
+	case apiPb.SchedulerType_HEARTBEAT:
+		// Ok snapshots written by pings, only missed ping should be written here
+		checkError := e.execHeartbeat(id, config.Interval, config.HeartbeatConfig, e.heartbeatStorage)
+		if checkError != nil {
+			_ = e.externalStorage.Write(checkError)
+		}
+		logger.Infof("Heartbeat job executed is used for scheduler id %s", schedulerID)
+	case apiPb.SchedulerType_CONTENT_CHANGE:
+		_ = e.externalStorage.Write(e.execContentChange(id, config.Timeout, config.ContentChangeConfig, e.httpTool, e.contentStorage))
+		logger.Infof("Content change job executed is used for scheduler id %s", schedulerID)
+	case apiPb.SchedulerType_PROMETHEUS:
+		_ = e.externalStorage.Write(e.execPrometheus(id, config.Timeout, config.PrometheusConfig, e.httpTool))
+		logger.Infof("Prometheus job executed is used for scheduler id %s", schedulerID)
 	default:
 		logger.Errorf("Incorrect config type passed to job executor: %s", config.Type)
 	}
 }
 
+func (e *executor) Ping(schedulerID primitive.ObjectID, pingType apiPb.PingRequest_PingType, message string) error {
+	config, err := e.configStorage.Get(context.Background(), schedulerID)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return errSchedulerNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if config == nil {
+		return errSchedulerNotFound
+	}
+	if config.Type != apiPb.SchedulerType_HEARTBEAT {
+		return errSchedulerNotHeartbeat
+	}
+	if config.Status != apiPb.SchedulerStatus_RUNNED {
+		return errSchedulerNotRunned
+	}
+	checkError := e.execHeartbeatPing(schedulerID.Hex(), pingType, message, e.heartbeatStorage)
+	if checkError != nil {
+		err = e.externalStorage.Write(checkError)
+		if err != nil {
+			return status.Error(codes.Unavailable, err.Error())
+		}
+	}
+	return nil
+}
+
+func (e *executor) Reset(schedulerID primitive.ObjectID) {
+	e.heartbeatStorage.Remove(schedulerID.Hex())
+}
+
+func (e *executor) Remove(schedulerID primitive.ObjectID) error {
+	e.Reset(schedulerID)
+	e.removeClient(schedulerID)
+	return e.contentStorage.Remove(context.Background(), schedulerID.Hex())
+}
+
+// Client of the scheduler options is cached by http tool, so it is dropped with the scheduler
+func (e *executor) removeClient(schedulerID primitive.ObjectID) {
+	remover, ok := e.httpTool.(httptools.ClientRemover)
+	if !ok {
+		return
+	}
+	config, err := e.configStorage.Get(context.Background(), schedulerID)
+	if err != nil || config == nil {
+		return
+	}
+	var client *scheduler_config_storage.HTTPClientConfig
+	switch {
+	case config.HTTPConfig != nil:
+		client = config.HTTPConfig.Client
+	case config.HTTPValueConfig != nil:
+		client = config.HTTPValueConfig.Client
+	case config.SiteMapConfig != nil:
+		client = config.SiteMapConfig.Client
+	}
+	remover.RemoveClient(job.HTTPClientOptions(client))
+}
+
 type JobExecutor interface {
 	Execute(schedulerID primitive.ObjectID)
+	Ping(schedulerID primitive.ObjectID, pingType apiPb.PingRequest_PingType, message string) error
+	// Reset removes state which checks keep between runs, it should be called when scheduler is run, stopped or removed
+	Reset(schedulerID primitive.ObjectID)
+	// Remove drops all state of removed scheduler including state persisted with its config
+	Remove(schedulerID primitive.ObjectID) error
 }
 
 func NewExecutor(
@@ -112,6 +258,14 @@ func NewExecutor(
 	execSiteMap SiteMapExecutor,
 	execHTTPValue HTTPValueExecutor,
 	execSSLExpiration SSLExpirationExecutor,
+	execWebSocket WebSocketExecutor,
+	execDatabase DatabaseExecutor,
+	heartbeatStorage heartbeat_storage.HeartbeatStorage,
+	execHeartbeat HeartbeatExecutor,
+	execHeartbeatPing HeartbeatPingExecutor,
+	contentStorage content_storage.ContentStorage,
+	execContentChange ContentChangeExecutor,
+	execPrometheus PrometheusExecutor,
 ) JobExecutor {
 	return &executor{
 		externalStorage:    externalStorage,
@@ -125,5 +279,13 @@ func NewExecutor(
 		execSiteMap:        execSiteMap,
 		execHTTPValue:      execHTTPValue,
 		execSSLExpiration:  execSSLExpiration,
+		execWebSocket:      execWebSocket,
+		execDatabase:       execDatabase,
+		heartbeatStorage:   heartbeatStorage,
+		execHeartbeat:      execHeartbeat,
+		execHeartbeatPing:  execHeartbeatPing,
+		contentStorage:     contentStorage,
+		execContentChange:  execContentChange,
+		execPrometheus:     execPrometheus,
 	}
 }
@@ -8,6 +8,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"google.golang.org/grpc"
+	content_storage "github.com/squzy/squzy/internal/content-storage"
+	heartbeat_storage "github.com/squzy/squzy/internal/heartbeat-storage"
 	"github.com/squzy/squzy/internal/httptools"
 	"github.com/squzy/squzy/internal/job"
 	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
@@ -58,6 +60,27 @@ func (c configStorageMockOk) GetAllForSync(ctx context.Context) ([]*scheduler_co
 	panic("implement me")
 }
 
+type configStorageMockHeartbeat struct {
+	configStorageMockOk
+	status apiPb.SchedulerStatus
+}
+
+func (c configStorageMockHeartbeat) Get(ctx context.Context, schedulerId primitive.ObjectID) (*scheduler_config_storage.SchedulerConfig, error) {
+	return &scheduler_config_storage.SchedulerConfig{
+		ID:     schedulerId,
+		Type:   c.typeOfChecker,
+		Status: c.status,
+	}, nil
+}
+
+type configStorageMockNil struct {
+	configStorageMockOk
+}
+
+func (c configStorageMockNil) Get(ctx context.Context, schedulerId primitive.ObjectID) (*scheduler_config_storage.SchedulerConfig, error) {
+	return nil, nil
+}
+
 type configStorageMockError struct {
 }
 
@@ -128,6 +151,66 @@ func (m *fnMock) SSLExpirationMock(
 	return nil
 }
 
+func (m *fnMock) WebSocketMock(
+	schedulerId string,
+	timeout int32,
+	config *scheduler_config_storage.WebSocketConfig,
+	cfg *tls.Config,
+) job.CheckError {
+	m.executed = true
+	return nil
+}
+
+func (m *fnMock) DatabaseMock(
+	schedulerId string,
+	timeout int32,
+	config *scheduler_config_storage.DatabaseConfig,
+) job.CheckError {
+	m.executed = true
+	return nil
+}
+
+func (m *fnMock) HeartbeatMock(
+	schedulerId string,
+	interval int32,
+	config *scheduler_config_storage.HeartbeatConfig,
+	heartbeatStorage heartbeat_storage.HeartbeatStorage,
+) job.CheckError {
+	m.executed = true
+	return nil
+}
+
+func (m *fnMock) HeartbeatPingMock(
+	schedulerId string,
+	pingType apiPb.PingRequest_PingType,
+	message string,
+	heartbeatStorage heartbeat_storage.HeartbeatStorage,
+) job.CheckError {
+	m.executed = true
+	return nil
+}
+
+func (m *fnMock) ContentChangeMock(
+	schedulerId string,
+	timeout int32,
+	config *scheduler_config_storage.ContentChangeConfig,
+	httpTool httptools.HTTPTool,
+	contentStorage content_storage.ContentStorage,
+) job.CheckError {
+	m.executed = true
+	return nil
+}
+
+func (m *fnMock) PrometheusMock(
+	schedulerId string,
+	timeout int32,
+	config *scheduler_config_storage.PrometheusConfig,
+	httpTool httptools.HTTPTool,
+) job.CheckError {
+	m.executed = true
+	return nil
+}
+
 func TestNewExecutor(t *testing.T) {
 	t.Run("Should: implement interface", func(t *testing.T) {
 		s := NewExecutor(
@@ -142,6 +225,14 @@ func TestNewExecutor(t *testing.T) {
 			nil,
 			nil,
 			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
 		)
 		assert.Implements(t, (*JobExecutor)(nil), s)
 	})
@@ -162,6 +253,14 @@ func TestExecutor_Execute(t *testing.T) {
 			fnMock.SiteMapMock,
 			fnMock.HttpValueMock,
 			fnMock.SSLExpirationMock,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
 		)
 		s.Execute(primitive.NewObjectID())
 		assert.Equal(t, false, fnMock.executed)
@@ -182,6 +281,14 @@ func TestExecutor_Execute(t *testing.T) {
 			nil,
 			nil,
 			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
 		)
 		s.Execute(primitive.NewObjectID())
 		assert.Equal(t, true, fnMock.executed)
@@ -202,6 +309,14 @@ func TestExecutor_Execute(t *testing.T) {
 			nil,
 			nil,
 			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
 		)
 		s.Execute(primitive.NewObjectID())
 		assert.Equal(t, true, fnMock.executed)
@@ -222,6 +337,14 @@ func TestExecutor_Execute(t *testing.T) {
 			nil,
 			nil,
 			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
 		)
 		s.Execute(primitive.NewObjectID())
 		assert.Equal(t, true, fnMock.executed)
@@ -242,6 +365,14 @@ func TestExecutor_Execute(t *testing.T) {
 			fnMock.SiteMapMock,
 			nil,
 			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
 		)
 		s.Execute(primitive.NewObjectID())
 		assert.Equal(t, true, fnMock.executed)
@@ -262,6 +393,14 @@ func TestExecutor_Execute(t *testing.T) {
 			nil,
 			nil,
 			fnMock.SSLExpirationMock,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
 		)
 		s.Execute(primitive.NewObjectID())
 		assert.Equal(t, true, fnMock.executed)
@@ -282,6 +421,154 @@ func TestExecutor_Execute(t *testing.T) {
 			nil,
 			fnMock.HttpValueMock,
 			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+		)
+		s.Execute(primitive.NewObjectID())
+		assert.Equal(t, true, fnMock.executed)
+	})
+	t.Run("Should: execute websocket mock", func(t *testing.T) {
+		fnMock := &fnMock{}
+		s := NewExecutor(
+			&externalStorageMock{},
+			nil,
+			nil,
+			nil,
+			&configStorageMockOk{
+				apiPb.SchedulerType_WEBSOCKET,
+			},
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			fnMock.WebSocketMock,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+		)
+		s.Execute(primitive.NewObjectID())
+		assert.Equal(t, true, fnMock.executed)
+	})
+	t.Run("Should: execute database mock", func(t *testing.T) {
+		fnMock := &fnMock{}
+		s := NewExecutor(
+			&externalStorageMock{},
+			nil,
+			nil,
+			nil,
+			&configStorageMockOk{
+				apiPb.SchedulerType_DATABASE,
+			},
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			fnMock.DatabaseMock,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+		)
+		s.Execute(primitive.NewObjectID())
+		assert.Equal(t, true, fnMock.executed)
+	})
+	t.Run("Should: execute heartbeat mock", func(t *testing.T) {
+		fnMock := &fnMock{}
+		s := NewExecutor(
+			&externalStorageMock{},
+			nil,
+			nil,
+			nil,
+			&configStorageMockOk{
+				apiPb.SchedulerType_HEARTBEAT,
+			},
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			fnMock.HeartbeatMock,
+			nil,
+			nil,
+			nil,
+			nil,
+		)
+		s.Execute(primitive.NewObjectID())
+		assert.Equal(t, true, fnMock.executed)
+	})
+	t.Run("Should: execute content change mock", func(t *testing.T) {
+		fnMock := &fnMock{}
+		s := NewExecutor(
+			&externalStorageMock{},
+			nil,
+			nil,
+			nil,
+			&configStorageMockOk{
+				apiPb.SchedulerType_CONTENT_CHANGE,
+			},
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			fnMock.ContentChangeMock,
+			nil,
+		)
+		s.Execute(primitive.NewObjectID())
+		assert.Equal(t, true, fnMock.executed)
+	})
+	t.Run("Should: execute prometheus mock", func(t *testing.T) {
+		fnMock := &fnMock{}
+		s := NewExecutor(
+			&externalStorageMock{},
+			nil,
+			nil,
+			nil,
+			&configStorageMockOk{
+				apiPb.SchedulerType_PROMETHEUS,
+			},
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			fnMock.PrometheusMock,
 		)
 		s.Execute(primitive.NewObjectID())
 		assert.Equal(t, true, fnMock.executed)
@@ -302,8 +589,290 @@ func TestExecutor_Execute(t *testing.T) {
 			nil,
 			nil,
 			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
 		)
 		s.Execute(primitive.NewObjectID())
 		assert.Equal(t, false, fnMock.executed)
 	})
 }
+
+func TestExecutor_Ping(t *testing.T) {
+	t.Run("Should: execute heartbeat ping mock", func(t *testing.T) {
+		fnMock := &fnMock{}
+		s := NewExecutor(
+			&externalStorageMock{},
+			nil,
+			nil,
+			nil,
+			&configStorageMockHeartbeat{
+				configStorageMockOk: configStorageMockOk{apiPb.SchedulerType_HEARTBEAT},
+				status:              apiPb.SchedulerStatus_RUNNED,
+			},
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			fnMock.HeartbeatPingMock,
+			nil,
+			nil,
+			nil,
+		)
+		err := s.Ping(primitive.NewObjectID(), apiPb.PingRequest_SUCCESS, "")
+		assert.Nil(t, err)
+		assert.Equal(t, true, fnMock.executed)
+	})
+	t.Run("Should: return error because scheduler stopped", func(t *testing.T) {
+		s := NewExecutor(
+			&externalStorageMock{},
+			nil,
+			nil,
+			nil,
+			&configStorageMockHeartbeat{
+				configStorageMockOk: configStorageMockOk{apiPb.SchedulerType_HEARTBEAT},
+				status:              apiPb.SchedulerStatus_STOPPED,
+			},
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+		)
+		err := s.Ping(primitive.NewObjectID(), apiPb.PingRequest_SUCCESS, "")
+		assert.Equal(t, errSchedulerNotRunned, err)
+	})
+	t.Run("Should: return error because scheduler not heartbeat", func(t *testing.T) {
+		s := NewExecutor(
+			&externalStorageMock{},
+			nil,
+			nil,
+			nil,
+			&configStorageMockHeartbeat{
+				configStorageMockOk: configStorageMockOk{apiPb.SchedulerType_HTTP},
+				status:              apiPb.SchedulerStatus_RUNNED,
+			},
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+		)
+		err := s.Ping(primitive.NewObjectID(), apiPb.PingRequest_SUCCESS, "")
+		assert.Equal(t, errSchedulerNotHeartbeat, err)
+	})
+	t.Run("Should: return error because scheduler not found", func(t *testing.T) {
+		s := NewExecutor(
+			&externalStorageMock{},
+			nil,
+			nil,
+			nil,
+			&configStorageMockNil{},
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+		)
+		err := s.Ping(primitive.NewObjectID(), apiPb.PingRequest_SUCCESS, "")
+		assert.Equal(t, errSchedulerNotFound, err)
+	})
+	t.Run("Should: return error because config storage error", func(t *testing.T) {
+		s := NewExecutor(
+			&externalStorageMock{},
+			nil,
+			nil,
+			nil,
+			&configStorageMockError{},
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+		)
+		err := s.Ping(primitive.NewObjectID(), apiPb.PingRequest_SUCCESS, "")
+		assert.NotNil(t, err)
+	})
+}
+
+type heartbeatStorageMock struct {
+	heartbeat_storage.HeartbeatStorage
+	removed []string
+}
+
+func (m *heartbeatStorageMock) Remove(schedulerID string) {
+	m.removed = append(m.removed, schedulerID)
+}
+
+func TestExecutor_Reset(t *testing.T) {
+	t.Run("Should: remove heartbeat state of scheduler", func(t *testing.T) {
+		heartbeatStorage := &heartbeatStorageMock{}
+		s := NewExecutor(
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			heartbeatStorage,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+		)
+		id := primitive.NewObjectID()
+		s.Reset(id)
+		assert.Equal(t, []string{id.Hex()}, heartbeatStorage.removed)
+	})
+}
+
+type contentStorageMock struct {
+	content_storage.ContentStorage
+	removed []string
+}
+
+func (m *contentStorageMock) Remove(ctx context.Context, schedulerID string) error {
+	m.removed = append(m.removed, schedulerID)
+	return nil
+}
+
+func TestExecutor_Remove(t *testing.T) {
+	t.Run("Should: remove heartbeat and content state of scheduler", func(t *testing.T) {
+		heartbeatStorage := &heartbeatStorageMock{}
+		contentStorage := &contentStorageMock{}
+		s := NewExecutor(
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			heartbeatStorage,
+			nil,
+			nil,
+			contentStorage,
+			nil,
+			nil,
+		)
+		id := primitive.NewObjectID()
+		assert.Nil(t, s.Remove(id))
+		assert.Equal(t, []string{id.Hex()}, heartbeatStorage.removed)
+		assert.Equal(t, []string{id.Hex()}, contentStorage.removed)
+	})
+}
+
+type configStorageMockClient struct {
+	configStorageMockOk
+}
+
+func (c configStorageMockClient) Get(ctx context.Context, schedulerId primitive.ObjectID) (*scheduler_config_storage.SchedulerConfig, error) {
+	return &scheduler_config_storage.SchedulerConfig{
+		ID:   schedulerId,
+		Type: apiPb.SchedulerType_HTTP,
+		HTTPConfig: &scheduler_config_storage.HTTPConfig{
+			Client: &scheduler_config_storage.HTTPClientConfig{
+				ProxyURL: "http://proxy",
+			},
+		},
+	}, nil
+}
+
+type httpToolMockRemover struct {
+	httptools.HTTPTool
+	removed []*httptools.ClientOptions
+}
+
+func (m *httpToolMockRemover) RemoveClient(options *httptools.ClientOptions) {
+	m.removed = append(m.removed, options)
+}
+
+func TestExecutor_RemoveClient(t *testing.T) {
+	t.Run("Should: remove cached client of scheduler options", func(t *testing.T) {
+		httpTool := &httpToolMockRemover{}
+		s := NewExecutor(
+			nil,
+			nil,
+			httpTool,
+			nil,
+			&configStorageMockClient{},
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			&heartbeatStorageMock{},
+			nil,
+			nil,
+			&contentStorageMock{},
+			nil,
+			nil,
+		)
+		assert.Nil(t, s.Remove(primitive.NewObjectID()))
+		assert.Equal(t, []*httptools.ClientOptions{{ProxyURL: "http://proxy"}}, httpTool.removed)
+	})
+}
@@ -0,0 +1,285 @@
+package job
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/andybalholm/cascadia"
+	"github.com/antchfx/htmlquery"
+	content_storage "github.com/squzy/squzy/internal/content-storage"
+	"github.com/squzy/squzy/internal/helpers"
+	"github.com/squzy/squzy/internal/httptools"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/tidwall/gjson"
+	"golang.org/x/net/html"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamp "google.golang.org/protobuf/types/known/timestamppb"
+	"net/http"
+	"strings"
+)
+
+const (
+	contentHashKey         = "hash"
+	contentPreviousHashKey = "previousHash"
+	contentAddedKey        = "added"
+	contentRemovedKey      = "removed"
+	contentAddedLinesKey   = "addedLines"
+	contentRemovedLinesKey = "removedLines"
+	// Max lines of each kind stored in diff summary
+	contentDiffLinesLimit = 5
+)
+
+var (
+	errContentChanged = errors.New("content changed")
+
+	selectorNotMatchedErrorFn = func(selector string) error {
+		return fmt.Errorf("nothing matched by selector=`%s`", selector)
+	}
+)
+
+type contentChangeError struct {
+	schedulerID string
+	startTime   *timestamp.Timestamp
+	endTime     *timestamp.Timestamp
+	code        apiPb.SchedulerCode
+	description string
+	value       *structpb.Value
+}
+
+func (e *contentChangeError) GetLogData() *apiPb.SchedulerResponse {
+	var err *apiPb.SchedulerSnapshot_Error
+	if e.code == apiPb.SchedulerCode_ERROR {
+		err = &apiPb.SchedulerSnapshot_Error{
+			Message: e.description,
+		}
+	}
+	return &apiPb.SchedulerResponse{
+		SchedulerId: e.schedulerID,
+		Snapshot: &apiPb.SchedulerSnapshot{
+			Code:  e.code,
+			Error: err,
+			Type:  apiPb.SchedulerType_CONTENT_CHANGE,
+			Meta: &apiPb.SchedulerSnapshot_MetaData{
+				StartTime: e.startTime,
+				EndTime:   e.endTime,
+				Value:     e.value,
+			},
+		},
+	}
+}
+
+func newContentChangeError(schedulerID string, startTime *timestamp.Timestamp, endTime *timestamp.Timestamp, code apiPb.SchedulerCode, description string, value *structpb.Value) CheckError {
+	return &contentChangeError{
+		schedulerID: schedulerID,
+		startTime:   startTime,
+		endTime:     endTime,
+		code:        code,
+		description: description,
+		value:       value,
+	}
+}
+
+// ExecContentChange compare hash of normalized content with pinned baseline or with content of the previous run
+func ExecContentChange(schedulerID string, timeout int32, config *scheduler_config_storage.ContentChangeConfig, httpTool httptools.HTTPTool, contentStorage content_storage.ContentStorage) CheckError {
+	startTime := timestamp.Now()
+	client := httpTool.WithAuth(httpAuthOptions(config.Auth))
+	req := client.CreateRequest(http.MethodGet, config.URL, &config.Headers, schedulerID)
+
+	_, data, err := client.SendRequestTimeoutStatusCode(req, helpers.DurationFromSecond(timeout), http.StatusOK)
+	if err != nil {
+		return newContentChangeError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_ERROR, err.Error(), nil)
+	}
+
+	content, err := selectContent(data, config.SelectorType, config.Selector)
+	if err != nil {
+		return newContentChangeError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_ERROR, err.Error(), nil)
+	}
+	content = normalizeContent(content)
+
+	ctx := context.Background()
+	state, err := contentStorage.Get(ctx, schedulerID)
+	if err != nil {
+		return newContentChangeError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_ERROR, err.Error(), nil)
+	}
+	hash := contentHash(content)
+	err = contentStorage.Set(ctx, schedulerID, hash, content)
+	if err != nil {
+		return newContentChangeError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_ERROR, err.Error(), nil)
+	}
+
+	baseline := config.Baseline
+	if baseline == "" {
+		baseline = hash
+		if state != nil {
+			baseline = state.Hash
+		}
+	}
+
+	// Content is not stored when it is too big, diff is skipped then
+	hasPrevious := state != nil && contentHash(state.Content) == state.Hash
+	previous := ""
+	if hasPrevious {
+		previous = state.Content
+	}
+
+	value := contentDiffValue(hash, baseline, previous, content, hasPrevious)
+	if !strings.EqualFold(hash, baseline) {
+		return newContentChangeError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_ERROR, errContentChanged.Error(), value)
+	}
+	return newContentChangeError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_OK, "", value)
+}
+
+func selectContent(data []byte, selectorType apiPb.ContentChangeConfig_SelectorType, selector string) (string, error) {
+	if selector == "" {
+		return string(data), nil
+	}
+	switch selectorType {
+	case apiPb.ContentChangeConfig_CSS:
+		sel, err := cascadia.Compile(selector)
+		if err != nil {
+			return "", err
+		}
+		doc, err := html.Parse(bytes.NewReader(data))
+		if err != nil {
+			return "", err
+		}
+		nodes := sel.MatchAll(doc)
+		if len(nodes) == 0 {
+			return "", selectorNotMatchedErrorFn(selector)
+		}
+		texts := make([]string, len(nodes))
+		for i, node := range nodes {
+			texts[i] = nodeText(node)
+		}
+		return strings.Join(texts, "\n"), nil
+	case apiPb.ContentChangeConfig_XPATH:
+		doc, err := htmlquery.Parse(bytes.NewReader(data))
+		if err != nil {
+			return "", err
+		}
+		nodes, err := htmlquery.QueryAll(doc, selector)
+		if err != nil {
+			return "", err
+		}
+		if len(nodes) == 0 {
+			return "", selectorNotMatchedErrorFn(selector)
+		}
+		texts := make([]string, len(nodes))
+		for i, node := range nodes {
+			texts[i] = nodeText(node)
+		}
+		return strings.Join(texts, "\n"), nil
+	case apiPb.ContentChangeConfig_JSON:
+		res := gjson.GetBytes(data, selector)
+		if !res.Exists() {
+			return "", valueNotExistErrorFn(selector)
+		}
+		return res.String(), nil
+	default:
+		return string(data), nil
+	}
+}
+
+// Each text node is placed on own line, so markup changes without text changes are ignored
+func nodeText(node *html.Node) string {
+	var buf strings.Builder
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.TextNode {
+			buf.WriteString(n.Data)
+			buf.WriteString("\n")
+			return
+		}
+		if n.Type == html.ElementNode && (n.Data == "script" || n.Data == "style") {
+			return
+		}
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			walk(child)
+		}
+	}
+	walk(node)
+	return strings.TrimSpace(buf.String())
+}
+
+// Whitespaces inside lines are collapsed, empty lines are dropped
+func normalizeContent(content string) string {
+	lines := strings.Split(content, "\n")
+	normalized := make([]string, 0, len(lines))
+	for _, line := range lines {
+		line = strings.Join(strings.Fields(line), " ")
+		if line == "" {
+			continue
+		}
+		normalized = append(normalized, line)
+	}
+	return strings.Join(normalized, "\n")
+}
+
+func contentHash(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+// Diff summary counts lines which were added/removed regardless of their order
+func contentDiffValue(hash string, baseline string, previous string, current string, hasPrevious bool) *structpb.Value {
+	fields := map[string]*structpb.Value{
+		contentHashKey:         structpb.NewStringValue(hash),
+		contentPreviousHashKey: structpb.NewStringValue(baseline),
+	}
+	if hasPrevious {
+		added, removed := diffLines(previous, current)
+		fields[contentAddedKey] = structpb.NewNumberValue(float64(len(added)))
+		fields[contentRemovedKey] = structpb.NewNumberValue(float64(len(removed)))
+		fields[contentAddedLinesKey] = linesToListValue(added)
+		fields[contentRemovedLinesKey] = linesToListValue(removed)
+	}
+	return structpb.NewStructValue(&structpb.Struct{
+		Fields: fields,
+	})
+}
+
+func diffLines(previous string, current string) ([]string, []string) {
+	count := map[string]int{}
+	if previous != "" {
+		for _, line := range strings.Split(previous, "\n") {
+			count[line]++
+		}
+	}
+	added := []string{}
+	if current != "" {
+		for _, line := range strings.Split(current, "\n") {
+			if count[line] > 0 {
+				count[line]--
+				continue
+			}
+			added = append(added, line)
+		}
+	}
+	removed := []string{}
+	if previous != "" {
+		for _, line := range strings.Split(previous, "\n") {
+			if count[line] > 0 {
+				count[line]--
+				removed = append(removed, line)
+			}
+		}
+	}
+	return added, removed
+}
+
+func linesToListValue(lines []string) *structpb.Value {
+	if len(lines) > contentDiffLinesLimit {
+		lines = lines[:contentDiffLinesLimit]
+	}
+	values := make([]*structpb.Value, len(lines))
+	for i, line := range lines {
+		values[i] = structpb.NewStringValue(line)
+	}
+	return structpb.NewListValue(&structpb.ListValue{
+		Values: values,
+	})
+}
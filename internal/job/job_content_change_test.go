@@ -0,0 +1,212 @@
+package job
+
+import (
+	"context"
+	"errors"
+	content_storage "github.com/squzy/squzy/internal/content-storage"
+	"github.com/squzy/squzy/internal/httptools"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+	"time"
+)
+
+type mockContent struct {
+	body string
+	err  error
+}
+
+func (m *mockContent) SendRequest(req *http.Request) (int, []byte, error) {
+	panic("implement me")
+}
+
+func (m *mockContent) SendRequestTimeout(req *http.Request, timeout time.Duration) (int, []byte, error) {
+	panic("implement me")
+}
+
+func (m *mockContent) SendRequestWithStatusCode(req *http.Request, expectedCode int) (int, []byte, error) {
+	panic("implement me")
+}
+
+func (m *mockContent) SendRequestTimeoutStatusCode(req *http.Request, timeout time.Duration, expectedCode int) (int, []byte, error) {
+	if m.err != nil {
+		return 0, nil, m.err
+	}
+	return http.StatusOK, []byte(m.body), nil
+}
+
+func (m *mockContent) CreateRequest(method string, url string, headers *map[string]string, schedulerID string) *http.Request {
+	req, _ := http.NewRequest(method, url, nil)
+	return req
+}
+
+func (m *mockContent) WithOptions(options *httptools.ClientOptions) (httptools.HTTPTool, error) {
+	return m, nil
+}
+
+func (m *mockContent) WithAuth(auth *httptools.AuthOptions) httptools.HTTPTool {
+	return m
+}
+
+type contentStorageMock struct {
+	kv     map[string]*content_storage.State
+	getErr error
+	setErr error
+}
+
+func (m *contentStorageMock) Get(ctx context.Context, schedulerID string) (*content_storage.State, error) {
+	if m.getErr != nil {
+		return nil, m.getErr
+	}
+	return m.kv[schedulerID], nil
+}
+
+func (m *contentStorageMock) Set(ctx context.Context, schedulerID string, hash string, content string) error {
+	if m.setErr != nil {
+		return m.setErr
+	}
+	if m.kv == nil {
+		m.kv = map[string]*content_storage.State{}
+	}
+	m.kv[schedulerID] = &content_storage.State{
+		Hash:    hash,
+		Content: content,
+	}
+	return nil
+}
+
+func (m *contentStorageMock) Remove(ctx context.Context, schedulerID string) error {
+	delete(m.kv, schedulerID)
+	return nil
+}
+
+const contentPage = `
+<html>
+	<body>
+		<div id="terms">
+			<p>First   rule</p>
+			<p>Second rule</p>
+		</div>
+		<div id="footer">Updated now</div>
+	</body>
+</html>`
+
+func TestExecContentChange(t *testing.T) {
+	t.Run("Should: return error because request failed", func(t *testing.T) {
+		job := ExecContentChange("id", 1, &scheduler_config_storage.ContentChangeConfig{}, &mockContent{err: errors.New("error")}, &contentStorageMock{})
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, job.GetLogData().Snapshot.Code)
+		assert.Equal(t, apiPb.SchedulerType_CONTENT_CHANGE, job.GetLogData().Snapshot.Type)
+	})
+	t.Run("Should: return error because selector not matched", func(t *testing.T) {
+		job := ExecContentChange("id", 1, &scheduler_config_storage.ContentChangeConfig{
+			SelectorType: apiPb.ContentChangeConfig_CSS,
+			Selector:     "#missing",
+		}, &mockContent{body: contentPage}, &contentStorageMock{})
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, job.GetLogData().Snapshot.Code)
+		assert.Equal(t, selectorNotMatchedErrorFn("#missing").Error(), job.GetLogData().Snapshot.Error.Message)
+	})
+	t.Run("Should: return ok on first run and error when content changed", func(t *testing.T) {
+		storage := &contentStorageMock{}
+		mock := &mockContent{body: contentPage}
+		config := &scheduler_config_storage.ContentChangeConfig{
+			SelectorType: apiPb.ContentChangeConfig_CSS,
+			Selector:     "#terms",
+		}
+		job := ExecContentChange("id", 1, config, mock, storage)
+		assert.Equal(t, apiPb.SchedulerCode_OK, job.GetLogData().Snapshot.Code)
+
+		mock.body = contentPage + "<p>ignored</p>"
+		job = ExecContentChange("id", 1, config, mock, storage)
+		assert.Equal(t, apiPb.SchedulerCode_OK, job.GetLogData().Snapshot.Code)
+
+		mock.body = `<div id="terms"><p>First rule</p><p>Third rule</p></div>`
+		job = ExecContentChange("id", 1, config, mock, storage)
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, job.GetLogData().Snapshot.Code)
+		assert.Equal(t, errContentChanged.Error(), job.GetLogData().Snapshot.Error.Message)
+		fields := job.GetLogData().Snapshot.Meta.Value.GetStructValue().GetFields()
+		assert.EqualValues(t, 1, fields[contentAddedKey].GetNumberValue())
+		assert.EqualValues(t, 1, fields[contentRemovedKey].GetNumberValue())
+		assert.Equal(t, "Third rule", fields[contentAddedLinesKey].GetListValue().Values[0].GetStringValue())
+		assert.Equal(t, "Second rule", fields[contentRemovedLinesKey].GetListValue().Values[0].GetStringValue())
+
+		job = ExecContentChange("id", 1, config, mock, storage)
+		assert.Equal(t, apiPb.SchedulerCode_OK, job.GetLogData().Snapshot.Code)
+	})
+	t.Run("Should: return error because state not loaded", func(t *testing.T) {
+		job := ExecContentChange("id", 1, &scheduler_config_storage.ContentChangeConfig{}, &mockContent{body: "body"}, &contentStorageMock{getErr: errors.New("get")})
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, job.GetLogData().Snapshot.Code)
+		assert.Equal(t, "get", job.GetLogData().Snapshot.Error.Message)
+	})
+	t.Run("Should: return error because state not saved", func(t *testing.T) {
+		job := ExecContentChange("id", 1, &scheduler_config_storage.ContentChangeConfig{}, &mockContent{body: "body"}, &contentStorageMock{setErr: errors.New("set")})
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, job.GetLogData().Snapshot.Code)
+		assert.Equal(t, "set", job.GetLogData().Snapshot.Error.Message)
+	})
+	t.Run("Should: compare hash without diff because previous content not stored", func(t *testing.T) {
+		storage := &contentStorageMock{
+			kv: map[string]*content_storage.State{
+				"id": {Hash: contentHash("previous")},
+			},
+		}
+		job := ExecContentChange("id", 1, &scheduler_config_storage.ContentChangeConfig{}, &mockContent{body: "body"}, storage)
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, job.GetLogData().Snapshot.Code)
+		fields := job.GetLogData().Snapshot.Meta.Value.GetStructValue().GetFields()
+		assert.Equal(t, contentHash("previous"), fields[contentPreviousHashKey].GetStringValue())
+		assert.Nil(t, fields[contentAddedKey])
+		assert.Equal(t, contentHash("body"), storage.kv["id"].Hash)
+	})
+	t.Run("Should: compare with pinned baseline", func(t *testing.T) {
+		mock := &mockContent{body: `{"version": "1.0.0", "time": "now"}`}
+		config := &scheduler_config_storage.ContentChangeConfig{
+			SelectorType: apiPb.ContentChangeConfig_JSON,
+			Selector:     "version",
+			Baseline:     contentHash("1.0.0"),
+		}
+		job := ExecContentChange("id", 1, config, mock, &contentStorageMock{})
+		assert.Equal(t, apiPb.SchedulerCode_OK, job.GetLogData().Snapshot.Code)
+
+		config.Baseline = contentHash("0.9.0")
+		job = ExecContentChange("id", 1, config, mock, &contentStorageMock{})
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, job.GetLogData().Snapshot.Code)
+		fields := job.GetLogData().Snapshot.Meta.Value.GetStructValue().GetFields()
+		assert.Equal(t, contentHash("1.0.0"), fields[contentHashKey].GetStringValue())
+		assert.Equal(t, contentHash("0.9.0"), fields[contentPreviousHashKey].GetStringValue())
+	})
+}
+
+func TestSelectContent(t *testing.T) {
+	t.Run("Should: return text by xpath", func(t *testing.T) {
+		content, err := selectContent([]byte(contentPage), apiPb.ContentChangeConfig_XPATH, `//div[@id="footer"]`)
+		assert.Nil(t, err)
+		assert.Equal(t, "Updated now", content)
+	})
+	t.Run("Should: return error because xpath not valid", func(t *testing.T) {
+		_, err := selectContent([]byte(contentPage), apiPb.ContentChangeConfig_XPATH, `//div[`)
+		assert.NotNil(t, err)
+	})
+	t.Run("Should: return error because xpath not matched", func(t *testing.T) {
+		_, err := selectContent([]byte(contentPage), apiPb.ContentChangeConfig_XPATH, `//span`)
+		assert.Equal(t, selectorNotMatchedErrorFn("//span"), err)
+	})
+	t.Run("Should: return error because css not valid", func(t *testing.T) {
+		_, err := selectContent([]byte(contentPage), apiPb.ContentChangeConfig_CSS, `div[`)
+		assert.NotNil(t, err)
+	})
+	t.Run("Should: return error because json path not exist", func(t *testing.T) {
+		_, err := selectContent([]byte(`{}`), apiPb.ContentChangeConfig_JSON, `version`)
+		assert.Equal(t, valueNotExistErrorFn("version"), err)
+	})
+	t.Run("Should: return whole body without selector", func(t *testing.T) {
+		content, err := selectContent([]byte("body"), apiPb.ContentChangeConfig_CSS, "")
+		assert.Nil(t, err)
+		assert.Equal(t, "body", content)
+	})
+}
+
+func TestNormalizeContent(t *testing.T) {
+	t.Run("Should: collapse whitespaces and drop empty lines", func(t *testing.T) {
+		assert.Equal(t, "a b\nc", normalizeContent("  a \t b \n\n\t\n c  \r\n"))
+	})
+}
@@ -0,0 +1,450 @@
+package job
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/go-redis/redis/v8"
+	_ "github.com/jinzhu/gorm/dialects/mysql"    // register mysql driver
+	_ "github.com/jinzhu/gorm/dialects/postgres" // register postgres driver
+	"github.com/squzy/squzy/internal/helpers"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/tidwall/gjson"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/connstring"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamp "google.golang.org/protobuf/types/known/timestamppb"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultMongoDatabase = "admin"
+)
+
+var (
+	errDatabaseTypeNotSupported = errors.New("database type not supported")
+	errDatabaseEmptyResult      = errors.New("query returned empty result")
+	errDatabaseValueNotScalar   = errors.New("query result is not a scalar value")
+	errDatabaseOperatorInvalid  = errors.New("assertion operator not supported")
+	// Query without args is sent by simple protocol, which runs every statement, so `COMMIT` could leave read-only transaction
+	errDatabaseMultipleStatements = errors.New("only single statement is allowed, `;` could be used only at the end of query")
+
+	databaseCommandNotReadOnlyFn = func(command string) error {
+		return fmt.Errorf("command `%s` is not allowed, only read-only commands supported", command)
+	}
+	databaseAssertionFailedFn = func(actual string, operator apiPb.DatabaseConfig_Operator, expected string) error {
+		return fmt.Errorf("assertion failed: `%s` %s `%s`", actual, operatorSymbols[operator], expected)
+	}
+
+	operatorSymbols = map[apiPb.DatabaseConfig_Operator]string{
+		apiPb.DatabaseConfig_EQUAL:            "==",
+		apiPb.DatabaseConfig_NOT_EQUAL:        "!=",
+		apiPb.DatabaseConfig_GREATER:          ">",
+		apiPb.DatabaseConfig_GREATER_OR_EQUAL: ">=",
+		apiPb.DatabaseConfig_LESS:             "<",
+		apiPb.DatabaseConfig_LESS_OR_EQUAL:    "<=",
+	}
+
+	// Redis commands which do not modify data
+	redisReadOnlyCommands = map[string]bool{
+		"ping":     true,
+		"echo":     true,
+		"info":     true,
+		"dbsize":   true,
+		"exists":   true,
+		"get":      true,
+		"strlen":   true,
+		"ttl":      true,
+		"pttl":     true,
+		"type":     true,
+		"hget":     true,
+		"hlen":     true,
+		"hexists":  true,
+		"llen":     true,
+		"lindex":   true,
+		"scard":    true,
+		"zcard":    true,
+		"zscore":   true,
+		"zcount":   true,
+		"xlen":     true,
+		"pfcount":  true,
+		"time":     true,
+		"lastsave": true,
+	}
+
+	// MongoDB commands which do not modify data
+	mongoReadOnlyCommands = map[string]bool{
+		"ping":             true,
+		"hello":            true,
+		"isMaster":         true,
+		"buildInfo":        true,
+		"serverStatus":     true,
+		"replSetGetStatus": true,
+		"dbStats":          true,
+		"collStats":        true,
+		"count":            true,
+		"distinct":         true,
+		"find":             true,
+		"listCollections":  true,
+		"listDatabases":    true,
+	}
+
+	databaseQueries = map[apiPb.DatabaseConfig_DatabaseType]func(ctx context.Context, config *scheduler_config_storage.DatabaseConfig) (*structpb.Value, error){
+		apiPb.DatabaseConfig_POSTGRES: func(ctx context.Context, config *scheduler_config_storage.DatabaseConfig) (*structpb.Value, error) {
+			return querySQLDatabase(ctx, "postgres", config)
+		},
+		apiPb.DatabaseConfig_MYSQL: func(ctx context.Context, config *scheduler_config_storage.DatabaseConfig) (*structpb.Value, error) {
+			return querySQLDatabase(ctx, "mysql", config)
+		},
+		apiPb.DatabaseConfig_REDIS: queryRedis,
+		apiPb.DatabaseConfig_MONGO: queryMongo,
+	}
+)
+
+type databaseError struct {
+	schedulerID string
+	startTime   *timestamp.Timestamp
+	endTime     *timestamp.Timestamp
+	code        apiPb.SchedulerCode
+	description string
+	value       *structpb.Value
+}
+
+func (e *databaseError) GetLogData() *apiPb.SchedulerResponse {
+	var err *apiPb.SchedulerSnapshot_Error
+	if e.code == apiPb.SchedulerCode_ERROR {
+		err = &apiPb.SchedulerSnapshot_Error{
+			Message: e.description,
+		}
+	}
+	return &apiPb.SchedulerResponse{
+		SchedulerId: e.schedulerID,
+		Snapshot: &apiPb.SchedulerSnapshot{
+			Code:  e.code,
+			Error: err,
+			Type:  apiPb.SchedulerType_DATABASE,
+			Meta: &apiPb.SchedulerSnapshot_MetaData{
+				StartTime: e.startTime,
+				EndTime:   e.endTime,
+				Value:     e.value,
+			},
+		},
+	}
+}
+
+func newDatabaseError(schedulerID string, startTime *timestamp.Timestamp, endTime *timestamp.Timestamp, code apiPb.SchedulerCode, description string, value *structpb.Value) CheckError {
+	return &databaseError{
+		schedulerID: schedulerID,
+		startTime:   startTime,
+		endTime:     endTime,
+		code:        code,
+		description: description,
+		value:       value,
+	}
+}
+
+// ValidateDatabaseConfig checks config when scheduler is added, so it does not fail on every run
+func ValidateDatabaseConfig(config *apiPb.DatabaseConfig) error {
+	if _, ok := databaseQueries[config.GetType()]; !ok {
+		return errDatabaseTypeNotSupported
+	}
+	for _, assertion := range config.GetAssertions() {
+		if _, ok := operatorSymbols[assertion.GetOperator()]; !ok {
+			return errDatabaseOperatorInvalid
+		}
+	}
+	switch config.GetType() {
+	case apiPb.DatabaseConfig_REDIS:
+		return checkRedisCommand(getRedisArgs(config.GetQuery(), config.GetArgs()))
+	case apiPb.DatabaseConfig_POSTGRES, apiPb.DatabaseConfig_MYSQL:
+		return checkSQLStatement(config.GetQuery())
+	}
+	return nil
+}
+
+func ExecDatabase(schedulerID string, timeout int32, config *scheduler_config_storage.DatabaseConfig) CheckError {
+	startTime := timestamp.Now()
+
+	query, ok := databaseQueries[config.Type]
+	if !ok {
+		return newDatabaseError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_ERROR, errDatabaseTypeNotSupported.Error(), nil)
+	}
+
+	ctx, cancel := helpers.TimeoutContext(context.Background(), helpers.DurationFromSecond(timeout))
+	defer cancel()
+
+	value, err := query(ctx, config)
+	if err != nil {
+		return newDatabaseError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_ERROR, err.Error(), nil)
+	}
+
+	for _, assertion := range config.Assertions {
+		err = checkAssertion(value, assertion)
+		if err != nil {
+			return newDatabaseError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_ERROR, err.Error(), value)
+		}
+	}
+
+	return newDatabaseError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_OK, "", value)
+}
+
+func querySQLDatabase(ctx context.Context, driver string, config *scheduler_config_storage.DatabaseConfig) (*structpb.Value, error) {
+	db, err := sql.Open(driver, config.DSN)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+	return querySQL(ctx, db, config.Query)
+}
+
+// Query executed inside read-only transaction which is always rolled back,
+// first column of the first row is used as result
+func querySQL(ctx context.Context, db *sql.DB, query string) (*structpb.Value, error) {
+	if query == "" {
+		query = "SELECT 1"
+	}
+	// Schedulers could be added before validation of statements
+	err := checkSQLStatement(query)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	rows, err := tx.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	if !rows.Next() {
+		if rows.Err() != nil {
+			return nil, rows.Err()
+		}
+		return nil, errDatabaseEmptyResult
+	}
+
+	values := make([]interface{}, len(columns))
+	dest := make([]interface{}, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	err = rows.Scan(dest...)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) == 0 {
+		return nil, errDatabaseEmptyResult
+	}
+	return sqlValueToProto(values[0]), nil
+}
+
+// Semicolons are not allowed even inside literals, so statements can not be hidden by quoting rules of dialect
+func checkSQLStatement(query string) error {
+	if strings.Contains(strings.TrimRight(strings.TrimSpace(query), ";"), ";") {
+		return errDatabaseMultipleStatements
+	}
+	return nil
+}
+
+func sqlValueToProto(value interface{}) *structpb.Value {
+	switch v := value.(type) {
+	case nil:
+		return structpb.NewNullValue()
+	case int64:
+		return structpb.NewNumberValue(float64(v))
+	case float64:
+		return structpb.NewNumberValue(v)
+	case bool:
+		return structpb.NewBoolValue(v)
+	case []byte:
+		return structpb.NewStringValue(string(v))
+	case string:
+		return structpb.NewStringValue(v)
+	case time.Time:
+		return structpb.NewStringValue(v.Format(time.RFC3339))
+	default:
+		return structpb.NewStringValue(fmt.Sprint(v))
+	}
+}
+
+func queryRedis(ctx context.Context, config *scheduler_config_storage.DatabaseConfig) (*structpb.Value, error) {
+	args := getRedisArgs(config.Query, config.Args)
+	err := checkRedisCommand(args)
+	if err != nil {
+		return nil, err
+	}
+
+	opt, err := redis.ParseURL(config.DSN)
+	if err != nil {
+		return nil, err
+	}
+	client := redis.NewClient(opt)
+	defer func() {
+		_ = client.Close()
+	}()
+
+	cmdArgs := make([]interface{}, len(args))
+	for i, arg := range args {
+		cmdArgs[i] = arg
+	}
+	res, err := client.Do(ctx, cmdArgs...).Result()
+	if err == redis.Nil {
+		return nil, errDatabaseEmptyResult
+	}
+	if err != nil {
+		return nil, err
+	}
+	return structpb.NewValue(res)
+}
+
+// Query is split by spaces only if arguments are not set, so arguments with spaces or quotes should be passed as args
+func getRedisArgs(query string, args []string) []string {
+	if len(args) > 0 {
+		return append([]string{strings.TrimSpace(query)}, args...)
+	}
+	res := strings.Fields(query)
+	if len(res) == 0 {
+		return []string{"PING"}
+	}
+	return res
+}
+
+func checkRedisCommand(args []string) error {
+	if !redisReadOnlyCommands[strings.ToLower(args[0])] {
+		return databaseCommandNotReadOnlyFn(args[0])
+	}
+	return nil
+}
+
+func queryMongo(ctx context.Context, config *scheduler_config_storage.DatabaseConfig) (*structpb.Value, error) {
+	query := config.Query
+	if query == "" {
+		query = `{"ping": 1}`
+	}
+	command := bson.D{}
+	err := bson.UnmarshalExtJSON([]byte(query), false, &command)
+	if err != nil {
+		return nil, err
+	}
+	if len(command) == 0 {
+		return nil, errDatabaseEmptyResult
+	}
+	if !mongoReadOnlyCommands[command[0].Key] {
+		return nil, databaseCommandNotReadOnlyFn(command[0].Key)
+	}
+
+	cs, err := connstring.ParseAndValidate(config.DSN)
+	if err != nil {
+		return nil, err
+	}
+	database := cs.Database
+	if database == "" {
+		database = defaultMongoDatabase
+	}
+
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(config.DSN))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = client.Disconnect(context.Background())
+	}()
+
+	raw, err := client.Database(database).RunCommand(ctx, command).DecodeBytes()
+	if err != nil {
+		return nil, err
+	}
+	data, err := bson.MarshalExtJSON(raw, false, false)
+	if err != nil {
+		return nil, err
+	}
+	return jsonToProto(data, config.Path)
+}
+
+func jsonToProto(data []byte, path string) (*structpb.Value, error) {
+	if path != "" {
+		res := gjson.GetBytes(data, path)
+		if !res.Exists() {
+			return nil, valueNotExistErrorFn(path)
+		}
+		data = []byte(res.Raw)
+	}
+	var value interface{}
+	err := json.Unmarshal(data, &value)
+	if err != nil {
+		return nil, err
+	}
+	return structpb.NewValue(value)
+}
+
+// Numbers compared as numbers, everything else compared as strings
+func checkAssertion(value *structpb.Value, assertion *scheduler_config_storage.Assertion) error {
+	var actual string
+	switch v := value.GetKind().(type) {
+	case *structpb.Value_NumberValue:
+		actual = strconv.FormatFloat(v.NumberValue, 'f', -1, 64)
+	case *structpb.Value_StringValue:
+		actual = v.StringValue
+	case *structpb.Value_BoolValue:
+		actual = strconv.FormatBool(v.BoolValue)
+	case *structpb.Value_NullValue:
+		actual = "null"
+	default:
+		return errDatabaseValueNotScalar
+	}
+
+	var cmp int
+	actualNumber, errActual := strconv.ParseFloat(actual, 64)
+	expectedNumber, errExpected := strconv.ParseFloat(assertion.Value, 64)
+	switch {
+	case errActual == nil && errExpected == nil && actualNumber < expectedNumber:
+		cmp = -1
+	case errActual == nil && errExpected == nil && actualNumber > expectedNumber:
+		cmp = 1
+	case errActual == nil && errExpected == nil:
+		cmp = 0
+	default:
+		cmp = strings.Compare(actual, assertion.Value)
+	}
+
+	var ok bool
+	switch assertion.Operator {
+	case apiPb.DatabaseConfig_EQUAL:
+		ok = cmp == 0
+	case apiPb.DatabaseConfig_NOT_EQUAL:
+		ok = cmp != 0
+	case apiPb.DatabaseConfig_GREATER:
+		ok = cmp > 0
+	case apiPb.DatabaseConfig_GREATER_OR_EQUAL:
+		ok = cmp >= 0
+	case apiPb.DatabaseConfig_LESS:
+		ok = cmp < 0
+	case apiPb.DatabaseConfig_LESS_OR_EQUAL:
+		ok = cmp <= 0
+	}
+	if !ok {
+		return databaseAssertionFailedFn(actual, assertion.Operator, assertion.Value)
+	}
+	return nil
+}
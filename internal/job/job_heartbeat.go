@@ -0,0 +1,97 @@
+package job
+
+import (
+	"errors"
+	"fmt"
+	heartbeat_storage "github.com/squzy/squzy/internal/heartbeat-storage"
+	"github.com/squzy/squzy/internal/helpers"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	timestamp "google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+var (
+	errHeartbeatJobFailed = errors.New("job reported failure")
+
+	heartbeatMissedErrorFn = func(lastPing time.Time) error {
+		return fmt.Errorf("ping not received since %s", lastPing.Format(time.RFC3339))
+	}
+)
+
+type heartbeatError struct {
+	schedulerID string
+	startTime   *timestamp.Timestamp
+	endTime     *timestamp.Timestamp
+	code        apiPb.SchedulerCode
+	description string
+}
+
+func (e *heartbeatError) GetLogData() *apiPb.SchedulerResponse {
+	var err *apiPb.SchedulerSnapshot_Error
+	if e.code == apiPb.SchedulerCode_ERROR {
+		err = &apiPb.SchedulerSnapshot_Error{
+			Message: e.description,
+		}
+	}
+	return &apiPb.SchedulerResponse{
+		SchedulerId: e.schedulerID,
+		Snapshot: &apiPb.SchedulerSnapshot{
+			Code:  e.code,
+			Error: err,
+			Type:  apiPb.SchedulerType_HEARTBEAT,
+			Meta: &apiPb.SchedulerSnapshot_MetaData{
+				StartTime: e.startTime,
+				EndTime:   e.endTime,
+			},
+		},
+	}
+}
+
+func newHeartbeatError(schedulerID string, startTime *timestamp.Timestamp, endTime *timestamp.Timestamp, code apiPb.SchedulerCode, description string) CheckError {
+	return &heartbeatError{
+		schedulerID: schedulerID,
+		startTime:   startTime,
+		endTime:     endTime,
+		code:        code,
+		description: description,
+	}
+}
+
+// ExecHeartbeat return nil if ping was received in time, ok snapshots are written by pings
+func ExecHeartbeat(schedulerID string, interval int32, config *scheduler_config_storage.HeartbeatConfig, heartbeatStorage heartbeat_storage.HeartbeatStorage) CheckError {
+	now := time.Now()
+	lastPing := heartbeatStorage.LastPing(schedulerID, now)
+	deadline := lastPing.Add(helpers.DurationFromSecond(interval))
+	if config != nil && config.Grace > 0 {
+		deadline = deadline.Add(helpers.DurationFromSecond(config.Grace))
+	}
+	if !now.After(deadline) {
+		return nil
+	}
+	return newHeartbeatError(
+		schedulerID,
+		timestamp.New(lastPing),
+		timestamp.New(now),
+		apiPb.SchedulerCode_ERROR,
+		heartbeatMissedErrorFn(lastPing).Error(),
+	)
+}
+
+// ExecHeartbeatPing return snapshot for received ping, start ping only saved and return nil.
+// Snapshot start time is the time of start ping, so duration of the job is stored
+func ExecHeartbeatPing(schedulerID string, pingType apiPb.PingRequest_PingType, message string, heartbeatStorage heartbeat_storage.HeartbeatStorage) CheckError {
+	now := time.Now()
+	if pingType == apiPb.PingRequest_START {
+		heartbeatStorage.Start(schedulerID, now)
+		return nil
+	}
+	start, _ := heartbeatStorage.Finish(schedulerID, now)
+	if pingType == apiPb.PingRequest_FAIL {
+		if message == "" {
+			message = errHeartbeatJobFailed.Error()
+		}
+		return newHeartbeatError(schedulerID, timestamp.New(start), timestamp.New(now), apiPb.SchedulerCode_ERROR, message)
+	}
+	return newHeartbeatError(schedulerID, timestamp.New(start), timestamp.New(now), apiPb.SchedulerCode_OK, "")
+}
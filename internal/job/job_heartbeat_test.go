@@ -0,0 +1,66 @@
+package job
+
+import (
+	heartbeat_storage "github.com/squzy/squzy/internal/heartbeat-storage"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestExecHeartbeat(t *testing.T) {
+	t.Run("Should: return nil because first execution", func(t *testing.T) {
+		job := ExecHeartbeat("id", 10, &scheduler_config_storage.HeartbeatConfig{}, heartbeat_storage.New())
+		assert.Nil(t, job)
+	})
+	t.Run("Should: return nil because ping in time", func(t *testing.T) {
+		s := heartbeat_storage.New()
+		s.Finish("id", time.Now().Add(-time.Second*15))
+		job := ExecHeartbeat("id", 10, &scheduler_config_storage.HeartbeatConfig{
+			Grace: 10,
+		}, s)
+		assert.Nil(t, job)
+	})
+	t.Run("Should: return error because ping missed", func(t *testing.T) {
+		s := heartbeat_storage.New()
+		lastPing := time.Now().Add(-time.Second * 15)
+		s.Finish("id", lastPing)
+		job := ExecHeartbeat("id", 10, nil, s)
+		assert.NotNil(t, job)
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, job.GetLogData().Snapshot.Code)
+		assert.Equal(t, apiPb.SchedulerType_HEARTBEAT, job.GetLogData().Snapshot.Type)
+		assert.Equal(t, heartbeatMissedErrorFn(lastPing).Error(), job.GetLogData().Snapshot.Error.Message)
+	})
+}
+
+func TestExecHeartbeatPing(t *testing.T) {
+	t.Run("Should: save start and return nil", func(t *testing.T) {
+		s := heartbeat_storage.New()
+		job := ExecHeartbeatPing("id", apiPb.PingRequest_START, "", s)
+		assert.Nil(t, job)
+		_, ok := s.Finish("id", time.Now())
+		assert.True(t, ok)
+	})
+	t.Run("Should: return ok snapshot", func(t *testing.T) {
+		job := ExecHeartbeatPing("id", apiPb.PingRequest_PING_TYPE_UNSPECIFIED, "", heartbeat_storage.New())
+		assert.Equal(t, apiPb.SchedulerCode_OK, job.GetLogData().Snapshot.Code)
+		assert.Equal(t, "id", job.GetLogData().SchedulerId)
+	})
+	t.Run("Should: return snapshot with duration of the job", func(t *testing.T) {
+		s := heartbeat_storage.New()
+		start := time.Now().Add(-time.Minute)
+		s.Start("id", start)
+		job := ExecHeartbeatPing("id", apiPb.PingRequest_SUCCESS, "", s)
+		assert.Equal(t, apiPb.SchedulerCode_OK, job.GetLogData().Snapshot.Code)
+		assert.Equal(t, start.UnixNano(), job.GetLogData().Snapshot.Meta.StartTime.AsTime().UnixNano())
+	})
+	t.Run("Should: return error snapshot", func(t *testing.T) {
+		job := ExecHeartbeatPing("id", apiPb.PingRequest_FAIL, "", heartbeat_storage.New())
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, job.GetLogData().Snapshot.Code)
+		assert.Equal(t, errHeartbeatJobFailed.Error(), job.GetLogData().Snapshot.Error.Message)
+
+		job = ExecHeartbeatPing("id", apiPb.PingRequest_FAIL, "exit code 1", heartbeat_storage.New())
+		assert.Equal(t, "exit code 1", job.GetLogData().Snapshot.Error.Message)
+	})
+}
@@ -6,6 +6,7 @@ import (
 	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
 	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
 	timestamp "google.golang.org/protobuf/types/known/timestamppb"
+	"strings"
 )
 
 type httpError struct {
@@ -49,9 +50,20 @@ func newHTTPError(schedulerID string, startTime *timestamp.Timestamp, endTime *t
 
 func ExecHTTP(schedulerID string, timeout int32, config *scheduler_config_storage.HTTPConfig, httpTool httptools.HTTPTool) CheckError {
 	startTime := timestamp.Now()
-	req := httpTool.CreateRequest(config.Method, config.URL, &config.Headers, schedulerID)
+	client, err := httpTool.WithOptions(HTTPClientOptions(config.Client))
+	if err != nil {
+		return newHTTPError(
+			schedulerID,
+			startTime,
+			timestamp.Now(),
+			apiPb.SchedulerCode_ERROR,
+			err.Error(),
+		)
+	}
+	client = client.WithAuth(httpAuthOptions(config.Auth))
+	req := client.CreateRequest(config.Method, config.URL, &config.Headers, schedulerID)
 
-	_, _, err := httpTool.SendRequestTimeoutStatusCode(req, helpers.DurationFromSecond(timeout), int(config.StatusCode))
+	_, _, err = client.SendRequestTimeoutStatusCode(req, helpers.DurationFromSecond(timeout), int(config.StatusCode))
 
 	if err != nil {
 		return newHTTPError(
@@ -71,3 +83,40 @@ func ExecHTTP(schedulerID string, timeout int32, config *scheduler_config_storag
 		"",
 	)
 }
+
+// HTTPClientOptions are also used as key of the client cache, so client could be removed with the scheduler
+func HTTPClientOptions(config *scheduler_config_storage.HTTPClientConfig) *httptools.ClientOptions {
+	if config == nil {
+		return nil
+	}
+	return &httptools.ClientOptions{
+		ProxyURL:           config.ProxyURL,
+		CABundle:           config.CABundle,
+		ClientCert:         config.ClientCert,
+		ClientKey:          config.ClientKey,
+		InsecureSkipVerify: config.InsecureSkipVerify,
+		DisableHTTP2:       config.DisableHTTP2,
+		SourceIP:           config.SourceIP,
+	}
+}
+
+func httpAuthOptions(config *scheduler_config_storage.HTTPAuthConfig) *httptools.AuthOptions {
+	if config == nil {
+		return nil
+	}
+	return &httptools.AuthOptions{
+		Type:         httptools.AuthType(config.Type),
+		Username:     config.Username,
+		Password:     config.Password,
+		Token:        config.Token,
+		TokenURL:     config.TokenURL,
+		ClientID:     config.ClientID,
+		ClientSecret: config.ClientSecret,
+		Scopes:       strings.Join(config.Scopes, " "),
+		AccessKey:    config.AccessKey,
+		SecretKey:    config.SecretKey,
+		SessionToken: config.SessionToken,
+		Region:       config.Region,
+		Service:      config.Service,
+	}
+}
@@ -5,6 +5,8 @@ import (
 	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
 	"github.com/stretchr/testify/assert"
 	"net/http"
+	"net/http/httptest"
+	"github.com/squzy/squzy/internal/httptools"
 	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
 	"testing"
 	"time"
@@ -26,6 +28,14 @@ func (h httpToolsMock) CreateRequest(method string, url string, headers *map[str
 	return req
 }
 
+func (h httpToolsMock) WithOptions(options *httptools.ClientOptions) (httptools.HTTPTool, error) {
+	return h, nil
+}
+
+func (h httpToolsMock) WithAuth(auth *httptools.AuthOptions) httptools.HTTPTool {
+	return h
+}
+
 type httpToolsMockError struct {
 }
 
@@ -50,6 +60,14 @@ func (h httpToolsMockError) CreateRequest(method string, url string, headers *ma
 	return rq
 }
 
+func (h httpToolsMockError) WithOptions(options *httptools.ClientOptions) (httptools.HTTPTool, error) {
+	return h, nil
+}
+
+func (h httpToolsMockError) WithAuth(auth *httptools.AuthOptions) httptools.HTTPTool {
+	return h
+}
+
 func (h httpToolsMockError) SendRequest(req *http.Request) (int, []byte, error) {
 	panic("implement me")
 }
@@ -85,4 +103,27 @@ func TestExecHttp(t *testing.T) {
 		s := ExecHTTP("", 0, &scheduler_config_storage.HTTPConfig{Method: http.MethodGet, Headers: map[string]string{}, StatusCode: http.StatusOK}, &httpToolsMockError{})
 		assert.Equal(t, apiPb.SchedulerCode_ERROR, s.GetLogData().Snapshot.Code)
 	})
+	t.Run("Should: authenticate request", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			username, password, _ := r.BasicAuth()
+			if username != "user" || password != "pass" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer ts.Close()
+		s := ExecHTTP("", 1, &scheduler_config_storage.HTTPConfig{Method: http.MethodGet, URL: ts.URL, StatusCode: http.StatusOK, Auth: &scheduler_config_storage.HTTPAuthConfig{
+			Type:     apiPb.HttpAuthConfig_BASIC,
+			Username: "user",
+			Password: "pass",
+		}}, httptools.New(""))
+		assert.Equal(t, apiPb.SchedulerCode_OK, s.GetLogData().Snapshot.Code)
+	})
+	t.Run("Should: return error because client options not valid", func(t *testing.T) {
+		s := ExecHTTP("", 0, &scheduler_config_storage.HTTPConfig{Method: http.MethodGet, StatusCode: http.StatusOK, Client: &scheduler_config_storage.HTTPClientConfig{
+			SourceIP: "ip",
+		}}, httptools.New(""))
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, s.GetLogData().Snapshot.Code)
+	})
 }
@@ -51,9 +51,21 @@ func (e *jsonHTTPError) GetLogData() *apiPb.SchedulerResponse {
 
 func ExecHTTPValue(schedulerID string, timeout int32, config *scheduler_config_storage.HTTPValueConfig, httpTool httptools.HTTPTool) CheckError {
 	startTime := timestamp.Now()
-	req := httpTool.CreateRequest(config.Method, config.URL, &config.Headers, schedulerID)
+	client, err := httpTool.WithOptions(HTTPClientOptions(config.Client))
+	if err != nil {
+		return newJSONHTTPError(
+			schedulerID,
+			startTime,
+			timestamp.Now(),
+			apiPb.SchedulerCode_ERROR,
+			err.Error(),
+			nil,
+		)
+	}
+	client = client.WithAuth(httpAuthOptions(config.Auth))
+	req := client.CreateRequest(config.Method, config.URL, &config.Headers, schedulerID)
 
-	_, data, err := httpTool.SendRequestTimeout(req, helpers.DurationFromSecond(timeout))
+	_, data, err := client.SendRequestTimeout(req, helpers.DurationFromSecond(timeout))
 
 	if err != nil {
 		return newJSONHTTPError(
@@ -2,6 +2,7 @@ package job
 
 import (
 	"errors"
+	"github.com/squzy/squzy/internal/httptools"
 	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
 	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
 	"github.com/stretchr/testify/assert"
@@ -46,6 +47,14 @@ func (m mockSuccess) CreateRequest(method string, url string, headers *map[strin
 	return req
 }
 
+func (m mockSuccess) WithOptions(options *httptools.ClientOptions) (httptools.HTTPTool, error) {
+	return m, nil
+}
+
+func (m mockSuccess) WithAuth(auth *httptools.AuthOptions) httptools.HTTPTool {
+	return m
+}
+
 func (m mockError) SendRequest(req *http.Request) (int, []byte, error) {
 	return 0, nil, errors.New("afsaf")
 }
@@ -59,7 +68,21 @@ func (m mockError) CreateRequest(method string, url string, headers *map[string]
 	return req
 }
 
+func (m mockError) WithOptions(options *httptools.ClientOptions) (httptools.HTTPTool, error) {
+	return m, nil
+}
+
+func (m mockError) WithAuth(auth *httptools.AuthOptions) httptools.HTTPTool {
+	return m
+}
+
 func TestExecHttpValue(t *testing.T) {
+	t.Run("Should: return error because client options not valid", func(t *testing.T) {
+		s := ExecHTTPValue("", 0, &scheduler_config_storage.HTTPValueConfig{Method: http.MethodGet, Client: &scheduler_config_storage.HTTPClientConfig{
+			CABundle: "ca",
+		}}, httptools.New(""))
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, s.GetLogData().Snapshot.Code)
+	})
 	t.Run("Should: return error on http request", func(t *testing.T) {
 		s := ExecHTTPValue("", 0, &scheduler_config_storage.HTTPValueConfig{Method: http.MethodGet, Headers: map[string]string{}}, &mockError{})
 		assert.Equal(t, apiPb.SchedulerCode_ERROR, s.GetLogData().Snapshot.Code)
@@ -0,0 +1,170 @@
+package job
+
+import (
+	"errors"
+	"fmt"
+	"github.com/squzy/squzy/internal/helpers"
+	"github.com/squzy/squzy/internal/httptools"
+	"github.com/squzy/squzy/internal/parsers"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamp "google.golang.org/protobuf/types/known/timestamppb"
+	"math"
+	"net/http"
+	"strconv"
+)
+
+const (
+	prometheusRuleKey   = "rule"
+	prometheusNameKey   = "name"
+	prometheusLabelsKey = "labels"
+	prometheusValueKey  = "value"
+)
+
+var (
+	prometheusParser = parsers.NewPrometheusParser()
+
+	errPrometheusRulesEmpty = errors.New("prometheus rules are empty")
+
+	prometheusRuleNotValidErrorFn = func(rule string, err error) error {
+		return fmt.Errorf("rule=`%s` is not valid: %s", rule, err.Error())
+	}
+	prometheusNothingMatchedErrorFn = func(rule string) error {
+		return fmt.Errorf("nothing matched by rule=`%s`", rule)
+	}
+	prometheusThresholdErrorFn = func(rule string, value float64) error {
+		return fmt.Errorf("value=%s not satisfy rule=`%s`", strconv.FormatFloat(value, 'f', -1, 64), rule)
+	}
+)
+
+type prometheusError struct {
+	schedulerID string
+	startTime   *timestamp.Timestamp
+	endTime     *timestamp.Timestamp
+	code        apiPb.SchedulerCode
+	description string
+	value       *structpb.Value
+}
+
+func (e *prometheusError) GetLogData() *apiPb.SchedulerResponse {
+	var err *apiPb.SchedulerSnapshot_Error
+	if e.code == apiPb.SchedulerCode_ERROR {
+		err = &apiPb.SchedulerSnapshot_Error{
+			Message: e.description,
+		}
+	}
+	return &apiPb.SchedulerResponse{
+		SchedulerId: e.schedulerID,
+		Snapshot: &apiPb.SchedulerSnapshot{
+			Code:  e.code,
+			Error: err,
+			Type:  apiPb.SchedulerType_PROMETHEUS,
+			Meta: &apiPb.SchedulerSnapshot_MetaData{
+				StartTime: e.startTime,
+				EndTime:   e.endTime,
+				Value:     e.value,
+			},
+		},
+	}
+}
+
+func newPrometheusError(schedulerID string, startTime *timestamp.Timestamp, endTime *timestamp.Timestamp, code apiPb.SchedulerCode, description string, value *structpb.Value) CheckError {
+	return &prometheusError{
+		schedulerID: schedulerID,
+		startTime:   startTime,
+		endTime:     endTime,
+		code:        code,
+		description: description,
+		value:       value,
+	}
+}
+
+// ValidatePrometheusConfig parses rules when scheduler is added, so invalid rule is reported to the caller
+func ValidatePrometheusConfig(config *apiPb.PrometheusConfig) error {
+	if len(config.GetRules()) == 0 {
+		return errPrometheusRulesEmpty
+	}
+	for _, rule := range config.GetRules() {
+		_, err := prometheusParser.ParseRule(rule)
+		if err != nil {
+			return prometheusRuleNotValidErrorFn(rule, err)
+		}
+	}
+	return nil
+}
+
+// ExecPrometheus scrape metrics endpoint, every sample selected by rule should satisfy the rule threshold
+func ExecPrometheus(schedulerID string, timeout int32, config *scheduler_config_storage.PrometheusConfig, httpTool httptools.HTTPTool) CheckError {
+	startTime := timestamp.Now()
+
+	rules := make([]*parsers.PrometheusRule, len(config.Rules))
+	for i, rule := range config.Rules {
+		parsed, err := prometheusParser.ParseRule(rule)
+		if err != nil {
+			return newPrometheusError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_ERROR, prometheusRuleNotValidErrorFn(rule, err).Error(), nil)
+		}
+		rules[i] = parsed
+	}
+
+	client := httpTool.WithAuth(httpAuthOptions(config.Auth))
+	req := client.CreateRequest(http.MethodGet, config.URL, &config.Headers, schedulerID)
+	_, data, err := client.SendRequestTimeoutStatusCode(req, helpers.DurationFromSecond(timeout), http.StatusOK)
+	if err != nil {
+		return newPrometheusError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_ERROR, err.Error(), nil)
+	}
+
+	samples, err := prometheusParser.Parse(data)
+	if err != nil {
+		return newPrometheusError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_ERROR, err.Error(), nil)
+	}
+
+	var checkErr error
+	values := []*structpb.Value{}
+	for i, rule := range rules {
+		matched := false
+		for _, sample := range samples {
+			if !rule.Match(sample) {
+				continue
+			}
+			matched = true
+			values = append(values, prometheusSampleToProto(config.Rules[i], sample))
+			if checkErr == nil && !rule.Check(sample.Value) {
+				checkErr = prometheusThresholdErrorFn(config.Rules[i], sample.Value)
+			}
+		}
+		if !matched && checkErr == nil {
+			checkErr = prometheusNothingMatchedErrorFn(config.Rules[i])
+		}
+	}
+
+	value := structpb.NewListValue(&structpb.ListValue{
+		Values: values,
+	})
+	if checkErr != nil {
+		return newPrometheusError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_ERROR, checkErr.Error(), value)
+	}
+	return newPrometheusError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_OK, "", value)
+}
+
+func prometheusSampleToProto(rule string, sample *parsers.PrometheusSample) *structpb.Value {
+	labels := make(map[string]*structpb.Value, len(sample.Labels))
+	for name, value := range sample.Labels {
+		labels[name] = structpb.NewStringValue(value)
+	}
+	// NaN and Inf can not be serialized as json number
+	value := structpb.NewNumberValue(sample.Value)
+	if math.IsNaN(sample.Value) || math.IsInf(sample.Value, 0) {
+		value = structpb.NewStringValue(strconv.FormatFloat(sample.Value, 'f', -1, 64))
+	}
+	return structpb.NewStructValue(&structpb.Struct{
+		Fields: map[string]*structpb.Value{
+			prometheusRuleKey: structpb.NewStringValue(rule),
+			prometheusNameKey: structpb.NewStringValue(sample.Name),
+			prometheusLabelsKey: structpb.NewStructValue(&structpb.Struct{
+				Fields: labels,
+			}),
+			prometheusValueKey: value,
+		},
+	})
+}
@@ -0,0 +1,87 @@
+package job
+
+import (
+	"errors"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+const prometheusPage = `
+# TYPE http_requests_inflight gauge
+http_requests_inflight{job="api",instance="a"} 120
+http_requests_inflight{job="api",instance="b"} 600
+http_requests_inflight{job="web",instance="a"} 10
+up NaN
+`
+
+func TestValidatePrometheusConfig(t *testing.T) {
+	t.Run("Should: return error because rules are empty", func(t *testing.T) {
+		assert.Equal(t, errPrometheusRulesEmpty, ValidatePrometheusConfig(&apiPb.PrometheusConfig{}))
+	})
+	t.Run("Should: return error because rule not valid", func(t *testing.T) {
+		err := ValidatePrometheusConfig(&apiPb.PrometheusConfig{
+			Rules: []string{`up == 1`, `up =< 1`},
+		})
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "up =< 1")
+	})
+	t.Run("Should: not return error", func(t *testing.T) {
+		err := ValidatePrometheusConfig(&apiPb.PrometheusConfig{
+			Rules: []string{`up{job="api"} == 1`, `http_requests_inflight < 500`},
+		})
+		assert.Nil(t, err)
+	})
+}
+
+func TestExecPrometheus(t *testing.T) {
+	t.Run("Should: return error because request failed", func(t *testing.T) {
+		job := ExecPrometheus("id", 1, &scheduler_config_storage.PrometheusConfig{}, &mockContent{err: errors.New("error")})
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, job.GetLogData().Snapshot.Code)
+		assert.Equal(t, apiPb.SchedulerType_PROMETHEUS, job.GetLogData().Snapshot.Type)
+	})
+	t.Run("Should: return error because rule not valid", func(t *testing.T) {
+		job := ExecPrometheus("id", 1, &scheduler_config_storage.PrometheusConfig{
+			Rules: []string{`up =< 1`},
+		}, &mockContent{body: prometheusPage})
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, job.GetLogData().Snapshot.Code)
+	})
+	t.Run("Should: return error because metrics not valid", func(t *testing.T) {
+		job := ExecPrometheus("id", 1, &scheduler_config_storage.PrometheusConfig{
+			Rules: []string{`up`},
+		}, &mockContent{body: "up{"})
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, job.GetLogData().Snapshot.Code)
+	})
+	t.Run("Should: return error because nothing matched", func(t *testing.T) {
+		job := ExecPrometheus("id", 1, &scheduler_config_storage.PrometheusConfig{
+			Rules: []string{`http_requests_inflight{job="db"} < 500`},
+		}, &mockContent{body: prometheusPage})
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, job.GetLogData().Snapshot.Code)
+		assert.Equal(t, prometheusNothingMatchedErrorFn(`http_requests_inflight{job="db"} < 500`).Error(), job.GetLogData().Snapshot.Error.Message)
+	})
+	t.Run("Should: return error because threshold not satisfied", func(t *testing.T) {
+		job := ExecPrometheus("id", 1, &scheduler_config_storage.PrometheusConfig{
+			Rules: []string{`http_requests_inflight{job="api"} < 500`},
+		}, &mockContent{body: prometheusPage})
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, job.GetLogData().Snapshot.Code)
+		assert.Equal(t, prometheusThresholdErrorFn(`http_requests_inflight{job="api"} < 500`, 600).Error(), job.GetLogData().Snapshot.Error.Message)
+		assert.Len(t, job.GetLogData().Snapshot.Meta.Value.GetListValue().Values, 2)
+	})
+	t.Run("Should: return selected values", func(t *testing.T) {
+		job := ExecPrometheus("id", 1, &scheduler_config_storage.PrometheusConfig{
+			Rules: []string{
+				`http_requests_inflight{instance="a"} < 500`,
+				`up`,
+			},
+		}, &mockContent{body: prometheusPage})
+		assert.Equal(t, apiPb.SchedulerCode_OK, job.GetLogData().Snapshot.Code)
+		values := job.GetLogData().Snapshot.Meta.Value.GetListValue().Values
+		assert.Len(t, values, 3)
+		fields := values[0].GetStructValue().GetFields()
+		assert.Equal(t, "http_requests_inflight", fields[prometheusNameKey].GetStringValue())
+		assert.Equal(t, "api", fields[prometheusLabelsKey].GetStructValue().GetFields()["job"].GetStringValue())
+		assert.EqualValues(t, 120, fields[prometheusValueKey].GetNumberValue())
+		assert.Equal(t, "NaN", values[2].GetStructValue().GetFields()[prometheusValueKey].GetStringValue())
+	})
+}
@@ -57,7 +57,12 @@ func newSiteMapError(schedulerID string, startTime *timestamp.Timestamp, endTime
 
 func ExecSiteMap(schedulerID string, timeout int32, config *scheduler_config_storage.SiteMapConfig, siteMapStorage sitemap_storage.SiteMapStorage, httpTools httptools.HTTPTool, semaphoreFactoryFn func(n int) semaphore.Semaphore) CheckError {
 	startTime := timestamp.Now()
-	siteMap, err := siteMapStorage.Get(config.URL)
+	options := HTTPClientOptions(config.Client)
+	client, err := httpTools.WithOptions(options)
+	if err != nil {
+		return newSiteMapError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_ERROR, err.Error(), config.URL)
+	}
+	siteMap, err := siteMapStorage.Get(config.URL, options)
 	if err != nil {
 		return newSiteMapError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_ERROR, err.Error(), config.URL)
 	}
@@ -92,8 +97,8 @@ func ExecSiteMap(schedulerID string, timeout int32, config *scheduler_config_sto
 
 			defer sem.Release()
 
-			rq := httpTools.CreateRequest(http.MethodGet, location, nil, schedulerID)
-			_, _, errSem = httpTools.SendRequestTimeoutStatusCode(rq, helpers.DurationFromSecond(timeout), http.StatusOK)
+			rq := client.CreateRequest(http.MethodGet, location, nil, schedulerID)
+			_, _, errSem = client.SendRequestTimeoutStatusCode(rq, helpers.DurationFromSecond(timeout), http.StatusOK)
 
 			if errSem != nil {
 				return errSem
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"net/http"
 	"github.com/squzy/squzy/internal/parsers"
+	"github.com/squzy/squzy/internal/httptools"
 	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
 	"github.com/squzy/squzy/internal/semaphore"
 	"testing"
@@ -29,6 +30,14 @@ func (m mockHttpTools) CreateRequest(method string, url string, headers *map[str
 	return rq
 }
 
+func (m mockHttpTools) WithOptions(options *httptools.ClientOptions) (httptools.HTTPTool, error) {
+	return m, nil
+}
+
+func (m mockHttpTools) WithAuth(auth *httptools.AuthOptions) httptools.HTTPTool {
+	return m
+}
+
 func (m mockHttpTools) SendRequest(req *http.Request) (int, []byte, error) {
 	return 200, nil, nil
 }
@@ -43,7 +52,7 @@ type siteMapStorage struct {
 type siteMapStorageIgnore struct {
 }
 
-func (s siteMapStorageIgnore) Get(url string) (*parsers.SiteMap, error) {
+func (s siteMapStorageIgnore) Get(url string, options *httptools.ClientOptions) (*parsers.SiteMap, error) {
 	return &parsers.SiteMap{
 		URLSet: []parsers.SiteMapURL{
 			{
@@ -58,7 +67,7 @@ func (s siteMapStorageIgnore) Get(url string) (*parsers.SiteMap, error) {
 	}, nil
 }
 
-func (s siteMapStorage) Get(url string) (*parsers.SiteMap, error) {
+func (s siteMapStorage) Get(url string, options *httptools.ClientOptions) (*parsers.SiteMap, error) {
 	return &parsers.SiteMap{
 		URLSet: []parsers.SiteMapURL{
 			{
@@ -76,14 +85,14 @@ func (s siteMapStorage) Get(url string) (*parsers.SiteMap, error) {
 type siteMapStorageError struct {
 }
 
-func (s siteMapStorageError) Get(url string) (*parsers.SiteMap, error) {
+func (s siteMapStorageError) Get(url string, options *httptools.ClientOptions) (*parsers.SiteMap, error) {
 	return nil, errors.New("SAFafs")
 }
 
 type siteMapStorageEmptyIgnore struct {
 }
 
-func (s siteMapStorageEmptyIgnore) Get(url string) (*parsers.SiteMap, error) {
+func (s siteMapStorageEmptyIgnore) Get(url string, options *httptools.ClientOptions) (*parsers.SiteMap, error) {
 	return &parsers.SiteMap{
 		URLSet: []parsers.SiteMapURL{},
 	}, nil
@@ -113,6 +122,14 @@ func (m mockHttpToolsWithError) CreateRequest(method string, url string, headers
 	return rq
 }
 
+func (m mockHttpToolsWithError) WithOptions(options *httptools.ClientOptions) (httptools.HTTPTool, error) {
+	return m, nil
+}
+
+func (m mockHttpToolsWithError) WithAuth(auth *httptools.AuthOptions) httptools.HTTPTool {
+	return m
+}
+
 func (m mockHttpToolsWithError) SendRequest(req *http.Request) (int, []byte, error) {
 	return 500, nil, errors.New("Wrong code")
 }
@@ -162,6 +179,14 @@ func TestExecSiteMap(t *testing.T) {
 		})
 	})
 	t.Run("Should: return error", func(t *testing.T) {
+		t.Run("Because client options not valid", func(t *testing.T) {
+			job := ExecSiteMap("", 0, &scheduler_config_storage.SiteMapConfig{
+				Client: &scheduler_config_storage.HTTPClientConfig{
+					SourceIP: "ip",
+				},
+			}, &siteMapStorage{}, httptools.New(""), successFactory)
+			assert.Equal(t, apiPb.SchedulerCode_ERROR, job.GetLogData().Snapshot.Code)
+		})
 		t.Run("Because Acquire error", func(t *testing.T) {
 			job := ExecSiteMap("", 0, &scheduler_config_storage.SiteMapConfig{
 				URL:         "",
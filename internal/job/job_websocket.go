@@ -0,0 +1,163 @@
+package job
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"github.com/gorilla/websocket"
+	"github.com/squzy/squzy/internal/helpers"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamp "google.golang.org/protobuf/types/known/timestamppb"
+	"net/http"
+	"regexp"
+	"time"
+)
+
+const (
+	webSocketHandshakeLatencyKey = "handshakeLatency"
+	webSocketMessageLatencyKey   = "messageLatency"
+)
+
+var (
+	webSocketMessageNotMatchFn = func(pattern string, err error) error {
+		return fmt.Errorf("message by pattern=`%s` not received: %s", pattern, err.Error())
+	}
+)
+
+type webSocketError struct {
+	schedulerID string
+	startTime   *timestamp.Timestamp
+	endTime     *timestamp.Timestamp
+	code        apiPb.SchedulerCode
+	description string
+	value       *structpb.Value
+}
+
+func (e *webSocketError) GetLogData() *apiPb.SchedulerResponse {
+	var err *apiPb.SchedulerSnapshot_Error
+	if e.code == apiPb.SchedulerCode_ERROR {
+		err = &apiPb.SchedulerSnapshot_Error{
+			Message: e.description,
+		}
+	}
+	return &apiPb.SchedulerResponse{
+		SchedulerId: e.schedulerID,
+		Snapshot: &apiPb.SchedulerSnapshot{
+			Code:  e.code,
+			Error: err,
+			Type:  apiPb.SchedulerType_WEBSOCKET,
+			Meta: &apiPb.SchedulerSnapshot_MetaData{
+				StartTime: e.startTime,
+				EndTime:   e.endTime,
+				Value:     e.value,
+			},
+		},
+	}
+}
+
+func newWebSocketError(schedulerID string, startTime *timestamp.Timestamp, endTime *timestamp.Timestamp, code apiPb.SchedulerCode, description string, value *structpb.Value) CheckError {
+	return &webSocketError{
+		schedulerID: schedulerID,
+		startTime:   startTime,
+		endTime:     endTime,
+		code:        code,
+		description: description,
+		value:       value,
+	}
+}
+
+// Latencies stored in nanoseconds, message latency counted from the end of handshake
+func webSocketLatencyValue(handshake time.Duration, message time.Duration) *structpb.Value {
+	fields := map[string]*structpb.Value{
+		webSocketHandshakeLatencyKey: structpb.NewNumberValue(float64(handshake.Nanoseconds())),
+	}
+	if message >= 0 {
+		fields[webSocketMessageLatencyKey] = structpb.NewNumberValue(float64(message.Nanoseconds()))
+	}
+	return structpb.NewStructValue(&structpb.Struct{
+		Fields: fields,
+	})
+}
+
+func ExecWebSocket(schedulerID string, timeout int32, config *scheduler_config_storage.WebSocketConfig, cfg *tls.Config) CheckError {
+	startTime := timestamp.Now()
+
+	ctx, cancel := helpers.TimeoutContext(context.Background(), helpers.DurationFromSecond(timeout))
+	defer cancel()
+
+	var pattern *regexp.Regexp
+	if config.Pattern != "" {
+		var err error
+		pattern, err = regexp.Compile(config.Pattern)
+		if err != nil {
+			return newWebSocketError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_ERROR, err.Error(), nil)
+		}
+	}
+
+	headers := http.Header{}
+	for k, v := range config.Headers {
+		headers.Set(k, v)
+	}
+	headers.Set(logMetaData, schedulerID)
+
+	dialer := &websocket.Dialer{
+		Proxy:           http.ProxyFromEnvironment,
+		TLSClientConfig: cfg,
+	}
+
+	handshakeStart := time.Now()
+	conn, resp, err := dialer.DialContext(ctx, config.URL, headers)
+	if resp != nil && resp.Body != nil {
+		_ = resp.Body.Close()
+	}
+	if err != nil {
+		return newWebSocketError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_ERROR, err.Error(), nil)
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+	handshakeLatency := time.Since(handshakeStart)
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetWriteDeadline(deadline)
+		_ = conn.SetReadDeadline(deadline)
+	}
+
+	messageStart := time.Now()
+	if config.Message != "" {
+		err = conn.WriteMessage(websocket.TextMessage, []byte(config.Message))
+		if err != nil {
+			return newWebSocketError(schedulerID, startTime, timestamp.Now(), apiPb.SchedulerCode_ERROR, err.Error(), webSocketLatencyValue(handshakeLatency, -1))
+		}
+	}
+
+	for {
+		_, data, errRead := conn.ReadMessage()
+		if errRead != nil {
+			return newWebSocketError(
+				schedulerID,
+				startTime,
+				timestamp.Now(),
+				apiPb.SchedulerCode_ERROR,
+				webSocketMessageNotMatchFn(config.Pattern, errRead).Error(),
+				webSocketLatencyValue(handshakeLatency, -1),
+			)
+		}
+		if pattern == nil || pattern.Match(data) {
+			break
+		}
+	}
+
+	_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second))
+
+	return newWebSocketError(
+		schedulerID,
+		startTime,
+		timestamp.Now(),
+		apiPb.SchedulerCode_OK,
+		"",
+		webSocketLatencyValue(handshakeLatency, time.Since(messageStart)),
+	)
+}
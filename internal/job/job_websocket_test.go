@@ -0,0 +1,94 @@
+package job
+
+import (
+	"github.com/gorilla/websocket"
+	scheduler_config_storage "github.com/squzy/squzy/internal/scheduler-config-storage"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func newWebSocketServer(handler func(conn *websocket.Conn)) *httptest.Server {
+	upgrader := websocket.Upgrader{}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Test") != "" {
+			w.Header().Set("X-Test", r.Header.Get("X-Test"))
+		}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		handler(conn)
+	}))
+}
+
+func wsURL(server *httptest.Server) string {
+	return "ws" + strings.TrimPrefix(server.URL, "http")
+}
+
+func TestExecWebSocket(t *testing.T) {
+	t.Run("Should: return error because server not exist", func(t *testing.T) {
+		job := ExecWebSocket("", 1, &scheduler_config_storage.WebSocketConfig{
+			URL: "ws://localhost:17824",
+		}, nil)
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, job.GetLogData().Snapshot.Code)
+		assert.Equal(t, apiPb.SchedulerType_WEBSOCKET, job.GetLogData().Snapshot.Type)
+	})
+	t.Run("Should: return error because pattern is not valid", func(t *testing.T) {
+		job := ExecWebSocket("", 1, &scheduler_config_storage.WebSocketConfig{
+			URL:     "ws://localhost:17824",
+			Pattern: "[",
+		}, nil)
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, job.GetLogData().Snapshot.Code)
+	})
+	t.Run("Should: return ok on first message", func(t *testing.T) {
+		server := newWebSocketServer(func(conn *websocket.Conn) {
+			_ = conn.WriteMessage(websocket.TextMessage, []byte("hello"))
+		})
+		defer server.Close()
+		job := ExecWebSocket("", 1, &scheduler_config_storage.WebSocketConfig{
+			URL: wsURL(server),
+		}, nil)
+		assert.Equal(t, apiPb.SchedulerCode_OK, job.GetLogData().Snapshot.Code)
+		fields := job.GetLogData().Snapshot.Meta.Value.GetStructValue().GetFields()
+		assert.Contains(t, fields, webSocketHandshakeLatencyKey)
+		assert.Contains(t, fields, webSocketMessageLatencyKey)
+	})
+	t.Run("Should: send message and wait matched answer", func(t *testing.T) {
+		server := newWebSocketServer(func(conn *websocket.Conn) {
+			_, msg, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			_ = conn.WriteMessage(websocket.TextMessage, []byte("ack"))
+			_ = conn.WriteMessage(websocket.TextMessage, append([]byte("re:"), msg...))
+		})
+		defer server.Close()
+		job := ExecWebSocket("", 1, &scheduler_config_storage.WebSocketConfig{
+			URL:     wsURL(server),
+			Headers: map[string]string{"X-Test": "1"},
+			Message: "ping",
+			Pattern: "^re:ping$",
+		}, nil)
+		assert.Equal(t, apiPb.SchedulerCode_OK, job.GetLogData().Snapshot.Code)
+	})
+	t.Run("Should: return error because message not matched in timeout", func(t *testing.T) {
+		server := newWebSocketServer(func(conn *websocket.Conn) {
+			_ = conn.WriteMessage(websocket.TextMessage, []byte("hello"))
+			_, _, _ = conn.ReadMessage()
+		})
+		defer server.Close()
+		job := ExecWebSocket("", 1, &scheduler_config_storage.WebSocketConfig{
+			URL:     wsURL(server),
+			Pattern: "pong",
+		}, nil)
+		assert.Equal(t, apiPb.SchedulerCode_ERROR, job.GetLogData().Snapshot.Code)
+		fields := job.GetLogData().Snapshot.Meta.Value.GetStructValue().GetFields()
+		assert.Contains(t, fields, webSocketHandshakeLatencyKey)
+		assert.NotContains(t, fields, webSocketMessageLatencyKey)
+	})
+}
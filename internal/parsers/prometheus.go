@@ -0,0 +1,285 @@
+package parsers
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	prometheusMatchEqual     = "="
+	prometheusMatchNotEqual  = "!="
+	prometheusMatchRegexp    = "=~"
+	prometheusMatchNotRegexp = "!~"
+)
+
+var (
+	errPrometheusMetricNameMissing = errors.New("metric name is missing")
+	errPrometheusLabelsNotClosed   = errors.New("labels are not closed")
+
+	prometheusLineErrorFn = func(line int, err error) error {
+		return fmt.Errorf("line %d: %s", line, err.Error())
+	}
+	prometheusUnexpectedErrorFn = func(value string) error {
+		return fmt.Errorf("unexpected `%s`", value)
+	}
+	prometheusOperators = []string{"<=", ">=", "==", "!=", "<", ">"}
+)
+
+// PrometheusSample is one line of the Prometheus text or OpenMetrics exposition
+type PrometheusSample struct {
+	Name   string
+	Labels map[string]string
+	Value  float64
+}
+
+type PrometheusMatcher struct {
+	Name  string
+	Type  string
+	Value string
+	re    *regexp.Regexp
+}
+
+// PrometheusRule selects samples by metric name and label matchers, threshold is checked only if operator is set
+type PrometheusRule struct {
+	Name      string
+	Matchers  []*PrometheusMatcher
+	Operator  string
+	Threshold float64
+}
+
+type prometheusParser struct {
+}
+
+type PrometheusParser interface {
+	Parse(data []byte) ([]*PrometheusSample, error)
+	ParseRule(rule string) (*PrometheusRule, error)
+}
+
+func NewPrometheusParser() PrometheusParser {
+	return &prometheusParser{}
+}
+
+func (parser *prometheusParser) Parse(data []byte) ([]*PrometheusSample, error) {
+	samples := []*PrometheusSample{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		sample, err := parsePrometheusSample(text)
+		if err != nil {
+			return nil, prometheusLineErrorFn(line, err)
+		}
+		samples = append(samples, sample)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return samples, nil
+}
+
+func (parser *prometheusParser) ParseRule(rule string) (*PrometheusRule, error) {
+	name, matchers, rest, err := parsePrometheusSelector(strings.TrimSpace(rule))
+	if err != nil {
+		return nil, err
+	}
+	res := &PrometheusRule{
+		Name:     name,
+		Matchers: matchers,
+	}
+	rest = strings.TrimSpace(rest)
+	if rest == "" {
+		return res, nil
+	}
+	for _, operator := range prometheusOperators {
+		if strings.HasPrefix(rest, operator) {
+			threshold, err := strconv.ParseFloat(strings.TrimSpace(rest[len(operator):]), 64)
+			if err != nil {
+				return nil, err
+			}
+			res.Operator = operator
+			res.Threshold = threshold
+			return res, nil
+		}
+	}
+	return nil, prometheusUnexpectedErrorFn(rest)
+}
+
+func (r *PrometheusRule) Match(sample *PrometheusSample) bool {
+	if sample.Name != r.Name {
+		return false
+	}
+	for _, matcher := range r.Matchers {
+		if !matcher.match(sample.Labels[matcher.Name]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *PrometheusRule) Check(value float64) bool {
+	switch r.Operator {
+	case "<":
+		return value < r.Threshold
+	case "<=":
+		return value <= r.Threshold
+	case ">":
+		return value > r.Threshold
+	case ">=":
+		return value >= r.Threshold
+	case "==":
+		return value == r.Threshold
+	case "!=":
+		return value != r.Threshold
+	default:
+		return true
+	}
+}
+
+func (m *PrometheusMatcher) match(value string) bool {
+	switch m.Type {
+	case prometheusMatchNotEqual:
+		return value != m.Value
+	case prometheusMatchRegexp:
+		return m.re.MatchString(value)
+	case prometheusMatchNotRegexp:
+		return !m.re.MatchString(value)
+	default:
+		return value == m.Value
+	}
+}
+
+func parsePrometheusSample(text string) (*PrometheusSample, error) {
+	name, matchers, rest, err := parsePrometheusSelector(text)
+	if err != nil {
+		return nil, err
+	}
+	labels := make(map[string]string, len(matchers))
+	for _, matcher := range matchers {
+		if matcher.Type != prometheusMatchEqual {
+			return nil, prometheusUnexpectedErrorFn(matcher.Type)
+		}
+		labels[matcher.Name] = matcher.Value
+	}
+	// Timestamp and OpenMetrics exemplar are going after value
+	fields := strings.Fields(rest)
+	if len(fields) == 0 {
+		return nil, prometheusUnexpectedErrorFn(text)
+	}
+	value, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return nil, err
+	}
+	return &PrometheusSample{
+		Name:   name,
+		Labels: labels,
+		Value:  value,
+	}, nil
+}
+
+// Returns metric name, label matchers and not parsed rest of the text
+func parsePrometheusSelector(text string) (string, []*PrometheusMatcher, string, error) {
+	i := 0
+	for i < len(text) && isPrometheusNameChar(text[i]) {
+		i++
+	}
+	name := text[:i]
+	if name == "" {
+		return "", nil, "", errPrometheusMetricNameMissing
+	}
+	rest := strings.TrimLeft(text[i:], " \t")
+	if !strings.HasPrefix(rest, "{") {
+		return name, nil, rest, nil
+	}
+	matchers := []*PrometheusMatcher{}
+	rest = rest[1:]
+	for {
+		rest = strings.TrimLeft(rest, " \t,")
+		if rest == "" {
+			return "", nil, "", errPrometheusLabelsNotClosed
+		}
+		if rest[0] == '}' {
+			return name, matchers, rest[1:], nil
+		}
+		matcher, tail, err := parsePrometheusMatcher(rest)
+		if err != nil {
+			return "", nil, "", err
+		}
+		matchers = append(matchers, matcher)
+		rest = tail
+	}
+}
+
+func parsePrometheusMatcher(text string) (*PrometheusMatcher, string, error) {
+	i := 0
+	for i < len(text) && isPrometheusNameChar(text[i]) {
+		i++
+	}
+	matcher := &PrometheusMatcher{
+		Name: text[:i],
+	}
+	rest := strings.TrimLeft(text[i:], " \t")
+	for _, matchType := range []string{prometheusMatchRegexp, prometheusMatchNotRegexp, prometheusMatchNotEqual, prometheusMatchEqual} {
+		if strings.HasPrefix(rest, matchType) {
+			matcher.Type = matchType
+			break
+		}
+	}
+	if matcher.Name == "" || matcher.Type == "" {
+		return nil, "", prometheusUnexpectedErrorFn(text)
+	}
+	rest = strings.TrimLeft(rest[len(matcher.Type):], " \t")
+	value, rest, err := parsePrometheusQuoted(rest)
+	if err != nil {
+		return nil, "", err
+	}
+	matcher.Value = value
+	if matcher.Type == prometheusMatchRegexp || matcher.Type == prometheusMatchNotRegexp {
+		// Same as in Prometheus regexp should match whole value
+		re, err := regexp.Compile("^(?:" + value + ")$")
+		if err != nil {
+			return nil, "", err
+		}
+		matcher.re = re
+	}
+	return matcher, rest, nil
+}
+
+func parsePrometheusQuoted(text string) (string, string, error) {
+	if !strings.HasPrefix(text, `"`) {
+		return "", "", prometheusUnexpectedErrorFn(text)
+	}
+	var buf strings.Builder
+	for i := 1; i < len(text); i++ {
+		switch text[i] {
+		case '"':
+			return buf.String(), text[i+1:], nil
+		case '\\':
+			i++
+			if i == len(text) {
+				return "", "", errPrometheusLabelsNotClosed
+			}
+			if text[i] == 'n' {
+				buf.WriteByte('\n')
+				continue
+			}
+			buf.WriteByte(text[i])
+		default:
+			buf.WriteByte(text[i])
+		}
+	}
+	return "", "", errPrometheusLabelsNotClosed
+}
+
+func isPrometheusNameChar(c byte) bool {
+	return c == '_' || c == ':' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
@@ -0,0 +1,132 @@
+package parsers
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+const prometheusMetrics = `
+# HELP http_requests_inflight Current requests.
+# TYPE http_requests_inflight gauge
+http_requests_inflight{job="api",path="/v1/users"} 120
+http_requests_inflight{job="api",path="/v1/agents"} 30 1395066363000
+http_requests_inflight{job="web", path="say \"hi\""} 7
+process_open_fds 15
+up NaN
+requests_total{code="200"} 1027 # {trace_id="abc"} 1.0
+# EOF
+`
+
+func TestNewPrometheusParser(t *testing.T) {
+	t.Run("Test: PrometheusParser create", func(t *testing.T) {
+		parser := NewPrometheusParser()
+		assert.IsType(t, &prometheusParser{}, parser)
+	})
+}
+
+func TestPrometheusParser_Parse(t *testing.T) {
+	t.Run("Should: parse without error", func(t *testing.T) {
+		samples, err := NewPrometheusParser().Parse([]byte(prometheusMetrics))
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 6, len(samples))
+		assert.Equal(t, "http_requests_inflight", samples[0].Name)
+		assert.Equal(t, map[string]string{"job": "api", "path": "/v1/users"}, samples[0].Labels)
+		assert.EqualValues(t, 120, samples[0].Value)
+		assert.EqualValues(t, 30, samples[1].Value)
+		assert.Equal(t, `say "hi"`, samples[2].Labels["path"])
+		assert.Equal(t, 0, len(samples[3].Labels))
+		assert.True(t, math.IsNaN(samples[4].Value))
+		assert.EqualValues(t, 1027, samples[5].Value)
+	})
+	t.Run("Should: parse with error", func(t *testing.T) {
+		for _, data := range []string{
+			"metric",
+			"metric{job=\"api\" 1",
+			"metric{job=api} 1",
+			"metric{job!=\"api\"} 1",
+			"metric value",
+			"{job=\"api\"} 1",
+		} {
+			_, err := NewPrometheusParser().Parse([]byte(data))
+			assert.NotEqual(t, nil, err, data)
+		}
+	})
+}
+
+func TestPrometheusParser_ParseRule(t *testing.T) {
+	parser := NewPrometheusParser()
+	t.Run("Should: parse rule with threshold", func(t *testing.T) {
+		rule, err := parser.ParseRule(`http_requests_inflight{job="api", path=~"/v1/.*"} <= 500`)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, "http_requests_inflight", rule.Name)
+		assert.Equal(t, 2, len(rule.Matchers))
+		assert.Equal(t, "<=", rule.Operator)
+		assert.EqualValues(t, 500, rule.Threshold)
+	})
+	t.Run("Should: parse rule without threshold", func(t *testing.T) {
+		rule, err := parser.ParseRule(`process_open_fds`)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, "", rule.Operator)
+		assert.True(t, rule.Check(100))
+	})
+	t.Run("Should: return error", func(t *testing.T) {
+		for _, rule := range []string{
+			`metric{job="api"} =< 1`,
+			`metric < value`,
+			`metric{path=~"["}`,
+			`< 1`,
+		} {
+			_, err := parser.ParseRule(rule)
+			assert.NotEqual(t, nil, err, rule)
+		}
+	})
+}
+
+func TestPrometheusRule_Match(t *testing.T) {
+	samples, _ := NewPrometheusParser().Parse([]byte(prometheusMetrics))
+	tests := []struct {
+		rule    string
+		matched int
+	}{
+		{`http_requests_inflight`, 3},
+		{`http_requests_inflight{job="api"}`, 2},
+		{`http_requests_inflight{job!="api"}`, 1},
+		{`http_requests_inflight{path=~"/v1/.*"}`, 2},
+		{`http_requests_inflight{path!~"/v1/.*"}`, 1},
+		{`http_requests_inflight{path=~"v1"}`, 0},
+		{`process_open_fds{job=""}`, 1},
+	}
+	for _, test := range tests {
+		rule, err := NewPrometheusParser().ParseRule(test.rule)
+		assert.Equal(t, nil, err)
+		matched := 0
+		for _, sample := range samples {
+			if rule.Match(sample) {
+				matched++
+			}
+		}
+		assert.Equal(t, test.matched, matched, test.rule)
+	}
+}
+
+func TestPrometheusRule_Check(t *testing.T) {
+	tests := []struct {
+		rule  string
+		value float64
+		ok    bool
+	}{
+		{`m < 5`, 4, true},
+		{`m < 5`, 5, false},
+		{`m <= 5`, 5, true},
+		{`m > 5`, 5, false},
+		{`m >= 5`, 5, true},
+		{`m == 5`, 5, true},
+		{`m != 5`, 5, false},
+	}
+	for _, test := range tests {
+		rule, err := NewPrometheusParser().ParseRule(test.rule)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, test.ok, rule.Check(test.value), test.rule)
+	}
+}
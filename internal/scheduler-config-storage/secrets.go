@@ -0,0 +1,33 @@
+package scheduler_config_storage
+
+import (
+	"context"
+	secret_storage "github.com/squzy/squzy/internal/secret-storage"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type secretStorage struct {
+	Storage
+	secrets secret_storage.SecretStorage
+}
+
+// Get returns config with {{secret "name"}} references replaced by values
+func (s *secretStorage) Get(ctx context.Context, schedulerID primitive.ObjectID) (*SchedulerConfig, error) {
+	config, err := s.Storage.Get(ctx, schedulerID)
+	if err != nil || config == nil {
+		return config, err
+	}
+	err = secret_storage.ResolveStruct(ctx, s.secrets, config)
+	if err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// NewWithSecrets wraps storage for consumers which need real credentials, like job executor
+func NewWithSecrets(storage Storage, secrets secret_storage.SecretStorage) Storage {
+	return &secretStorage{
+		Storage: storage,
+		secrets: secrets,
+	}
+}
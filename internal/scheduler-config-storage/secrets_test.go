@@ -0,0 +1,78 @@
+package scheduler_config_storage
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"testing"
+)
+
+type mockSecrets struct {
+}
+
+func (m mockSecrets) Set(ctx context.Context, name string, value string) error {
+	panic("implement me")
+}
+
+func (m mockSecrets) Get(ctx context.Context, name string) (string, error) {
+	if name == "token" {
+		return "qwerty", nil
+	}
+	return "", basicError
+}
+
+func (m mockSecrets) Remove(ctx context.Context, name string) error {
+	panic("implement me")
+}
+
+func (m mockSecrets) GetNames(ctx context.Context) ([]string, error) {
+	panic("implement me")
+}
+
+type mockStorage struct {
+	Storage
+	config *SchedulerConfig
+}
+
+func (m mockStorage) Get(ctx context.Context, schedulerID primitive.ObjectID) (*SchedulerConfig, error) {
+	if m.config == nil {
+		return nil, basicError
+	}
+	return m.config, nil
+}
+
+func TestNewWithSecrets(t *testing.T) {
+	t.Run("Should: implement interface", func(t *testing.T) {
+		s := NewWithSecrets(New(nil), &mockSecrets{})
+		assert.Implements(t, (*Storage)(nil), s)
+	})
+}
+
+func TestSecretStorage_Get(t *testing.T) {
+	t.Run("Should: resolve secrets", func(t *testing.T) {
+		s := NewWithSecrets(&mockStorage{config: &SchedulerConfig{
+			HTTPConfig: &HTTPConfig{
+				URL:     "https://squzy.app",
+				Headers: map[string]string{"Authorization": `Bearer {{secret "token"}}`},
+				Auth:    &HTTPAuthConfig{Password: `{{secret "token"}}`},
+			},
+		}}, &mockSecrets{})
+		config, err := s.Get(context.Background(), primitive.NewObjectID())
+		assert.Equal(t, nil, err)
+		assert.Equal(t, "https://squzy.app", config.HTTPConfig.URL)
+		assert.Equal(t, "Bearer qwerty", config.HTTPConfig.Headers["Authorization"])
+		assert.Equal(t, "qwerty", config.HTTPConfig.Auth.Password)
+	})
+	t.Run("Should: return error if secret not exist", func(t *testing.T) {
+		s := NewWithSecrets(&mockStorage{config: &SchedulerConfig{
+			DatabaseConfig: &DatabaseConfig{DSN: `{{secret "missing"}}`},
+		}}, &mockSecrets{})
+		_, err := s.Get(context.Background(), primitive.NewObjectID())
+		assert.NotEqual(t, nil, err)
+	})
+	t.Run("Should: return error from storage", func(t *testing.T) {
+		s := NewWithSecrets(&mockStorage{}, &mockSecrets{})
+		_, err := s.Get(context.Background(), primitive.NewObjectID())
+		assert.NotEqual(t, nil, err)
+	})
+}
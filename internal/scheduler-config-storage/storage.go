@@ -24,6 +24,8 @@ type HTTPConfig struct {
 	URL        string            `bson:"url"`
 	Headers    map[string]string `bson:"headers"`
 	StatusCode int32             `bson:"statusCode"`
+	Client     *HTTPClientConfig `bson:"client,omitempty"`
+	Auth       *HTTPAuthConfig   `bson:"auth,omitempty"`
 }
 
 type HTTPValueConfig struct {
@@ -31,6 +33,34 @@ type HTTPValueConfig struct {
 	URL       string            `bson:"url"`
 	Headers   map[string]string `bson:"headers"`
 	Selectors []*Selectors      `bson:"selectors"`
+	Client    *HTTPClientConfig `bson:"client,omitempty"`
+	Auth      *HTTPAuthConfig   `bson:"auth,omitempty"`
+}
+
+type HTTPClientConfig struct {
+	ProxyURL           string `bson:"proxyUrl"`
+	CABundle           string `bson:"caBundle"`
+	ClientCert         string `bson:"clientCert"`
+	ClientKey          string `bson:"clientKey"`
+	InsecureSkipVerify bool   `bson:"insecureSkipVerify"`
+	DisableHTTP2       bool   `bson:"disableHttp2"`
+	SourceIP           string `bson:"sourceIp"`
+}
+
+type HTTPAuthConfig struct {
+	Type         apiPb.HttpAuthConfig_Type `bson:"type"`
+	Username     string                    `bson:"username"`
+	Password     string                    `bson:"password"`
+	Token        string                    `bson:"token"`
+	TokenURL     string                    `bson:"tokenUrl"`
+	ClientID     string                    `bson:"clientId"`
+	ClientSecret string                    `bson:"clientSecret"`
+	Scopes       []string                  `bson:"scopes"`
+	AccessKey    string                    `bson:"accessKey"`
+	SecretKey    string                    `bson:"secretKey"`
+	SessionToken string                    `bson:"sessionToken"`
+	Region       string                    `bson:"region"`
+	Service      string                    `bson:"service"`
 }
 
 type Selectors struct {
@@ -44,8 +74,50 @@ type TCPConfig struct {
 }
 
 type SiteMapConfig struct {
-	URL         string `bson:"url"`
-	Concurrency int32  `bson:"concurrency"`
+	URL         string            `bson:"url"`
+	Concurrency int32             `bson:"concurrency"`
+	Client      *HTTPClientConfig `bson:"client,omitempty"`
+}
+
+type WebSocketConfig struct {
+	URL     string            `bson:"url"`
+	Headers map[string]string `bson:"headers"`
+	Message string            `bson:"message"`
+	Pattern string            `bson:"pattern"`
+}
+
+type DatabaseConfig struct {
+	Type       apiPb.DatabaseConfig_DatabaseType `bson:"type"`
+	DSN        string                            `bson:"dsn"`
+	Query      string                            `bson:"query"`
+	Path       string                            `bson:"path"`
+	Assertions []*Assertion                      `bson:"assertions"`
+	Args       []string                          `bson:"args,omitempty"`
+}
+
+type Assertion struct {
+	Operator apiPb.DatabaseConfig_Operator `bson:"operator"`
+	Value    string                        `bson:"value"`
+}
+
+type HeartbeatConfig struct {
+	Grace int32 `bson:"grace"`
+}
+
+type ContentChangeConfig struct {
+	URL          string                                 `bson:"url"`
+	Headers      map[string]string                      `bson:"headers"`
+	SelectorType apiPb.ContentChangeConfig_SelectorType `bson:"selectorType"`
+	Selector     string                                 `bson:"selector"`
+	Baseline     string                                 `bson:"baseline"`
+	Auth         *HTTPAuthConfig                        `bson:"auth,omitempty"`
+}
+
+type PrometheusConfig struct {
+	URL     string            `bson:"url"`
+	Headers map[string]string `bson:"headers"`
+	Rules   []string          `bson:"rules"`
+	Auth    *HTTPAuthConfig   `bson:"auth,omitempty"`
 }
 
 type SchedulerConfig struct {
@@ -61,6 +133,11 @@ type SchedulerConfig struct {
 	HTTPConfig          *HTTPConfig           `bson:"httpConfig,omitempty"`
 	HTTPValueConfig     *HTTPValueConfig      `bson:"httpValueConfig,omitempty"`
 	SslExpirationConfig *SslExpirationConfig  `bson:"sslExpirationConfig,omitempty"`
+	WebSocketConfig     *WebSocketConfig      `bson:"webSocketConfig,omitempty"`
+	DatabaseConfig      *DatabaseConfig       `bson:"databaseConfig,omitempty"`
+	HeartbeatConfig     *HeartbeatConfig      `bson:"heartbeatConfig,omitempty"`
+	ContentChangeConfig *ContentChangeConfig  `bson:"contentChangeConfig,omitempty"`
+	PrometheusConfig    *PrometheusConfig     `bson:"prometheusConfig,omitempty"`
 }
 
 type Storage interface {
@@ -1,6 +1,7 @@
 package scheduler
 
 import (
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
 	"github.com/stretchr/testify/assert"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"testing"
@@ -15,6 +16,17 @@ func (j *jobExecutor) Execute(schedulerId primitive.ObjectID) {
 	j.count += 1
 }
 
+func (j *jobExecutor) Ping(schedulerId primitive.ObjectID, pingType apiPb.PingRequest_PingType, message string) error {
+	return nil
+}
+
+func (j *jobExecutor) Reset(schedulerId primitive.ObjectID) {
+}
+
+func (j *jobExecutor) Remove(schedulerId primitive.ObjectID) error {
+	return nil
+}
+
 func TestNew(t *testing.T) {
 	t.Run("Tests: Scheduler.New()", func(t *testing.T) {
 		t.Run("Should: create new app without error", func(t *testing.T) {
@@ -0,0 +1,42 @@
+package secret_storage
+
+import (
+	"encoding/base64"
+	"errors"
+	"io/ioutil"
+	"strings"
+)
+
+const (
+	keySize = 32
+)
+
+var (
+	errKeyNotValid = errors.New("SECRET_KEY_SHOULD_BE_32_BYTES")
+)
+
+// LoadKey reads base64 encoded key from value or from file if value is empty,
+// file can also contain raw key. Nil is returned when key is not configured
+func LoadKey(value string, path string) ([]byte, error) {
+	if value == "" && path == "" {
+		return nil, nil
+	}
+	if value == "" {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if len(data) == keySize {
+			return data, nil
+		}
+		value = string(data)
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(value))
+	if err != nil {
+		return nil, err
+	}
+	if len(key) != keySize {
+		return nil, errKeyNotValid
+	}
+	return key, nil
+}
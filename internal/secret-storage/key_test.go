@@ -0,0 +1,51 @@
+package secret_storage
+
+import (
+	"encoding/base64"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadKey(t *testing.T) {
+	encoded := base64.StdEncoding.EncodeToString(testKey)
+	t.Run("Should: return nil if key not configured", func(t *testing.T) {
+		key, err := LoadKey("", "")
+		assert.Equal(t, nil, err)
+		assert.Nil(t, key)
+	})
+	t.Run("Should: decode key from value", func(t *testing.T) {
+		key, err := LoadKey(encoded, "")
+		assert.Equal(t, nil, err)
+		assert.Equal(t, testKey, key)
+	})
+	t.Run("Should: return error if key has wrong size", func(t *testing.T) {
+		_, err := LoadKey(base64.StdEncoding.EncodeToString([]byte("short")), "")
+		assert.Equal(t, errKeyNotValid, err)
+	})
+	t.Run("Should: return error if value not base64", func(t *testing.T) {
+		_, err := LoadKey("%%%", "")
+		assert.NotEqual(t, nil, err)
+	})
+	t.Run("Should: read key from file", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "secret")
+		assert.Equal(t, nil, err)
+		defer os.RemoveAll(dir)
+		raw := filepath.Join(dir, "raw")
+		assert.Equal(t, nil, ioutil.WriteFile(raw, testKey, 0600))
+		key, err := LoadKey("", raw)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, testKey, key)
+		file := filepath.Join(dir, "encoded")
+		assert.Equal(t, nil, ioutil.WriteFile(file, []byte(encoded+"\n"), 0600))
+		key, err = LoadKey("", file)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, testKey, key)
+	})
+	t.Run("Should: return error if file not exist", func(t *testing.T) {
+		_, err := LoadKey("", "/not/exist")
+		assert.NotEqual(t, nil, err)
+	})
+}
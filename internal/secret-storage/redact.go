@@ -0,0 +1,133 @@
+package secret_storage
+
+import (
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"strings"
+)
+
+const (
+	RedactedValue = "******"
+)
+
+var (
+	// Fields with these names are redacted in every message
+	sensitiveNames = map[protoreflect.Name]bool{
+		"headers":       true,
+		"password":      true,
+		"token":         true,
+		"client_secret": true,
+		"client_key":    true,
+		"secret_key":    true,
+		"session_token": true,
+		"dsn":           true,
+	}
+	// Fields which are sensitive only in the particular message
+	sensitiveFields = map[protoreflect.FullName]bool{
+		fieldName(&apiPb.SlackMethod{}, "url"):   true,
+		fieldName(&apiPb.WebHookMethod{}, "url"): true,
+	}
+	// Only user info of these fields is redacted
+	urlNames = map[protoreflect.Name]bool{
+		"url":       true,
+		"proxy_url": true,
+		"token_url": true,
+	}
+)
+
+func fieldName(m proto.Message, name protoreflect.Name) protoreflect.FullName {
+	return m.ProtoReflect().Descriptor().Fields().ByName(name).FullName()
+}
+
+// Redact hides every part of value except references to secrets
+func Redact(value string) string {
+	if value == "" {
+		return value
+	}
+	builder := strings.Builder{}
+	last := 0
+	for _, match := range referenceRegexp.FindAllStringIndex(value, -1) {
+		if match[0] > last {
+			builder.WriteString(RedactedValue)
+		}
+		builder.WriteString(value[match[0]:match[1]])
+		last = match[1]
+	}
+	if last < len(value) {
+		builder.WriteString(RedactedValue)
+	}
+	return builder.String()
+}
+
+// RedactURL hides user info of url, for example credentials of proxy
+func RedactURL(value string) string {
+	schemeEnd := strings.Index(value, "://")
+	if schemeEnd < 0 {
+		return value
+	}
+	authority := value[schemeEnd+len("://"):]
+	if end := strings.IndexAny(authority, "/?#"); end >= 0 {
+		authority = authority[:end]
+	}
+	at := strings.LastIndex(authority, "@")
+	if at < 0 {
+		return value
+	}
+	userInfoStart := schemeEnd + len("://")
+	return value[:userInfoStart] + Redact(value[userInfoStart:userInfoStart+at]) + value[userInfoStart+at:]
+}
+
+// RedactMessage hides credentials in message and all nested messages
+func RedactMessage(m proto.Message) {
+	if m == nil {
+		return
+	}
+	redactMessage(m.ProtoReflect())
+}
+
+func redactMessage(m protoreflect.Message) {
+	if !m.IsValid() {
+		return
+	}
+	// Fields are replaced after iteration, message should not be changed by Range
+	redacted := map[protoreflect.FieldDescriptor]protoreflect.Value{}
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		sensitive := sensitiveNames[fd.Name()] || sensitiveFields[fd.FullName()]
+		switch {
+		case fd.IsMap() && fd.MapValue().Kind() == protoreflect.MessageKind:
+			v.Map().Range(func(key protoreflect.MapKey, value protoreflect.Value) bool {
+				redactMessage(value.Message())
+				return true
+			})
+		case fd.IsMap():
+			if !sensitive || fd.MapValue().Kind() != protoreflect.StringKind {
+				return true
+			}
+			// Map can be shared with the caller, so redacted copy is set instead
+			copied := m.NewField(fd)
+			v.Map().Range(func(key protoreflect.MapKey, value protoreflect.Value) bool {
+				copied.Map().Set(key, protoreflect.ValueOfString(Redact(value.String())))
+				return true
+			})
+			redacted[fd] = copied
+		case fd.IsList() && fd.Kind() == protoreflect.MessageKind:
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				redactMessage(list.Get(i).Message())
+			}
+		case fd.IsList():
+			return true
+		case fd.Kind() == protoreflect.MessageKind:
+			redactMessage(v.Message())
+		case sensitive && fd.Kind() == protoreflect.StringKind:
+			redacted[fd] = protoreflect.ValueOfString(Redact(v.String()))
+		case urlNames[fd.Name()] && fd.Kind() == protoreflect.StringKind:
+			redacted[fd] = protoreflect.ValueOfString(RedactURL(v.String()))
+		}
+		return true
+	})
+	for fd, v := range redacted {
+		m.Set(fd, v)
+	}
+}
@@ -0,0 +1,92 @@
+package secret_storage
+
+import (
+	"context"
+	"reflect"
+	"regexp"
+)
+
+var (
+	referenceRegexp = regexp.MustCompile(`\{\{\s*secret\s+"([^"]+)"\s*\}\}`)
+)
+
+// HasReference reports whether value contains {{secret "name"}}
+func HasReference(value string) bool {
+	return referenceRegexp.MatchString(value)
+}
+
+// Resolve replaces every {{secret "name"}} in value by the secret
+func Resolve(ctx context.Context, secrets SecretStorage, value string) (string, error) {
+	var resolveErr error
+	resolved := referenceRegexp.ReplaceAllStringFunc(value, func(reference string) string {
+		if resolveErr != nil {
+			return reference
+		}
+		name := referenceRegexp.FindStringSubmatch(reference)[1]
+		secret, err := secrets.Get(ctx, name)
+		if err != nil {
+			resolveErr = err
+			return reference
+		}
+		return secret
+	})
+	if resolveErr != nil {
+		return "", resolveErr
+	}
+	return resolved, nil
+}
+
+// ResolveStruct resolves secrets in all strings, string slices and string maps reachable from pointer v
+func ResolveStruct(ctx context.Context, secrets SecretStorage, v interface{}) error {
+	return resolveValue(ctx, secrets, reflect.ValueOf(v))
+}
+
+func resolveValue(ctx context.Context, secrets SecretStorage, v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return resolveValue(ctx, secrets, v.Elem())
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Field(i)
+			if !field.CanSet() {
+				continue
+			}
+			err := resolveValue(ctx, secrets, field)
+			if err != nil {
+				return err
+			}
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			err := resolveValue(ctx, secrets, v.Index(i))
+			if err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		if v.Type().Elem().Kind() != reflect.String {
+			return nil
+		}
+		iter := v.MapRange()
+		for iter.Next() {
+			resolved, err := Resolve(ctx, secrets, iter.Value().String())
+			if err != nil {
+				return err
+			}
+			v.SetMapIndex(iter.Key(), reflect.ValueOf(resolved).Convert(v.Type().Elem()))
+		}
+	case reflect.String:
+		if !v.CanSet() || !HasReference(v.String()) {
+			return nil
+		}
+		resolved, err := Resolve(ctx, secrets, v.String())
+		if err != nil {
+			return err
+		}
+		v.SetString(resolved)
+	}
+	return nil
+}
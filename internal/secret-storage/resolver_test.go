@@ -0,0 +1,62 @@
+package secret_storage
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type resolvable struct {
+	Url     string
+	Headers map[string]string
+	Nested  *resolvable
+	List    []*resolvable
+	private string
+}
+
+func TestResolve(t *testing.T) {
+	s, _ := newMemoryStorage(t)
+	assert.Equal(t, nil, s.Set(context.Background(), "token", "qwerty"))
+	t.Run("Should: replace references", func(t *testing.T) {
+		value, err := Resolve(context.Background(), s, `Bearer {{ secret "token" }}/{{secret "token"}}`)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, "Bearer qwerty/qwerty", value)
+	})
+	t.Run("Should: keep value without references", func(t *testing.T) {
+		value, err := Resolve(context.Background(), s, "plain")
+		assert.Equal(t, nil, err)
+		assert.Equal(t, "plain", value)
+	})
+	t.Run("Should: return error if secret not exist", func(t *testing.T) {
+		_, err := Resolve(context.Background(), s, `{{secret "missing"}}`)
+		assert.NotEqual(t, nil, err)
+	})
+}
+
+func TestResolveStruct(t *testing.T) {
+	s, _ := newMemoryStorage(t)
+	assert.Equal(t, nil, s.Set(context.Background(), "token", "qwerty"))
+	t.Run("Should: resolve nested values", func(t *testing.T) {
+		value := &resolvable{
+			Url:     `https://{{secret "token"}}@squzy.app`,
+			Headers: map[string]string{"Authorization": `{{secret "token"}}`},
+			Nested:  &resolvable{Url: `{{secret "token"}}`},
+			List:    []*resolvable{{Url: `{{secret "token"}}`}},
+			private: `{{secret "token"}}`,
+		}
+		assert.Equal(t, nil, ResolveStruct(context.Background(), s, value))
+		assert.Equal(t, "https://qwerty@squzy.app", value.Url)
+		assert.Equal(t, "qwerty", value.Headers["Authorization"])
+		assert.Equal(t, "qwerty", value.Nested.Url)
+		assert.Equal(t, "qwerty", value.List[0].Url)
+		assert.Equal(t, `{{secret "token"}}`, value.private)
+	})
+	t.Run("Should: return error if secret not exist", func(t *testing.T) {
+		assert.NotEqual(t, nil, ResolveStruct(context.Background(), s, &resolvable{
+			List: []*resolvable{{Url: `{{secret "missing"}}`}},
+		}))
+		assert.NotEqual(t, nil, ResolveStruct(context.Background(), s, &resolvable{
+			Headers: map[string]string{"Authorization": `{{secret "missing"}}`},
+		}))
+	})
+}
@@ -0,0 +1,120 @@
+package secret_storage
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"github.com/squzy/mongo_helper"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"io"
+	"time"
+)
+
+var (
+	errSecretNotValid = errors.New("SECRET_NOT_VALID")
+)
+
+// SecretStorage keeps values encrypted by AES-256-GCM, name of the secret is authenticated with the value
+type SecretStorage interface {
+	Set(ctx context.Context, name string, value string) error
+	Get(ctx context.Context, name string) (string, error)
+	Remove(ctx context.Context, name string) error
+	GetNames(ctx context.Context) ([]string, error)
+}
+
+type Secret struct {
+	Name      string    `bson:"_id"`
+	Value     string    `bson:"value"`
+	UpdatedAt time.Time `bson:"updatedAt"`
+}
+
+type storage struct {
+	connector mongo_helper.Connector
+	aead      cipher.AEAD
+}
+
+func (s *storage) Set(ctx context.Context, name string, value string) error {
+	nonce := make([]byte, s.aead.NonceSize())
+	_, err := io.ReadFull(rand.Reader, nonce)
+	if err != nil {
+		return err
+	}
+	sealed := s.aead.Seal(nonce, nonce, []byte(value), []byte(name))
+	_, err = s.connector.UpdateOne(ctx, bson.M{
+		"_id": name,
+	}, bson.M{
+		"$set": bson.M{
+			"value":     base64.StdEncoding.EncodeToString(sealed),
+			"updatedAt": time.Now(),
+		},
+	}, options.Update().SetUpsert(true))
+	return err
+}
+
+func (s *storage) Get(ctx context.Context, name string) (string, error) {
+	secret := &Secret{}
+	err := s.connector.FindOne(ctx, bson.M{
+		"_id": name,
+	}, secret)
+	if err != nil {
+		return "", err
+	}
+	sealed, err := base64.StdEncoding.DecodeString(secret.Value)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < s.aead.NonceSize() {
+		return "", errSecretNotValid
+	}
+	nonce := sealed[:s.aead.NonceSize()]
+	value, err := s.aead.Open(nil, nonce, sealed[s.aead.NonceSize():], []byte(name))
+	if err != nil {
+		return "", err
+	}
+	return string(value), nil
+}
+
+func (s *storage) Remove(ctx context.Context, name string) error {
+	_, err := s.connector.Delete(ctx, bson.M{
+		"_id": name,
+	})
+	return err
+}
+
+func (s *storage) GetNames(ctx context.Context) ([]string, error) {
+	secrets := []*Secret{}
+	err := s.connector.FindAll(ctx, bson.M{}, &secrets, options.Find().SetProjection(bson.M{
+		"value": 0,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(secrets))
+	for i, secret := range secrets {
+		names[i] = secret.Name
+	}
+	return names, nil
+}
+
+// New returns error if key is not 32 bytes long
+func New(connector mongo_helper.Connector, key []byte) (SecretStorage, error) {
+	if len(key) != keySize {
+		return nil, errKeyNotValid
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &storage{
+		connector: connector,
+		aead:      aead,
+	}, nil
+}
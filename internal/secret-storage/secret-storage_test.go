@@ -0,0 +1,173 @@
+package secret_storage
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"testing"
+)
+
+var (
+	basicError = errors.New("")
+	testKey    = []byte("0123456789abcdef0123456789abcdef")
+)
+
+type mockMemory struct {
+	values map[string]string
+}
+
+func (m *mockMemory) Delete(ctx context.Context, filter interface{}, opts ...*options.DeleteOptions) (*mongo.DeleteResult, error) {
+	delete(m.values, filter.(bson.M)["_id"].(string))
+	return nil, nil
+}
+
+func (m *mockMemory) InsertOne(ctx context.Context, document interface{}, opts ...*options.InsertOneOptions) (*mongo.InsertOneResult, error) {
+	panic("implement me")
+}
+
+func (m *mockMemory) FindOne(ctx context.Context, filter interface{}, structToDeserialize interface{}, opts ...*options.FindOneOptions) error {
+	name := filter.(bson.M)["_id"].(string)
+	value, ok := m.values[name]
+	if !ok {
+		return mongo.ErrNoDocuments
+	}
+	secret := structToDeserialize.(*Secret)
+	secret.Name = name
+	secret.Value = value
+	return nil
+}
+
+func (m *mockMemory) FindAll(ctx context.Context, predicate bson.M, structToDeserialize interface{}, opts ...*options.FindOptions) error {
+	secrets := structToDeserialize.(*[]*Secret)
+	for name := range m.values {
+		*secrets = append(*secrets, &Secret{Name: name})
+	}
+	return nil
+}
+
+func (m *mockMemory) UpdateOne(ctx context.Context, filter interface{}, update interface{}, opts ...*options.UpdateOptions) (*mongo.UpdateResult, error) {
+	m.values[filter.(bson.M)["_id"].(string)] = update.(bson.M)["$set"].(bson.M)["value"].(string)
+	return nil, nil
+}
+
+type mockError struct {
+}
+
+func (m mockError) Delete(ctx context.Context, filter interface{}, opts ...*options.DeleteOptions) (*mongo.DeleteResult, error) {
+	return nil, basicError
+}
+
+func (m mockError) InsertOne(ctx context.Context, document interface{}, opts ...*options.InsertOneOptions) (*mongo.InsertOneResult, error) {
+	return nil, basicError
+}
+
+func (m mockError) FindOne(ctx context.Context, filter interface{}, structToDeserialize interface{}, opts ...*options.FindOneOptions) error {
+	return basicError
+}
+
+func (m mockError) FindAll(ctx context.Context, predicate bson.M, structToDeserialize interface{}, opts ...*options.FindOptions) error {
+	return basicError
+}
+
+func (m mockError) UpdateOne(ctx context.Context, filter interface{}, update interface{}, opts ...*options.UpdateOptions) (*mongo.UpdateResult, error) {
+	return nil, basicError
+}
+
+func newMemoryStorage(t *testing.T) (SecretStorage, *mockMemory) {
+	connector := &mockMemory{values: map[string]string{}}
+	s, err := New(connector, testKey)
+	assert.Equal(t, nil, err)
+	return s, connector
+}
+
+func TestNew(t *testing.T) {
+	t.Run("Should: implement interface", func(t *testing.T) {
+		s, err := New(nil, testKey)
+		assert.Equal(t, nil, err)
+		assert.Implements(t, (*SecretStorage)(nil), s)
+	})
+	t.Run("Should: return error if key is not valid", func(t *testing.T) {
+		_, err := New(nil, []byte("short"))
+		assert.Equal(t, errKeyNotValid, err)
+	})
+}
+
+func TestStorage_Set(t *testing.T) {
+	t.Run("Should: store encrypted value", func(t *testing.T) {
+		s, connector := newMemoryStorage(t)
+		assert.Equal(t, nil, s.Set(context.Background(), "token", "qwerty"))
+		assert.NotContains(t, connector.values["token"], "qwerty")
+		value, err := s.Get(context.Background(), "token")
+		assert.Equal(t, nil, err)
+		assert.Equal(t, "qwerty", value)
+	})
+	t.Run("Should: return error", func(t *testing.T) {
+		s, _ := New(&mockError{}, testKey)
+		assert.NotEqual(t, nil, s.Set(context.Background(), "token", "qwerty"))
+	})
+}
+
+func TestStorage_Get(t *testing.T) {
+	t.Run("Should: return error if secret not exist", func(t *testing.T) {
+		s, _ := newMemoryStorage(t)
+		_, err := s.Get(context.Background(), "token")
+		assert.NotEqual(t, nil, err)
+	})
+	t.Run("Should: return error if value moved to another name", func(t *testing.T) {
+		s, connector := newMemoryStorage(t)
+		assert.Equal(t, nil, s.Set(context.Background(), "token", "qwerty"))
+		connector.values["other"] = connector.values["token"]
+		_, err := s.Get(context.Background(), "other")
+		assert.NotEqual(t, nil, err)
+	})
+	t.Run("Should: return error if value not base64", func(t *testing.T) {
+		s, connector := newMemoryStorage(t)
+		connector.values["token"] = "%%%"
+		_, err := s.Get(context.Background(), "token")
+		assert.NotEqual(t, nil, err)
+	})
+	t.Run("Should: return error if value too short", func(t *testing.T) {
+		s, connector := newMemoryStorage(t)
+		connector.values["token"] = "AAAA"
+		_, err := s.Get(context.Background(), "token")
+		assert.Equal(t, errSecretNotValid, err)
+	})
+	t.Run("Should: return error if key is different", func(t *testing.T) {
+		s, connector := newMemoryStorage(t)
+		assert.Equal(t, nil, s.Set(context.Background(), "token", "qwerty"))
+		other, _ := New(connector, []byte("abcdef0123456789abcdef0123456789"))
+		_, err := other.Get(context.Background(), "token")
+		assert.NotEqual(t, nil, err)
+	})
+}
+
+func TestStorage_Remove(t *testing.T) {
+	t.Run("Should: remove secret", func(t *testing.T) {
+		s, connector := newMemoryStorage(t)
+		assert.Equal(t, nil, s.Set(context.Background(), "token", "qwerty"))
+		assert.Equal(t, nil, s.Remove(context.Background(), "token"))
+		assert.Equal(t, 0, len(connector.values))
+	})
+	t.Run("Should: return error", func(t *testing.T) {
+		s, _ := New(&mockError{}, testKey)
+		assert.NotEqual(t, nil, s.Remove(context.Background(), "token"))
+	})
+}
+
+func TestStorage_GetNames(t *testing.T) {
+	t.Run("Should: return names", func(t *testing.T) {
+		s, _ := newMemoryStorage(t)
+		assert.Equal(t, nil, s.Set(context.Background(), "token", "qwerty"))
+		names, err := s.GetNames(context.Background())
+		assert.Equal(t, nil, err)
+		assert.Equal(t, []string{"token"}, names)
+	})
+	t.Run("Should: return error", func(t *testing.T) {
+		s, _ := New(&mockError{}, testKey)
+		_, err := s.GetNames(context.Background())
+		assert.NotEqual(t, nil, err)
+	})
+}
@@ -1,39 +1,80 @@
 package sitemap_storage
 
 import (
-	"net/http"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"github.com/squzy/squzy/internal/httptools"
+	"github.com/squzy/squzy/internal/logger"
 	"github.com/squzy/squzy/internal/parsers"
+	"golang.org/x/sync/singleflight"
+	"net/http"
 	"sync"
 	"time"
 )
 
 type SiteMapStorage interface {
-	Get(url string) (*parsers.SiteMap, error)
+	// Sitemap is downloaded with client of the options, cached sitemap is shared only by the same url and options
+	Get(url string, options *httptools.ClientOptions) (*parsers.SiteMap, error)
 }
 
 type storage struct {
-	httpTools     httptools.HTTPTool
-	duration      time.Duration
-	kv            map[string]*StorageItem
-	mutex         sync.RWMutex
+	httpTools  httptools.HTTPTool
+	duration   time.Duration
+	maxEntries int
+	kv         map[string]*list.Element
+	// Most recently used items are in the front
+	lru           *list.List
+	mutex         sync.Mutex
+	group         singleflight.Group
 	siteMapParser parsers.SiteMapParser
 }
 
 type StorageItem struct {
+	key      string
 	deadline time.Time
 	siteMap  *parsers.SiteMap
 }
 
-func (s *storage) Get(url string) (*parsers.SiteMap, error) {
-	s.mutex.Lock()
-	defer s.mutex.Unlock()
-	value, exist := s.kv[url]
-	if exist && time.Now().Before(value.deadline) {
-		return value.siteMap, nil
+// Get downloads sitemap only once for all concurrent calls with the same url and options,
+// expired sitemap is returned if it could not be refreshed
+func (s *storage) Get(url string, options *httptools.ClientOptions) (*parsers.SiteMap, error) {
+	key := cacheKey(url, options)
+	item, exist := s.lookup(key)
+	if exist && time.Now().Before(item.deadline) {
+		return item.siteMap, nil
+	}
+	value, err, _ := s.group.Do(key, func() (interface{}, error) {
+		return s.load(key, url, options)
+	})
+	if err != nil {
+		if exist {
+			logger.Errorf("Could not refresh sitemap %s, expired one is used: %s", url, err.Error())
+			return item.siteMap, nil
+		}
+		return nil, err
+	}
+	return value.(*parsers.SiteMap), nil
+}
+
+// Sitemap could differ for clients with other proxy or certificates, options are hashed because they contain secrets
+func cacheKey(url string, options *httptools.ClientOptions) string {
+	if options == nil {
+		return url
+	}
+	data, _ := json.Marshal(options)
+	sum := sha256.Sum256(data)
+	return url + "#" + hex.EncodeToString(sum[:])
+}
+
+func (s *storage) load(key string, url string, options *httptools.ClientOptions) (*parsers.SiteMap, error) {
+	client, err := s.httpTools.WithOptions(options)
+	if err != nil {
+		return nil, err
 	}
-	req := s.httpTools.CreateRequest(http.MethodGet, url, nil, "")
-	_, resp, err := s.httpTools.SendRequestWithStatusCode(req, http.StatusOK)
+	req := client.CreateRequest(http.MethodGet, url, nil, "")
+	_, resp, err := client.SendRequestWithStatusCode(req, http.StatusOK)
 	if err != nil {
 		return nil, err
 	}
@@ -41,17 +82,49 @@ func (s *storage) Get(url string) (*parsers.SiteMap, error) {
 	if err != nil {
 		return nil, err
 	}
-	s.kv[url] = &StorageItem{
+	s.set(key, siteMap)
+	return siteMap, nil
+}
+
+func (s *storage) lookup(key string) (*StorageItem, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	element, exist := s.kv[key]
+	if !exist {
+		return nil, false
+	}
+	s.lru.MoveToFront(element)
+	return element.Value.(*StorageItem), true
+}
+
+func (s *storage) set(key string, siteMap *parsers.SiteMap) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	item := &StorageItem{
+		key:      key,
 		deadline: time.Now().Add(s.duration),
 		siteMap:  siteMap,
 	}
-	return siteMap, nil
+	if element, exist := s.kv[key]; exist {
+		element.Value = item
+		s.lru.MoveToFront(element)
+		return
+	}
+	s.kv[key] = s.lru.PushFront(item)
+	for s.lru.Len() > s.maxEntries {
+		oldest := s.lru.Back()
+		s.lru.Remove(oldest)
+		delete(s.kv, oldest.Value.(*StorageItem).key)
+	}
 }
 
-func New(duration time.Duration, httpTools httptools.HTTPTool, siteMapParser parsers.SiteMapParser) SiteMapStorage {
+// New returns storage which keeps sitemaps during duration, when there are more than maxEntries sitemaps least recently used is removed
+func New(duration time.Duration, maxEntries int, httpTools httptools.HTTPTool, siteMapParser parsers.SiteMapParser) SiteMapStorage {
 	return &storage{
 		duration:      duration,
-		kv:            make(map[string]*StorageItem),
+		maxEntries:    maxEntries,
+		kv:            make(map[string]*list.Element),
+		lru:           list.New(),
 		httpTools:     httpTools,
 		siteMapParser: siteMapParser,
 	}
@@ -2,8 +2,10 @@ package sitemap_storage
 
 import (
 	"errors"
+	"sync"
 	"github.com/stretchr/testify/assert"
 	"net/http"
+	"github.com/squzy/squzy/internal/httptools"
 	"github.com/squzy/squzy/internal/parsers"
 	"testing"
 	"time"
@@ -32,6 +34,14 @@ func (m mockHttp) CreateRequest(method string, url string, headers *map[string]s
 	return nil
 }
 
+func (m mockHttp) WithOptions(options *httptools.ClientOptions) (httptools.HTTPTool, error) {
+	return m, nil
+}
+
+func (m mockHttp) WithAuth(auth *httptools.AuthOptions) httptools.HTTPTool {
+	return m
+}
+
 func (m mockHttp) SendRequest(req *http.Request) (int, []byte, error) {
 	return 200, nil, nil
 }
@@ -77,6 +87,14 @@ func (m mockHttpError) CreateRequest(method string, url string, headers *map[str
 	return nil
 }
 
+func (m mockHttpError) WithOptions(options *httptools.ClientOptions) (httptools.HTTPTool, error) {
+	return m, nil
+}
+
+func (m mockHttpError) WithAuth(auth *httptools.AuthOptions) httptools.HTTPTool {
+	return m
+}
+
 func (m mockHttpError) SendRequest(req *http.Request) (int, []byte, error) {
 	return 0, nil, errors.New("ascss")
 }
@@ -85,36 +103,172 @@ func (m mockHttpError) SendRequestWithStatusCode(req *http.Request, expectedCode
 	return 0, nil, errors.New("ascss")
 }
 
+type mockHttpCounter struct {
+	mu    sync.Mutex
+	calls map[string]int
+	fail  bool
+	delay time.Duration
+}
+
+func (m *mockHttpCounter) SendRequestTimeoutStatusCode(req *http.Request, timeout time.Duration, expectedCode int) (int, []byte, error) {
+	panic("implement me")
+}
+
+func (m *mockHttpCounter) SendRequestTimeout(req *http.Request, timeout time.Duration) (int, []byte, error) {
+	panic("implement me")
+}
+
+func (m *mockHttpCounter) GetWithRedirectsWithStatusCode(url string, expectedCode int) (int, []byte, error) {
+	panic("implement me")
+}
+
+func (m *mockHttpCounter) GetWithRedirects(url string) (int, []byte, error) {
+	panic("implement me")
+}
+
+func (m *mockHttpCounter) CreateRequest(method string, url string, headers *map[string]string, log string) *http.Request {
+	req, _ := http.NewRequest(method, url, nil)
+	return req
+}
+
+func (m *mockHttpCounter) WithOptions(options *httptools.ClientOptions) (httptools.HTTPTool, error) {
+	return m, nil
+}
+
+func (m *mockHttpCounter) WithAuth(auth *httptools.AuthOptions) httptools.HTTPTool {
+	return m
+}
+
+func (m *mockHttpCounter) SendRequest(req *http.Request) (int, []byte, error) {
+	panic("implement me")
+}
+
+func (m *mockHttpCounter) SendRequestWithStatusCode(req *http.Request, expectedCode int) (int, []byte, error) {
+	time.Sleep(m.delay)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls[req.URL.String()]++
+	if m.fail {
+		return 0, nil, errors.New("ascss")
+	}
+	return 200, nil, nil
+}
+
+func (m *mockHttpCounter) getCalls(url string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.calls[url]
+}
+
+func (m *mockHttpCounter) setFail(fail bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.fail = fail
+}
+
 func TestNew(t *testing.T) {
 	t.Run("Shoudle implement interface", func(t *testing.T) {
-		s := New(time.Second, &mockHttp{}, &mockSiteMapParser{})
+		s := New(time.Second, 10, &mockHttp{}, &mockSiteMapParser{})
 		assert.Implements(t, (*SiteMapStorage)(nil), s)
 	})
 }
 
 func TestStorage_Get(t *testing.T) {
 	t.Run("Should: return error because httpError", func(t *testing.T) {
-		s := New(time.Second, &mockHttpError{}, &mockSiteMapParser{})
-		_, err := s.Get("evrerver")
+		s := New(time.Second, 10, &mockHttpError{}, &mockSiteMapParser{})
+		_, err := s.Get("evrerver", nil)
 		assert.NotEqual(t, nil, err)
 	})
 	t.Run("Should: return error because parseError", func(t *testing.T) {
-		s := New(time.Second, &mockHttp{}, &mockSiteMapParserError{})
-		_, err := s.Get("evrerver")
+		s := New(time.Second, 10, &mockHttp{}, &mockSiteMapParserError{})
+		_, err := s.Get("evrerver", nil)
 		assert.NotEqual(t, nil, err)
 	})
 	t.Run("Should: return sitemap", func(t *testing.T) {
-		s := New(time.Second, &mockHttp{}, &mockSiteMapParser{})
-		sm, err := s.Get("evrerver")
+		s := New(time.Second, 10, &mockHttp{}, &mockSiteMapParser{})
+		sm, err := s.Get("evrerver", nil)
 		assert.Equal(t, nil, err)
 		assert.NotEqual(t, sm, err)
 	})
 	t.Run("Should: return from cache", func(t *testing.T) {
-		s := New(time.Minute, &mockHttp{}, &mockSiteMapParser{})
-		sm, err := s.Get("evrerver")
+		s := New(time.Minute, 10, &mockHttp{}, &mockSiteMapParser{})
+		sm, err := s.Get("evrerver", nil)
 		assert.Equal(t, nil, err)
 		assert.NotEqual(t, sm, err)
-		sm2, _ := s.Get("evrerver")
+		sm2, _ := s.Get("evrerver", nil)
 		assert.Equal(t, sm, sm2)
 	})
 }
+
+func TestStorage_GetConcurrent(t *testing.T) {
+	t.Run("Should: download sitemap once for concurrent calls", func(t *testing.T) {
+		httpMock := &mockHttpCounter{calls: map[string]int{}, delay: time.Millisecond * 50}
+		s := New(time.Minute, 10, httpMock, &mockSiteMapParser{})
+		wg := sync.WaitGroup{}
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := s.Get("http://a", nil)
+				assert.Equal(t, nil, err)
+			}()
+		}
+		wg.Wait()
+		assert.Equal(t, 1, httpMock.getCalls("http://a"))
+	})
+}
+
+func TestStorage_GetEviction(t *testing.T) {
+	t.Run("Should: remove least recently used sitemap", func(t *testing.T) {
+		httpMock := &mockHttpCounter{calls: map[string]int{}}
+		s := New(time.Minute, 2, httpMock, &mockSiteMapParser{})
+		_, _ = s.Get("http://a", nil)
+		_, _ = s.Get("http://b", nil)
+		_, _ = s.Get("http://a", nil)
+		_, _ = s.Get("http://c", nil)
+		_, _ = s.Get("http://a", nil)
+		assert.Equal(t, 1, httpMock.getCalls("http://a"))
+		_, _ = s.Get("http://b", nil)
+		assert.Equal(t, 2, httpMock.getCalls("http://b"))
+	})
+	t.Run("Should: download expired sitemap again", func(t *testing.T) {
+		httpMock := &mockHttpCounter{calls: map[string]int{}}
+		s := New(time.Millisecond, 2, httpMock, &mockSiteMapParser{})
+		_, _ = s.Get("http://a", nil)
+		time.Sleep(time.Millisecond * 5)
+		_, _ = s.Get("http://a", nil)
+		assert.Equal(t, 2, httpMock.getCalls("http://a"))
+	})
+}
+
+func TestStorage_GetStale(t *testing.T) {
+	t.Run("Should: return expired sitemap because refresh failed", func(t *testing.T) {
+		httpMock := &mockHttpCounter{calls: map[string]int{}}
+		s := New(time.Millisecond, 2, httpMock, &mockSiteMapParser{})
+		sm, err := s.Get("http://a", nil)
+		assert.Equal(t, nil, err)
+		time.Sleep(time.Millisecond * 5)
+		httpMock.setFail(true)
+		stale, err := s.Get("http://a", nil)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, sm, stale)
+		assert.Equal(t, 2, httpMock.getCalls("http://a"))
+	})
+}
+
+func TestStorage_GetOptions(t *testing.T) {
+	t.Run("Should: download sitemap again for other client options", func(t *testing.T) {
+		httpMock := &mockHttpCounter{calls: map[string]int{}}
+		s := New(time.Minute, 10, httpMock, &mockSiteMapParser{})
+		_, _ = s.Get("http://a", nil)
+		_, _ = s.Get("http://a", &httptools.ClientOptions{ProxyURL: "http://proxy"})
+		_, _ = s.Get("http://a", &httptools.ClientOptions{ProxyURL: "http://proxy"})
+		_, _ = s.Get("http://a", &httptools.ClientOptions{ProxyURL: "http://other"})
+		assert.Equal(t, 3, httpMock.getCalls("http://a"))
+	})
+	t.Run("Should: not keep secrets of options in key", func(t *testing.T) {
+		key := cacheKey("http://a", &httptools.ClientOptions{ClientKey: "secret"})
+		assert.NotContains(t, key, "secret")
+		assert.NotEqual(t, key, cacheKey("http://a", &httptools.ClientOptions{ClientKey: "other"}))
+	})
+}
@@ -0,0 +1,98 @@
+package storage
+
+import (
+	"github.com/squzy/squzy/internal/job"
+	"github.com/squzy/squzy/internal/logger"
+	"sync"
+	"time"
+)
+
+// BatchStorage collects logs and sends them to the target when batch is full or flush interval is passed
+type BatchStorage interface {
+	Storage
+	Close() error
+}
+
+type batchStorage struct {
+	mu     sync.Mutex
+	target BatchWriter
+	size   int
+	logs   []job.CheckError
+	closed bool
+	done   chan struct{}
+}
+
+func NewBatchStorage(target BatchWriter, size int, interval time.Duration) BatchStorage {
+	s := &batchStorage{
+		target: target,
+		size:   size,
+		logs:   make([]job.CheckError, 0, size),
+		done:   make(chan struct{}),
+	}
+	go s.flushLoop(interval)
+	return s
+}
+
+func (s *batchStorage) Write(log job.CheckError) error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return s.target.WriteBatch([]job.CheckError{log})
+	}
+	s.logs = append(s.logs, log)
+	if len(s.logs) < s.size {
+		s.mu.Unlock()
+		return nil
+	}
+	logs := s.take()
+	s.mu.Unlock()
+	return s.flush(logs)
+}
+
+// Close sends logs which are left in the batch
+func (s *batchStorage) Close() error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil
+	}
+	s.closed = true
+	close(s.done)
+	logs := s.take()
+	s.mu.Unlock()
+	return s.flush(logs)
+}
+
+func (s *batchStorage) flushLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+			s.mu.Lock()
+			logs := s.take()
+			s.mu.Unlock()
+			_ = s.flush(logs)
+		}
+	}
+}
+
+// Should be called under lock
+func (s *batchStorage) take() []job.CheckError {
+	logs := s.logs
+	s.logs = make([]job.CheckError, 0, s.size)
+	return logs
+}
+
+func (s *batchStorage) flush(logs []job.CheckError) error {
+	if len(logs) == 0 {
+		return nil
+	}
+	err := s.target.WriteBatch(logs)
+	if err != nil {
+		logger.Errorf("Could not send batch of %d snapshots: %s", len(logs), err.Error())
+	}
+	return err
+}
@@ -0,0 +1,88 @@
+package storage
+
+import (
+	"errors"
+	"github.com/squzy/squzy/internal/job"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+	"time"
+)
+
+type batchTargetMock struct {
+	mu      sync.Mutex
+	fail    bool
+	batches [][]string
+}
+
+func (m *batchTargetMock) WriteBatch(logs []job.CheckError) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.fail {
+		return errors.New("error")
+	}
+	ids := make([]string, len(logs))
+	for i, log := range logs {
+		ids[i] = log.GetLogData().SchedulerId
+	}
+	m.batches = append(m.batches, ids)
+	return nil
+}
+
+func (m *batchTargetMock) getBatches() [][]string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.batches
+}
+
+func TestNewBatchStorage(t *testing.T) {
+	t.Run("Should: create batch storage", func(t *testing.T) {
+		s := NewBatchStorage(&batchTargetMock{}, 10, time.Hour)
+		assert.Implements(t, (*Storage)(nil), s)
+		assert.Equal(t, nil, s.Close())
+	})
+}
+
+func TestBatchStorage_Write(t *testing.T) {
+	t.Run("Should: send batch when it is full", func(t *testing.T) {
+		target := &batchTargetMock{}
+		s := NewBatchStorage(target, 2, time.Hour)
+		defer s.Close()
+		assert.Equal(t, nil, s.Write(&queueLogMock{id: "1"}))
+		assert.Len(t, target.getBatches(), 0)
+		assert.Equal(t, nil, s.Write(&queueLogMock{id: "2"}))
+		assert.Equal(t, [][]string{{"1", "2"}}, target.getBatches())
+	})
+	t.Run("Should: send batch by interval", func(t *testing.T) {
+		target := &batchTargetMock{}
+		s := NewBatchStorage(target, 10, time.Millisecond*10)
+		defer s.Close()
+		assert.Equal(t, nil, s.Write(&queueLogMock{id: "1"}))
+		assert.Eventually(t, func() bool {
+			return len(target.getBatches()) == 1
+		}, time.Second, time.Millisecond*10)
+	})
+	t.Run("Should: return error of the target", func(t *testing.T) {
+		s := NewBatchStorage(&batchTargetMock{fail: true}, 1, time.Hour)
+		defer s.Close()
+		assert.Error(t, s.Write(&queueLogMock{id: "1"}))
+	})
+	t.Run("Should: write directly after close", func(t *testing.T) {
+		target := &batchTargetMock{}
+		s := NewBatchStorage(target, 10, time.Hour)
+		assert.Equal(t, nil, s.Close())
+		assert.Equal(t, nil, s.Write(&queueLogMock{id: "1"}))
+		assert.Equal(t, [][]string{{"1"}}, target.getBatches())
+	})
+}
+
+func TestBatchStorage_Close(t *testing.T) {
+	t.Run("Should: send left logs", func(t *testing.T) {
+		target := &batchTargetMock{}
+		s := NewBatchStorage(target, 10, time.Hour)
+		assert.Equal(t, nil, s.Write(&queueLogMock{id: "1"}))
+		assert.Equal(t, nil, s.Close())
+		assert.Equal(t, nil, s.Close())
+		assert.Equal(t, [][]string{{"1"}}, target.getBatches())
+	})
+}
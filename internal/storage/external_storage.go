@@ -6,18 +6,34 @@ import (
 	"fmt"
 	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/status"
 	"github.com/squzy/squzy/internal/grpctools"
 	"github.com/squzy/squzy/internal/job"
 	"github.com/squzy/squzy/internal/logger"
+	"sync"
 	"time"
 )
 
 type externalStorage struct {
+	mu       sync.RWMutex
+	conn     *grpc.ClientConn
 	client   apiPb.StorageClient
 	fallback Storage
 	address  string
 }
 
+// BatchWriter is implemented by storages which could save several logs in one request
+type BatchWriter interface {
+	WriteBatch(logs []job.CheckError) error
+}
+
+// StateReporter is implemented by storages which write to the remote service
+type StateReporter interface {
+	State() connectivity.State
+}
+
 const (
 	loggerConnTimeout = time.Second * 5
 )
@@ -25,32 +41,157 @@ const (
 var (
 	errConnectionExternalStorageError = errors.New("CANT_CONNECT_TO_EXTERNAL_STORAGE")
 	errStorageNotSaveLog              = errors.New("EXTERNAL_STORAGE_NOT_SAVE_LOG")
+
+	reconnectMinInterval = time.Second
+	reconnectMaxInterval = time.Minute
 )
 
+// NewExternalStorage returns fallback only when address is empty, otherwise connection
+// is retried in background and logs are written to fallback until storage is available
 func NewExternalStorage(grpcTools grpctools.GrpcTool, address string, timeout time.Duration, fallBack Storage, options ...grpc.DialOption) Storage {
-	conn, err := grpcTools.GetConnection(address, timeout, options...)
-	if err != nil {
+	if address == "" {
 		logger.Info("Will wrote to in memory storage")
 		return fallBack
 	}
-	logger.Info(fmt.Sprintf("Will send log to client %s", address))
-	return &externalStorage{
-		client:   apiPb.NewStorageClient(conn),
+	s := &externalStorage{
 		fallback: fallBack,
 		address:  address,
 	}
+	conn, err := grpcTools.GetConnection(address, timeout, options...)
+	if err != nil {
+		logger.Errorf("Could not connect to storage %s, will wrote to in memory storage until it is available", address)
+		go s.reconnect(grpcTools, timeout, options...)
+		return s
+	}
+	s.setConnection(conn)
+	return s
+}
+
+func (s *externalStorage) reconnect(grpcTools grpctools.GrpcTool, timeout time.Duration, options ...grpc.DialOption) {
+	interval := reconnectMinInterval
+	for {
+		time.Sleep(interval)
+		conn, err := grpcTools.GetConnection(s.address, timeout, options...)
+		if err == nil {
+			s.setConnection(conn)
+			return
+		}
+		logger.Errorf("Could not connect to storage %s, retry in %s", s.address, interval)
+		interval *= 2
+		if interval > reconnectMaxInterval {
+			interval = reconnectMaxInterval
+		}
+	}
+}
+
+func (s *externalStorage) setConnection(conn *grpc.ClientConn) {
+	s.mu.Lock()
+	s.conn = conn
+	s.client = apiPb.NewStorageClient(conn)
+	s.mu.Unlock()
+	logger.Info(fmt.Sprintf("Will send log to client %s", s.address))
+	go s.watchState(conn)
+}
+
+// Every transition is logged, so it is visible when storage was not available
+func (s *externalStorage) watchState(conn *grpc.ClientConn) {
+	state := conn.GetState()
+	for state != connectivity.Shutdown {
+		if !conn.WaitForStateChange(context.Background(), state) {
+			return
+		}
+		next := conn.GetState()
+		if next == connectivity.TransientFailure {
+			logger.Errorf("Connection to storage %s changed state from %s to %s", s.address, state, next)
+		} else {
+			logger.Infof("Connection to storage %s changed state from %s to %s", s.address, state, next)
+		}
+		state = next
+	}
+}
+
+func (s *externalStorage) getClient() apiPb.StorageClient {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.client
+}
+
+// State of the connection, it is CONNECTING until first successful connection
+func (s *externalStorage) State() connectivity.State {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.conn == nil {
+		return connectivity.Connecting
+	}
+	return s.conn.GetState()
 }
 
 func (s *externalStorage) Write(checkerLog job.CheckError) error {
+	client := s.getClient()
+	if client == nil {
+		if s.fallback != nil {
+			_ = s.fallback.Write(checkerLog)
+		}
+		return errConnectionExternalStorageError
+	}
 	req := checkerLog.GetLogData()
 	ctx, cancel := context.WithTimeout(context.Background(), loggerConnTimeout)
 	defer cancel()
-	_, err := s.client.SaveResponseFromScheduler(ctx, req)
+	_, err := client.SaveResponseFromScheduler(ctx, req)
 	if err != nil {
 		if s.fallback != nil {
 			_ = s.fallback.Write(checkerLog)
 		}
+		return getWriteError(err)
+	}
+	return nil
+}
+
+// WriteBatch saves all logs in one request, on failure every log is written to fallback
+func (s *externalStorage) WriteBatch(logs []job.CheckError) error {
+	client := s.getClient()
+	if client == nil {
+		s.writeFallback(logs)
 		return errConnectionExternalStorageError
 	}
+	req := &apiPb.SchedulerResponseBatch{
+		Responses: make([]*apiPb.SchedulerResponse, len(logs)),
+	}
+	for i, checkerLog := range logs {
+		req.Responses[i] = checkerLog.GetLogData()
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), loggerConnTimeout)
+	defer cancel()
+	_, err := client.SaveResponsesFromScheduler(ctx, req)
+	if err != nil {
+		s.writeFallback(logs)
+		return getWriteError(err)
+	}
 	return nil
 }
+
+// Storage which is not available is told apart from storage which rejected logs, only the first one is worth retrying
+func getWriteError(err error) error {
+	if isTransientError(err) {
+		return errConnectionExternalStorageError
+	}
+	return errStorageNotSaveLog
+}
+
+// Storage is not available, so write could be retried without limit
+func isTransientError(err error) bool {
+	if err == errConnectionExternalStorageError {
+		return true
+	}
+	code := status.Code(err)
+	return code == codes.Unavailable || code == codes.DeadlineExceeded
+}
+
+func (s *externalStorage) writeFallback(logs []job.CheckError) {
+	if s.fallback == nil {
+		return
+	}
+	for _, checkerLog := range logs {
+		_ = s.fallback.Write(checkerLog)
+	}
+}
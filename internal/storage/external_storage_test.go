@@ -9,8 +9,12 @@ import (
 	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/status"
 	empty "google.golang.org/protobuf/types/known/emptypb"
 	"net"
+	"sync"
 	"testing"
 	"time"
 )
@@ -42,6 +46,22 @@ func (s server) GetSchedulerUptime(ctx context.Context, request *apiPb.GetSchedu
 	panic("implement me")
 }
 
+func (s server) GetSchedulerUptimeSeries(ctx context.Context, request *apiPb.GetSchedulerUptimeSeriesRequest) (*apiPb.GetSchedulerUptimeSeriesResponse, error) {
+	panic("implement me")
+}
+
+func (s server) GetSchedulerOutages(ctx context.Context, request *apiPb.GetSchedulerOutagesRequest) (*apiPb.GetSchedulerOutagesResponse, error) {
+	panic("implement me")
+}
+
+func (s server) GetAgentSeries(ctx context.Context, request *apiPb.GetAgentSeriesRequest) (*apiPb.GetAgentSeriesResponse, error) {
+	panic("implement me")
+}
+
+func (s server) Subscribe(request *apiPb.SubscribeRequest, srv apiPb.Storage_SubscribeServer) error {
+	panic("implement me")
+}
+
 func (s server) SaveTransaction(ctx context.Context, info *apiPb.TransactionInfo) (*empty.Empty, error) {
 	panic("implement me")
 }
@@ -58,6 +78,10 @@ func (s server) GetTransactionById(ctx context.Context, request *apiPb.GetTransa
 	panic("implement me")
 }
 
+func (s server) GetTransactionTree(ctx context.Context, request *apiPb.GetTransactionByIdRequest) (*apiPb.GetTransactionTreeResponse, error) {
+	panic("implement me")
+}
+
 func (s server) GetSchedulerInformation(ctx context.Context, request *apiPb.GetSchedulerInformationRequest) (*apiPb.GetSchedulerInformationResponse, error) {
 	panic("implement me")
 }
@@ -97,6 +121,22 @@ func (s serverErrorThrow) GetSchedulerUptime(ctx context.Context, request *apiPb
 	panic("implement me")
 }
 
+func (s serverErrorThrow) GetSchedulerUptimeSeries(ctx context.Context, request *apiPb.GetSchedulerUptimeSeriesRequest) (*apiPb.GetSchedulerUptimeSeriesResponse, error) {
+	panic("implement me")
+}
+
+func (s serverErrorThrow) GetSchedulerOutages(ctx context.Context, request *apiPb.GetSchedulerOutagesRequest) (*apiPb.GetSchedulerOutagesResponse, error) {
+	panic("implement me")
+}
+
+func (s serverErrorThrow) GetAgentSeries(ctx context.Context, request *apiPb.GetAgentSeriesRequest) (*apiPb.GetAgentSeriesResponse, error) {
+	panic("implement me")
+}
+
+func (s serverErrorThrow) Subscribe(request *apiPb.SubscribeRequest, srv apiPb.Storage_SubscribeServer) error {
+	panic("implement me")
+}
+
 func (s serverErrorThrow) SaveTransaction(ctx context.Context, info *apiPb.TransactionInfo) (*empty.Empty, error) {
 	panic("implement me")
 }
@@ -113,6 +153,10 @@ func (s serverErrorThrow) GetTransactionById(ctx context.Context, request *apiPb
 	panic("implement me")
 }
 
+func (s serverErrorThrow) GetTransactionTree(ctx context.Context, request *apiPb.GetTransactionByIdRequest) (*apiPb.GetTransactionTreeResponse, error) {
+	panic("implement me")
+}
+
 func (s serverErrorThrow) GetSchedulerInformation(ctx context.Context, request *apiPb.GetSchedulerInformationRequest) (*apiPb.GetSchedulerInformationResponse, error) {
 	panic("implement me")
 }
@@ -125,6 +169,10 @@ func (s serverErrorThrow) SaveResponseFromScheduler(context.Context, *apiPb.Sche
 	return nil, errors.New("saf")
 }
 
+func (s serverErrorThrow) SaveResponsesFromScheduler(context.Context, *apiPb.SchedulerResponseBatch) (*empty.Empty, error) {
+	return nil, errors.New("saf")
+}
+
 func (s serverErrorThrow) SaveResponseFromAgent(context.Context, *apiPb.Metric) (*empty.Empty, error) {
 	panic("implement me")
 }
@@ -133,6 +181,10 @@ func (s server) SaveResponseFromScheduler(context.Context, *apiPb.SchedulerRespo
 	return &empty.Empty{}, nil
 }
 
+func (s server) SaveResponsesFromScheduler(context.Context, *apiPb.SchedulerResponseBatch) (*empty.Empty, error) {
+	return &empty.Empty{}, nil
+}
+
 type mockStorage struct {
 }
 
@@ -161,6 +213,21 @@ func (g grpcMockError) GetConnection(address string, timeout time.Duration, opti
 	return nil, errors.New("error")
 }
 
+type grpcMockFlaky struct {
+	mu       sync.Mutex
+	failures int
+}
+
+func (g *grpcMockFlaky) GetConnection(address string, timeout time.Duration, option ...grpc.DialOption) (*grpc.ClientConn, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.failures > 0 {
+		g.failures--
+		return nil, errors.New("error")
+	}
+	return grpctools.New().GetConnection(address, timeout, option...)
+}
+
 type mock struct {
 }
 
@@ -196,7 +263,7 @@ func TestExternalStorage_Write(t *testing.T) {
 		s := NewExternalStorage(grpctools.New(), "localhost:12122", time.Second*2, &mockStorage{}, grpc.WithInsecure(), grpc.WithBlock())
 		assert.Equal(t, nil, s.Write(&mock{}))
 	})
-	t.Run("Should: return error connection error on write real storage", func(t *testing.T) {
+	t.Run("Should: return errStorageNotSaveLog because real storage rejected log", func(t *testing.T) {
 		lis, _ := net.Listen("tcp", fmt.Sprintf(":%d", 12124))
 		grpcServer := grpc.NewServer()
 		apiPb.RegisterStorageServer(grpcServer, &serverErrorThrow{})
@@ -205,6 +272,79 @@ func TestExternalStorage_Write(t *testing.T) {
 		}()
 		time.Sleep(time.Second * 2)
 		s := NewExternalStorage(grpctools.New(), "localhost:12124", time.Second*2, &mockStorage{}, grpc.WithInsecure(), grpc.WithBlock())
+		assert.Equal(t, errStorageNotSaveLog, s.Write(&mock{}))
+	})
+}
+
+func TestExternalStorage_Reconnect(t *testing.T) {
+	t.Run("Should: write to fallback until storage is available", func(t *testing.T) {
+		reconnectMinInterval = time.Millisecond * 10
+		defer func() {
+			reconnectMinInterval = time.Second
+		}()
+		lis, _ := net.Listen("tcp", fmt.Sprintf(":%d", 12126))
+		grpcServer := grpc.NewServer()
+		apiPb.RegisterStorageServer(grpcServer, &server{})
+		go func() {
+			_ = grpcServer.Serve(lis)
+		}()
+		defer grpcServer.Stop()
+
+		grpcTool := &grpcMockFlaky{failures: 2}
+		s := NewExternalStorage(grpcTool, "localhost:12126", time.Second*2, &mockStorage{}, grpc.WithInsecure(), grpc.WithBlock())
 		assert.Equal(t, errConnectionExternalStorageError, s.Write(&mock{}))
+		assert.Equal(t, connectivity.Connecting, s.(StateReporter).State())
+
+		assert.Eventually(t, func() bool {
+			return s.Write(&mock{}) == nil
+		}, time.Second*5, time.Millisecond*50)
+		assert.Equal(t, connectivity.Ready, s.(StateReporter).State())
+	})
+}
+
+func TestExternalStorage_WriteBatch(t *testing.T) {
+	t.Run("Should: write to fallback because not connected", func(t *testing.T) {
+		fallback := &queueTargetMock{}
+		s := &externalStorage{
+			fallback: fallback,
+		}
+		assert.Equal(t, errConnectionExternalStorageError, s.WriteBatch([]job.CheckError{&mock{}, &mock{}}))
+		assert.Len(t, fallback.written, 2)
+	})
+	t.Run("Should: not return error on write real storage", func(t *testing.T) {
+		lis, _ := net.Listen("tcp", fmt.Sprintf(":%d", 12128))
+		grpcServer := grpc.NewServer()
+		apiPb.RegisterStorageServer(grpcServer, &server{})
+		go func() {
+			_ = grpcServer.Serve(lis)
+		}()
+		defer grpcServer.Stop()
+		s := NewExternalStorage(grpctools.New(), "localhost:12128", time.Second*2, &mockStorage{}, grpc.WithInsecure(), grpc.WithBlock())
+		assert.Equal(t, nil, s.(BatchWriter).WriteBatch([]job.CheckError{&mock{}, &mock{}}))
+	})
+	t.Run("Should: write to fallback on error of real storage", func(t *testing.T) {
+		lis, _ := net.Listen("tcp", fmt.Sprintf(":%d", 12130))
+		grpcServer := grpc.NewServer()
+		apiPb.RegisterStorageServer(grpcServer, &serverErrorThrow{})
+		go func() {
+			_ = grpcServer.Serve(lis)
+		}()
+		defer grpcServer.Stop()
+		fallback := &queueTargetMock{}
+		s := NewExternalStorage(grpctools.New(), "localhost:12130", time.Second*2, fallback, grpc.WithInsecure(), grpc.WithBlock())
+		assert.Equal(t, errStorageNotSaveLog, s.(BatchWriter).WriteBatch([]job.CheckError{&mock{}, &mock{}}))
+		assert.Len(t, fallback.written, 2)
+	})
+}
+
+func TestIsTransientError(t *testing.T) {
+	t.Run("Should: retry not available storage", func(t *testing.T) {
+		assert.True(t, isTransientError(errConnectionExternalStorageError))
+		assert.True(t, isTransientError(status.Error(codes.Unavailable, "")))
+		assert.True(t, isTransientError(status.Error(codes.DeadlineExceeded, "")))
+	})
+	t.Run("Should: not retry rejected log", func(t *testing.T) {
+		assert.False(t, isTransientError(errStorageNotSaveLog))
+		assert.False(t, isTransientError(status.Error(codes.InvalidArgument, "")))
 	})
 }
@@ -0,0 +1,435 @@
+package storage
+
+import (
+	"encoding/binary"
+	"errors"
+	"github.com/squzy/squzy/internal/job"
+	"github.com/squzy/squzy/internal/logger"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"google.golang.org/protobuf/proto"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+type DropPolicy string
+
+const (
+	// Oldest queued snapshots are removed to free space for the new one
+	DropOldest DropPolicy = "oldest"
+	// New snapshots are rejected while queue is full
+	DropNewest DropPolicy = "newest"
+
+	// File starts with offset of the first not replayed record
+	queueHeaderSize = 8
+	// Every record is prefixed with length of marshaled SchedulerResponse
+	queueRecordPrefixSize = 4
+	// Queue is compacted to file with suffix, which replaces queue file when it is completely written
+	queueCompactSuffix = ".compact"
+	// Snapshot which storage rejected this many times is dropped, so it does not block the queue
+	maxReplayAttempts = 5
+)
+
+var (
+	errQueueFull         = errors.New("STORAGE_QUEUE_IS_FULL")
+	errQueueClosed       = errors.New("STORAGE_QUEUE_IS_CLOSED")
+	errInvalidDropPolicy = errors.New("INVALID_STORAGE_QUEUE_DROP_POLICY")
+)
+
+type QueueStats struct {
+	Length   int
+	Bytes    int64
+	Enqueued uint64
+	Replayed uint64
+	Dropped  uint64
+	// Snapshots which were dropped because storage rejected them maxReplayAttempts times
+	Rejected uint64
+}
+
+// QueueStorage keeps snapshots which were not written to the target storage on disk
+// and replays them in the same order when target storage is available again
+type QueueStorage interface {
+	Storage
+	Stats() QueueStats
+	Close() error
+}
+
+type queueStorage struct {
+	mu      sync.Mutex
+	target  Storage
+	path    string
+	file    *os.File
+	head    int64
+	tail    int64
+	records []int64
+	// Sequence number of the head record, used to detect that record was dropped during replay
+	first uint64
+	// Count of rejected writes of the head record
+	attempts int
+	maxBytes int64
+	policy   DropPolicy
+	stats    QueueStats
+	closed   bool
+	done     chan struct{}
+}
+
+type queuedLog struct {
+	response *apiPb.SchedulerResponse
+}
+
+func (l *queuedLog) GetLogData() *apiPb.SchedulerResponse {
+	return l.response
+}
+
+func NewQueueStorage(target Storage, path string, maxBytes int64, policy DropPolicy, replayInterval time.Duration) (QueueStorage, error) {
+	if policy != DropOldest && policy != DropNewest {
+		return nil, errInvalidDropPolicy
+	}
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	q := &queueStorage{
+		target:   target,
+		path:     path,
+		file:     file,
+		maxBytes: maxBytes,
+		policy:   policy,
+		done:     make(chan struct{}),
+	}
+	err = q.load()
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	if len(q.records) > 0 {
+		logger.Infof("Storage queue restored with %d snapshots", len(q.records))
+	}
+	go q.replayLoop(replayInterval)
+	return q, nil
+}
+
+func (q *queueStorage) Write(log job.CheckError) error {
+	q.mu.Lock()
+	empty := len(q.records) == 0
+	q.mu.Unlock()
+	// Snapshots should not overtake already queued one
+	if empty && q.target.Write(log) == nil {
+		return nil
+	}
+	return q.enqueue(log.GetLogData())
+}
+
+// WriteBatch sends logs to the target in one request if target supports it and nothing is queued
+func (q *queueStorage) WriteBatch(logs []job.CheckError) error {
+	var lastErr error
+	batchWriter, ok := q.target.(BatchWriter)
+	if !ok {
+		for _, log := range logs {
+			err := q.Write(log)
+			if err != nil {
+				lastErr = err
+			}
+		}
+		return lastErr
+	}
+	q.mu.Lock()
+	empty := len(q.records) == 0
+	q.mu.Unlock()
+	if empty && batchWriter.WriteBatch(logs) == nil {
+		return nil
+	}
+	for _, log := range logs {
+		err := q.enqueue(log.GetLogData())
+		if err != nil {
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+func (q *queueStorage) Stats() QueueStats {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	stats := q.stats
+	stats.Length = len(q.records)
+	stats.Bytes = q.tail - q.head
+	return stats
+}
+
+func (q *queueStorage) Close() error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return nil
+	}
+	q.closed = true
+	close(q.done)
+	return q.file.Close()
+}
+
+func (q *queueStorage) load() error {
+	info, err := q.file.Stat()
+	if err != nil {
+		return err
+	}
+	q.head = queueHeaderSize
+	q.tail = queueHeaderSize
+	if info.Size() < queueHeaderSize {
+		return q.reset()
+	}
+	header := make([]byte, queueHeaderSize)
+	_, err = q.file.ReadAt(header, 0)
+	if err != nil {
+		return err
+	}
+	head := int64(binary.BigEndian.Uint64(header))
+	if head < queueHeaderSize || head > info.Size() {
+		return q.reset()
+	}
+	q.head = head
+	q.tail = head
+	prefix := make([]byte, queueRecordPrefixSize)
+	for q.tail+queueRecordPrefixSize <= info.Size() {
+		_, err = q.file.ReadAt(prefix, q.tail)
+		if err != nil {
+			return err
+		}
+		size := queueRecordPrefixSize + int64(binary.BigEndian.Uint32(prefix))
+		if q.tail+size > info.Size() {
+			break
+		}
+		q.records = append(q.records, size)
+		q.tail += size
+	}
+	// Partially written record after crash is removed
+	if q.tail != info.Size() {
+		return q.file.Truncate(q.tail)
+	}
+	return nil
+}
+
+func (q *queueStorage) enqueue(response *apiPb.SchedulerResponse) error {
+	data, err := proto.Marshal(response)
+	if err != nil {
+		return err
+	}
+	size := int64(queueRecordPrefixSize + len(data))
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return errQueueClosed
+	}
+	if size > q.maxBytes || (q.policy == DropNewest && q.tail-q.head+size > q.maxBytes) {
+		q.stats.Dropped++
+		logger.Errorf("Storage queue is full, snapshot of scheduler %s is dropped", response.SchedulerId)
+		return errQueueFull
+	}
+	dropped := 0
+	for q.tail-q.head+size > q.maxBytes {
+		dropped++
+		q.stats.Dropped++
+		err = q.pop()
+		if err != nil {
+			return err
+		}
+	}
+	if dropped > 0 {
+		logger.Errorf("Storage queue is full, %d oldest snapshots are dropped", dropped)
+	}
+
+	record := make([]byte, size)
+	binary.BigEndian.PutUint32(record, uint32(len(data)))
+	copy(record[queueRecordPrefixSize:], data)
+	_, err = q.file.WriteAt(record, q.tail)
+	if err != nil {
+		return err
+	}
+	err = q.file.Sync()
+	if err != nil {
+		return err
+	}
+	q.tail += size
+	q.records = append(q.records, size)
+	q.stats.Enqueued++
+	return nil
+}
+
+func (q *queueStorage) replayLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-q.done:
+			return
+		case <-ticker.C:
+			q.replay()
+		}
+	}
+}
+
+// Replay stops on the first failed write, rest of the queue will be replayed on the next tick.
+// Snapshot is retried without limit while storage is not available and dropped after maxReplayAttempts
+// if storage rejects it, so one broken snapshot does not block the whole queue
+func (q *queueStorage) replay() {
+	replayed := 0
+	defer func() {
+		if replayed > 0 {
+			stats := q.Stats()
+			logger.Infof("Storage queue replayed %d snapshots, %d snapshots are left", replayed, stats.Length)
+		}
+	}()
+	for {
+		q.mu.Lock()
+		if q.closed || len(q.records) == 0 {
+			q.mu.Unlock()
+			return
+		}
+		seq := q.first
+		data := make([]byte, q.records[0]-queueRecordPrefixSize)
+		_, err := q.file.ReadAt(data, q.head+queueRecordPrefixSize)
+		q.mu.Unlock()
+		if err != nil {
+			logger.Errorf("Could not read storage queue: %s", err.Error())
+			return
+		}
+
+		response := &apiPb.SchedulerResponse{}
+		// Broken record could not be replayed, so it is dropped
+		broken := proto.Unmarshal(data, response) != nil
+		var writeErr error
+		if !broken {
+			writeErr = q.target.Write(&queuedLog{response: response})
+			if writeErr != nil && isTransientError(writeErr) {
+				return
+			}
+		}
+
+		q.mu.Lock()
+		// Record can be dropped by drop policy while it was sent
+		if q.first != seq || q.closed {
+			q.mu.Unlock()
+			continue
+		}
+		switch {
+		case broken:
+			q.stats.Dropped++
+		case writeErr != nil:
+			q.attempts++
+			if q.attempts < maxReplayAttempts {
+				q.mu.Unlock()
+				return
+			}
+			q.stats.Rejected++
+			logger.Errorf("Storage rejected snapshot of scheduler %s %d times, it is dropped: %s", response.SchedulerId, q.attempts, writeErr.Error())
+		default:
+			q.stats.Replayed++
+			replayed++
+		}
+		err = q.pop()
+		q.mu.Unlock()
+		if err != nil {
+			logger.Errorf("Could not update storage queue: %s", err.Error())
+			return
+		}
+	}
+}
+
+// Should be called under lock
+func (q *queueStorage) pop() error {
+	q.head += q.records[0]
+	q.records = q.records[1:]
+	q.first++
+	q.attempts = 0
+	if len(q.records) == 0 {
+		return q.reset()
+	}
+	// Space of replayed records is reused only when it is bigger than the queue itself
+	if q.head-queueHeaderSize > q.tail-q.head && q.head-queueHeaderSize >= q.maxBytes {
+		return q.compact()
+	}
+	return q.writeHeader()
+}
+
+func (q *queueStorage) reset() error {
+	q.head = queueHeaderSize
+	q.tail = queueHeaderSize
+	err := q.file.Truncate(queueHeaderSize)
+	if err != nil {
+		return err
+	}
+	return q.writeHeader()
+}
+
+// Queue is copied to the new file which replaces the old one only after it is synced,
+// so crash at any moment leaves either old or new file with valid header
+func (q *queueStorage) compact() error {
+	file, err := q.writeCompacted()
+	if err != nil {
+		return err
+	}
+	return q.replaceFile(file)
+}
+
+func (q *queueStorage) writeCompacted() (*os.File, error) {
+	path := q.path + queueCompactSuffix
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return nil, err
+	}
+	err = func() error {
+		header := make([]byte, queueHeaderSize)
+		binary.BigEndian.PutUint64(header, queueHeaderSize)
+		_, err := file.Write(header)
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(file, io.NewSectionReader(q.file, q.head, q.tail-q.head))
+		if err != nil {
+			return err
+		}
+		return file.Sync()
+	}()
+	if err != nil {
+		_ = file.Close()
+		_ = os.Remove(path)
+		return nil, err
+	}
+	return file, nil
+}
+
+func (q *queueStorage) replaceFile(file *os.File) error {
+	err := os.Rename(file.Name(), q.path)
+	if err != nil {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+		return err
+	}
+	_ = q.file.Close()
+	q.file = file
+	q.tail = queueHeaderSize + q.tail - q.head
+	q.head = queueHeaderSize
+	// Rename is durable only when directory is synced
+	return syncDir(filepath.Dir(q.path))
+}
+
+func syncDir(path string) error {
+	dir, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = dir.Close()
+	}()
+	return dir.Sync()
+}
+
+func (q *queueStorage) writeHeader() error {
+	header := make([]byte, queueHeaderSize)
+	binary.BigEndian.PutUint64(header, uint64(q.head))
+	_, err := q.file.WriteAt(header, 0)
+	return err
+}
@@ -0,0 +1,317 @@
+package storage
+
+import (
+	"errors"
+	"github.com/squzy/squzy/internal/job"
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+type queueTargetMock struct {
+	mu      sync.Mutex
+	fail    bool
+	err     error
+	written []string
+}
+
+func (m *queueTargetMock) Write(log job.CheckError) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.fail {
+		if m.err != nil {
+			return m.err
+		}
+		return errors.New("error")
+	}
+	m.written = append(m.written, log.GetLogData().SchedulerId)
+	return nil
+}
+
+func (m *queueTargetMock) setFail(fail bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.fail = fail
+}
+
+type queueLogMock struct {
+	id string
+}
+
+func (m *queueLogMock) GetLogData() *apiPb.SchedulerResponse {
+	return &apiPb.SchedulerResponse{
+		SchedulerId: m.id,
+		Snapshot: &apiPb.SchedulerSnapshot{
+			Code: apiPb.SchedulerCode_ERROR,
+		},
+	}
+}
+
+func newTestQueue(t *testing.T, target Storage, path string, maxBytes int64, policy DropPolicy) *queueStorage {
+	q, err := NewQueueStorage(target, path, maxBytes, policy, time.Hour)
+	assert.Equal(t, nil, err)
+	return q.(*queueStorage)
+}
+
+func TestNewQueueStorage(t *testing.T) {
+	t.Run("Should: create queue storage", func(t *testing.T) {
+		q, err := NewQueueStorage(&queueTargetMock{}, filepath.Join(t.TempDir(), "queue"), 1024, DropOldest, time.Hour)
+		assert.Equal(t, nil, err)
+		assert.Implements(t, (*Storage)(nil), q)
+		assert.Equal(t, nil, q.Close())
+		assert.Equal(t, nil, q.Close())
+	})
+	t.Run("Should: return error because file can not be opened", func(t *testing.T) {
+		_, err := NewQueueStorage(&queueTargetMock{}, filepath.Join(t.TempDir(), "not_exist", "queue"), 1024, DropOldest, time.Hour)
+		assert.NotEqual(t, nil, err)
+	})
+	t.Run("Should: return error because drop policy is unknown", func(t *testing.T) {
+		_, err := NewQueueStorage(&queueTargetMock{}, filepath.Join(t.TempDir(), "queue"), 1024, DropPolicy("latest"), time.Hour)
+		assert.Equal(t, errInvalidDropPolicy, err)
+	})
+}
+
+func TestQueueStorage_Write(t *testing.T) {
+	t.Run("Should: write directly to target", func(t *testing.T) {
+		target := &queueTargetMock{}
+		q := newTestQueue(t, target, filepath.Join(t.TempDir(), "queue"), 1024, DropOldest)
+		defer q.Close()
+		assert.Equal(t, nil, q.Write(&queueLogMock{id: "1"}))
+		assert.Equal(t, []string{"1"}, target.written)
+		assert.Equal(t, 0, q.Stats().Length)
+	})
+	t.Run("Should: queue snapshots and replay them in order", func(t *testing.T) {
+		target := &queueTargetMock{fail: true}
+		q := newTestQueue(t, target, filepath.Join(t.TempDir(), "queue"), 1024, DropOldest)
+		defer q.Close()
+		assert.Equal(t, nil, q.Write(&queueLogMock{id: "1"}))
+		target.setFail(false)
+		// Should not overtake queued snapshot
+		assert.Equal(t, nil, q.Write(&queueLogMock{id: "2"}))
+		assert.Equal(t, 0, len(target.written))
+		assert.Equal(t, 2, q.Stats().Length)
+		assert.Equal(t, uint64(2), q.Stats().Enqueued)
+
+		q.replay()
+		assert.Equal(t, []string{"1", "2"}, target.written)
+		assert.Equal(t, 0, q.Stats().Length)
+		assert.Equal(t, int64(0), q.Stats().Bytes)
+		assert.Equal(t, uint64(2), q.Stats().Replayed)
+	})
+	t.Run("Should: stop replay on first error", func(t *testing.T) {
+		target := &queueTargetMock{fail: true}
+		q := newTestQueue(t, target, filepath.Join(t.TempDir(), "queue"), 1024, DropOldest)
+		defer q.Close()
+		assert.Equal(t, nil, q.Write(&queueLogMock{id: "1"}))
+		q.replay()
+		assert.Equal(t, 1, q.Stats().Length)
+		assert.Equal(t, uint64(0), q.Stats().Replayed)
+	})
+	t.Run("Should: drop snapshot which storage rejected", func(t *testing.T) {
+		target := &queueTargetMock{fail: true, err: errStorageNotSaveLog}
+		q := newTestQueue(t, target, filepath.Join(t.TempDir(), "queue"), 1024, DropOldest)
+		defer q.Close()
+		assert.Equal(t, nil, q.Write(&queueLogMock{id: "1"}))
+		for i := 1; i < maxReplayAttempts; i++ {
+			q.replay()
+			assert.Equal(t, 1, q.Stats().Length)
+		}
+		q.replay()
+		assert.Equal(t, 0, q.Stats().Length)
+		assert.Equal(t, uint64(1), q.Stats().Rejected)
+	})
+	t.Run("Should: retry snapshot while storage is not available", func(t *testing.T) {
+		target := &queueTargetMock{fail: true, err: errConnectionExternalStorageError}
+		q := newTestQueue(t, target, filepath.Join(t.TempDir(), "queue"), 1024, DropOldest)
+		defer q.Close()
+		assert.Equal(t, nil, q.Write(&queueLogMock{id: "1"}))
+		for i := 0; i < maxReplayAttempts*2; i++ {
+			q.replay()
+		}
+		assert.Equal(t, 1, q.Stats().Length)
+		assert.Equal(t, uint64(0), q.Stats().Rejected)
+		target.setFail(false)
+		q.replay()
+		assert.Equal(t, []string{"1"}, target.written)
+	})
+	t.Run("Should: drop oldest snapshots", func(t *testing.T) {
+		target := &queueTargetMock{fail: true}
+		q := newTestQueue(t, target, filepath.Join(t.TempDir(), "queue"), 40, DropOldest)
+		defer q.Close()
+		for _, id := range []string{"1", "2", "3", "4"} {
+			assert.Equal(t, nil, q.Write(&queueLogMock{id: id}))
+		}
+		stats := q.Stats()
+		assert.True(t, stats.Bytes <= 40)
+		assert.True(t, stats.Dropped > 0)
+		target.setFail(false)
+		q.replay()
+		assert.Equal(t, "4", target.written[len(target.written)-1])
+		assert.NotContains(t, target.written, "1")
+	})
+	t.Run("Should: drop newest snapshots", func(t *testing.T) {
+		target := &queueTargetMock{fail: true}
+		q := newTestQueue(t, target, filepath.Join(t.TempDir(), "queue"), 40, DropNewest)
+		defer q.Close()
+		results := []error{}
+		for _, id := range []string{"1", "2", "3", "4"} {
+			results = append(results, q.Write(&queueLogMock{id: id}))
+		}
+		assert.Equal(t, nil, results[0])
+		assert.Equal(t, errQueueFull, results[3])
+		target.setFail(false)
+		q.replay()
+		assert.Equal(t, "1", target.written[0])
+		assert.NotContains(t, target.written, "4")
+	})
+	t.Run("Should: return error because snapshot bigger than queue", func(t *testing.T) {
+		q := newTestQueue(t, &queueTargetMock{fail: true}, filepath.Join(t.TempDir(), "queue"), 4, DropOldest)
+		defer q.Close()
+		assert.Equal(t, errQueueFull, q.Write(&queueLogMock{id: "1"}))
+	})
+	t.Run("Should: return error because queue closed", func(t *testing.T) {
+		q := newTestQueue(t, &queueTargetMock{fail: true}, filepath.Join(t.TempDir(), "queue"), 1024, DropOldest)
+		_ = q.Close()
+		assert.Equal(t, errQueueClosed, q.Write(&queueLogMock{id: "1"}))
+	})
+}
+
+type queueBatchTargetMock struct {
+	queueTargetMock
+	batches int
+}
+
+func (m *queueBatchTargetMock) WriteBatch(logs []job.CheckError) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.fail {
+		return errors.New("error")
+	}
+	m.batches++
+	for _, log := range logs {
+		m.written = append(m.written, log.GetLogData().SchedulerId)
+	}
+	return nil
+}
+
+func TestQueueStorage_WriteBatch(t *testing.T) {
+	t.Run("Should: write batch directly to target", func(t *testing.T) {
+		target := &queueBatchTargetMock{}
+		q := newTestQueue(t, target, filepath.Join(t.TempDir(), "queue"), 1024, DropOldest)
+		defer q.Close()
+		assert.Equal(t, nil, q.WriteBatch([]job.CheckError{&queueLogMock{id: "1"}, &queueLogMock{id: "2"}}))
+		assert.Equal(t, []string{"1", "2"}, target.written)
+		assert.Equal(t, 1, target.batches)
+	})
+	t.Run("Should: write one by one because target not support batches", func(t *testing.T) {
+		target := &queueTargetMock{}
+		q := newTestQueue(t, target, filepath.Join(t.TempDir(), "queue"), 1024, DropOldest)
+		defer q.Close()
+		assert.Equal(t, nil, q.WriteBatch([]job.CheckError{&queueLogMock{id: "1"}, &queueLogMock{id: "2"}}))
+		assert.Equal(t, []string{"1", "2"}, target.written)
+	})
+	t.Run("Should: queue batch because target failed", func(t *testing.T) {
+		target := &queueBatchTargetMock{}
+		target.setFail(true)
+		q := newTestQueue(t, target, filepath.Join(t.TempDir(), "queue"), 1024, DropOldest)
+		defer q.Close()
+		assert.Equal(t, nil, q.WriteBatch([]job.CheckError{&queueLogMock{id: "1"}, &queueLogMock{id: "2"}}))
+		assert.Equal(t, 2, q.Stats().Length)
+		target.setFail(false)
+		// Should not overtake queued snapshots
+		assert.Equal(t, nil, q.WriteBatch([]job.CheckError{&queueLogMock{id: "3"}}))
+		assert.Equal(t, 3, q.Stats().Length)
+		q.replay()
+		assert.Equal(t, []string{"1", "2", "3"}, target.written)
+	})
+}
+
+func TestQueueStorage_Restore(t *testing.T) {
+	t.Run("Should: restore not replayed snapshots after restart", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "queue")
+		target := &queueTargetMock{fail: true}
+		q := newTestQueue(t, target, path, 1024, DropOldest)
+		for _, id := range []string{"1", "2", "3"} {
+			assert.Equal(t, nil, q.Write(&queueLogMock{id: id}))
+		}
+		target.setFail(false)
+		// Replay only first one
+		q.mu.Lock()
+		assert.Equal(t, nil, q.pop())
+		q.mu.Unlock()
+		assert.Equal(t, nil, q.Close())
+
+		q = newTestQueue(t, target, path, 1024, DropOldest)
+		defer q.Close()
+		assert.Equal(t, 2, q.Stats().Length)
+		q.replay()
+		assert.Equal(t, []string{"2", "3"}, target.written)
+	})
+	t.Run("Should: remove partially written snapshot", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "queue")
+		target := &queueTargetMock{fail: true}
+		q := newTestQueue(t, target, path, 1024, DropOldest)
+		assert.Equal(t, nil, q.Write(&queueLogMock{id: "1"}))
+		assert.Equal(t, nil, q.Close())
+
+		f, _ := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0600)
+		_, _ = f.Write([]byte{0, 0, 0, 100, 1, 2})
+		_ = f.Close()
+
+		q = newTestQueue(t, target, path, 1024, DropOldest)
+		defer q.Close()
+		assert.Equal(t, 1, q.Stats().Length)
+		target.setFail(false)
+		q.replay()
+		assert.Equal(t, []string{"1"}, target.written)
+	})
+	t.Run("Should: compact file when replayed part is big", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "queue")
+		target := &queueTargetMock{fail: true}
+		q := newTestQueue(t, target, path, 60, DropOldest)
+		defer q.Close()
+		for i := 0; i < 20; i++ {
+			assert.Equal(t, nil, q.Write(&queueLogMock{id: strconv.Itoa(i)}))
+		}
+		info, _ := os.Stat(path)
+		// Replayed part is reused when it is bigger than the queue
+		assert.True(t, info.Size() <= queueHeaderSize+2*60)
+		target.setFail(false)
+		q.replay()
+		assert.Equal(t, "19", target.written[len(target.written)-1])
+	})
+	t.Run("Should: keep queue valid after every step of compaction", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "queue")
+		target := &queueTargetMock{fail: true}
+		q := newTestQueue(t, target, path, 1024, DropOldest)
+		defer q.Close()
+		for _, id := range []string{"1", "2", "3"} {
+			assert.Equal(t, nil, q.Write(&queueLogMock{id: id}))
+		}
+		q.mu.Lock()
+		defer q.mu.Unlock()
+		assert.Equal(t, nil, q.pop())
+
+		bytes := q.tail - q.head
+		// Restored queue is not replayed, so it does not change the file
+		reopen := func() {
+			restored := newTestQueue(t, target, path, 1024, DropOldest)
+			assert.Equal(t, 2, len(restored.records))
+			assert.Equal(t, bytes, restored.tail-restored.head)
+			assert.Equal(t, nil, restored.Close())
+		}
+		file, err := q.writeCompacted()
+		assert.Equal(t, nil, err)
+		reopen()
+		assert.Equal(t, nil, q.replaceFile(file))
+		assert.Equal(t, int64(queueHeaderSize), q.head)
+		reopen()
+		_, err = os.Stat(path + queueCompactSuffix)
+		assert.True(t, os.IsNotExist(err))
+	})
+}
@@ -0,0 +1,42 @@
+package storage
+
+import (
+	apiPb "github.com/squzy/squzy_generated/generated/github.com/squzy/squzy_proto"
+)
+
+// StatusReporter returns state of snapshots delivery to the storage
+type StatusReporter interface {
+	GetStatus() *apiPb.GetStorageStatusResponse
+}
+
+type statusReporter struct {
+	queue QueueStorage
+	state StateReporter
+}
+
+// NewStatusReporter queue can be nil if it is disabled, state can be nil if external storage is not configured
+func NewStatusReporter(queue QueueStorage, state StateReporter) StatusReporter {
+	return &statusReporter{
+		queue: queue,
+		state: state,
+	}
+}
+
+func (s *statusReporter) GetStatus() *apiPb.GetStorageStatusResponse {
+	res := &apiPb.GetStorageStatusResponse{}
+	if s.state != nil {
+		res.ConnectionState = s.state.State().String()
+	}
+	if s.queue != nil {
+		stats := s.queue.Stats()
+		res.Queue = &apiPb.StorageQueueStatus{
+			Length:   int64(stats.Length),
+			Bytes:    stats.Bytes,
+			Enqueued: stats.Enqueued,
+			Replayed: stats.Replayed,
+			Dropped:  stats.Dropped,
+			Rejected: stats.Rejected,
+		}
+	}
+	return res
+}
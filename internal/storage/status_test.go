@@ -0,0 +1,36 @@
+package storage
+
+import (
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/connectivity"
+	"path/filepath"
+	"testing"
+)
+
+func TestStatusReporter_GetStatus(t *testing.T) {
+	t.Run("Should: return empty status without queue", func(t *testing.T) {
+		assert.Nil(t, NewStatusReporter(nil, nil).GetStatus().GetQueue())
+	})
+	t.Run("Should: return stats of queue", func(t *testing.T) {
+		q := newTestQueue(t, &queueTargetMock{fail: true}, filepath.Join(t.TempDir(), "queue"), 1024, DropOldest)
+		defer q.Close()
+		assert.Equal(t, nil, q.Write(&queueLogMock{id: "1"}))
+		res := NewStatusReporter(q, nil).GetStatus().GetQueue()
+		assert.Equal(t, int64(1), res.GetLength())
+		assert.Equal(t, uint64(1), res.GetEnqueued())
+		assert.True(t, res.GetBytes() > 0)
+	})
+	t.Run("Should: return state of connection", func(t *testing.T) {
+		res := NewStatusReporter(nil, stateReporterMock(connectivity.TransientFailure)).GetStatus()
+		assert.Equal(t, "TRANSIENT_FAILURE", res.GetConnectionState())
+	})
+	t.Run("Should: return empty state without external storage", func(t *testing.T) {
+		assert.Equal(t, "", NewStatusReporter(nil, nil).GetStatus().GetConnectionState())
+	})
+}
+
+type stateReporterMock connectivity.State
+
+func (m stateReporterMock) State() connectivity.State {
+	return connectivity.State(m)
+}
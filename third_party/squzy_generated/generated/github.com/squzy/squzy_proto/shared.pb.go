@@ -0,0 +1,143 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.19.3
+// source: proto/v1/shared.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ComponentOwnerType int32
+
+const (
+	ComponentOwnerType_COMPONENT_OWNER_TYPE_UNSPECIFIED ComponentOwnerType = 0
+	ComponentOwnerType_COMPONENT_OWNER_TYPE_SCHEDULER   ComponentOwnerType = 1
+	ComponentOwnerType_COMPONENT_OWNER_TYPE_AGENT       ComponentOwnerType = 2
+	ComponentOwnerType_COMPONENT_OWNER_TYPE_APPLICATION ComponentOwnerType = 3
+)
+
+// Enum value maps for ComponentOwnerType.
+var (
+	ComponentOwnerType_name = map[int32]string{
+		0: "COMPONENT_OWNER_TYPE_UNSPECIFIED",
+		1: "COMPONENT_OWNER_TYPE_SCHEDULER",
+		2: "COMPONENT_OWNER_TYPE_AGENT",
+		3: "COMPONENT_OWNER_TYPE_APPLICATION",
+	}
+	ComponentOwnerType_value = map[string]int32{
+		"COMPONENT_OWNER_TYPE_UNSPECIFIED": 0,
+		"COMPONENT_OWNER_TYPE_SCHEDULER":   1,
+		"COMPONENT_OWNER_TYPE_AGENT":       2,
+		"COMPONENT_OWNER_TYPE_APPLICATION": 3,
+	}
+)
+
+func (x ComponentOwnerType) Enum() *ComponentOwnerType {
+	p := new(ComponentOwnerType)
+	*p = x
+	return p
+}
+
+func (x ComponentOwnerType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ComponentOwnerType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_shared_proto_enumTypes[0].Descriptor()
+}
+
+func (ComponentOwnerType) Type() protoreflect.EnumType {
+	return &file_proto_v1_shared_proto_enumTypes[0]
+}
+
+func (x ComponentOwnerType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ComponentOwnerType.Descriptor instead.
+func (ComponentOwnerType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_shared_proto_rawDescGZIP(), []int{0}
+}
+
+var File_proto_v1_shared_proto protoreflect.FileDescriptor
+
+var file_proto_v1_shared_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2a, 0xa4, 0x01, 0x0a, 0x12, 0x43, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x24, 0x0a, 0x20, 0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x5f, 0x4f, 0x57, 0x4e,
+	0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x4e, 0x45,
+	0x4e, 0x54, 0x5f, 0x4f, 0x57, 0x4e, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x43,
+	0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x52, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x4f, 0x4d,
+	0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x5f, 0x4f, 0x57, 0x4e, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x41, 0x47, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x24, 0x0a, 0x20, 0x43, 0x4f, 0x4d,
+	0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x5f, 0x4f, 0x57, 0x4e, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x41, 0x50, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x42,
+	0x24, 0x5a, 0x22, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x71,
+	0x75, 0x7a, 0x79, 0x2f, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x3b,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_v1_shared_proto_rawDescOnce sync.Once
+	file_proto_v1_shared_proto_rawDescData = file_proto_v1_shared_proto_rawDesc
+)
+
+func file_proto_v1_shared_proto_rawDescGZIP() []byte {
+	file_proto_v1_shared_proto_rawDescOnce.Do(func() {
+		file_proto_v1_shared_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_v1_shared_proto_rawDescData)
+	})
+	return file_proto_v1_shared_proto_rawDescData
+}
+
+var file_proto_v1_shared_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_v1_shared_proto_goTypes = []interface{}{
+	(ComponentOwnerType)(0), // 0: squzy.v1.shared.ComponentOwnerType
+}
+var file_proto_v1_shared_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_proto_v1_shared_proto_init() }
+func file_proto_v1_shared_proto_init() {
+	if File_proto_v1_shared_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_v1_shared_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_v1_shared_proto_goTypes,
+		DependencyIndexes: file_proto_v1_shared_proto_depIdxs,
+		EnumInfos:         file_proto_v1_shared_proto_enumTypes,
+	}.Build()
+	File_proto_v1_shared_proto = out.File
+	file_proto_v1_shared_proto_rawDesc = nil
+	file_proto_v1_shared_proto_goTypes = nil
+	file_proto_v1_shared_proto_depIdxs = nil
+}
@@ -0,0 +1,2276 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.19.3
+// source: proto/v1/squzy_agent_server.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AgentStatus int32
+
+const (
+	// Initial status
+	AgentStatus_AGENT_STATUS_UNSPECIFIED AgentStatus = 0
+	// Agent is runned but not send stat yet
+	AgentStatus_REGISTRED AgentStatus = 1
+	// Actually send stat
+	AgentStatus_RUNNED AgentStatus = 2
+	// Stopped to send stat
+	AgentStatus_DISCONNECTED AgentStatus = 3
+	// Unregistred
+	AgentStatus_UNREGISTRED AgentStatus = 4
+)
+
+// Enum value maps for AgentStatus.
+var (
+	AgentStatus_name = map[int32]string{
+		0: "AGENT_STATUS_UNSPECIFIED",
+		1: "REGISTRED",
+		2: "RUNNED",
+		3: "DISCONNECTED",
+		4: "UNREGISTRED",
+	}
+	AgentStatus_value = map[string]int32{
+		"AGENT_STATUS_UNSPECIFIED": 0,
+		"REGISTRED":                1,
+		"RUNNED":                   2,
+		"DISCONNECTED":             3,
+		"UNREGISTRED":              4,
+	}
+)
+
+func (x AgentStatus) Enum() *AgentStatus {
+	p := new(AgentStatus)
+	*p = x
+	return p
+}
+
+func (x AgentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AgentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_squzy_agent_server_proto_enumTypes[0].Descriptor()
+}
+
+func (AgentStatus) Type() protoreflect.EnumType {
+	return &file_proto_v1_squzy_agent_server_proto_enumTypes[0]
+}
+
+func (x AgentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AgentStatus.Descriptor instead.
+func (AgentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_agent_server_proto_rawDescGZIP(), []int{0}
+}
+
+type Metric struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AgentId string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	// Should be set by user from env var
+	AgentName  string                 `protobuf:"bytes,2,opt,name=agent_name,json=agentName,proto3" json:"agent_name,omitempty"`
+	CpuInfo    *CpuInfo               `protobuf:"bytes,3,opt,name=cpu_info,json=cpuInfo,proto3" json:"cpu_info,omitempty"`
+	MemoryInfo *MemoryInfo            `protobuf:"bytes,4,opt,name=memory_info,json=memoryInfo,proto3" json:"memory_info,omitempty"`
+	DiskInfo   *DiskInfo              `protobuf:"bytes,5,opt,name=disk_info,json=diskInfo,proto3" json:"disk_info,omitempty"`
+	NetInfo    *NetInfo               `protobuf:"bytes,6,opt,name=net_info,json=netInfo,proto3" json:"net_info,omitempty"`
+	Time       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *Metric) Reset() {
+	*x = Metric{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Metric) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Metric) ProtoMessage() {}
+
+func (x *Metric) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Metric.ProtoReflect.Descriptor instead.
+func (*Metric) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_agent_server_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Metric) GetAgentId() string {
+	if x != nil {
+		return x.AgentId
+	}
+	return ""
+}
+
+func (x *Metric) GetAgentName() string {
+	if x != nil {
+		return x.AgentName
+	}
+	return ""
+}
+
+func (x *Metric) GetCpuInfo() *CpuInfo {
+	if x != nil {
+		return x.CpuInfo
+	}
+	return nil
+}
+
+func (x *Metric) GetMemoryInfo() *MemoryInfo {
+	if x != nil {
+		return x.MemoryInfo
+	}
+	return nil
+}
+
+func (x *Metric) GetDiskInfo() *DiskInfo {
+	if x != nil {
+		return x.DiskInfo
+	}
+	return nil
+}
+
+func (x *Metric) GetNetInfo() *NetInfo {
+	if x != nil {
+		return x.NetInfo
+	}
+	return nil
+}
+
+func (x *Metric) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+type SendMetricsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// generated by server
+	//
+	// Types that are assignable to Msg:
+	//	*SendMetricsRequest_Metric
+	//	*SendMetricsRequest_Disconnect_
+	Msg isSendMetricsRequest_Msg `protobuf_oneof:"msg"`
+}
+
+func (x *SendMetricsRequest) Reset() {
+	*x = SendMetricsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMetricsRequest) ProtoMessage() {}
+
+func (x *SendMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMetricsRequest.ProtoReflect.Descriptor instead.
+func (*SendMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_agent_server_proto_rawDescGZIP(), []int{1}
+}
+
+func (m *SendMetricsRequest) GetMsg() isSendMetricsRequest_Msg {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (x *SendMetricsRequest) GetMetric() *Metric {
+	if x, ok := x.GetMsg().(*SendMetricsRequest_Metric); ok {
+		return x.Metric
+	}
+	return nil
+}
+
+func (x *SendMetricsRequest) GetDisconnect() *SendMetricsRequest_Disconnect {
+	if x, ok := x.GetMsg().(*SendMetricsRequest_Disconnect_); ok {
+		return x.Disconnect
+	}
+	return nil
+}
+
+type isSendMetricsRequest_Msg interface {
+	isSendMetricsRequest_Msg()
+}
+
+type SendMetricsRequest_Metric struct {
+	Metric *Metric `protobuf:"bytes,1,opt,name=metric,proto3,oneof"`
+}
+
+type SendMetricsRequest_Disconnect_ struct {
+	Disconnect *SendMetricsRequest_Disconnect `protobuf:"bytes,2,opt,name=disconnect,proto3,oneof"`
+}
+
+func (*SendMetricsRequest_Metric) isSendMetricsRequest_Msg() {}
+
+func (*SendMetricsRequest_Disconnect_) isSendMetricsRequest_Msg() {}
+
+type GetAgentByIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AgentId string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+}
+
+func (x *GetAgentByIdRequest) Reset() {
+	*x = GetAgentByIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAgentByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAgentByIdRequest) ProtoMessage() {}
+
+func (x *GetAgentByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAgentByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetAgentByIdRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_agent_server_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetAgentByIdRequest) GetAgentId() string {
+	if x != nil {
+		return x.AgentId
+	}
+	return ""
+}
+
+type CpuInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// protolint:disable:next REPEATED_FIELD_NAMES_PLURALIZED
+	Cpus []*CpuInfo_CPU `protobuf:"bytes,1,rep,name=cpus,proto3" json:"cpus,omitempty"`
+}
+
+func (x *CpuInfo) Reset() {
+	*x = CpuInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CpuInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CpuInfo) ProtoMessage() {}
+
+func (x *CpuInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CpuInfo.ProtoReflect.Descriptor instead.
+func (*CpuInfo) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_agent_server_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CpuInfo) GetCpus() []*CpuInfo_CPU {
+	if x != nil {
+		return x.Cpus
+	}
+	return nil
+}
+
+type MemoryInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mem  *MemoryInfo_Memory `protobuf:"bytes,1,opt,name=mem,proto3" json:"mem,omitempty"`
+	Swap *MemoryInfo_Memory `protobuf:"bytes,2,opt,name=swap,proto3" json:"swap,omitempty"`
+}
+
+func (x *MemoryInfo) Reset() {
+	*x = MemoryInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemoryInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoryInfo) ProtoMessage() {}
+
+func (x *MemoryInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoryInfo.ProtoReflect.Descriptor instead.
+func (*MemoryInfo) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_agent_server_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MemoryInfo) GetMem() *MemoryInfo_Memory {
+	if x != nil {
+		return x.Mem
+	}
+	return nil
+}
+
+func (x *MemoryInfo) GetSwap() *MemoryInfo_Memory {
+	if x != nil {
+		return x.Swap
+	}
+	return nil
+}
+
+type DiskInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Disks map[string]*DiskInfo_Disk `protobuf:"bytes,1,rep,name=disks,proto3" json:"disks,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *DiskInfo) Reset() {
+	*x = DiskInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskInfo) ProtoMessage() {}
+
+func (x *DiskInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskInfo.ProtoReflect.Descriptor instead.
+func (*DiskInfo) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_agent_server_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DiskInfo) GetDisks() map[string]*DiskInfo_Disk {
+	if x != nil {
+		return x.Disks
+	}
+	return nil
+}
+
+type NetInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Interfaces map[string]*NetInfo_Interface `protobuf:"bytes,1,rep,name=interfaces,proto3" json:"interfaces,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *NetInfo) Reset() {
+	*x = NetInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetInfo) ProtoMessage() {}
+
+func (x *NetInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetInfo.ProtoReflect.Descriptor instead.
+func (*NetInfo) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_agent_server_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *NetInfo) GetInterfaces() map[string]*NetInfo_Interface {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+type GetByAgentNameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AgentName string `protobuf:"bytes,1,opt,name=agent_name,json=agentName,proto3" json:"agent_name,omitempty"`
+}
+
+func (x *GetByAgentNameRequest) Reset() {
+	*x = GetByAgentNameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetByAgentNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetByAgentNameRequest) ProtoMessage() {}
+
+func (x *GetByAgentNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetByAgentNameRequest.ProtoReflect.Descriptor instead.
+func (*GetByAgentNameRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_agent_server_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetByAgentNameRequest) GetAgentName() string {
+	if x != nil {
+		return x.AgentName
+	}
+	return ""
+}
+
+type GetAgentListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Agents []*AgentItem `protobuf:"bytes,1,rep,name=agents,proto3" json:"agents,omitempty"`
+}
+
+func (x *GetAgentListResponse) Reset() {
+	*x = GetAgentListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAgentListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAgentListResponse) ProtoMessage() {}
+
+func (x *GetAgentListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAgentListResponse.ProtoReflect.Descriptor instead.
+func (*GetAgentListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_agent_server_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetAgentListResponse) GetAgents() []*AgentItem {
+	if x != nil {
+		return x.Agents
+	}
+	return nil
+}
+
+type UnRegisterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Time *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *UnRegisterRequest) Reset() {
+	*x = UnRegisterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnRegisterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnRegisterRequest) ProtoMessage() {}
+
+func (x *UnRegisterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnRegisterRequest.ProtoReflect.Descriptor instead.
+func (*UnRegisterRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_agent_server_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UnRegisterRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UnRegisterRequest) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+type UnRegisterResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *UnRegisterResponse) Reset() {
+	*x = UnRegisterResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnRegisterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnRegisterResponse) ProtoMessage() {}
+
+func (x *UnRegisterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnRegisterResponse.ProtoReflect.Descriptor instead.
+func (*UnRegisterResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_agent_server_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UnRegisterResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RegisterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name form env
+	AgentName string                 `protobuf:"bytes,1,opt,name=agent_name,json=agentName,proto3" json:"agent_name,omitempty"`
+	HostInfo  *HostInfo              `protobuf:"bytes,2,opt,name=host_info,json=hostInfo,proto3" json:"host_info,omitempty"`
+	Time      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	// interval for update
+	Interval int64 `protobuf:"varint,4,opt,name=interval,proto3" json:"interval,omitempty"`
+}
+
+func (x *RegisterRequest) Reset() {
+	*x = RegisterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterRequest) ProtoMessage() {}
+
+func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterRequest.ProtoReflect.Descriptor instead.
+func (*RegisterRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_agent_server_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RegisterRequest) GetAgentName() string {
+	if x != nil {
+		return x.AgentName
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetHostInfo() *HostInfo {
+	if x != nil {
+		return x.HostInfo
+	}
+	return nil
+}
+
+func (x *RegisterRequest) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *RegisterRequest) GetInterval() int64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+type AgentItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AgentName string      `protobuf:"bytes,2,opt,name=agent_name,json=agentName,proto3" json:"agent_name,omitempty"`
+	Status    AgentStatus `protobuf:"varint,3,opt,name=status,proto3,enum=squzy.v1.agent.AgentStatus" json:"status,omitempty"`
+	HostInfo  *HostInfo   `protobuf:"bytes,4,opt,name=host_info,json=hostInfo,proto3" json:"host_info,omitempty"`
+	Interval  int64       `protobuf:"varint,5,opt,name=interval,proto3" json:"interval,omitempty"`
+}
+
+func (x *AgentItem) Reset() {
+	*x = AgentItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgentItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentItem) ProtoMessage() {}
+
+func (x *AgentItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentItem.ProtoReflect.Descriptor instead.
+func (*AgentItem) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_agent_server_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AgentItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AgentItem) GetAgentName() string {
+	if x != nil {
+		return x.AgentName
+	}
+	return ""
+}
+
+func (x *AgentItem) GetStatus() AgentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AgentStatus_AGENT_STATUS_UNSPECIFIED
+}
+
+func (x *AgentItem) GetHostInfo() *HostInfo {
+	if x != nil {
+		return x.HostInfo
+	}
+	return nil
+}
+
+func (x *AgentItem) GetInterval() int64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+type HostInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HostName     string        `protobuf:"bytes,1,opt,name=host_name,json=hostName,proto3" json:"host_name,omitempty"`
+	Os           string        `protobuf:"bytes,2,opt,name=os,proto3" json:"os,omitempty"`
+	PlatformInfo *PlatformInfo `protobuf:"bytes,3,opt,name=platform_info,json=platformInfo,proto3" json:"platform_info,omitempty"`
+}
+
+func (x *HostInfo) Reset() {
+	*x = HostInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostInfo) ProtoMessage() {}
+
+func (x *HostInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostInfo.ProtoReflect.Descriptor instead.
+func (*HostInfo) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_agent_server_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *HostInfo) GetHostName() string {
+	if x != nil {
+		return x.HostName
+	}
+	return ""
+}
+
+func (x *HostInfo) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *HostInfo) GetPlatformInfo() *PlatformInfo {
+	if x != nil {
+		return x.PlatformInfo
+	}
+	return nil
+}
+
+type PlatformInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Family  string `protobuf:"bytes,2,opt,name=family,proto3" json:"family,omitempty"`
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *PlatformInfo) Reset() {
+	*x = PlatformInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlatformInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlatformInfo) ProtoMessage() {}
+
+func (x *PlatformInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlatformInfo.ProtoReflect.Descriptor instead.
+func (*PlatformInfo) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_agent_server_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PlatformInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlatformInfo) GetFamily() string {
+	if x != nil {
+		return x.Family
+	}
+	return ""
+}
+
+func (x *PlatformInfo) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type RegisterResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EnvName string `protobuf:"bytes,2,opt,name=env_name,json=envName,proto3" json:"env_name,omitempty"`
+}
+
+func (x *RegisterResponse) Reset() {
+	*x = RegisterResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterResponse) ProtoMessage() {}
+
+func (x *RegisterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterResponse.ProtoReflect.Descriptor instead.
+func (*RegisterResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_agent_server_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RegisterResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RegisterResponse) GetEnvName() string {
+	if x != nil {
+		return x.EnvName
+	}
+	return ""
+}
+
+type SendMetricsRequest_Disconnect struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AgentId string                 `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	Time    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *SendMetricsRequest_Disconnect) Reset() {
+	*x = SendMetricsRequest_Disconnect{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendMetricsRequest_Disconnect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMetricsRequest_Disconnect) ProtoMessage() {}
+
+func (x *SendMetricsRequest_Disconnect) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMetricsRequest_Disconnect.ProtoReflect.Descriptor instead.
+func (*SendMetricsRequest_Disconnect) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_agent_server_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *SendMetricsRequest_Disconnect) GetAgentId() string {
+	if x != nil {
+		return x.AgentId
+	}
+	return ""
+}
+
+func (x *SendMetricsRequest_Disconnect) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+type CpuInfo_CPU struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Load float64 `protobuf:"fixed64,1,opt,name=load,proto3" json:"load,omitempty"`
+}
+
+func (x *CpuInfo_CPU) Reset() {
+	*x = CpuInfo_CPU{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CpuInfo_CPU) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CpuInfo_CPU) ProtoMessage() {}
+
+func (x *CpuInfo_CPU) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CpuInfo_CPU.ProtoReflect.Descriptor instead.
+func (*CpuInfo_CPU) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_agent_server_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *CpuInfo_CPU) GetLoad() float64 {
+	if x != nil {
+		return x.Load
+	}
+	return 0
+}
+
+type MemoryInfo_Memory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total       uint64  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Used        uint64  `protobuf:"varint,2,opt,name=used,proto3" json:"used,omitempty"`
+	Free        uint64  `protobuf:"varint,3,opt,name=free,proto3" json:"free,omitempty"`
+	Shared      uint64  `protobuf:"varint,4,opt,name=shared,proto3" json:"shared,omitempty"`
+	UsedPercent float64 `protobuf:"fixed64,5,opt,name=used_percent,json=usedPercent,proto3" json:"used_percent,omitempty"`
+}
+
+func (x *MemoryInfo_Memory) Reset() {
+	*x = MemoryInfo_Memory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemoryInfo_Memory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoryInfo_Memory) ProtoMessage() {}
+
+func (x *MemoryInfo_Memory) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoryInfo_Memory.ProtoReflect.Descriptor instead.
+func (*MemoryInfo_Memory) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_agent_server_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *MemoryInfo_Memory) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *MemoryInfo_Memory) GetUsed() uint64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *MemoryInfo_Memory) GetFree() uint64 {
+	if x != nil {
+		return x.Free
+	}
+	return 0
+}
+
+func (x *MemoryInfo_Memory) GetShared() uint64 {
+	if x != nil {
+		return x.Shared
+	}
+	return 0
+}
+
+func (x *MemoryInfo_Memory) GetUsedPercent() float64 {
+	if x != nil {
+		return x.UsedPercent
+	}
+	return 0
+}
+
+type DiskInfo_Disk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total       uint64  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Free        uint64  `protobuf:"varint,2,opt,name=free,proto3" json:"free,omitempty"`
+	Used        uint64  `protobuf:"varint,3,opt,name=used,proto3" json:"used,omitempty"`
+	UsedPercent float64 `protobuf:"fixed64,4,opt,name=used_percent,json=usedPercent,proto3" json:"used_percent,omitempty"`
+}
+
+func (x *DiskInfo_Disk) Reset() {
+	*x = DiskInfo_Disk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskInfo_Disk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskInfo_Disk) ProtoMessage() {}
+
+func (x *DiskInfo_Disk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskInfo_Disk.ProtoReflect.Descriptor instead.
+func (*DiskInfo_Disk) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_agent_server_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *DiskInfo_Disk) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *DiskInfo_Disk) GetFree() uint64 {
+	if x != nil {
+		return x.Free
+	}
+	return 0
+}
+
+func (x *DiskInfo_Disk) GetUsed() uint64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *DiskInfo_Disk) GetUsedPercent() float64 {
+	if x != nil {
+		return x.UsedPercent
+	}
+	return 0
+}
+
+type NetInfo_Interface struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BytesSent   uint64 `protobuf:"varint,1,opt,name=bytes_sent,json=bytesSent,proto3" json:"bytes_sent,omitempty"`
+	BytesRecv   uint64 `protobuf:"varint,2,opt,name=bytes_recv,json=bytesRecv,proto3" json:"bytes_recv,omitempty"`
+	PacketsSent uint64 `protobuf:"varint,3,opt,name=packets_sent,json=packetsSent,proto3" json:"packets_sent,omitempty"`
+	PacketsRecv uint64 `protobuf:"varint,4,opt,name=packets_recv,json=packetsRecv,proto3" json:"packets_recv,omitempty"`
+	// total number of errors while receiving
+	ErrIn uint64 `protobuf:"varint,5,opt,name=err_in,json=errIn,proto3" json:"err_in,omitempty"`
+	// total number of errors while sending
+	ErrOut uint64 `protobuf:"varint,6,opt,name=err_out,json=errOut,proto3" json:"err_out,omitempty"`
+	// total number of incoming packets which were dropped
+	DropIn uint64 `protobuf:"varint,7,opt,name=drop_in,json=dropIn,proto3" json:"drop_in,omitempty"`
+	// total number of outgoing packets which were dropped
+	// (always 0 on OSX and BSD)
+	DropOut uint64 `protobuf:"varint,8,opt,name=drop_out,json=dropOut,proto3" json:"drop_out,omitempty"`
+}
+
+func (x *NetInfo_Interface) Reset() {
+	*x = NetInfo_Interface{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetInfo_Interface) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetInfo_Interface) ProtoMessage() {}
+
+func (x *NetInfo_Interface) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_agent_server_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetInfo_Interface.ProtoReflect.Descriptor instead.
+func (*NetInfo_Interface) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_agent_server_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *NetInfo_Interface) GetBytesSent() uint64 {
+	if x != nil {
+		return x.BytesSent
+	}
+	return 0
+}
+
+func (x *NetInfo_Interface) GetBytesRecv() uint64 {
+	if x != nil {
+		return x.BytesRecv
+	}
+	return 0
+}
+
+func (x *NetInfo_Interface) GetPacketsSent() uint64 {
+	if x != nil {
+		return x.PacketsSent
+	}
+	return 0
+}
+
+func (x *NetInfo_Interface) GetPacketsRecv() uint64 {
+	if x != nil {
+		return x.PacketsRecv
+	}
+	return 0
+}
+
+func (x *NetInfo_Interface) GetErrIn() uint64 {
+	if x != nil {
+		return x.ErrIn
+	}
+	return 0
+}
+
+func (x *NetInfo_Interface) GetErrOut() uint64 {
+	if x != nil {
+		return x.ErrOut
+	}
+	return 0
+}
+
+func (x *NetInfo_Interface) GetDropIn() uint64 {
+	if x != nil {
+		return x.DropIn
+	}
+	return 0
+}
+
+func (x *NetInfo_Interface) GetDropOut() uint64 {
+	if x != nil {
+		return x.DropOut
+	}
+	return 0
+}
+
+var File_proto_v1_squzy_agent_server_proto protoreflect.FileDescriptor
+
+var file_proto_v1_squzy_agent_server_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x71, 0x75, 0x7a, 0x79,
+	0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xce, 0x02, 0x0a, 0x06, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x19, 0x0a, 0x08,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x63, 0x70, 0x75, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x70, 0x75, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x07, 0x63, 0x70, 0x75, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3b, 0x0a, 0x0b, 0x6d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x6d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x35, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f,
+	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x71, 0x75,
+	0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x69, 0x73, 0x6b,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x32,
+	0x0a, 0x08, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x2e, 0x4e, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x22, 0xf7, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x71, 0x75, 0x7a,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x48, 0x00, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x4f, 0x0a, 0x0a, 0x64,
+	0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x48, 0x00,
+	0x52, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x1a, 0x57, 0x0a, 0x0a,
+	0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x05, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x30, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x55,
+	0x0a, 0x07, 0x43, 0x70, 0x75, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2f, 0x0a, 0x04, 0x63, 0x70, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x70, 0x75, 0x49, 0x6e, 0x66, 0x6f,
+	0x2e, 0x43, 0x50, 0x55, 0x52, 0x04, 0x63, 0x70, 0x75, 0x73, 0x1a, 0x19, 0x0a, 0x03, 0x43, 0x50,
+	0x55, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x04, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xfc, 0x01, 0x0a, 0x0a, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x33, 0x0a, 0x03, 0x6d, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x52, 0x03, 0x6d, 0x65, 0x6d, 0x12, 0x35, 0x0a, 0x04, 0x73, 0x77, 0x61,
+	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x49,
+	0x6e, 0x66, 0x6f, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x04, 0x73, 0x77, 0x61, 0x70,
+	0x1a, 0x81, 0x01, 0x0a, 0x06, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x04, 0x75, 0x73, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x65, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x04, 0x66, 0x72, 0x65, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x64, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x22, 0x87, 0x02, 0x0a, 0x08, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x39, 0x0a, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x1a, 0x67, 0x0a, 0x04,
+	0x44, 0x69, 0x73, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72,
+	0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x66, 0x72, 0x65, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x64, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x1a, 0x57, 0x0a, 0x0a, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x33, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x44,
+	0x69, 0x73, 0x6b, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xaa,
+	0x03, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x47, 0x0a, 0x0a, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
+	0x4e, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x73, 0x1a, 0xf3, 0x01, 0x0a, 0x09, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x62, 0x79, 0x74, 0x65, 0x73, 0x53, 0x65, 0x6e, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x76, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x62, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x63, 0x76, 0x12,
+	0x21, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x53, 0x65,
+	0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x5f, 0x72, 0x65,
+	0x63, 0x76, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x52, 0x65, 0x63, 0x76, 0x12, 0x15, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x5f, 0x69, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x72, 0x72, 0x49, 0x6e, 0x12, 0x17, 0x0a, 0x07,
+	0x65, 0x72, 0x72, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x65,
+	0x72, 0x72, 0x4f, 0x75, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x69, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x64, 0x72, 0x6f, 0x70, 0x49, 0x6e, 0x12, 0x19,
+	0x0a, 0x08, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x1a, 0x60, 0x0a, 0x0f, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x37,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4e,
+	0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x36, 0x0a, 0x15, 0x47,
+	0x65, 0x74, 0x42, 0x79, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x49, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x71,
+	0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x53,
+	0x0a, 0x11, 0x55, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x22, 0x24, 0x0a, 0x12, 0x55, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xb3, 0x01, 0x0a, 0x0f, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x09,
+	0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2e, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22,
+	0xc2, 0x01, 0x0a, 0x09, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x73,
+	0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x35, 0x0a, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08,
+	0x68, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x22, 0x7a, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x1b, 0x0a, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x73, 0x12, 0x41, 0x0a,
+	0x0d, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x6e, 0x66, 0x6f,
+	0x22, 0x54, 0x0a, 0x0c, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3d, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e,
+	0x76, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e,
+	0x76, 0x4e, 0x61, 0x6d, 0x65, 0x2a, 0x69, 0x0a, 0x0b, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x47, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x52, 0x45, 0x44, 0x10,
+	0x01, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x55, 0x4e, 0x4e, 0x45, 0x44, 0x10, 0x02, 0x12, 0x10, 0x0a,
+	0x0c, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12,
+	0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x52, 0x45, 0x44, 0x10, 0x04,
+	0x32, 0xfb, 0x03, 0x0a, 0x0b, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x12, 0x4d, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x73,
+	0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x25, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e,
+	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x49, 0x64, 0x12, 0x23,
+	0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
+	0x47, 0x65, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x53,
+	0x0a, 0x0a, 0x55, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x73,
+	0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x6e,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2e, 0x55, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x24, 0x2e, 0x73, 0x71,
+	0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x67, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4b, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x12, 0x22, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x28, 0x01, 0x42, 0x24,
+	0x5a, 0x22, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x71, 0x75,
+	0x7a, 0x79, 0x2f, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x3b, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_v1_squzy_agent_server_proto_rawDescOnce sync.Once
+	file_proto_v1_squzy_agent_server_proto_rawDescData = file_proto_v1_squzy_agent_server_proto_rawDesc
+)
+
+func file_proto_v1_squzy_agent_server_proto_rawDescGZIP() []byte {
+	file_proto_v1_squzy_agent_server_proto_rawDescOnce.Do(func() {
+		file_proto_v1_squzy_agent_server_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_v1_squzy_agent_server_proto_rawDescData)
+	})
+	return file_proto_v1_squzy_agent_server_proto_rawDescData
+}
+
+var file_proto_v1_squzy_agent_server_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_v1_squzy_agent_server_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_proto_v1_squzy_agent_server_proto_goTypes = []interface{}{
+	(AgentStatus)(0),                      // 0: squzy.v1.agent.AgentStatus
+	(*Metric)(nil),                        // 1: squzy.v1.agent.Metric
+	(*SendMetricsRequest)(nil),            // 2: squzy.v1.agent.SendMetricsRequest
+	(*GetAgentByIdRequest)(nil),           // 3: squzy.v1.agent.GetAgentByIdRequest
+	(*CpuInfo)(nil),                       // 4: squzy.v1.agent.CpuInfo
+	(*MemoryInfo)(nil),                    // 5: squzy.v1.agent.MemoryInfo
+	(*DiskInfo)(nil),                      // 6: squzy.v1.agent.DiskInfo
+	(*NetInfo)(nil),                       // 7: squzy.v1.agent.NetInfo
+	(*GetByAgentNameRequest)(nil),         // 8: squzy.v1.agent.GetByAgentNameRequest
+	(*GetAgentListResponse)(nil),          // 9: squzy.v1.agent.GetAgentListResponse
+	(*UnRegisterRequest)(nil),             // 10: squzy.v1.agent.UnRegisterRequest
+	(*UnRegisterResponse)(nil),            // 11: squzy.v1.agent.UnRegisterResponse
+	(*RegisterRequest)(nil),               // 12: squzy.v1.agent.RegisterRequest
+	(*AgentItem)(nil),                     // 13: squzy.v1.agent.AgentItem
+	(*HostInfo)(nil),                      // 14: squzy.v1.agent.HostInfo
+	(*PlatformInfo)(nil),                  // 15: squzy.v1.agent.PlatformInfo
+	(*RegisterResponse)(nil),              // 16: squzy.v1.agent.RegisterResponse
+	(*SendMetricsRequest_Disconnect)(nil), // 17: squzy.v1.agent.SendMetricsRequest.Disconnect
+	(*CpuInfo_CPU)(nil),                   // 18: squzy.v1.agent.CpuInfo.CPU
+	(*MemoryInfo_Memory)(nil),             // 19: squzy.v1.agent.MemoryInfo.Memory
+	(*DiskInfo_Disk)(nil),                 // 20: squzy.v1.agent.DiskInfo.Disk
+	nil,                                   // 21: squzy.v1.agent.DiskInfo.DisksEntry
+	(*NetInfo_Interface)(nil),             // 22: squzy.v1.agent.NetInfo.Interface
+	nil,                                   // 23: squzy.v1.agent.NetInfo.InterfacesEntry
+	(*timestamppb.Timestamp)(nil),         // 24: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                 // 25: google.protobuf.Empty
+}
+var file_proto_v1_squzy_agent_server_proto_depIdxs = []int32{
+	4,  // 0: squzy.v1.agent.Metric.cpu_info:type_name -> squzy.v1.agent.CpuInfo
+	5,  // 1: squzy.v1.agent.Metric.memory_info:type_name -> squzy.v1.agent.MemoryInfo
+	6,  // 2: squzy.v1.agent.Metric.disk_info:type_name -> squzy.v1.agent.DiskInfo
+	7,  // 3: squzy.v1.agent.Metric.net_info:type_name -> squzy.v1.agent.NetInfo
+	24, // 4: squzy.v1.agent.Metric.time:type_name -> google.protobuf.Timestamp
+	1,  // 5: squzy.v1.agent.SendMetricsRequest.metric:type_name -> squzy.v1.agent.Metric
+	17, // 6: squzy.v1.agent.SendMetricsRequest.disconnect:type_name -> squzy.v1.agent.SendMetricsRequest.Disconnect
+	18, // 7: squzy.v1.agent.CpuInfo.cpus:type_name -> squzy.v1.agent.CpuInfo.CPU
+	19, // 8: squzy.v1.agent.MemoryInfo.mem:type_name -> squzy.v1.agent.MemoryInfo.Memory
+	19, // 9: squzy.v1.agent.MemoryInfo.swap:type_name -> squzy.v1.agent.MemoryInfo.Memory
+	21, // 10: squzy.v1.agent.DiskInfo.disks:type_name -> squzy.v1.agent.DiskInfo.DisksEntry
+	23, // 11: squzy.v1.agent.NetInfo.interfaces:type_name -> squzy.v1.agent.NetInfo.InterfacesEntry
+	13, // 12: squzy.v1.agent.GetAgentListResponse.agents:type_name -> squzy.v1.agent.AgentItem
+	24, // 13: squzy.v1.agent.UnRegisterRequest.time:type_name -> google.protobuf.Timestamp
+	14, // 14: squzy.v1.agent.RegisterRequest.host_info:type_name -> squzy.v1.agent.HostInfo
+	24, // 15: squzy.v1.agent.RegisterRequest.time:type_name -> google.protobuf.Timestamp
+	0,  // 16: squzy.v1.agent.AgentItem.status:type_name -> squzy.v1.agent.AgentStatus
+	14, // 17: squzy.v1.agent.AgentItem.host_info:type_name -> squzy.v1.agent.HostInfo
+	15, // 18: squzy.v1.agent.HostInfo.platform_info:type_name -> squzy.v1.agent.PlatformInfo
+	24, // 19: squzy.v1.agent.SendMetricsRequest.Disconnect.time:type_name -> google.protobuf.Timestamp
+	20, // 20: squzy.v1.agent.DiskInfo.DisksEntry.value:type_name -> squzy.v1.agent.DiskInfo.Disk
+	22, // 21: squzy.v1.agent.NetInfo.InterfacesEntry.value:type_name -> squzy.v1.agent.NetInfo.Interface
+	12, // 22: squzy.v1.agent.AgentServer.Register:input_type -> squzy.v1.agent.RegisterRequest
+	8,  // 23: squzy.v1.agent.AgentServer.GetByAgentName:input_type -> squzy.v1.agent.GetByAgentNameRequest
+	3,  // 24: squzy.v1.agent.AgentServer.GetAgentById:input_type -> squzy.v1.agent.GetAgentByIdRequest
+	10, // 25: squzy.v1.agent.AgentServer.UnRegister:input_type -> squzy.v1.agent.UnRegisterRequest
+	25, // 26: squzy.v1.agent.AgentServer.GetAgentList:input_type -> google.protobuf.Empty
+	2,  // 27: squzy.v1.agent.AgentServer.SendMetrics:input_type -> squzy.v1.agent.SendMetricsRequest
+	16, // 28: squzy.v1.agent.AgentServer.Register:output_type -> squzy.v1.agent.RegisterResponse
+	9,  // 29: squzy.v1.agent.AgentServer.GetByAgentName:output_type -> squzy.v1.agent.GetAgentListResponse
+	13, // 30: squzy.v1.agent.AgentServer.GetAgentById:output_type -> squzy.v1.agent.AgentItem
+	11, // 31: squzy.v1.agent.AgentServer.UnRegister:output_type -> squzy.v1.agent.UnRegisterResponse
+	9,  // 32: squzy.v1.agent.AgentServer.GetAgentList:output_type -> squzy.v1.agent.GetAgentListResponse
+	25, // 33: squzy.v1.agent.AgentServer.SendMetrics:output_type -> google.protobuf.Empty
+	28, // [28:34] is the sub-list for method output_type
+	22, // [22:28] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
+}
+
+func init() { file_proto_v1_squzy_agent_server_proto_init() }
+func file_proto_v1_squzy_agent_server_proto_init() {
+	if File_proto_v1_squzy_agent_server_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_v1_squzy_agent_server_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Metric); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_agent_server_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendMetricsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_agent_server_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAgentByIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_agent_server_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CpuInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_agent_server_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemoryInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_agent_server_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_agent_server_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_agent_server_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetByAgentNameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_agent_server_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAgentListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_agent_server_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnRegisterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_agent_server_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnRegisterResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_agent_server_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_agent_server_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_agent_server_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_agent_server_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlatformInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_agent_server_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_agent_server_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendMetricsRequest_Disconnect); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_agent_server_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CpuInfo_CPU); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_agent_server_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemoryInfo_Memory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_agent_server_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskInfo_Disk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_agent_server_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetInfo_Interface); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_proto_v1_squzy_agent_server_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*SendMetricsRequest_Metric)(nil),
+		(*SendMetricsRequest_Disconnect_)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_v1_squzy_agent_server_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   23,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_v1_squzy_agent_server_proto_goTypes,
+		DependencyIndexes: file_proto_v1_squzy_agent_server_proto_depIdxs,
+		EnumInfos:         file_proto_v1_squzy_agent_server_proto_enumTypes,
+		MessageInfos:      file_proto_v1_squzy_agent_server_proto_msgTypes,
+	}.Build()
+	File_proto_v1_squzy_agent_server_proto = out.File
+	file_proto_v1_squzy_agent_server_proto_rawDesc = nil
+	file_proto_v1_squzy_agent_server_proto_goTypes = nil
+	file_proto_v1_squzy_agent_server_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// AgentServerClient is the client API for AgentServer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AgentServerClient interface {
+	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
+	GetByAgentName(ctx context.Context, in *GetByAgentNameRequest, opts ...grpc.CallOption) (*GetAgentListResponse, error)
+	GetAgentById(ctx context.Context, in *GetAgentByIdRequest, opts ...grpc.CallOption) (*AgentItem, error)
+	UnRegister(ctx context.Context, in *UnRegisterRequest, opts ...grpc.CallOption) (*UnRegisterResponse, error)
+	GetAgentList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetAgentListResponse, error)
+	SendMetrics(ctx context.Context, opts ...grpc.CallOption) (AgentServer_SendMetricsClient, error)
+}
+
+type agentServerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAgentServerClient(cc grpc.ClientConnInterface) AgentServerClient {
+	return &agentServerClient{cc}
+}
+
+func (c *agentServerClient) Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error) {
+	out := new(RegisterResponse)
+	err := c.cc.Invoke(ctx, "/squzy.v1.agent.AgentServer/Register", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServerClient) GetByAgentName(ctx context.Context, in *GetByAgentNameRequest, opts ...grpc.CallOption) (*GetAgentListResponse, error) {
+	out := new(GetAgentListResponse)
+	err := c.cc.Invoke(ctx, "/squzy.v1.agent.AgentServer/GetByAgentName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServerClient) GetAgentById(ctx context.Context, in *GetAgentByIdRequest, opts ...grpc.CallOption) (*AgentItem, error) {
+	out := new(AgentItem)
+	err := c.cc.Invoke(ctx, "/squzy.v1.agent.AgentServer/GetAgentById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServerClient) UnRegister(ctx context.Context, in *UnRegisterRequest, opts ...grpc.CallOption) (*UnRegisterResponse, error) {
+	out := new(UnRegisterResponse)
+	err := c.cc.Invoke(ctx, "/squzy.v1.agent.AgentServer/UnRegister", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServerClient) GetAgentList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetAgentListResponse, error) {
+	out := new(GetAgentListResponse)
+	err := c.cc.Invoke(ctx, "/squzy.v1.agent.AgentServer/GetAgentList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServerClient) SendMetrics(ctx context.Context, opts ...grpc.CallOption) (AgentServer_SendMetricsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AgentServer_serviceDesc.Streams[0], "/squzy.v1.agent.AgentServer/SendMetrics", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &agentServerSendMetricsClient{stream}
+	return x, nil
+}
+
+type AgentServer_SendMetricsClient interface {
+	Send(*SendMetricsRequest) error
+	CloseAndRecv() (*emptypb.Empty, error)
+	grpc.ClientStream
+}
+
+type agentServerSendMetricsClient struct {
+	grpc.ClientStream
+}
+
+func (x *agentServerSendMetricsClient) Send(m *SendMetricsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *agentServerSendMetricsClient) CloseAndRecv() (*emptypb.Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(emptypb.Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// AgentServerServer is the server API for AgentServer service.
+type AgentServerServer interface {
+	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
+	GetByAgentName(context.Context, *GetByAgentNameRequest) (*GetAgentListResponse, error)
+	GetAgentById(context.Context, *GetAgentByIdRequest) (*AgentItem, error)
+	UnRegister(context.Context, *UnRegisterRequest) (*UnRegisterResponse, error)
+	GetAgentList(context.Context, *emptypb.Empty) (*GetAgentListResponse, error)
+	SendMetrics(AgentServer_SendMetricsServer) error
+}
+
+// UnimplementedAgentServerServer can be embedded to have forward compatible implementations.
+type UnimplementedAgentServerServer struct {
+}
+
+func (*UnimplementedAgentServerServer) Register(context.Context, *RegisterRequest) (*RegisterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+func (*UnimplementedAgentServerServer) GetByAgentName(context.Context, *GetByAgentNameRequest) (*GetAgentListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByAgentName not implemented")
+}
+func (*UnimplementedAgentServerServer) GetAgentById(context.Context, *GetAgentByIdRequest) (*AgentItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAgentById not implemented")
+}
+func (*UnimplementedAgentServerServer) UnRegister(context.Context, *UnRegisterRequest) (*UnRegisterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnRegister not implemented")
+}
+func (*UnimplementedAgentServerServer) GetAgentList(context.Context, *emptypb.Empty) (*GetAgentListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAgentList not implemented")
+}
+func (*UnimplementedAgentServerServer) SendMetrics(AgentServer_SendMetricsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SendMetrics not implemented")
+}
+
+func RegisterAgentServerServer(s *grpc.Server, srv AgentServerServer) {
+	s.RegisterService(&_AgentServer_serviceDesc, srv)
+}
+
+func _AgentServer_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServerServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.agent.AgentServer/Register",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServerServer).Register(ctx, req.(*RegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentServer_GetByAgentName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByAgentNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServerServer).GetByAgentName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.agent.AgentServer/GetByAgentName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServerServer).GetByAgentName(ctx, req.(*GetByAgentNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentServer_GetAgentById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAgentByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServerServer).GetAgentById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.agent.AgentServer/GetAgentById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServerServer).GetAgentById(ctx, req.(*GetAgentByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentServer_UnRegister_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnRegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServerServer).UnRegister(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.agent.AgentServer/UnRegister",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServerServer).UnRegister(ctx, req.(*UnRegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentServer_GetAgentList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServerServer).GetAgentList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.agent.AgentServer/GetAgentList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServerServer).GetAgentList(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentServer_SendMetrics_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AgentServerServer).SendMetrics(&agentServerSendMetricsServer{stream})
+}
+
+type AgentServer_SendMetricsServer interface {
+	SendAndClose(*emptypb.Empty) error
+	Recv() (*SendMetricsRequest, error)
+	grpc.ServerStream
+}
+
+type agentServerSendMetricsServer struct {
+	grpc.ServerStream
+}
+
+func (x *agentServerSendMetricsServer) SendAndClose(m *emptypb.Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *agentServerSendMetricsServer) Recv() (*SendMetricsRequest, error) {
+	m := new(SendMetricsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+var _AgentServer_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "squzy.v1.agent.AgentServer",
+	HandlerType: (*AgentServerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Register",
+			Handler:    _AgentServer_Register_Handler,
+		},
+		{
+			MethodName: "GetByAgentName",
+			Handler:    _AgentServer_GetByAgentName_Handler,
+		},
+		{
+			MethodName: "GetAgentById",
+			Handler:    _AgentServer_GetAgentById_Handler,
+		},
+		{
+			MethodName: "UnRegister",
+			Handler:    _AgentServer_UnRegister_Handler,
+		},
+		{
+			MethodName: "GetAgentList",
+			Handler:    _AgentServer_GetAgentList_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SendMetrics",
+			Handler:       _AgentServer_SendMetrics_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "proto/v1/squzy_agent_server.proto",
+}
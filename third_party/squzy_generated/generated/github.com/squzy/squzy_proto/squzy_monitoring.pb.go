@@ -0,0 +1,4864 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        (unknown)
+// source: proto/v1/squzy_monitoring.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SchedulerCode int32
+
+const (
+	SchedulerCode_SCHEDULER_CODE_UNSPECIFIED SchedulerCode = 0
+	SchedulerCode_OK                         SchedulerCode = 1
+	SchedulerCode_ERROR                      SchedulerCode = 2
+)
+
+// Enum value maps for SchedulerCode.
+var (
+	SchedulerCode_name = map[int32]string{
+		0: "SCHEDULER_CODE_UNSPECIFIED",
+		1: "OK",
+		2: "ERROR",
+	}
+	SchedulerCode_value = map[string]int32{
+		"SCHEDULER_CODE_UNSPECIFIED": 0,
+		"OK":                         1,
+		"ERROR":                      2,
+	}
+)
+
+func (x SchedulerCode) Enum() *SchedulerCode {
+	p := new(SchedulerCode)
+	*p = x
+	return p
+}
+
+func (x SchedulerCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SchedulerCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_squzy_monitoring_proto_enumTypes[0].Descriptor()
+}
+
+func (SchedulerCode) Type() protoreflect.EnumType {
+	return &file_proto_v1_squzy_monitoring_proto_enumTypes[0]
+}
+
+func (x SchedulerCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SchedulerCode.Descriptor instead.
+func (SchedulerCode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{0}
+}
+
+type SchedulerStatus int32
+
+const (
+	SchedulerStatus_SCHEDULER_STATUS_UNSPECIFIED SchedulerStatus = 0
+	SchedulerStatus_RUNNED                       SchedulerStatus = 1
+	SchedulerStatus_STOPPED                      SchedulerStatus = 2
+	SchedulerStatus_REMOVED                      SchedulerStatus = 3
+)
+
+// Enum value maps for SchedulerStatus.
+var (
+	SchedulerStatus_name = map[int32]string{
+		0: "SCHEDULER_STATUS_UNSPECIFIED",
+		1: "RUNNED",
+		2: "STOPPED",
+		3: "REMOVED",
+	}
+	SchedulerStatus_value = map[string]int32{
+		"SCHEDULER_STATUS_UNSPECIFIED": 0,
+		"RUNNED":                       1,
+		"STOPPED":                      2,
+		"REMOVED":                      3,
+	}
+)
+
+func (x SchedulerStatus) Enum() *SchedulerStatus {
+	p := new(SchedulerStatus)
+	*p = x
+	return p
+}
+
+func (x SchedulerStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SchedulerStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_squzy_monitoring_proto_enumTypes[1].Descriptor()
+}
+
+func (SchedulerStatus) Type() protoreflect.EnumType {
+	return &file_proto_v1_squzy_monitoring_proto_enumTypes[1]
+}
+
+func (x SchedulerStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SchedulerStatus.Descriptor instead.
+func (SchedulerStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{1}
+}
+
+type SchedulerType int32
+
+const (
+	SchedulerType_SCHEDULER_TYPE_UNSPECIFIED SchedulerType = 0
+	SchedulerType_TCP                        SchedulerType = 1
+	SchedulerType_GRPC                       SchedulerType = 2
+	SchedulerType_HTTP                       SchedulerType = 3
+	SchedulerType_SITE_MAP                   SchedulerType = 4
+	SchedulerType_HTTP_JSON_VALUE            SchedulerType = 5
+	SchedulerType_SSL_EXPIRATION             SchedulerType = 6
+	SchedulerType_WEBSOCKET                  SchedulerType = 7
+	SchedulerType_DATABASE                   SchedulerType = 8
+	SchedulerType_HEARTBEAT                  SchedulerType = 9
+	SchedulerType_CONTENT_CHANGE             SchedulerType = 10
+	SchedulerType_PROMETHEUS                 SchedulerType = 11
+)
+
+// Enum value maps for SchedulerType.
+var (
+	SchedulerType_name = map[int32]string{
+		0:  "SCHEDULER_TYPE_UNSPECIFIED",
+		1:  "TCP",
+		2:  "GRPC",
+		3:  "HTTP",
+		4:  "SITE_MAP",
+		5:  "HTTP_JSON_VALUE",
+		6:  "SSL_EXPIRATION",
+		7:  "WEBSOCKET",
+		8:  "DATABASE",
+		9:  "HEARTBEAT",
+		10: "CONTENT_CHANGE",
+		11: "PROMETHEUS",
+	}
+	SchedulerType_value = map[string]int32{
+		"SCHEDULER_TYPE_UNSPECIFIED": 0,
+		"TCP":                        1,
+		"GRPC":                       2,
+		"HTTP":                       3,
+		"SITE_MAP":                   4,
+		"HTTP_JSON_VALUE":            5,
+		"SSL_EXPIRATION":             6,
+		"WEBSOCKET":                  7,
+		"DATABASE":                   8,
+		"HEARTBEAT":                  9,
+		"CONTENT_CHANGE":             10,
+		"PROMETHEUS":                 11,
+	}
+)
+
+func (x SchedulerType) Enum() *SchedulerType {
+	p := new(SchedulerType)
+	*p = x
+	return p
+}
+
+func (x SchedulerType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SchedulerType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_squzy_monitoring_proto_enumTypes[2].Descriptor()
+}
+
+func (SchedulerType) Type() protoreflect.EnumType {
+	return &file_proto_v1_squzy_monitoring_proto_enumTypes[2]
+}
+
+func (x SchedulerType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SchedulerType.Descriptor instead.
+func (SchedulerType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{2}
+}
+
+type HttpAuthConfig_Type int32
+
+const (
+	HttpAuthConfig_AUTH_TYPE_UNSPECIFIED     HttpAuthConfig_Type = 0
+	HttpAuthConfig_BASIC                     HttpAuthConfig_Type = 1
+	HttpAuthConfig_BEARER                    HttpAuthConfig_Type = 2
+	HttpAuthConfig_OAUTH2_CLIENT_CREDENTIALS HttpAuthConfig_Type = 3
+	HttpAuthConfig_AWS_SIGV4                 HttpAuthConfig_Type = 4
+)
+
+// Enum value maps for HttpAuthConfig_Type.
+var (
+	HttpAuthConfig_Type_name = map[int32]string{
+		0: "AUTH_TYPE_UNSPECIFIED",
+		1: "BASIC",
+		2: "BEARER",
+		3: "OAUTH2_CLIENT_CREDENTIALS",
+		4: "AWS_SIGV4",
+	}
+	HttpAuthConfig_Type_value = map[string]int32{
+		"AUTH_TYPE_UNSPECIFIED":     0,
+		"BASIC":                     1,
+		"BEARER":                    2,
+		"OAUTH2_CLIENT_CREDENTIALS": 3,
+		"AWS_SIGV4":                 4,
+	}
+)
+
+func (x HttpAuthConfig_Type) Enum() *HttpAuthConfig_Type {
+	p := new(HttpAuthConfig_Type)
+	*p = x
+	return p
+}
+
+func (x HttpAuthConfig_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HttpAuthConfig_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_squzy_monitoring_proto_enumTypes[3].Descriptor()
+}
+
+func (HttpAuthConfig_Type) Type() protoreflect.EnumType {
+	return &file_proto_v1_squzy_monitoring_proto_enumTypes[3]
+}
+
+func (x HttpAuthConfig_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HttpAuthConfig_Type.Descriptor instead.
+func (HttpAuthConfig_Type) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{7, 0}
+}
+
+type DatabaseConfig_DatabaseType int32
+
+const (
+	DatabaseConfig_DATABASE_TYPE_UNSPECIFIED DatabaseConfig_DatabaseType = 0
+	DatabaseConfig_POSTGRES                  DatabaseConfig_DatabaseType = 1
+	DatabaseConfig_MYSQL                     DatabaseConfig_DatabaseType = 2
+	DatabaseConfig_REDIS                     DatabaseConfig_DatabaseType = 3
+	DatabaseConfig_MONGO                     DatabaseConfig_DatabaseType = 4
+)
+
+// Enum value maps for DatabaseConfig_DatabaseType.
+var (
+	DatabaseConfig_DatabaseType_name = map[int32]string{
+		0: "DATABASE_TYPE_UNSPECIFIED",
+		1: "POSTGRES",
+		2: "MYSQL",
+		3: "REDIS",
+		4: "MONGO",
+	}
+	DatabaseConfig_DatabaseType_value = map[string]int32{
+		"DATABASE_TYPE_UNSPECIFIED": 0,
+		"POSTGRES":                  1,
+		"MYSQL":                     2,
+		"REDIS":                     3,
+		"MONGO":                     4,
+	}
+)
+
+func (x DatabaseConfig_DatabaseType) Enum() *DatabaseConfig_DatabaseType {
+	p := new(DatabaseConfig_DatabaseType)
+	*p = x
+	return p
+}
+
+func (x DatabaseConfig_DatabaseType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DatabaseConfig_DatabaseType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_squzy_monitoring_proto_enumTypes[4].Descriptor()
+}
+
+func (DatabaseConfig_DatabaseType) Type() protoreflect.EnumType {
+	return &file_proto_v1_squzy_monitoring_proto_enumTypes[4]
+}
+
+func (x DatabaseConfig_DatabaseType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DatabaseConfig_DatabaseType.Descriptor instead.
+func (DatabaseConfig_DatabaseType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{13, 0}
+}
+
+type DatabaseConfig_Operator int32
+
+const (
+	DatabaseConfig_OPERATOR_UNSPECIFIED DatabaseConfig_Operator = 0
+	DatabaseConfig_EQUAL                DatabaseConfig_Operator = 1
+	DatabaseConfig_NOT_EQUAL            DatabaseConfig_Operator = 2
+	DatabaseConfig_GREATER              DatabaseConfig_Operator = 3
+	DatabaseConfig_GREATER_OR_EQUAL     DatabaseConfig_Operator = 4
+	DatabaseConfig_LESS                 DatabaseConfig_Operator = 5
+	DatabaseConfig_LESS_OR_EQUAL        DatabaseConfig_Operator = 6
+)
+
+// Enum value maps for DatabaseConfig_Operator.
+var (
+	DatabaseConfig_Operator_name = map[int32]string{
+		0: "OPERATOR_UNSPECIFIED",
+		1: "EQUAL",
+		2: "NOT_EQUAL",
+		3: "GREATER",
+		4: "GREATER_OR_EQUAL",
+		5: "LESS",
+		6: "LESS_OR_EQUAL",
+	}
+	DatabaseConfig_Operator_value = map[string]int32{
+		"OPERATOR_UNSPECIFIED": 0,
+		"EQUAL":                1,
+		"NOT_EQUAL":            2,
+		"GREATER":              3,
+		"GREATER_OR_EQUAL":     4,
+		"LESS":                 5,
+		"LESS_OR_EQUAL":        6,
+	}
+)
+
+func (x DatabaseConfig_Operator) Enum() *DatabaseConfig_Operator {
+	p := new(DatabaseConfig_Operator)
+	*p = x
+	return p
+}
+
+func (x DatabaseConfig_Operator) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DatabaseConfig_Operator) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_squzy_monitoring_proto_enumTypes[5].Descriptor()
+}
+
+func (DatabaseConfig_Operator) Type() protoreflect.EnumType {
+	return &file_proto_v1_squzy_monitoring_proto_enumTypes[5]
+}
+
+func (x DatabaseConfig_Operator) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DatabaseConfig_Operator.Descriptor instead.
+func (DatabaseConfig_Operator) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{13, 1}
+}
+
+type ContentChangeConfig_SelectorType int32
+
+const (
+	ContentChangeConfig_SELECTOR_TYPE_UNSPECIFIED ContentChangeConfig_SelectorType = 0
+	ContentChangeConfig_CSS                       ContentChangeConfig_SelectorType = 1
+	ContentChangeConfig_XPATH                     ContentChangeConfig_SelectorType = 2
+	ContentChangeConfig_JSON                      ContentChangeConfig_SelectorType = 3
+)
+
+// Enum value maps for ContentChangeConfig_SelectorType.
+var (
+	ContentChangeConfig_SelectorType_name = map[int32]string{
+		0: "SELECTOR_TYPE_UNSPECIFIED",
+		1: "CSS",
+		2: "XPATH",
+		3: "JSON",
+	}
+	ContentChangeConfig_SelectorType_value = map[string]int32{
+		"SELECTOR_TYPE_UNSPECIFIED": 0,
+		"CSS":                       1,
+		"XPATH":                     2,
+		"JSON":                      3,
+	}
+)
+
+func (x ContentChangeConfig_SelectorType) Enum() *ContentChangeConfig_SelectorType {
+	p := new(ContentChangeConfig_SelectorType)
+	*p = x
+	return p
+}
+
+func (x ContentChangeConfig_SelectorType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContentChangeConfig_SelectorType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_squzy_monitoring_proto_enumTypes[6].Descriptor()
+}
+
+func (ContentChangeConfig_SelectorType) Type() protoreflect.EnumType {
+	return &file_proto_v1_squzy_monitoring_proto_enumTypes[6]
+}
+
+func (x ContentChangeConfig_SelectorType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContentChangeConfig_SelectorType.Descriptor instead.
+func (ContentChangeConfig_SelectorType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{15, 0}
+}
+
+type HttpJsonValueConfig_JsonValueParseType int32
+
+const (
+	HttpJsonValueConfig_JSON_PARSE_VALUE_UNSPECIFIED HttpJsonValueConfig_JsonValueParseType = 0
+	HttpJsonValueConfig_STRING                       HttpJsonValueConfig_JsonValueParseType = 1
+	HttpJsonValueConfig_BOOL                         HttpJsonValueConfig_JsonValueParseType = 2
+	HttpJsonValueConfig_NUMBER                       HttpJsonValueConfig_JsonValueParseType = 3
+	HttpJsonValueConfig_TIME                         HttpJsonValueConfig_JsonValueParseType = 4
+	HttpJsonValueConfig_ANY                          HttpJsonValueConfig_JsonValueParseType = 5
+	HttpJsonValueConfig_RAW                          HttpJsonValueConfig_JsonValueParseType = 6
+)
+
+// Enum value maps for HttpJsonValueConfig_JsonValueParseType.
+var (
+	HttpJsonValueConfig_JsonValueParseType_name = map[int32]string{
+		0: "JSON_PARSE_VALUE_UNSPECIFIED",
+		1: "STRING",
+		2: "BOOL",
+		3: "NUMBER",
+		4: "TIME",
+		5: "ANY",
+		6: "RAW",
+	}
+	HttpJsonValueConfig_JsonValueParseType_value = map[string]int32{
+		"JSON_PARSE_VALUE_UNSPECIFIED": 0,
+		"STRING":                       1,
+		"BOOL":                         2,
+		"NUMBER":                       3,
+		"TIME":                         4,
+		"ANY":                          5,
+		"RAW":                          6,
+	}
+)
+
+func (x HttpJsonValueConfig_JsonValueParseType) Enum() *HttpJsonValueConfig_JsonValueParseType {
+	p := new(HttpJsonValueConfig_JsonValueParseType)
+	*p = x
+	return p
+}
+
+func (x HttpJsonValueConfig_JsonValueParseType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HttpJsonValueConfig_JsonValueParseType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_squzy_monitoring_proto_enumTypes[7].Descriptor()
+}
+
+func (HttpJsonValueConfig_JsonValueParseType) Type() protoreflect.EnumType {
+	return &file_proto_v1_squzy_monitoring_proto_enumTypes[7]
+}
+
+func (x HttpJsonValueConfig_JsonValueParseType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HttpJsonValueConfig_JsonValueParseType.Descriptor instead.
+func (HttpJsonValueConfig_JsonValueParseType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{17, 0}
+}
+
+type PingRequest_PingType int32
+
+const (
+	PingRequest_PING_TYPE_UNSPECIFIED PingRequest_PingType = 0
+	PingRequest_SUCCESS               PingRequest_PingType = 1
+	PingRequest_START                 PingRequest_PingType = 2
+	PingRequest_FAIL                  PingRequest_PingType = 3
+)
+
+// Enum value maps for PingRequest_PingType.
+var (
+	PingRequest_PingType_name = map[int32]string{
+		0: "PING_TYPE_UNSPECIFIED",
+		1: "SUCCESS",
+		2: "START",
+		3: "FAIL",
+	}
+	PingRequest_PingType_value = map[string]int32{
+		"PING_TYPE_UNSPECIFIED": 0,
+		"SUCCESS":               1,
+		"START":                 2,
+		"FAIL":                  3,
+	}
+)
+
+func (x PingRequest_PingType) Enum() *PingRequest_PingType {
+	p := new(PingRequest_PingType)
+	*p = x
+	return p
+}
+
+func (x PingRequest_PingType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PingRequest_PingType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_squzy_monitoring_proto_enumTypes[8].Descriptor()
+}
+
+func (PingRequest_PingType) Type() protoreflect.EnumType {
+	return &file_proto_v1_squzy_monitoring_proto_enumTypes[8]
+}
+
+func (x PingRequest_PingType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PingRequest_PingType.Descriptor instead.
+func (PingRequest_PingType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{24, 0}
+}
+
+type SchedulerSnapshotWithId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Snapshot *SchedulerSnapshot `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	Id       string             `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *SchedulerSnapshotWithId) Reset() {
+	*x = SchedulerSnapshotWithId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchedulerSnapshotWithId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulerSnapshotWithId) ProtoMessage() {}
+
+func (x *SchedulerSnapshotWithId) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulerSnapshotWithId.ProtoReflect.Descriptor instead.
+func (*SchedulerSnapshotWithId) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SchedulerSnapshotWithId) GetSnapshot() *SchedulerSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *SchedulerSnapshotWithId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type SchedulerSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code  SchedulerCode               `protobuf:"varint,2,opt,name=code,proto3,enum=squzy.v1.monitoring.SchedulerCode" json:"code,omitempty"`
+	Type  SchedulerType               `protobuf:"varint,3,opt,name=type,proto3,enum=squzy.v1.monitoring.SchedulerType" json:"type,omitempty"`
+	Error *SchedulerSnapshot_Error    `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Meta  *SchedulerSnapshot_MetaData `protobuf:"bytes,5,opt,name=meta,proto3" json:"meta,omitempty"`
+}
+
+func (x *SchedulerSnapshot) Reset() {
+	*x = SchedulerSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchedulerSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulerSnapshot) ProtoMessage() {}
+
+func (x *SchedulerSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulerSnapshot.ProtoReflect.Descriptor instead.
+func (*SchedulerSnapshot) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SchedulerSnapshot) GetCode() SchedulerCode {
+	if x != nil {
+		return x.Code
+	}
+	return SchedulerCode_SCHEDULER_CODE_UNSPECIFIED
+}
+
+func (x *SchedulerSnapshot) GetType() SchedulerType {
+	if x != nil {
+		return x.Type
+	}
+	return SchedulerType_SCHEDULER_TYPE_UNSPECIFIED
+}
+
+func (x *SchedulerSnapshot) GetError() *SchedulerSnapshot_Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *SchedulerSnapshot) GetMeta() *SchedulerSnapshot_MetaData {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type GetSchedulerByIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetSchedulerByIdRequest) Reset() {
+	*x = GetSchedulerByIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSchedulerByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSchedulerByIdRequest) ProtoMessage() {}
+
+func (x *GetSchedulerByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSchedulerByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetSchedulerByIdRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetSchedulerByIdRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Scheduler struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name     string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type     SchedulerType   `protobuf:"varint,3,opt,name=type,proto3,enum=squzy.v1.monitoring.SchedulerType" json:"type,omitempty"`
+	Status   SchedulerStatus `protobuf:"varint,4,opt,name=status,proto3,enum=squzy.v1.monitoring.SchedulerStatus" json:"status,omitempty"`
+	Interval int32           `protobuf:"varint,5,opt,name=interval,proto3" json:"interval,omitempty"`
+	Timeout  int32           `protobuf:"varint,6,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Types that are assignable to Config:
+	//	*Scheduler_Tcp
+	//	*Scheduler_Sitemap
+	//	*Scheduler_Grpc
+	//	*Scheduler_Http
+	//	*Scheduler_HttpValue
+	//	*Scheduler_SslExpiration
+	//	*Scheduler_Websocket
+	//	*Scheduler_Database
+	//	*Scheduler_Heartbeat
+	//	*Scheduler_ContentChange
+	//	*Scheduler_Prometheus
+	Config isScheduler_Config `protobuf_oneof:"config"`
+}
+
+func (x *Scheduler) Reset() {
+	*x = Scheduler{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Scheduler) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Scheduler) ProtoMessage() {}
+
+func (x *Scheduler) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Scheduler.ProtoReflect.Descriptor instead.
+func (*Scheduler) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Scheduler) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Scheduler) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Scheduler) GetType() SchedulerType {
+	if x != nil {
+		return x.Type
+	}
+	return SchedulerType_SCHEDULER_TYPE_UNSPECIFIED
+}
+
+func (x *Scheduler) GetStatus() SchedulerStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SchedulerStatus_SCHEDULER_STATUS_UNSPECIFIED
+}
+
+func (x *Scheduler) GetInterval() int32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *Scheduler) GetTimeout() int32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (m *Scheduler) GetConfig() isScheduler_Config {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (x *Scheduler) GetTcp() *TcpConfig {
+	if x, ok := x.GetConfig().(*Scheduler_Tcp); ok {
+		return x.Tcp
+	}
+	return nil
+}
+
+func (x *Scheduler) GetSitemap() *SiteMapConfig {
+	if x, ok := x.GetConfig().(*Scheduler_Sitemap); ok {
+		return x.Sitemap
+	}
+	return nil
+}
+
+func (x *Scheduler) GetGrpc() *GrpcConfig {
+	if x, ok := x.GetConfig().(*Scheduler_Grpc); ok {
+		return x.Grpc
+	}
+	return nil
+}
+
+func (x *Scheduler) GetHttp() *HttpConfig {
+	if x, ok := x.GetConfig().(*Scheduler_Http); ok {
+		return x.Http
+	}
+	return nil
+}
+
+func (x *Scheduler) GetHttpValue() *HttpJsonValueConfig {
+	if x, ok := x.GetConfig().(*Scheduler_HttpValue); ok {
+		return x.HttpValue
+	}
+	return nil
+}
+
+func (x *Scheduler) GetSslExpiration() *SslExpirationConfig {
+	if x, ok := x.GetConfig().(*Scheduler_SslExpiration); ok {
+		return x.SslExpiration
+	}
+	return nil
+}
+
+func (x *Scheduler) GetWebsocket() *WebSocketConfig {
+	if x, ok := x.GetConfig().(*Scheduler_Websocket); ok {
+		return x.Websocket
+	}
+	return nil
+}
+
+func (x *Scheduler) GetDatabase() *DatabaseConfig {
+	if x, ok := x.GetConfig().(*Scheduler_Database); ok {
+		return x.Database
+	}
+	return nil
+}
+
+func (x *Scheduler) GetHeartbeat() *HeartbeatConfig {
+	if x, ok := x.GetConfig().(*Scheduler_Heartbeat); ok {
+		return x.Heartbeat
+	}
+	return nil
+}
+
+func (x *Scheduler) GetContentChange() *ContentChangeConfig {
+	if x, ok := x.GetConfig().(*Scheduler_ContentChange); ok {
+		return x.ContentChange
+	}
+	return nil
+}
+
+func (x *Scheduler) GetPrometheus() *PrometheusConfig {
+	if x, ok := x.GetConfig().(*Scheduler_Prometheus); ok {
+		return x.Prometheus
+	}
+	return nil
+}
+
+type isScheduler_Config interface {
+	isScheduler_Config()
+}
+
+type Scheduler_Tcp struct {
+	Tcp *TcpConfig `protobuf:"bytes,7,opt,name=tcp,proto3,oneof"`
+}
+
+type Scheduler_Sitemap struct {
+	Sitemap *SiteMapConfig `protobuf:"bytes,8,opt,name=sitemap,proto3,oneof"`
+}
+
+type Scheduler_Grpc struct {
+	Grpc *GrpcConfig `protobuf:"bytes,9,opt,name=grpc,proto3,oneof"`
+}
+
+type Scheduler_Http struct {
+	Http *HttpConfig `protobuf:"bytes,10,opt,name=http,proto3,oneof"`
+}
+
+type Scheduler_HttpValue struct {
+	HttpValue *HttpJsonValueConfig `protobuf:"bytes,11,opt,name=http_value,json=httpValue,proto3,oneof"`
+}
+
+type Scheduler_SslExpiration struct {
+	SslExpiration *SslExpirationConfig `protobuf:"bytes,12,opt,name=ssl_expiration,json=sslExpiration,proto3,oneof"`
+}
+
+type Scheduler_Websocket struct {
+	Websocket *WebSocketConfig `protobuf:"bytes,13,opt,name=websocket,proto3,oneof"`
+}
+
+type Scheduler_Database struct {
+	Database *DatabaseConfig `protobuf:"bytes,14,opt,name=database,proto3,oneof"`
+}
+
+type Scheduler_Heartbeat struct {
+	Heartbeat *HeartbeatConfig `protobuf:"bytes,15,opt,name=heartbeat,proto3,oneof"`
+}
+
+type Scheduler_ContentChange struct {
+	ContentChange *ContentChangeConfig `protobuf:"bytes,16,opt,name=content_change,json=contentChange,proto3,oneof"`
+}
+
+type Scheduler_Prometheus struct {
+	Prometheus *PrometheusConfig `protobuf:"bytes,17,opt,name=prometheus,proto3,oneof"`
+}
+
+func (*Scheduler_Tcp) isScheduler_Config() {}
+
+func (*Scheduler_Sitemap) isScheduler_Config() {}
+
+func (*Scheduler_Grpc) isScheduler_Config() {}
+
+func (*Scheduler_Http) isScheduler_Config() {}
+
+func (*Scheduler_HttpValue) isScheduler_Config() {}
+
+func (*Scheduler_SslExpiration) isScheduler_Config() {}
+
+func (*Scheduler_Websocket) isScheduler_Config() {}
+
+func (*Scheduler_Database) isScheduler_Config() {}
+
+func (*Scheduler_Heartbeat) isScheduler_Config() {}
+
+func (*Scheduler_ContentChange) isScheduler_Config() {}
+
+func (*Scheduler_Prometheus) isScheduler_Config() {}
+
+type GetSchedulerListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lists []*Scheduler `protobuf:"bytes,1,rep,name=lists,proto3" json:"lists,omitempty"`
+}
+
+func (x *GetSchedulerListResponse) Reset() {
+	*x = GetSchedulerListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSchedulerListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSchedulerListResponse) ProtoMessage() {}
+
+func (x *GetSchedulerListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSchedulerListResponse.ProtoReflect.Descriptor instead.
+func (*GetSchedulerListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetSchedulerListResponse) GetLists() []*Scheduler {
+	if x != nil {
+		return x.Lists
+	}
+	return nil
+}
+
+type SiteMapConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url         string            `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Concurrency int32             `protobuf:"varint,2,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	Client      *HttpClientConfig `protobuf:"bytes,3,opt,name=client,proto3" json:"client,omitempty"`
+}
+
+func (x *SiteMapConfig) Reset() {
+	*x = SiteMapConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SiteMapConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteMapConfig) ProtoMessage() {}
+
+func (x *SiteMapConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteMapConfig.ProtoReflect.Descriptor instead.
+func (*SiteMapConfig) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SiteMapConfig) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *SiteMapConfig) GetConcurrency() int32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+func (x *SiteMapConfig) GetClient() *HttpClientConfig {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+// Client with default options is used if not set, clients are shared between schedulers with the same options
+type HttpClientConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Example: http://proxy:3128, proxy from environment is used if empty
+	ProxyUrl string `protobuf:"bytes,1,opt,name=proxy_url,json=proxyUrl,proto3" json:"proxy_url,omitempty"`
+	// PEM encoded certificates which are trusted in addition to the system ones
+	CaBundle string `protobuf:"bytes,2,opt,name=ca_bundle,json=caBundle,proto3" json:"ca_bundle,omitempty"`
+	// PEM encoded client certificate and key for mTLS
+	ClientCert string `protobuf:"bytes,3,opt,name=client_cert,json=clientCert,proto3" json:"client_cert,omitempty"`
+	// Should be secret reference, example: {{secret "client-key"}}
+	ClientKey          string `protobuf:"bytes,4,opt,name=client_key,json=clientKey,proto3" json:"client_key,omitempty"`
+	InsecureSkipVerify bool   `protobuf:"varint,5,opt,name=insecure_skip_verify,json=insecureSkipVerify,proto3" json:"insecure_skip_verify,omitempty"`
+	DisableHttp2       bool   `protobuf:"varint,6,opt,name=disable_http2,json=disableHttp2,proto3" json:"disable_http2,omitempty"`
+	// Local address of the outgoing connections
+	SourceIp string `protobuf:"bytes,7,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty"`
+}
+
+func (x *HttpClientConfig) Reset() {
+	*x = HttpClientConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HttpClientConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpClientConfig) ProtoMessage() {}
+
+func (x *HttpClientConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpClientConfig.ProtoReflect.Descriptor instead.
+func (*HttpClientConfig) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *HttpClientConfig) GetProxyUrl() string {
+	if x != nil {
+		return x.ProxyUrl
+	}
+	return ""
+}
+
+func (x *HttpClientConfig) GetCaBundle() string {
+	if x != nil {
+		return x.CaBundle
+	}
+	return ""
+}
+
+func (x *HttpClientConfig) GetClientCert() string {
+	if x != nil {
+		return x.ClientCert
+	}
+	return ""
+}
+
+func (x *HttpClientConfig) GetClientKey() string {
+	if x != nil {
+		return x.ClientKey
+	}
+	return ""
+}
+
+func (x *HttpClientConfig) GetInsecureSkipVerify() bool {
+	if x != nil {
+		return x.InsecureSkipVerify
+	}
+	return false
+}
+
+func (x *HttpClientConfig) GetDisableHttp2() bool {
+	if x != nil {
+		return x.DisableHttp2
+	}
+	return false
+}
+
+func (x *HttpClientConfig) GetSourceIp() string {
+	if x != nil {
+		return x.SourceIp
+	}
+	return ""
+}
+
+// Headers set by authentication override static headers with the same name
+type HttpAuthConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type HttpAuthConfig_Type `protobuf:"varint,1,opt,name=type,proto3,enum=squzy.v1.monitoring.HttpAuthConfig_Type" json:"type,omitempty"`
+	// BASIC
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	// BEARER
+	Token string `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	// OAUTH2_CLIENT_CREDENTIALS, token is cached and refreshed before expiry
+	TokenUrl     string   `protobuf:"bytes,5,opt,name=token_url,json=tokenUrl,proto3" json:"token_url,omitempty"`
+	ClientId     string   `protobuf:"bytes,6,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientSecret string   `protobuf:"bytes,7,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	Scopes       []string `protobuf:"bytes,8,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// AWS_SIGV4
+	AccessKey string `protobuf:"bytes,9,opt,name=access_key,json=accessKey,proto3" json:"access_key,omitempty"`
+	SecretKey string `protobuf:"bytes,10,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
+	// Optional token of temporary credentials
+	SessionToken string `protobuf:"bytes,11,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	Region       string `protobuf:"bytes,12,opt,name=region,proto3" json:"region,omitempty"`
+	Service      string `protobuf:"bytes,13,opt,name=service,proto3" json:"service,omitempty"`
+}
+
+func (x *HttpAuthConfig) Reset() {
+	*x = HttpAuthConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HttpAuthConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpAuthConfig) ProtoMessage() {}
+
+func (x *HttpAuthConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpAuthConfig.ProtoReflect.Descriptor instead.
+func (*HttpAuthConfig) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *HttpAuthConfig) GetType() HttpAuthConfig_Type {
+	if x != nil {
+		return x.Type
+	}
+	return HttpAuthConfig_AUTH_TYPE_UNSPECIFIED
+}
+
+func (x *HttpAuthConfig) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *HttpAuthConfig) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *HttpAuthConfig) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *HttpAuthConfig) GetTokenUrl() string {
+	if x != nil {
+		return x.TokenUrl
+	}
+	return ""
+}
+
+func (x *HttpAuthConfig) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *HttpAuthConfig) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *HttpAuthConfig) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *HttpAuthConfig) GetAccessKey() string {
+	if x != nil {
+		return x.AccessKey
+	}
+	return ""
+}
+
+func (x *HttpAuthConfig) GetSecretKey() string {
+	if x != nil {
+		return x.SecretKey
+	}
+	return ""
+}
+
+func (x *HttpAuthConfig) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *HttpAuthConfig) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *HttpAuthConfig) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+type TcpConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Port int32  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+}
+
+func (x *TcpConfig) Reset() {
+	*x = TcpConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TcpConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TcpConfig) ProtoMessage() {}
+
+func (x *TcpConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TcpConfig.ProtoReflect.Descriptor instead.
+func (*TcpConfig) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TcpConfig) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *TcpConfig) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+type SslExpirationConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Port int32  `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+}
+
+func (x *SslExpirationConfig) Reset() {
+	*x = SslExpirationConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SslExpirationConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SslExpirationConfig) ProtoMessage() {}
+
+func (x *SslExpirationConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SslExpirationConfig.ProtoReflect.Descriptor instead.
+func (*SslExpirationConfig) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SslExpirationConfig) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *SslExpirationConfig) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+type GrpcConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Service string `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Host    string `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	Port    int32  `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+}
+
+func (x *GrpcConfig) Reset() {
+	*x = GrpcConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GrpcConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrpcConfig) ProtoMessage() {}
+
+func (x *GrpcConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrpcConfig.ProtoReflect.Descriptor instead.
+func (*GrpcConfig) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GrpcConfig) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *GrpcConfig) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *GrpcConfig) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+type HttpConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Method     string            `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Url        string            `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Headers    map[string]string `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	StatusCode int32             `protobuf:"varint,4,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Client     *HttpClientConfig `protobuf:"bytes,5,opt,name=client,proto3" json:"client,omitempty"`
+	Auth       *HttpAuthConfig   `protobuf:"bytes,6,opt,name=auth,proto3" json:"auth,omitempty"`
+}
+
+func (x *HttpConfig) Reset() {
+	*x = HttpConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HttpConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpConfig) ProtoMessage() {}
+
+func (x *HttpConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpConfig.ProtoReflect.Descriptor instead.
+func (*HttpConfig) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *HttpConfig) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *HttpConfig) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *HttpConfig) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *HttpConfig) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *HttpConfig) GetClient() *HttpClientConfig {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *HttpConfig) GetAuth() *HttpAuthConfig {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+type WebSocketConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url     string            `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Headers map[string]string `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Message which will be sent after handshake, skipped if empty
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// Regexp which the received message should match, first message is used if empty
+	Pattern string `protobuf:"bytes,4,opt,name=pattern,proto3" json:"pattern,omitempty"`
+}
+
+func (x *WebSocketConfig) Reset() {
+	*x = WebSocketConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebSocketConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebSocketConfig) ProtoMessage() {}
+
+func (x *WebSocketConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebSocketConfig.ProtoReflect.Descriptor instead.
+func (*WebSocketConfig) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *WebSocketConfig) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebSocketConfig) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *WebSocketConfig) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *WebSocketConfig) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+type DatabaseConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type DatabaseConfig_DatabaseType `protobuf:"varint,1,opt,name=type,proto3,enum=squzy.v1.monitoring.DatabaseConfig_DatabaseType" json:"type,omitempty"`
+	Dsn  string                      `protobuf:"bytes,2,opt,name=dsn,proto3" json:"dsn,omitempty"`
+	// SQL query for Postgres/MySQL, command for Redis, JSON command document for MongoDB
+	Query string `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	// Path (https://github.com/tidwall/gjson) to the value in MongoDB command result
+	Path       string                      `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	Assertions []*DatabaseConfig_Assertion `protobuf:"bytes,5,rep,name=assertions,proto3" json:"assertions,omitempty"`
+	// Arguments of Redis command, query is used as command name when they are set
+	Args []string `protobuf:"bytes,6,rep,name=args,proto3" json:"args,omitempty"`
+}
+
+func (x *DatabaseConfig) Reset() {
+	*x = DatabaseConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DatabaseConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseConfig) ProtoMessage() {}
+
+func (x *DatabaseConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseConfig.ProtoReflect.Descriptor instead.
+func (*DatabaseConfig) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DatabaseConfig) GetType() DatabaseConfig_DatabaseType {
+	if x != nil {
+		return x.Type
+	}
+	return DatabaseConfig_DATABASE_TYPE_UNSPECIFIED
+}
+
+func (x *DatabaseConfig) GetDsn() string {
+	if x != nil {
+		return x.Dsn
+	}
+	return ""
+}
+
+func (x *DatabaseConfig) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *DatabaseConfig) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *DatabaseConfig) GetAssertions() []*DatabaseConfig_Assertion {
+	if x != nil {
+		return x.Assertions
+	}
+	return nil
+}
+
+func (x *DatabaseConfig) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+type HeartbeatConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Seconds which ping can be late after scheduler interval
+	Grace int32 `protobuf:"varint,1,opt,name=grace,proto3" json:"grace,omitempty"`
+}
+
+func (x *HeartbeatConfig) Reset() {
+	*x = HeartbeatConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeartbeatConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatConfig) ProtoMessage() {}
+
+func (x *HeartbeatConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatConfig.ProtoReflect.Descriptor instead.
+func (*HeartbeatConfig) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *HeartbeatConfig) GetGrace() int32 {
+	if x != nil {
+		return x.Grace
+	}
+	return 0
+}
+
+type ContentChangeConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url          string                           `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Headers      map[string]string                `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	SelectorType ContentChangeConfig_SelectorType `protobuf:"varint,3,opt,name=selector_type,json=selectorType,proto3,enum=squzy.v1.monitoring.ContentChangeConfig_SelectorType" json:"selector_type,omitempty"`
+	Selector     string                           `protobuf:"bytes,4,opt,name=selector,proto3" json:"selector,omitempty"`
+	// Sha256 hash of normalized content, content of the previous run is used if empty
+	Baseline string          `protobuf:"bytes,5,opt,name=baseline,proto3" json:"baseline,omitempty"`
+	Auth     *HttpAuthConfig `protobuf:"bytes,6,opt,name=auth,proto3" json:"auth,omitempty"`
+}
+
+func (x *ContentChangeConfig) Reset() {
+	*x = ContentChangeConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContentChangeConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentChangeConfig) ProtoMessage() {}
+
+func (x *ContentChangeConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentChangeConfig.ProtoReflect.Descriptor instead.
+func (*ContentChangeConfig) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ContentChangeConfig) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ContentChangeConfig) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *ContentChangeConfig) GetSelectorType() ContentChangeConfig_SelectorType {
+	if x != nil {
+		return x.SelectorType
+	}
+	return ContentChangeConfig_SELECTOR_TYPE_UNSPECIFIED
+}
+
+func (x *ContentChangeConfig) GetSelector() string {
+	if x != nil {
+		return x.Selector
+	}
+	return ""
+}
+
+func (x *ContentChangeConfig) GetBaseline() string {
+	if x != nil {
+		return x.Baseline
+	}
+	return ""
+}
+
+func (x *ContentChangeConfig) GetAuth() *HttpAuthConfig {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+type PrometheusConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url     string            `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Headers map[string]string `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Metric name with label matchers and optional threshold, for example: http_requests_inflight{job="api"} < 500
+	Rules []string        `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"`
+	Auth  *HttpAuthConfig `protobuf:"bytes,4,opt,name=auth,proto3" json:"auth,omitempty"`
+}
+
+func (x *PrometheusConfig) Reset() {
+	*x = PrometheusConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrometheusConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrometheusConfig) ProtoMessage() {}
+
+func (x *PrometheusConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrometheusConfig.ProtoReflect.Descriptor instead.
+func (*PrometheusConfig) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PrometheusConfig) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *PrometheusConfig) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *PrometheusConfig) GetRules() []string {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *PrometheusConfig) GetAuth() *HttpAuthConfig {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+type HttpJsonValueConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Method    string                           `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Url       string                           `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Headers   map[string]string                `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Selectors []*HttpJsonValueConfig_Selectors `protobuf:"bytes,4,rep,name=selectors,proto3" json:"selectors,omitempty"`
+	Client    *HttpClientConfig                `protobuf:"bytes,5,opt,name=client,proto3" json:"client,omitempty"`
+	Auth      *HttpAuthConfig                  `protobuf:"bytes,6,opt,name=auth,proto3" json:"auth,omitempty"`
+}
+
+func (x *HttpJsonValueConfig) Reset() {
+	*x = HttpJsonValueConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HttpJsonValueConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpJsonValueConfig) ProtoMessage() {}
+
+func (x *HttpJsonValueConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpJsonValueConfig.ProtoReflect.Descriptor instead.
+func (*HttpJsonValueConfig) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *HttpJsonValueConfig) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *HttpJsonValueConfig) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *HttpJsonValueConfig) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *HttpJsonValueConfig) GetSelectors() []*HttpJsonValueConfig_Selectors {
+	if x != nil {
+		return x.Selectors
+	}
+	return nil
+}
+
+func (x *HttpJsonValueConfig) GetClient() *HttpClientConfig {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *HttpJsonValueConfig) GetAuth() *HttpAuthConfig {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+type AddRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Interval int32  `protobuf:"varint,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	Timeout  int32  `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Name     string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are assignable to Config:
+	//	*AddRequest_Tcp
+	//	*AddRequest_Sitemap
+	//	*AddRequest_Grpc
+	//	*AddRequest_Http
+	//	*AddRequest_HttpValue
+	//	*AddRequest_SslExpiration
+	//	*AddRequest_Websocket
+	//	*AddRequest_Database
+	//	*AddRequest_Heartbeat
+	//	*AddRequest_ContentChange
+	//	*AddRequest_Prometheus
+	Config isAddRequest_Config `protobuf_oneof:"config"`
+}
+
+func (x *AddRequest) Reset() {
+	*x = AddRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRequest) ProtoMessage() {}
+
+func (x *AddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRequest.ProtoReflect.Descriptor instead.
+func (*AddRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AddRequest) GetInterval() int32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *AddRequest) GetTimeout() int32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (x *AddRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (m *AddRequest) GetConfig() isAddRequest_Config {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (x *AddRequest) GetTcp() *TcpConfig {
+	if x, ok := x.GetConfig().(*AddRequest_Tcp); ok {
+		return x.Tcp
+	}
+	return nil
+}
+
+func (x *AddRequest) GetSitemap() *SiteMapConfig {
+	if x, ok := x.GetConfig().(*AddRequest_Sitemap); ok {
+		return x.Sitemap
+	}
+	return nil
+}
+
+func (x *AddRequest) GetGrpc() *GrpcConfig {
+	if x, ok := x.GetConfig().(*AddRequest_Grpc); ok {
+		return x.Grpc
+	}
+	return nil
+}
+
+func (x *AddRequest) GetHttp() *HttpConfig {
+	if x, ok := x.GetConfig().(*AddRequest_Http); ok {
+		return x.Http
+	}
+	return nil
+}
+
+func (x *AddRequest) GetHttpValue() *HttpJsonValueConfig {
+	if x, ok := x.GetConfig().(*AddRequest_HttpValue); ok {
+		return x.HttpValue
+	}
+	return nil
+}
+
+func (x *AddRequest) GetSslExpiration() *SslExpirationConfig {
+	if x, ok := x.GetConfig().(*AddRequest_SslExpiration); ok {
+		return x.SslExpiration
+	}
+	return nil
+}
+
+func (x *AddRequest) GetWebsocket() *WebSocketConfig {
+	if x, ok := x.GetConfig().(*AddRequest_Websocket); ok {
+		return x.Websocket
+	}
+	return nil
+}
+
+func (x *AddRequest) GetDatabase() *DatabaseConfig {
+	if x, ok := x.GetConfig().(*AddRequest_Database); ok {
+		return x.Database
+	}
+	return nil
+}
+
+func (x *AddRequest) GetHeartbeat() *HeartbeatConfig {
+	if x, ok := x.GetConfig().(*AddRequest_Heartbeat); ok {
+		return x.Heartbeat
+	}
+	return nil
+}
+
+func (x *AddRequest) GetContentChange() *ContentChangeConfig {
+	if x, ok := x.GetConfig().(*AddRequest_ContentChange); ok {
+		return x.ContentChange
+	}
+	return nil
+}
+
+func (x *AddRequest) GetPrometheus() *PrometheusConfig {
+	if x, ok := x.GetConfig().(*AddRequest_Prometheus); ok {
+		return x.Prometheus
+	}
+	return nil
+}
+
+type isAddRequest_Config interface {
+	isAddRequest_Config()
+}
+
+type AddRequest_Tcp struct {
+	Tcp *TcpConfig `protobuf:"bytes,4,opt,name=tcp,proto3,oneof"`
+}
+
+type AddRequest_Sitemap struct {
+	Sitemap *SiteMapConfig `protobuf:"bytes,5,opt,name=sitemap,proto3,oneof"`
+}
+
+type AddRequest_Grpc struct {
+	Grpc *GrpcConfig `protobuf:"bytes,6,opt,name=grpc,proto3,oneof"`
+}
+
+type AddRequest_Http struct {
+	Http *HttpConfig `protobuf:"bytes,7,opt,name=http,proto3,oneof"`
+}
+
+type AddRequest_HttpValue struct {
+	HttpValue *HttpJsonValueConfig `protobuf:"bytes,8,opt,name=http_value,json=httpValue,proto3,oneof"`
+}
+
+type AddRequest_SslExpiration struct {
+	SslExpiration *SslExpirationConfig `protobuf:"bytes,9,opt,name=ssl_expiration,json=sslExpiration,proto3,oneof"`
+}
+
+type AddRequest_Websocket struct {
+	Websocket *WebSocketConfig `protobuf:"bytes,10,opt,name=websocket,proto3,oneof"`
+}
+
+type AddRequest_Database struct {
+	Database *DatabaseConfig `protobuf:"bytes,11,opt,name=database,proto3,oneof"`
+}
+
+type AddRequest_Heartbeat struct {
+	Heartbeat *HeartbeatConfig `protobuf:"bytes,12,opt,name=heartbeat,proto3,oneof"`
+}
+
+type AddRequest_ContentChange struct {
+	ContentChange *ContentChangeConfig `protobuf:"bytes,13,opt,name=content_change,json=contentChange,proto3,oneof"`
+}
+
+type AddRequest_Prometheus struct {
+	Prometheus *PrometheusConfig `protobuf:"bytes,14,opt,name=prometheus,proto3,oneof"`
+}
+
+func (*AddRequest_Tcp) isAddRequest_Config() {}
+
+func (*AddRequest_Sitemap) isAddRequest_Config() {}
+
+func (*AddRequest_Grpc) isAddRequest_Config() {}
+
+func (*AddRequest_Http) isAddRequest_Config() {}
+
+func (*AddRequest_HttpValue) isAddRequest_Config() {}
+
+func (*AddRequest_SslExpiration) isAddRequest_Config() {}
+
+func (*AddRequest_Websocket) isAddRequest_Config() {}
+
+func (*AddRequest_Database) isAddRequest_Config() {}
+
+func (*AddRequest_Heartbeat) isAddRequest_Config() {}
+
+func (*AddRequest_ContentChange) isAddRequest_Config() {}
+
+func (*AddRequest_Prometheus) isAddRequest_Config() {}
+
+type AddResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *AddResponse) Reset() {
+	*x = AddResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddResponse) ProtoMessage() {}
+
+func (x *AddResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddResponse.ProtoReflect.Descriptor instead.
+func (*AddResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AddResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RemoveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RemoveRequest) Reset() {
+	*x = RemoveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveRequest) ProtoMessage() {}
+
+func (x *RemoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveRequest.ProtoReflect.Descriptor instead.
+func (*RemoveRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RemoveRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RemoveResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RemoveResponse) Reset() {
+	*x = RemoveResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveResponse) ProtoMessage() {}
+
+func (x *RemoveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveResponse.ProtoReflect.Descriptor instead.
+func (*RemoveResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RemoveResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RunRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RunRequest) Reset() {
+	*x = RunRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunRequest) ProtoMessage() {}
+
+func (x *RunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunRequest.ProtoReflect.Descriptor instead.
+func (*RunRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RunRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type StopRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *StopRequest) Reset() {
+	*x = StopRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopRequest) ProtoMessage() {}
+
+func (x *StopRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopRequest.ProtoReflect.Descriptor instead.
+func (*StopRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *StopRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type    PingRequest_PingType `protobuf:"varint,2,opt,name=type,proto3,enum=squzy.v1.monitoring.PingRequest_PingType" json:"type,omitempty"`
+	Message string               `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *PingRequest) Reset() {
+	*x = PingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingRequest) ProtoMessage() {}
+
+func (x *PingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
+func (*PingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PingRequest) GetType() PingRequest_PingType {
+	if x != nil {
+		return x.Type
+	}
+	return PingRequest_PING_TYPE_UNSPECIFIED
+}
+
+func (x *PingRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type PingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *PingResponse) Reset() {
+	*x = PingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingResponse) ProtoMessage() {}
+
+func (x *PingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingResponse.ProtoReflect.Descriptor instead.
+func (*PingResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PingResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RunResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RunResponse) Reset() {
+	*x = RunResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunResponse) ProtoMessage() {}
+
+func (x *RunResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunResponse.ProtoReflect.Descriptor instead.
+func (*RunResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RunResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type StopResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *StopResponse) Reset() {
+	*x = StopResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopResponse) ProtoMessage() {}
+
+func (x *StopResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopResponse.ProtoReflect.Descriptor instead.
+func (*StopResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *StopResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type SetSecretRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *SetSecretRequest) Reset() {
+	*x = SetSecretRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSecretRequest) ProtoMessage() {}
+
+func (x *SetSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSecretRequest.ProtoReflect.Descriptor instead.
+func (*SetSecretRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SetSecretRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetSecretRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type SecretRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *SecretRequest) Reset() {
+	*x = SecretRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretRequest) ProtoMessage() {}
+
+func (x *SecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretRequest.ProtoReflect.Descriptor instead.
+func (*SecretRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SecretRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetSecretListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+}
+
+func (x *GetSecretListResponse) Reset() {
+	*x = GetSecretListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSecretListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecretListResponse) ProtoMessage() {}
+
+func (x *GetSecretListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecretListResponse.ProtoReflect.Descriptor instead.
+func (*GetSecretListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetSecretListResponse) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type StorageQueueStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Length   int64  `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	Bytes    int64  `protobuf:"varint,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Enqueued uint64 `protobuf:"varint,3,opt,name=enqueued,proto3" json:"enqueued,omitempty"`
+	Replayed uint64 `protobuf:"varint,4,opt,name=replayed,proto3" json:"replayed,omitempty"`
+	Dropped  uint64 `protobuf:"varint,5,opt,name=dropped,proto3" json:"dropped,omitempty"`
+	// Dropped because storage rejected them several times
+	Rejected uint64 `protobuf:"varint,6,opt,name=rejected,proto3" json:"rejected,omitempty"`
+}
+
+func (x *StorageQueueStatus) Reset() {
+	*x = StorageQueueStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StorageQueueStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageQueueStatus) ProtoMessage() {}
+
+func (x *StorageQueueStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageQueueStatus.ProtoReflect.Descriptor instead.
+func (*StorageQueueStatus) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *StorageQueueStatus) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *StorageQueueStatus) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *StorageQueueStatus) GetEnqueued() uint64 {
+	if x != nil {
+		return x.Enqueued
+	}
+	return 0
+}
+
+func (x *StorageQueueStatus) GetReplayed() uint64 {
+	if x != nil {
+		return x.Replayed
+	}
+	return 0
+}
+
+func (x *StorageQueueStatus) GetDropped() uint64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+func (x *StorageQueueStatus) GetRejected() uint64 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+type GetStorageStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Not set if queue is disabled
+	Queue *StorageQueueStatus `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	// State of connection to the storage: IDLE, CONNECTING, READY, TRANSIENT_FAILURE or SHUTDOWN, empty if storage is not configured
+	ConnectionState string `protobuf:"bytes,2,opt,name=connection_state,json=connectionState,proto3" json:"connection_state,omitempty"`
+}
+
+func (x *GetStorageStatusResponse) Reset() {
+	*x = GetStorageStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStorageStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStorageStatusResponse) ProtoMessage() {}
+
+func (x *GetStorageStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStorageStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetStorageStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetStorageStatusResponse) GetQueue() *StorageQueueStatus {
+	if x != nil {
+		return x.Queue
+	}
+	return nil
+}
+
+func (x *GetStorageStatusResponse) GetConnectionState() string {
+	if x != nil {
+		return x.ConnectionState
+	}
+	return ""
+}
+
+type SchedulerSnapshot_Error struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *SchedulerSnapshot_Error) Reset() {
+	*x = SchedulerSnapshot_Error{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchedulerSnapshot_Error) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulerSnapshot_Error) ProtoMessage() {}
+
+func (x *SchedulerSnapshot_Error) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulerSnapshot_Error.ProtoReflect.Descriptor instead.
+func (*SchedulerSnapshot_Error) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *SchedulerSnapshot_Error) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SchedulerSnapshot_MetaData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Value     *structpb.Value        `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *SchedulerSnapshot_MetaData) Reset() {
+	*x = SchedulerSnapshot_MetaData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchedulerSnapshot_MetaData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulerSnapshot_MetaData) ProtoMessage() {}
+
+func (x *SchedulerSnapshot_MetaData) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulerSnapshot_MetaData.ProtoReflect.Descriptor instead.
+func (*SchedulerSnapshot_MetaData) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *SchedulerSnapshot_MetaData) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *SchedulerSnapshot_MetaData) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *SchedulerSnapshot_MetaData) GetValue() *structpb.Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type DatabaseConfig_Assertion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operator DatabaseConfig_Operator `protobuf:"varint,1,opt,name=operator,proto3,enum=squzy.v1.monitoring.DatabaseConfig_Operator" json:"operator,omitempty"`
+	Value    string                  `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *DatabaseConfig_Assertion) Reset() {
+	*x = DatabaseConfig_Assertion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DatabaseConfig_Assertion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseConfig_Assertion) ProtoMessage() {}
+
+func (x *DatabaseConfig_Assertion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseConfig_Assertion.ProtoReflect.Descriptor instead.
+func (*DatabaseConfig_Assertion) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{13, 0}
+}
+
+func (x *DatabaseConfig_Assertion) GetOperator() DatabaseConfig_Operator {
+	if x != nil {
+		return x.Operator
+	}
+	return DatabaseConfig_OPERATOR_UNSPECIFIED
+}
+
+func (x *DatabaseConfig_Assertion) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type HttpJsonValueConfig_Selectors struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type HttpJsonValueConfig_JsonValueParseType `protobuf:"varint,1,opt,name=type,proto3,enum=squzy.v1.monitoring.HttpJsonValueConfig_JsonValueParseType" json:"type,omitempty"`
+	Path string                                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *HttpJsonValueConfig_Selectors) Reset() {
+	*x = HttpJsonValueConfig_Selectors{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HttpJsonValueConfig_Selectors) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpJsonValueConfig_Selectors) ProtoMessage() {}
+
+func (x *HttpJsonValueConfig_Selectors) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_monitoring_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpJsonValueConfig_Selectors.ProtoReflect.Descriptor instead.
+func (*HttpJsonValueConfig_Selectors) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_monitoring_proto_rawDescGZIP(), []int{17, 1}
+}
+
+func (x *HttpJsonValueConfig_Selectors) GetType() HttpJsonValueConfig_JsonValueParseType {
+	if x != nil {
+		return x.Type
+	}
+	return HttpJsonValueConfig_JSON_PARSE_VALUE_UNSPECIFIED
+}
+
+func (x *HttpJsonValueConfig_Selectors) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+var File_proto_v1_squzy_monitoring_proto protoreflect.FileDescriptor
+
+var file_proto_v1_squzy_monitoring_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x71, 0x75, 0x7a, 0x79,
+	0x5f, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x13, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69,
+	0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x6d, 0x0a, 0x17, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x57, 0x69, 0x74, 0x68, 0x49, 0x64, 0x12, 0x42, 0x0a,
+	0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74,
+	0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0xdc, 0x03, 0x0a, 0x11, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x36, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x36, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e,
+	0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
+	0x69, 0x6e, 0x67, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x42, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x43, 0x0a, 0x04, 0x6d,
+	0x65, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x73, 0x71, 0x75, 0x7a,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61,
+	0x1a, 0x21, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x1a, 0xaa, 0x01, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65,
+	0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x29, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xd0, 0x07, 0x0a, 0x09,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x73, 0x71,
+	0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e,
+	0x67, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12,
+	0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x32, 0x0a, 0x03, 0x74, 0x63, 0x70,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x63, 0x70,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x03, 0x74, 0x63, 0x70, 0x12, 0x3e, 0x0a,
+	0x07, 0x73, 0x69, 0x74, 0x65, 0x6d, 0x61, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x4d, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x48, 0x00, 0x52, 0x07, 0x73, 0x69, 0x74, 0x65, 0x6d, 0x61, 0x70, 0x12, 0x35, 0x0a,
+	0x04, 0x67, 0x72, 0x70, 0x63, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x71,
+	0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e,
+	0x67, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x04,
+	0x67, 0x72, 0x70, 0x63, 0x12, 0x35, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x04, 0x68, 0x74, 0x74, 0x70, 0x12, 0x49, 0x0a, 0x0a, 0x68,
+	0x74, 0x74, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74,
+	0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x4a, 0x73, 0x6f, 0x6e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x09, 0x68, 0x74, 0x74,
+	0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x73, 0x73, 0x6c, 0x5f, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x73, 0x6c, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x0d, 0x73, 0x73, 0x6c, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x09, 0x77, 0x65, 0x62,
+	0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73,
+	0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69,
+	0x6e, 0x67, 0x2e, 0x57, 0x65, 0x62, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x48, 0x00, 0x52, 0x09, 0x77, 0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x12,
+	0x41, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x12, 0x44, 0x0a, 0x09, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x48, 0x65, 0x61, 0x72,
+	0x74, 0x62, 0x65, 0x61, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x09, 0x68,
+	0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x51, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69,
+	0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x0d, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x47, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74,
+	0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x6d, 0x65, 0x74,
+	0x68, 0x65, 0x75, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x50,
+	0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x6c, 0x69,
+	0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x71, 0x75, 0x7a,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x52, 0x05, 0x6c, 0x69, 0x73, 0x74, 0x73,
+	0x22, 0x82, 0x01, 0x0a, 0x0d, 0x53, 0x69, 0x74, 0x65, 0x4d, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x3d, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x48, 0x74, 0x74,
+	0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x22, 0x80, 0x02, 0x0a, 0x10, 0x48, 0x74, 0x74, 0x70, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x72,
+	0x6f, 0x78, 0x79, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x78, 0x79, 0x55, 0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61, 0x5f, 0x62, 0x75,
+	0x6e, 0x64, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x42, 0x75,
+	0x6e, 0x64, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63,
+	0x65, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x43, 0x65, 0x72, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x4b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65,
+	0x5f, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x12, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x53, 0x6b, 0x69, 0x70,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x68, 0x74, 0x74, 0x70, 0x32, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x64,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x48, 0x74, 0x74, 0x70, 0x32, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x70, 0x22, 0x90, 0x04, 0x0a, 0x0e, 0x48, 0x74, 0x74,
+	0x70, 0x41, 0x75, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3c, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x73, 0x71, 0x75, 0x7a,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x48, 0x74, 0x74, 0x70, 0x41, 0x75, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x55, 0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x23, 0x0a, 0x0d,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x22, 0x66, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x41,
+	0x55, 0x54, 0x48, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x41, 0x53, 0x49, 0x43, 0x10,
+	0x01, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x45, 0x41, 0x52, 0x45, 0x52, 0x10, 0x02, 0x12, 0x1d, 0x0a,
+	0x19, 0x4f, 0x41, 0x55, 0x54, 0x48, 0x32, 0x5f, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x43,
+	0x52, 0x45, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x41, 0x4c, 0x53, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09,
+	0x41, 0x57, 0x53, 0x5f, 0x53, 0x49, 0x47, 0x56, 0x34, 0x10, 0x04, 0x22, 0x33, 0x0a, 0x09, 0x54,
+	0x63, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74,
+	0x22, 0x3d, 0x0a, 0x13, 0x53, 0x73, 0x6c, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22,
+	0x4e, 0x0a, 0x0a, 0x47, 0x72, 0x70, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22,
+	0xd3, 0x02, 0x0a, 0x0a, 0x48, 0x74, 0x74, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16,
+	0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x46, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x71, 0x75, 0x7a,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x48, 0x74, 0x74, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x3d, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x12, 0x37, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x41, 0x75, 0x74, 0x68, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xe0, 0x01, 0x0a, 0x0f, 0x57, 0x65, 0x62, 0x53, 0x6f, 0x63,
+	0x6b, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x4b, 0x0a, 0x07, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x73,
+	0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69,
+	0x6e, 0x67, 0x2e, 0x57, 0x65, 0x62, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x1a, 0x3a, 0x0a, 0x0c,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xc0, 0x04, 0x0a, 0x0e, 0x44, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x44, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x73, 0x71, 0x75, 0x7a,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x73, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x64, 0x73, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x4d, 0x0a,
+	0x0a, 0x61, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x61, 0x72, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73,
+	0x1a, 0x6b, 0x0a, 0x09, 0x41, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x48, 0x0a,
+	0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x2c, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74,
+	0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x5c, 0x0a,
+	0x0c, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a,
+	0x19, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08,
+	0x50, 0x4f, 0x53, 0x54, 0x47, 0x52, 0x45, 0x53, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x59,
+	0x53, 0x51, 0x4c, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x45, 0x44, 0x49, 0x53, 0x10, 0x03,
+	0x12, 0x09, 0x0a, 0x05, 0x4d, 0x4f, 0x4e, 0x47, 0x4f, 0x10, 0x04, 0x22, 0x7e, 0x0a, 0x08, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x50, 0x45, 0x52, 0x41,
+	0x54, 0x4f, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09,
+	0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x47,
+	0x52, 0x45, 0x41, 0x54, 0x45, 0x52, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x52, 0x45, 0x41,
+	0x54, 0x45, 0x52, 0x5f, 0x4f, 0x52, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x10, 0x04, 0x12, 0x08,
+	0x0a, 0x04, 0x4c, 0x45, 0x53, 0x53, 0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x45, 0x53, 0x53,
+	0x5f, 0x4f, 0x52, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x10, 0x06, 0x22, 0x27, 0x0a, 0x0f, 0x48,
+	0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x14,
+	0x0a, 0x05, 0x67, 0x72, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x67,
+	0x72, 0x61, 0x63, 0x65, 0x22, 0xce, 0x03, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x4f,
+	0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x35, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74,
+	0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12,
+	0x5a, 0x0a, 0x0d, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x12, 0x37, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x41, 0x75, 0x74, 0x68,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x1a, 0x3a, 0x0a, 0x0c,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x4b, 0x0a, 0x0c, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x45, 0x4c, 0x45,
+	0x43, 0x54, 0x4f, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x53, 0x53, 0x10, 0x01,
+	0x12, 0x09, 0x0a, 0x05, 0x58, 0x50, 0x41, 0x54, 0x48, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x4a,
+	0x53, 0x4f, 0x4e, 0x10, 0x03, 0x22, 0xfd, 0x01, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x6d, 0x65, 0x74,
+	0x68, 0x65, 0x75, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x4c, 0x0a, 0x07,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e,
+	0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
+	0x69, 0x6e, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x75,
+	0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73,
+	0x12, 0x37, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x41, 0x75, 0x74, 0x68, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xfe, 0x04, 0x0a, 0x13, 0x48, 0x74, 0x74, 0x70, 0x4a, 0x73,
+	0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a,
+	0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x4f, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x48,
+	0x74, 0x74, 0x70, 0x4a, 0x73, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x50, 0x0a, 0x09, 0x73, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x73, 0x71,
+	0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e,
+	0x67, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x4a, 0x73, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52,
+	0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x3d, 0x0a, 0x06, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x71, 0x75,
+	0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67,
+	0x2e, 0x48, 0x74, 0x74, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x04, 0x61, 0x75, 0x74,
+	0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x48, 0x74,
+	0x74, 0x70, 0x41, 0x75, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x04, 0x61, 0x75,
+	0x74, 0x68, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x70,
+	0x0a, 0x09, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x4f, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3b, 0x2e, 0x73, 0x71, 0x75, 0x7a,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x48, 0x74, 0x74, 0x70, 0x4a, 0x73, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x4a, 0x73, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x50, 0x61, 0x72,
+	0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x22, 0x74, 0x0a, 0x12, 0x4a, 0x73, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x50, 0x61, 0x72,
+	0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x4a, 0x53, 0x4f, 0x4e, 0x5f, 0x50,
+	0x41, 0x52, 0x53, 0x45, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x52, 0x49,
+	0x4e, 0x47, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x4f, 0x4f, 0x4c, 0x10, 0x02, 0x12, 0x0a,
+	0x0a, 0x06, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x49,
+	0x4d, 0x45, 0x10, 0x04, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4e, 0x59, 0x10, 0x05, 0x12, 0x07, 0x0a,
+	0x03, 0x52, 0x41, 0x57, 0x10, 0x06, 0x22, 0xcb, 0x06, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x32, 0x0a, 0x03, 0x74, 0x63, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73,
+	0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69,
+	0x6e, 0x67, 0x2e, 0x54, 0x63, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x03,
+	0x74, 0x63, 0x70, 0x12, 0x3e, 0x0a, 0x07, 0x73, 0x69, 0x74, 0x65, 0x6d, 0x61, 0x70, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x4d,
+	0x61, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x07, 0x73, 0x69, 0x74, 0x65,
+	0x6d, 0x61, 0x70, 0x12, 0x35, 0x0a, 0x04, 0x67, 0x72, 0x70, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x48, 0x00, 0x52, 0x04, 0x67, 0x72, 0x70, 0x63, 0x12, 0x35, 0x0a, 0x04, 0x68, 0x74,
+	0x74, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x48,
+	0x74, 0x74, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x04, 0x68, 0x74, 0x74,
+	0x70, 0x12, 0x49, 0x0a, 0x0a, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x48, 0x74, 0x74, 0x70,
+	0x4a, 0x73, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48,
+	0x00, 0x52, 0x09, 0x68, 0x74, 0x74, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x51, 0x0a, 0x0e,
+	0x73, 0x73, 0x6c, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x73, 0x6c, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00,
+	0x52, 0x0d, 0x73, 0x73, 0x6c, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x44, 0x0a, 0x09, 0x77, 0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x57, 0x65, 0x62, 0x53, 0x6f, 0x63, 0x6b,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x09, 0x77, 0x65, 0x62, 0x73,
+	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x41, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x08,
+	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x09, 0x68, 0x65, 0x61, 0x72,
+	0x74, 0x62, 0x65, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x71,
+	0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e,
+	0x67, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x48, 0x00, 0x52, 0x09, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x51,
+	0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x48, 0x00, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x6d,
+	0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x0a,
+	0x70, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x22, 0x1d, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x1f, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x20, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1c, 0x0a, 0x0a, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x1d, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0xbf, 0x01, 0x0a, 0x0b, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x3d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x29, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x47, 0x0a, 0x08,
+	0x50, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x49, 0x4e, 0x47,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x01,
+	0x12, 0x09, 0x0a, 0x05, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x46,
+	0x41, 0x49, 0x4c, 0x10, 0x03, 0x22, 0x1e, 0x0a, 0x0c, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1d, 0x0a, 0x0b, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x1e, 0x0a, 0x0c, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x3c, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x23, 0x0a, 0x0d, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2d, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0xb0, 0x01, 0x0a, 0x12, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x65,
+	0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x65,
+	0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0x84, 0x01, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x2a, 0x42, 0x0a, 0x0d, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x52, 0x5f, 0x43,
+	0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x10, 0x02, 0x2a, 0x59, 0x0a, 0x0f, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x43, 0x48, 0x45, 0x44,
+	0x55, 0x4c, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x55, 0x4e,
+	0x4e, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x03, 0x2a,
+	0xd3, 0x01, 0x0a, 0x0d, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x52, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x43, 0x50, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x47, 0x52,
+	0x50, 0x43, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x54, 0x54, 0x50, 0x10, 0x03, 0x12, 0x0c,
+	0x0a, 0x08, 0x53, 0x49, 0x54, 0x45, 0x5f, 0x4d, 0x41, 0x50, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f,
+	0x48, 0x54, 0x54, 0x50, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x10,
+	0x05, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x53, 0x4c, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x10, 0x06, 0x12, 0x0d, 0x0a, 0x09, 0x57, 0x45, 0x42, 0x53, 0x4f, 0x43, 0x4b,
+	0x45, 0x54, 0x10, 0x07, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45,
+	0x10, 0x08, 0x12, 0x0d, 0x0a, 0x09, 0x48, 0x45, 0x41, 0x52, 0x54, 0x42, 0x45, 0x41, 0x54, 0x10,
+	0x09, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x41,
+	0x4e, 0x47, 0x45, 0x10, 0x0a, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x52, 0x4f, 0x4d, 0x45, 0x54, 0x48,
+	0x45, 0x55, 0x53, 0x10, 0x0b, 0x32, 0x9a, 0x07, 0x0a, 0x12, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x72, 0x73, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x12, 0x59, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2d, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x42, 0x79, 0x49, 0x64, 0x12, 0x2c, 0x2e, 0x73, 0x71,
+	0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e,
+	0x67, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x42, 0x79,
+	0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x71, 0x75, 0x7a,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x12, 0x48, 0x0a, 0x03, 0x41, 0x64, 0x64,
+	0x12, 0x1f, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69,
+	0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x06, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x22, 0x2e,
+	0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
+	0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x03, 0x52, 0x75, 0x6e, 0x12, 0x1f, 0x2e,
+	0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
+	0x69, 0x6e, 0x67, 0x2e, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4b, 0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x20, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x53,
+	0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x71, 0x75,
+	0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67,
+	0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a,
+	0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x09, 0x53, 0x65,
+	0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x25, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65,
+	0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4a, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x22, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x53, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2a, 0x2e, 0x73, 0x71,
+	0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e,
+	0x67, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x2d, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x24, 0x5a, 0x22, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2f, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_v1_squzy_monitoring_proto_rawDescOnce sync.Once
+	file_proto_v1_squzy_monitoring_proto_rawDescData = file_proto_v1_squzy_monitoring_proto_rawDesc
+)
+
+func file_proto_v1_squzy_monitoring_proto_rawDescGZIP() []byte {
+	file_proto_v1_squzy_monitoring_proto_rawDescOnce.Do(func() {
+		file_proto_v1_squzy_monitoring_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_v1_squzy_monitoring_proto_rawDescData)
+	})
+	return file_proto_v1_squzy_monitoring_proto_rawDescData
+}
+
+var file_proto_v1_squzy_monitoring_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_proto_v1_squzy_monitoring_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
+var file_proto_v1_squzy_monitoring_proto_goTypes = []interface{}{
+	(SchedulerCode)(0),                          // 0: squzy.v1.monitoring.SchedulerCode
+	(SchedulerStatus)(0),                        // 1: squzy.v1.monitoring.SchedulerStatus
+	(SchedulerType)(0),                          // 2: squzy.v1.monitoring.SchedulerType
+	(HttpAuthConfig_Type)(0),                    // 3: squzy.v1.monitoring.HttpAuthConfig.Type
+	(DatabaseConfig_DatabaseType)(0),            // 4: squzy.v1.monitoring.DatabaseConfig.DatabaseType
+	(DatabaseConfig_Operator)(0),                // 5: squzy.v1.monitoring.DatabaseConfig.Operator
+	(ContentChangeConfig_SelectorType)(0),       // 6: squzy.v1.monitoring.ContentChangeConfig.SelectorType
+	(HttpJsonValueConfig_JsonValueParseType)(0), // 7: squzy.v1.monitoring.HttpJsonValueConfig.JsonValueParseType
+	(PingRequest_PingType)(0),                   // 8: squzy.v1.monitoring.PingRequest.PingType
+	(*SchedulerSnapshotWithId)(nil),             // 9: squzy.v1.monitoring.SchedulerSnapshotWithId
+	(*SchedulerSnapshot)(nil),                   // 10: squzy.v1.monitoring.SchedulerSnapshot
+	(*GetSchedulerByIdRequest)(nil),             // 11: squzy.v1.monitoring.GetSchedulerByIdRequest
+	(*Scheduler)(nil),                           // 12: squzy.v1.monitoring.Scheduler
+	(*GetSchedulerListResponse)(nil),            // 13: squzy.v1.monitoring.GetSchedulerListResponse
+	(*SiteMapConfig)(nil),                       // 14: squzy.v1.monitoring.SiteMapConfig
+	(*HttpClientConfig)(nil),                    // 15: squzy.v1.monitoring.HttpClientConfig
+	(*HttpAuthConfig)(nil),                      // 16: squzy.v1.monitoring.HttpAuthConfig
+	(*TcpConfig)(nil),                           // 17: squzy.v1.monitoring.TcpConfig
+	(*SslExpirationConfig)(nil),                 // 18: squzy.v1.monitoring.SslExpirationConfig
+	(*GrpcConfig)(nil),                          // 19: squzy.v1.monitoring.GrpcConfig
+	(*HttpConfig)(nil),                          // 20: squzy.v1.monitoring.HttpConfig
+	(*WebSocketConfig)(nil),                     // 21: squzy.v1.monitoring.WebSocketConfig
+	(*DatabaseConfig)(nil),                      // 22: squzy.v1.monitoring.DatabaseConfig
+	(*HeartbeatConfig)(nil),                     // 23: squzy.v1.monitoring.HeartbeatConfig
+	(*ContentChangeConfig)(nil),                 // 24: squzy.v1.monitoring.ContentChangeConfig
+	(*PrometheusConfig)(nil),                    // 25: squzy.v1.monitoring.PrometheusConfig
+	(*HttpJsonValueConfig)(nil),                 // 26: squzy.v1.monitoring.HttpJsonValueConfig
+	(*AddRequest)(nil),                          // 27: squzy.v1.monitoring.AddRequest
+	(*AddResponse)(nil),                         // 28: squzy.v1.monitoring.AddResponse
+	(*RemoveRequest)(nil),                       // 29: squzy.v1.monitoring.RemoveRequest
+	(*RemoveResponse)(nil),                      // 30: squzy.v1.monitoring.RemoveResponse
+	(*RunRequest)(nil),                          // 31: squzy.v1.monitoring.RunRequest
+	(*StopRequest)(nil),                         // 32: squzy.v1.monitoring.StopRequest
+	(*PingRequest)(nil),                         // 33: squzy.v1.monitoring.PingRequest
+	(*PingResponse)(nil),                        // 34: squzy.v1.monitoring.PingResponse
+	(*RunResponse)(nil),                         // 35: squzy.v1.monitoring.RunResponse
+	(*StopResponse)(nil),                        // 36: squzy.v1.monitoring.StopResponse
+	(*SetSecretRequest)(nil),                    // 37: squzy.v1.monitoring.SetSecretRequest
+	(*SecretRequest)(nil),                       // 38: squzy.v1.monitoring.SecretRequest
+	(*GetSecretListResponse)(nil),               // 39: squzy.v1.monitoring.GetSecretListResponse
+	(*StorageQueueStatus)(nil),                  // 40: squzy.v1.monitoring.StorageQueueStatus
+	(*GetStorageStatusResponse)(nil),            // 41: squzy.v1.monitoring.GetStorageStatusResponse
+	(*SchedulerSnapshot_Error)(nil),             // 42: squzy.v1.monitoring.SchedulerSnapshot.Error
+	(*SchedulerSnapshot_MetaData)(nil),          // 43: squzy.v1.monitoring.SchedulerSnapshot.MetaData
+	nil,                                         // 44: squzy.v1.monitoring.HttpConfig.HeadersEntry
+	nil,                                         // 45: squzy.v1.monitoring.WebSocketConfig.HeadersEntry
+	(*DatabaseConfig_Assertion)(nil),            // 46: squzy.v1.monitoring.DatabaseConfig.Assertion
+	nil,                                         // 47: squzy.v1.monitoring.ContentChangeConfig.HeadersEntry
+	nil,                                         // 48: squzy.v1.monitoring.PrometheusConfig.HeadersEntry
+	nil,                                         // 49: squzy.v1.monitoring.HttpJsonValueConfig.HeadersEntry
+	(*HttpJsonValueConfig_Selectors)(nil),       // 50: squzy.v1.monitoring.HttpJsonValueConfig.Selectors
+	(*timestamppb.Timestamp)(nil),               // 51: google.protobuf.Timestamp
+	(*structpb.Value)(nil),                      // 52: google.protobuf.Value
+	(*emptypb.Empty)(nil),                       // 53: google.protobuf.Empty
+}
+var file_proto_v1_squzy_monitoring_proto_depIdxs = []int32{
+	10, // 0: squzy.v1.monitoring.SchedulerSnapshotWithId.snapshot:type_name -> squzy.v1.monitoring.SchedulerSnapshot
+	0,  // 1: squzy.v1.monitoring.SchedulerSnapshot.code:type_name -> squzy.v1.monitoring.SchedulerCode
+	2,  // 2: squzy.v1.monitoring.SchedulerSnapshot.type:type_name -> squzy.v1.monitoring.SchedulerType
+	42, // 3: squzy.v1.monitoring.SchedulerSnapshot.error:type_name -> squzy.v1.monitoring.SchedulerSnapshot.Error
+	43, // 4: squzy.v1.monitoring.SchedulerSnapshot.meta:type_name -> squzy.v1.monitoring.SchedulerSnapshot.MetaData
+	2,  // 5: squzy.v1.monitoring.Scheduler.type:type_name -> squzy.v1.monitoring.SchedulerType
+	1,  // 6: squzy.v1.monitoring.Scheduler.status:type_name -> squzy.v1.monitoring.SchedulerStatus
+	17, // 7: squzy.v1.monitoring.Scheduler.tcp:type_name -> squzy.v1.monitoring.TcpConfig
+	14, // 8: squzy.v1.monitoring.Scheduler.sitemap:type_name -> squzy.v1.monitoring.SiteMapConfig
+	19, // 9: squzy.v1.monitoring.Scheduler.grpc:type_name -> squzy.v1.monitoring.GrpcConfig
+	20, // 10: squzy.v1.monitoring.Scheduler.http:type_name -> squzy.v1.monitoring.HttpConfig
+	26, // 11: squzy.v1.monitoring.Scheduler.http_value:type_name -> squzy.v1.monitoring.HttpJsonValueConfig
+	18, // 12: squzy.v1.monitoring.Scheduler.ssl_expiration:type_name -> squzy.v1.monitoring.SslExpirationConfig
+	21, // 13: squzy.v1.monitoring.Scheduler.websocket:type_name -> squzy.v1.monitoring.WebSocketConfig
+	22, // 14: squzy.v1.monitoring.Scheduler.database:type_name -> squzy.v1.monitoring.DatabaseConfig
+	23, // 15: squzy.v1.monitoring.Scheduler.heartbeat:type_name -> squzy.v1.monitoring.HeartbeatConfig
+	24, // 16: squzy.v1.monitoring.Scheduler.content_change:type_name -> squzy.v1.monitoring.ContentChangeConfig
+	25, // 17: squzy.v1.monitoring.Scheduler.prometheus:type_name -> squzy.v1.monitoring.PrometheusConfig
+	12, // 18: squzy.v1.monitoring.GetSchedulerListResponse.lists:type_name -> squzy.v1.monitoring.Scheduler
+	15, // 19: squzy.v1.monitoring.SiteMapConfig.client:type_name -> squzy.v1.monitoring.HttpClientConfig
+	3,  // 20: squzy.v1.monitoring.HttpAuthConfig.type:type_name -> squzy.v1.monitoring.HttpAuthConfig.Type
+	44, // 21: squzy.v1.monitoring.HttpConfig.headers:type_name -> squzy.v1.monitoring.HttpConfig.HeadersEntry
+	15, // 22: squzy.v1.monitoring.HttpConfig.client:type_name -> squzy.v1.monitoring.HttpClientConfig
+	16, // 23: squzy.v1.monitoring.HttpConfig.auth:type_name -> squzy.v1.monitoring.HttpAuthConfig
+	45, // 24: squzy.v1.monitoring.WebSocketConfig.headers:type_name -> squzy.v1.monitoring.WebSocketConfig.HeadersEntry
+	4,  // 25: squzy.v1.monitoring.DatabaseConfig.type:type_name -> squzy.v1.monitoring.DatabaseConfig.DatabaseType
+	46, // 26: squzy.v1.monitoring.DatabaseConfig.assertions:type_name -> squzy.v1.monitoring.DatabaseConfig.Assertion
+	47, // 27: squzy.v1.monitoring.ContentChangeConfig.headers:type_name -> squzy.v1.monitoring.ContentChangeConfig.HeadersEntry
+	6,  // 28: squzy.v1.monitoring.ContentChangeConfig.selector_type:type_name -> squzy.v1.monitoring.ContentChangeConfig.SelectorType
+	16, // 29: squzy.v1.monitoring.ContentChangeConfig.auth:type_name -> squzy.v1.monitoring.HttpAuthConfig
+	48, // 30: squzy.v1.monitoring.PrometheusConfig.headers:type_name -> squzy.v1.monitoring.PrometheusConfig.HeadersEntry
+	16, // 31: squzy.v1.monitoring.PrometheusConfig.auth:type_name -> squzy.v1.monitoring.HttpAuthConfig
+	49, // 32: squzy.v1.monitoring.HttpJsonValueConfig.headers:type_name -> squzy.v1.monitoring.HttpJsonValueConfig.HeadersEntry
+	50, // 33: squzy.v1.monitoring.HttpJsonValueConfig.selectors:type_name -> squzy.v1.monitoring.HttpJsonValueConfig.Selectors
+	15, // 34: squzy.v1.monitoring.HttpJsonValueConfig.client:type_name -> squzy.v1.monitoring.HttpClientConfig
+	16, // 35: squzy.v1.monitoring.HttpJsonValueConfig.auth:type_name -> squzy.v1.monitoring.HttpAuthConfig
+	17, // 36: squzy.v1.monitoring.AddRequest.tcp:type_name -> squzy.v1.monitoring.TcpConfig
+	14, // 37: squzy.v1.monitoring.AddRequest.sitemap:type_name -> squzy.v1.monitoring.SiteMapConfig
+	19, // 38: squzy.v1.monitoring.AddRequest.grpc:type_name -> squzy.v1.monitoring.GrpcConfig
+	20, // 39: squzy.v1.monitoring.AddRequest.http:type_name -> squzy.v1.monitoring.HttpConfig
+	26, // 40: squzy.v1.monitoring.AddRequest.http_value:type_name -> squzy.v1.monitoring.HttpJsonValueConfig
+	18, // 41: squzy.v1.monitoring.AddRequest.ssl_expiration:type_name -> squzy.v1.monitoring.SslExpirationConfig
+	21, // 42: squzy.v1.monitoring.AddRequest.websocket:type_name -> squzy.v1.monitoring.WebSocketConfig
+	22, // 43: squzy.v1.monitoring.AddRequest.database:type_name -> squzy.v1.monitoring.DatabaseConfig
+	23, // 44: squzy.v1.monitoring.AddRequest.heartbeat:type_name -> squzy.v1.monitoring.HeartbeatConfig
+	24, // 45: squzy.v1.monitoring.AddRequest.content_change:type_name -> squzy.v1.monitoring.ContentChangeConfig
+	25, // 46: squzy.v1.monitoring.AddRequest.prometheus:type_name -> squzy.v1.monitoring.PrometheusConfig
+	8,  // 47: squzy.v1.monitoring.PingRequest.type:type_name -> squzy.v1.monitoring.PingRequest.PingType
+	40, // 48: squzy.v1.monitoring.GetStorageStatusResponse.queue:type_name -> squzy.v1.monitoring.StorageQueueStatus
+	51, // 49: squzy.v1.monitoring.SchedulerSnapshot.MetaData.start_time:type_name -> google.protobuf.Timestamp
+	51, // 50: squzy.v1.monitoring.SchedulerSnapshot.MetaData.end_time:type_name -> google.protobuf.Timestamp
+	52, // 51: squzy.v1.monitoring.SchedulerSnapshot.MetaData.value:type_name -> google.protobuf.Value
+	5,  // 52: squzy.v1.monitoring.DatabaseConfig.Assertion.operator:type_name -> squzy.v1.monitoring.DatabaseConfig.Operator
+	7,  // 53: squzy.v1.monitoring.HttpJsonValueConfig.Selectors.type:type_name -> squzy.v1.monitoring.HttpJsonValueConfig.JsonValueParseType
+	53, // 54: squzy.v1.monitoring.SchedulersExecutor.GetSchedulerList:input_type -> google.protobuf.Empty
+	11, // 55: squzy.v1.monitoring.SchedulersExecutor.GetSchedulerById:input_type -> squzy.v1.monitoring.GetSchedulerByIdRequest
+	27, // 56: squzy.v1.monitoring.SchedulersExecutor.Add:input_type -> squzy.v1.monitoring.AddRequest
+	29, // 57: squzy.v1.monitoring.SchedulersExecutor.Remove:input_type -> squzy.v1.monitoring.RemoveRequest
+	31, // 58: squzy.v1.monitoring.SchedulersExecutor.Run:input_type -> squzy.v1.monitoring.RunRequest
+	32, // 59: squzy.v1.monitoring.SchedulersExecutor.Stop:input_type -> squzy.v1.monitoring.StopRequest
+	33, // 60: squzy.v1.monitoring.SchedulersExecutor.Ping:input_type -> squzy.v1.monitoring.PingRequest
+	37, // 61: squzy.v1.monitoring.SchedulersExecutor.SetSecret:input_type -> squzy.v1.monitoring.SetSecretRequest
+	38, // 62: squzy.v1.monitoring.SchedulersExecutor.RemoveSecret:input_type -> squzy.v1.monitoring.SecretRequest
+	53, // 63: squzy.v1.monitoring.SchedulersExecutor.GetSecretList:input_type -> google.protobuf.Empty
+	53, // 64: squzy.v1.monitoring.SchedulersExecutor.GetStorageStatus:input_type -> google.protobuf.Empty
+	13, // 65: squzy.v1.monitoring.SchedulersExecutor.GetSchedulerList:output_type -> squzy.v1.monitoring.GetSchedulerListResponse
+	12, // 66: squzy.v1.monitoring.SchedulersExecutor.GetSchedulerById:output_type -> squzy.v1.monitoring.Scheduler
+	28, // 67: squzy.v1.monitoring.SchedulersExecutor.Add:output_type -> squzy.v1.monitoring.AddResponse
+	30, // 68: squzy.v1.monitoring.SchedulersExecutor.Remove:output_type -> squzy.v1.monitoring.RemoveResponse
+	35, // 69: squzy.v1.monitoring.SchedulersExecutor.Run:output_type -> squzy.v1.monitoring.RunResponse
+	36, // 70: squzy.v1.monitoring.SchedulersExecutor.Stop:output_type -> squzy.v1.monitoring.StopResponse
+	34, // 71: squzy.v1.monitoring.SchedulersExecutor.Ping:output_type -> squzy.v1.monitoring.PingResponse
+	53, // 72: squzy.v1.monitoring.SchedulersExecutor.SetSecret:output_type -> google.protobuf.Empty
+	53, // 73: squzy.v1.monitoring.SchedulersExecutor.RemoveSecret:output_type -> google.protobuf.Empty
+	39, // 74: squzy.v1.monitoring.SchedulersExecutor.GetSecretList:output_type -> squzy.v1.monitoring.GetSecretListResponse
+	41, // 75: squzy.v1.monitoring.SchedulersExecutor.GetStorageStatus:output_type -> squzy.v1.monitoring.GetStorageStatusResponse
+	65, // [65:76] is the sub-list for method output_type
+	54, // [54:65] is the sub-list for method input_type
+	54, // [54:54] is the sub-list for extension type_name
+	54, // [54:54] is the sub-list for extension extendee
+	0,  // [0:54] is the sub-list for field type_name
+}
+
+func init() { file_proto_v1_squzy_monitoring_proto_init() }
+func file_proto_v1_squzy_monitoring_proto_init() {
+	if File_proto_v1_squzy_monitoring_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_v1_squzy_monitoring_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SchedulerSnapshotWithId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SchedulerSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSchedulerByIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Scheduler); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSchedulerListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteMapConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpClientConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpAuthConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TcpConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SslExpirationConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrpcConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebSocketConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DatabaseConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeartbeatConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContentChangeConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrometheusConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpJsonValueConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSecretRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSecretListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageQueueStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStorageStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SchedulerSnapshot_Error); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SchedulerSnapshot_MetaData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DatabaseConfig_Assertion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_monitoring_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpJsonValueConfig_Selectors); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_proto_v1_squzy_monitoring_proto_msgTypes[3].OneofWrappers = []interface{}{
+		(*Scheduler_Tcp)(nil),
+		(*Scheduler_Sitemap)(nil),
+		(*Scheduler_Grpc)(nil),
+		(*Scheduler_Http)(nil),
+		(*Scheduler_HttpValue)(nil),
+		(*Scheduler_SslExpiration)(nil),
+		(*Scheduler_Websocket)(nil),
+		(*Scheduler_Database)(nil),
+		(*Scheduler_Heartbeat)(nil),
+		(*Scheduler_ContentChange)(nil),
+		(*Scheduler_Prometheus)(nil),
+	}
+	file_proto_v1_squzy_monitoring_proto_msgTypes[18].OneofWrappers = []interface{}{
+		(*AddRequest_Tcp)(nil),
+		(*AddRequest_Sitemap)(nil),
+		(*AddRequest_Grpc)(nil),
+		(*AddRequest_Http)(nil),
+		(*AddRequest_HttpValue)(nil),
+		(*AddRequest_SslExpiration)(nil),
+		(*AddRequest_Websocket)(nil),
+		(*AddRequest_Database)(nil),
+		(*AddRequest_Heartbeat)(nil),
+		(*AddRequest_ContentChange)(nil),
+		(*AddRequest_Prometheus)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_v1_squzy_monitoring_proto_rawDesc,
+			NumEnums:      9,
+			NumMessages:   42,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_v1_squzy_monitoring_proto_goTypes,
+		DependencyIndexes: file_proto_v1_squzy_monitoring_proto_depIdxs,
+		EnumInfos:         file_proto_v1_squzy_monitoring_proto_enumTypes,
+		MessageInfos:      file_proto_v1_squzy_monitoring_proto_msgTypes,
+	}.Build()
+	File_proto_v1_squzy_monitoring_proto = out.File
+	file_proto_v1_squzy_monitoring_proto_rawDesc = nil
+	file_proto_v1_squzy_monitoring_proto_goTypes = nil
+	file_proto_v1_squzy_monitoring_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// SchedulersExecutorClient is the client API for SchedulersExecutor service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SchedulersExecutorClient interface {
+	GetSchedulerList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetSchedulerListResponse, error)
+	GetSchedulerById(ctx context.Context, in *GetSchedulerByIdRequest, opts ...grpc.CallOption) (*Scheduler, error)
+	Add(ctx context.Context, in *AddRequest, opts ...grpc.CallOption) (*AddResponse, error)
+	Remove(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*RemoveResponse, error)
+	Run(ctx context.Context, in *RunRequest, opts ...grpc.CallOption) (*RunResponse, error)
+	Stop(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopResponse, error)
+	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
+	SetSecret(ctx context.Context, in *SetSecretRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RemoveSecret(ctx context.Context, in *SecretRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetSecretList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetSecretListResponse, error)
+	GetStorageStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetStorageStatusResponse, error)
+}
+
+type schedulersExecutorClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSchedulersExecutorClient(cc grpc.ClientConnInterface) SchedulersExecutorClient {
+	return &schedulersExecutorClient{cc}
+}
+
+func (c *schedulersExecutorClient) GetSchedulerList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetSchedulerListResponse, error) {
+	out := new(GetSchedulerListResponse)
+	err := c.cc.Invoke(ctx, "/squzy.v1.monitoring.SchedulersExecutor/GetSchedulerList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulersExecutorClient) GetSchedulerById(ctx context.Context, in *GetSchedulerByIdRequest, opts ...grpc.CallOption) (*Scheduler, error) {
+	out := new(Scheduler)
+	err := c.cc.Invoke(ctx, "/squzy.v1.monitoring.SchedulersExecutor/GetSchedulerById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulersExecutorClient) Add(ctx context.Context, in *AddRequest, opts ...grpc.CallOption) (*AddResponse, error) {
+	out := new(AddResponse)
+	err := c.cc.Invoke(ctx, "/squzy.v1.monitoring.SchedulersExecutor/Add", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulersExecutorClient) Remove(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*RemoveResponse, error) {
+	out := new(RemoveResponse)
+	err := c.cc.Invoke(ctx, "/squzy.v1.monitoring.SchedulersExecutor/Remove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulersExecutorClient) Run(ctx context.Context, in *RunRequest, opts ...grpc.CallOption) (*RunResponse, error) {
+	out := new(RunResponse)
+	err := c.cc.Invoke(ctx, "/squzy.v1.monitoring.SchedulersExecutor/Run", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulersExecutorClient) Stop(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopResponse, error) {
+	out := new(StopResponse)
+	err := c.cc.Invoke(ctx, "/squzy.v1.monitoring.SchedulersExecutor/Stop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulersExecutorClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error) {
+	out := new(PingResponse)
+	err := c.cc.Invoke(ctx, "/squzy.v1.monitoring.SchedulersExecutor/Ping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulersExecutorClient) SetSecret(ctx context.Context, in *SetSecretRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/squzy.v1.monitoring.SchedulersExecutor/SetSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulersExecutorClient) RemoveSecret(ctx context.Context, in *SecretRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/squzy.v1.monitoring.SchedulersExecutor/RemoveSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulersExecutorClient) GetSecretList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetSecretListResponse, error) {
+	out := new(GetSecretListResponse)
+	err := c.cc.Invoke(ctx, "/squzy.v1.monitoring.SchedulersExecutor/GetSecretList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulersExecutorClient) GetStorageStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetStorageStatusResponse, error) {
+	out := new(GetStorageStatusResponse)
+	err := c.cc.Invoke(ctx, "/squzy.v1.monitoring.SchedulersExecutor/GetStorageStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SchedulersExecutorServer is the server API for SchedulersExecutor service.
+type SchedulersExecutorServer interface {
+	GetSchedulerList(context.Context, *emptypb.Empty) (*GetSchedulerListResponse, error)
+	GetSchedulerById(context.Context, *GetSchedulerByIdRequest) (*Scheduler, error)
+	Add(context.Context, *AddRequest) (*AddResponse, error)
+	Remove(context.Context, *RemoveRequest) (*RemoveResponse, error)
+	Run(context.Context, *RunRequest) (*RunResponse, error)
+	Stop(context.Context, *StopRequest) (*StopResponse, error)
+	Ping(context.Context, *PingRequest) (*PingResponse, error)
+	SetSecret(context.Context, *SetSecretRequest) (*emptypb.Empty, error)
+	RemoveSecret(context.Context, *SecretRequest) (*emptypb.Empty, error)
+	GetSecretList(context.Context, *emptypb.Empty) (*GetSecretListResponse, error)
+	GetStorageStatus(context.Context, *emptypb.Empty) (*GetStorageStatusResponse, error)
+}
+
+// UnimplementedSchedulersExecutorServer can be embedded to have forward compatible implementations.
+type UnimplementedSchedulersExecutorServer struct {
+}
+
+func (*UnimplementedSchedulersExecutorServer) GetSchedulerList(context.Context, *emptypb.Empty) (*GetSchedulerListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchedulerList not implemented")
+}
+func (*UnimplementedSchedulersExecutorServer) GetSchedulerById(context.Context, *GetSchedulerByIdRequest) (*Scheduler, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchedulerById not implemented")
+}
+func (*UnimplementedSchedulersExecutorServer) Add(context.Context, *AddRequest) (*AddResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Add not implemented")
+}
+func (*UnimplementedSchedulersExecutorServer) Remove(context.Context, *RemoveRequest) (*RemoveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Remove not implemented")
+}
+func (*UnimplementedSchedulersExecutorServer) Run(context.Context, *RunRequest) (*RunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Run not implemented")
+}
+func (*UnimplementedSchedulersExecutorServer) Stop(context.Context, *StopRequest) (*StopResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stop not implemented")
+}
+func (*UnimplementedSchedulersExecutorServer) Ping(context.Context, *PingRequest) (*PingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+func (*UnimplementedSchedulersExecutorServer) SetSecret(context.Context, *SetSecretRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSecret not implemented")
+}
+func (*UnimplementedSchedulersExecutorServer) RemoveSecret(context.Context, *SecretRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSecret not implemented")
+}
+func (*UnimplementedSchedulersExecutorServer) GetSecretList(context.Context, *emptypb.Empty) (*GetSecretListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSecretList not implemented")
+}
+func (*UnimplementedSchedulersExecutorServer) GetStorageStatus(context.Context, *emptypb.Empty) (*GetStorageStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStorageStatus not implemented")
+}
+
+func RegisterSchedulersExecutorServer(s *grpc.Server, srv SchedulersExecutorServer) {
+	s.RegisterService(&_SchedulersExecutor_serviceDesc, srv)
+}
+
+func _SchedulersExecutor_GetSchedulerList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulersExecutorServer).GetSchedulerList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.monitoring.SchedulersExecutor/GetSchedulerList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulersExecutorServer).GetSchedulerList(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulersExecutor_GetSchedulerById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSchedulerByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulersExecutorServer).GetSchedulerById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.monitoring.SchedulersExecutor/GetSchedulerById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulersExecutorServer).GetSchedulerById(ctx, req.(*GetSchedulerByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulersExecutor_Add_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulersExecutorServer).Add(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.monitoring.SchedulersExecutor/Add",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulersExecutorServer).Add(ctx, req.(*AddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulersExecutor_Remove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulersExecutorServer).Remove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.monitoring.SchedulersExecutor/Remove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulersExecutorServer).Remove(ctx, req.(*RemoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulersExecutor_Run_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulersExecutorServer).Run(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.monitoring.SchedulersExecutor/Run",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulersExecutorServer).Run(ctx, req.(*RunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulersExecutor_Stop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulersExecutorServer).Stop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.monitoring.SchedulersExecutor/Stop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulersExecutorServer).Stop(ctx, req.(*StopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulersExecutor_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulersExecutorServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.monitoring.SchedulersExecutor/Ping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulersExecutorServer).Ping(ctx, req.(*PingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulersExecutor_SetSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulersExecutorServer).SetSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.monitoring.SchedulersExecutor/SetSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulersExecutorServer).SetSecret(ctx, req.(*SetSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulersExecutor_RemoveSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulersExecutorServer).RemoveSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.monitoring.SchedulersExecutor/RemoveSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulersExecutorServer).RemoveSecret(ctx, req.(*SecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulersExecutor_GetSecretList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulersExecutorServer).GetSecretList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.monitoring.SchedulersExecutor/GetSecretList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulersExecutorServer).GetSecretList(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulersExecutor_GetStorageStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulersExecutorServer).GetStorageStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.monitoring.SchedulersExecutor/GetStorageStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulersExecutorServer).GetStorageStatus(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _SchedulersExecutor_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "squzy.v1.monitoring.SchedulersExecutor",
+	HandlerType: (*SchedulersExecutorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSchedulerList",
+			Handler:    _SchedulersExecutor_GetSchedulerList_Handler,
+		},
+		{
+			MethodName: "GetSchedulerById",
+			Handler:    _SchedulersExecutor_GetSchedulerById_Handler,
+		},
+		{
+			MethodName: "Add",
+			Handler:    _SchedulersExecutor_Add_Handler,
+		},
+		{
+			MethodName: "Remove",
+			Handler:    _SchedulersExecutor_Remove_Handler,
+		},
+		{
+			MethodName: "Run",
+			Handler:    _SchedulersExecutor_Run_Handler,
+		},
+		{
+			MethodName: "Stop",
+			Handler:    _SchedulersExecutor_Stop_Handler,
+		},
+		{
+			MethodName: "Ping",
+			Handler:    _SchedulersExecutor_Ping_Handler,
+		},
+		{
+			MethodName: "SetSecret",
+			Handler:    _SchedulersExecutor_SetSecret_Handler,
+		},
+		{
+			MethodName: "RemoveSecret",
+			Handler:    _SchedulersExecutor_RemoveSecret_Handler,
+		},
+		{
+			MethodName: "GetSecretList",
+			Handler:    _SchedulersExecutor_GetSecretList_Handler,
+		},
+		{
+			MethodName: "GetStorageStatus",
+			Handler:    _SchedulersExecutor_GetStorageStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/v1/squzy_monitoring.proto",
+}
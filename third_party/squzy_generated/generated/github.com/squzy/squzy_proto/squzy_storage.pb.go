@@ -0,0 +1,5580 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        (unknown)
+// source: proto/v1/squzy_storage.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SortIncidentList int32
+
+const (
+	SortIncidentList_SORT_INCIDENT_LIST_UNSPECIFIED SortIncidentList = 0
+	SortIncidentList_INCIDENT_LIST_BY_START_TIME    SortIncidentList = 1
+	SortIncidentList_INCIDENT_LIST_BY_END_TIME      SortIncidentList = 2
+)
+
+// Enum value maps for SortIncidentList.
+var (
+	SortIncidentList_name = map[int32]string{
+		0: "SORT_INCIDENT_LIST_UNSPECIFIED",
+		1: "INCIDENT_LIST_BY_START_TIME",
+		2: "INCIDENT_LIST_BY_END_TIME",
+	}
+	SortIncidentList_value = map[string]int32{
+		"SORT_INCIDENT_LIST_UNSPECIFIED": 0,
+		"INCIDENT_LIST_BY_START_TIME":    1,
+		"INCIDENT_LIST_BY_END_TIME":      2,
+	}
+)
+
+func (x SortIncidentList) Enum() *SortIncidentList {
+	p := new(SortIncidentList)
+	*p = x
+	return p
+}
+
+func (x SortIncidentList) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortIncidentList) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_squzy_storage_proto_enumTypes[0].Descriptor()
+}
+
+func (SortIncidentList) Type() protoreflect.EnumType {
+	return &file_proto_v1_squzy_storage_proto_enumTypes[0]
+}
+
+func (x SortIncidentList) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortIncidentList.Descriptor instead.
+func (SortIncidentList) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{0}
+}
+
+type SortTransactionList int32
+
+const (
+	SortTransactionList_SORT_TRANSACTION_LIST_UNSPECIFIED SortTransactionList = 0
+	SortTransactionList_DURATION                          SortTransactionList = 1
+	SortTransactionList_BY_TRANSACTION_START_TIME         SortTransactionList = 2
+	SortTransactionList_BY_TRANSACTION_END_TIME           SortTransactionList = 3
+)
+
+// Enum value maps for SortTransactionList.
+var (
+	SortTransactionList_name = map[int32]string{
+		0: "SORT_TRANSACTION_LIST_UNSPECIFIED",
+		1: "DURATION",
+		2: "BY_TRANSACTION_START_TIME",
+		3: "BY_TRANSACTION_END_TIME",
+	}
+	SortTransactionList_value = map[string]int32{
+		"SORT_TRANSACTION_LIST_UNSPECIFIED": 0,
+		"DURATION":                          1,
+		"BY_TRANSACTION_START_TIME":         2,
+		"BY_TRANSACTION_END_TIME":           3,
+	}
+)
+
+func (x SortTransactionList) Enum() *SortTransactionList {
+	p := new(SortTransactionList)
+	*p = x
+	return p
+}
+
+func (x SortTransactionList) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortTransactionList) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_squzy_storage_proto_enumTypes[1].Descriptor()
+}
+
+func (SortTransactionList) Type() protoreflect.EnumType {
+	return &file_proto_v1_squzy_storage_proto_enumTypes[1]
+}
+
+func (x SortTransactionList) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortTransactionList.Descriptor instead.
+func (SortTransactionList) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{1}
+}
+
+type SeriesResolution int32
+
+const (
+	SeriesResolution_SERIES_RESOLUTION_UNSPECIFIED SeriesResolution = 0
+	SeriesResolution_SERIES_RESOLUTION_MINUTE      SeriesResolution = 1
+	SeriesResolution_SERIES_RESOLUTION_HOUR        SeriesResolution = 2
+	SeriesResolution_SERIES_RESOLUTION_DAY         SeriesResolution = 3
+)
+
+// Enum value maps for SeriesResolution.
+var (
+	SeriesResolution_name = map[int32]string{
+		0: "SERIES_RESOLUTION_UNSPECIFIED",
+		1: "SERIES_RESOLUTION_MINUTE",
+		2: "SERIES_RESOLUTION_HOUR",
+		3: "SERIES_RESOLUTION_DAY",
+	}
+	SeriesResolution_value = map[string]int32{
+		"SERIES_RESOLUTION_UNSPECIFIED": 0,
+		"SERIES_RESOLUTION_MINUTE":      1,
+		"SERIES_RESOLUTION_HOUR":        2,
+		"SERIES_RESOLUTION_DAY":         3,
+	}
+)
+
+func (x SeriesResolution) Enum() *SeriesResolution {
+	p := new(SeriesResolution)
+	*p = x
+	return p
+}
+
+func (x SeriesResolution) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SeriesResolution) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_squzy_storage_proto_enumTypes[2].Descriptor()
+}
+
+func (SeriesResolution) Type() protoreflect.EnumType {
+	return &file_proto_v1_squzy_storage_proto_enumTypes[2]
+}
+
+func (x SeriesResolution) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SeriesResolution.Descriptor instead.
+func (SeriesResolution) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{2}
+}
+
+type SortDirection int32
+
+const (
+	SortDirection_SORT_DIRECTION_UNSPECIFIED SortDirection = 0
+	SortDirection_ASC                        SortDirection = 1
+	SortDirection_DESC                       SortDirection = 2
+)
+
+// Enum value maps for SortDirection.
+var (
+	SortDirection_name = map[int32]string{
+		0: "SORT_DIRECTION_UNSPECIFIED",
+		1: "ASC",
+		2: "DESC",
+	}
+	SortDirection_value = map[string]int32{
+		"SORT_DIRECTION_UNSPECIFIED": 0,
+		"ASC":                        1,
+		"DESC":                       2,
+	}
+)
+
+func (x SortDirection) Enum() *SortDirection {
+	p := new(SortDirection)
+	*p = x
+	return p
+}
+
+func (x SortDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_squzy_storage_proto_enumTypes[3].Descriptor()
+}
+
+func (SortDirection) Type() protoreflect.EnumType {
+	return &file_proto_v1_squzy_storage_proto_enumTypes[3]
+}
+
+func (x SortDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortDirection.Descriptor instead.
+func (SortDirection) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{3}
+}
+
+type SortSchedulerList int32
+
+const (
+	SortSchedulerList_SORT_SCHEDULER_LIST_UNSPECIFIED SortSchedulerList = 0
+	SortSchedulerList_BY_START_TIME                   SortSchedulerList = 1
+	SortSchedulerList_BY_END_TIME                     SortSchedulerList = 2
+	SortSchedulerList_BY_LATENCY                      SortSchedulerList = 3
+)
+
+// Enum value maps for SortSchedulerList.
+var (
+	SortSchedulerList_name = map[int32]string{
+		0: "SORT_SCHEDULER_LIST_UNSPECIFIED",
+		1: "BY_START_TIME",
+		2: "BY_END_TIME",
+		3: "BY_LATENCY",
+	}
+	SortSchedulerList_value = map[string]int32{
+		"SORT_SCHEDULER_LIST_UNSPECIFIED": 0,
+		"BY_START_TIME":                   1,
+		"BY_END_TIME":                     2,
+		"BY_LATENCY":                      3,
+	}
+)
+
+func (x SortSchedulerList) Enum() *SortSchedulerList {
+	p := new(SortSchedulerList)
+	*p = x
+	return p
+}
+
+func (x SortSchedulerList) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortSchedulerList) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_squzy_storage_proto_enumTypes[4].Descriptor()
+}
+
+func (SortSchedulerList) Type() protoreflect.EnumType {
+	return &file_proto_v1_squzy_storage_proto_enumTypes[4]
+}
+
+func (x SortSchedulerList) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortSchedulerList.Descriptor instead.
+func (SortSchedulerList) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{4}
+}
+
+type GroupTransaction int32
+
+const (
+	GroupTransaction_GROUP_TRANSACTION_UNSPECIFIED GroupTransaction = 0
+	GroupTransaction_BY_TYPE                       GroupTransaction = 1
+	GroupTransaction_BY_NAME                       GroupTransaction = 2
+	GroupTransaction_BY_METHOD                     GroupTransaction = 3
+	GroupTransaction_BY_HOST                       GroupTransaction = 4
+	GroupTransaction_BY_PATH                       GroupTransaction = 5
+)
+
+// Enum value maps for GroupTransaction.
+var (
+	GroupTransaction_name = map[int32]string{
+		0: "GROUP_TRANSACTION_UNSPECIFIED",
+		1: "BY_TYPE",
+		2: "BY_NAME",
+		3: "BY_METHOD",
+		4: "BY_HOST",
+		5: "BY_PATH",
+	}
+	GroupTransaction_value = map[string]int32{
+		"GROUP_TRANSACTION_UNSPECIFIED": 0,
+		"BY_TYPE":                       1,
+		"BY_NAME":                       2,
+		"BY_METHOD":                     3,
+		"BY_HOST":                       4,
+		"BY_PATH":                       5,
+	}
+)
+
+func (x GroupTransaction) Enum() *GroupTransaction {
+	p := new(GroupTransaction)
+	*p = x
+	return p
+}
+
+func (x GroupTransaction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GroupTransaction) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_squzy_storage_proto_enumTypes[5].Descriptor()
+}
+
+func (GroupTransaction) Type() protoreflect.EnumType {
+	return &file_proto_v1_squzy_storage_proto_enumTypes[5]
+}
+
+func (x GroupTransaction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GroupTransaction.Descriptor instead.
+func (GroupTransaction) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{5}
+}
+
+type TypeAgentStat int32
+
+const (
+	TypeAgentStat_AGENT_STATS_TYPE_UNSPECIFIED TypeAgentStat = 0
+	TypeAgentStat_ALL                          TypeAgentStat = 1
+	TypeAgentStat_CPU                          TypeAgentStat = 2
+	TypeAgentStat_DISK                         TypeAgentStat = 3
+	TypeAgentStat_MEMORY                       TypeAgentStat = 4
+	TypeAgentStat_NET                          TypeAgentStat = 5
+)
+
+// Enum value maps for TypeAgentStat.
+var (
+	TypeAgentStat_name = map[int32]string{
+		0: "AGENT_STATS_TYPE_UNSPECIFIED",
+		1: "ALL",
+		2: "CPU",
+		3: "DISK",
+		4: "MEMORY",
+		5: "NET",
+	}
+	TypeAgentStat_value = map[string]int32{
+		"AGENT_STATS_TYPE_UNSPECIFIED": 0,
+		"ALL":                          1,
+		"CPU":                          2,
+		"DISK":                         3,
+		"MEMORY":                       4,
+		"NET":                          5,
+	}
+)
+
+func (x TypeAgentStat) Enum() *TypeAgentStat {
+	p := new(TypeAgentStat)
+	*p = x
+	return p
+}
+
+func (x TypeAgentStat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TypeAgentStat) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_squzy_storage_proto_enumTypes[6].Descriptor()
+}
+
+func (TypeAgentStat) Type() protoreflect.EnumType {
+	return &file_proto_v1_squzy_storage_proto_enumTypes[6]
+}
+
+func (x TypeAgentStat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TypeAgentStat.Descriptor instead.
+func (TypeAgentStat) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{6}
+}
+
+type StreamEventType int32
+
+const (
+	StreamEventType_STREAM_EVENT_TYPE_UNSPECIFIED StreamEventType = 0
+	StreamEventType_STREAM_EVENT_SNAPSHOT         StreamEventType = 1
+	StreamEventType_STREAM_EVENT_AGENT_METRIC     StreamEventType = 2
+	StreamEventType_STREAM_EVENT_TRANSACTION      StreamEventType = 3
+	StreamEventType_STREAM_EVENT_INCIDENT         StreamEventType = 4
+)
+
+// Enum value maps for StreamEventType.
+var (
+	StreamEventType_name = map[int32]string{
+		0: "STREAM_EVENT_TYPE_UNSPECIFIED",
+		1: "STREAM_EVENT_SNAPSHOT",
+		2: "STREAM_EVENT_AGENT_METRIC",
+		3: "STREAM_EVENT_TRANSACTION",
+		4: "STREAM_EVENT_INCIDENT",
+	}
+	StreamEventType_value = map[string]int32{
+		"STREAM_EVENT_TYPE_UNSPECIFIED": 0,
+		"STREAM_EVENT_SNAPSHOT":         1,
+		"STREAM_EVENT_AGENT_METRIC":     2,
+		"STREAM_EVENT_TRANSACTION":      3,
+		"STREAM_EVENT_INCIDENT":         4,
+	}
+)
+
+func (x StreamEventType) Enum() *StreamEventType {
+	p := new(StreamEventType)
+	*p = x
+	return p
+}
+
+func (x StreamEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StreamEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_squzy_storage_proto_enumTypes[7].Descriptor()
+}
+
+func (StreamEventType) Type() protoreflect.EnumType {
+	return &file_proto_v1_squzy_storage_proto_enumTypes[7]
+}
+
+func (x StreamEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StreamEventType.Descriptor instead.
+func (StreamEventType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{7}
+}
+
+type UpdateIncidentStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IncidentId string         `protobuf:"bytes,1,opt,name=incident_id,json=incidentId,proto3" json:"incident_id,omitempty"`
+	Status     IncidentStatus `protobuf:"varint,2,opt,name=status,proto3,enum=squzy.v1.incident.IncidentStatus" json:"status,omitempty"`
+}
+
+func (x *UpdateIncidentStatusRequest) Reset() {
+	*x = UpdateIncidentStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateIncidentStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateIncidentStatusRequest) ProtoMessage() {}
+
+func (x *UpdateIncidentStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateIncidentStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateIncidentStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UpdateIncidentStatusRequest) GetIncidentId() string {
+	if x != nil {
+		return x.IncidentId
+	}
+	return ""
+}
+
+func (x *UpdateIncidentStatusRequest) GetStatus() IncidentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return IncidentStatus_INCIDENT_STATUS_UNSPECIFIED
+}
+
+type SortingIncidentList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SortBy    SortIncidentList `protobuf:"varint,1,opt,name=sort_by,json=sortBy,proto3,enum=squzy.v1.storage.SortIncidentList" json:"sort_by,omitempty"`
+	Direction SortDirection    `protobuf:"varint,2,opt,name=direction,proto3,enum=squzy.v1.storage.SortDirection" json:"direction,omitempty"`
+}
+
+func (x *SortingIncidentList) Reset() {
+	*x = SortingIncidentList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SortingIncidentList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortingIncidentList) ProtoMessage() {}
+
+func (x *SortingIncidentList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortingIncidentList.ProtoReflect.Descriptor instead.
+func (*SortingIncidentList) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SortingIncidentList) GetSortBy() SortIncidentList {
+	if x != nil {
+		return x.SortBy
+	}
+	return SortIncidentList_SORT_INCIDENT_LIST_UNSPECIFIED
+}
+
+func (x *SortingIncidentList) GetDirection() SortDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return SortDirection_SORT_DIRECTION_UNSPECIFIED
+}
+
+type GetIncidentsListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status     IncidentStatus          `protobuf:"varint,1,opt,name=status,proto3,enum=squzy.v1.incident.IncidentStatus" json:"status,omitempty"`
+	RuleId     *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Pagination *Pagination             `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	TimeRange  *TimeFilter             `protobuf:"bytes,4,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	Sort       *SortingIncidentList    `protobuf:"bytes,5,opt,name=sort,proto3" json:"sort,omitempty"`
+}
+
+func (x *GetIncidentsListRequest) Reset() {
+	*x = GetIncidentsListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetIncidentsListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIncidentsListRequest) ProtoMessage() {}
+
+func (x *GetIncidentsListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIncidentsListRequest.ProtoReflect.Descriptor instead.
+func (*GetIncidentsListRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetIncidentsListRequest) GetStatus() IncidentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return IncidentStatus_INCIDENT_STATUS_UNSPECIFIED
+}
+
+func (x *GetIncidentsListRequest) GetRuleId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.RuleId
+	}
+	return nil
+}
+
+func (x *GetIncidentsListRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *GetIncidentsListRequest) GetTimeRange() *TimeFilter {
+	if x != nil {
+		return x.TimeRange
+	}
+	return nil
+}
+
+func (x *GetIncidentsListRequest) GetSort() *SortingIncidentList {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+type GetIncidentsListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count     int64        `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Incidents []*Incident  `protobuf:"bytes,2,rep,name=incidents,proto3" json:"incidents,omitempty"`
+	Cursors   *PageCursors `protobuf:"bytes,3,opt,name=cursors,proto3" json:"cursors,omitempty"`
+}
+
+func (x *GetIncidentsListResponse) Reset() {
+	*x = GetIncidentsListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetIncidentsListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIncidentsListResponse) ProtoMessage() {}
+
+func (x *GetIncidentsListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIncidentsListResponse.ProtoReflect.Descriptor instead.
+func (*GetIncidentsListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetIncidentsListResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetIncidentsListResponse) GetIncidents() []*Incident {
+	if x != nil {
+		return x.Incidents
+	}
+	return nil
+}
+
+func (x *GetIncidentsListResponse) GetCursors() *PageCursors {
+	if x != nil {
+		return x.Cursors
+	}
+	return nil
+}
+
+type GetSchedulerUptimeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SchedulerId string      `protobuf:"bytes,1,opt,name=scheduler_id,json=schedulerId,proto3" json:"scheduler_id,omitempty"`
+	TimeRange   *TimeFilter `protobuf:"bytes,2,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+}
+
+func (x *GetSchedulerUptimeRequest) Reset() {
+	*x = GetSchedulerUptimeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSchedulerUptimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSchedulerUptimeRequest) ProtoMessage() {}
+
+func (x *GetSchedulerUptimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSchedulerUptimeRequest.ProtoReflect.Descriptor instead.
+func (*GetSchedulerUptimeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetSchedulerUptimeRequest) GetSchedulerId() string {
+	if x != nil {
+		return x.SchedulerId
+	}
+	return ""
+}
+
+func (x *GetSchedulerUptimeRequest) GetTimeRange() *TimeFilter {
+	if x != nil {
+		return x.TimeRange
+	}
+	return nil
+}
+
+type GetSchedulerUptimeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uptime  float64 `protobuf:"fixed64,1,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	Latency float64 `protobuf:"fixed64,2,opt,name=latency,proto3" json:"latency,omitempty"`
+}
+
+func (x *GetSchedulerUptimeResponse) Reset() {
+	*x = GetSchedulerUptimeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSchedulerUptimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSchedulerUptimeResponse) ProtoMessage() {}
+
+func (x *GetSchedulerUptimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSchedulerUptimeResponse.ProtoReflect.Descriptor instead.
+func (*GetSchedulerUptimeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetSchedulerUptimeResponse) GetUptime() float64 {
+	if x != nil {
+		return x.Uptime
+	}
+	return 0
+}
+
+func (x *GetSchedulerUptimeResponse) GetLatency() float64 {
+	if x != nil {
+		return x.Latency
+	}
+	return 0
+}
+
+// Resolution is increased if time range contains too many buckets, unspecified one is chosen by time range
+type GetSchedulerUptimeSeriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SchedulerId string           `protobuf:"bytes,1,opt,name=scheduler_id,json=schedulerId,proto3" json:"scheduler_id,omitempty"`
+	TimeRange   *TimeFilter      `protobuf:"bytes,2,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	Resolution  SeriesResolution `protobuf:"varint,3,opt,name=resolution,proto3,enum=squzy.v1.storage.SeriesResolution" json:"resolution,omitempty"`
+}
+
+func (x *GetSchedulerUptimeSeriesRequest) Reset() {
+	*x = GetSchedulerUptimeSeriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSchedulerUptimeSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSchedulerUptimeSeriesRequest) ProtoMessage() {}
+
+func (x *GetSchedulerUptimeSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSchedulerUptimeSeriesRequest.ProtoReflect.Descriptor instead.
+func (*GetSchedulerUptimeSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetSchedulerUptimeSeriesRequest) GetSchedulerId() string {
+	if x != nil {
+		return x.SchedulerId
+	}
+	return ""
+}
+
+func (x *GetSchedulerUptimeSeriesRequest) GetTimeRange() *TimeFilter {
+	if x != nil {
+		return x.TimeRange
+	}
+	return nil
+}
+
+func (x *GetSchedulerUptimeSeriesRequest) GetResolution() SeriesResolution {
+	if x != nil {
+		return x.Resolution
+	}
+	return SeriesResolution_SERIES_RESOLUTION_UNSPECIFIED
+}
+
+// Latency is calculated only by successful checks, buckets without checks are skipped
+type UptimeSeriesPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time       *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Count      int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Uptime     float64                `protobuf:"fixed64,3,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	LatencyP50 float64                `protobuf:"fixed64,4,opt,name=latency_p50,json=latencyP50,proto3" json:"latency_p50,omitempty"`
+	LatencyP95 float64                `protobuf:"fixed64,5,opt,name=latency_p95,json=latencyP95,proto3" json:"latency_p95,omitempty"`
+	LatencyP99 float64                `protobuf:"fixed64,6,opt,name=latency_p99,json=latencyP99,proto3" json:"latency_p99,omitempty"`
+}
+
+func (x *UptimeSeriesPoint) Reset() {
+	*x = UptimeSeriesPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UptimeSeriesPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UptimeSeriesPoint) ProtoMessage() {}
+
+func (x *UptimeSeriesPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UptimeSeriesPoint.ProtoReflect.Descriptor instead.
+func (*UptimeSeriesPoint) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UptimeSeriesPoint) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *UptimeSeriesPoint) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *UptimeSeriesPoint) GetUptime() float64 {
+	if x != nil {
+		return x.Uptime
+	}
+	return 0
+}
+
+func (x *UptimeSeriesPoint) GetLatencyP50() float64 {
+	if x != nil {
+		return x.LatencyP50
+	}
+	return 0
+}
+
+func (x *UptimeSeriesPoint) GetLatencyP95() float64 {
+	if x != nil {
+		return x.LatencyP95
+	}
+	return 0
+}
+
+func (x *UptimeSeriesPoint) GetLatencyP99() float64 {
+	if x != nil {
+		return x.LatencyP99
+	}
+	return 0
+}
+
+type GetSchedulerUptimeSeriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Resolution SeriesResolution     `protobuf:"varint,1,opt,name=resolution,proto3,enum=squzy.v1.storage.SeriesResolution" json:"resolution,omitempty"`
+	Points     []*UptimeSeriesPoint `protobuf:"bytes,2,rep,name=points,proto3" json:"points,omitempty"`
+}
+
+func (x *GetSchedulerUptimeSeriesResponse) Reset() {
+	*x = GetSchedulerUptimeSeriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSchedulerUptimeSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSchedulerUptimeSeriesResponse) ProtoMessage() {}
+
+func (x *GetSchedulerUptimeSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSchedulerUptimeSeriesResponse.ProtoReflect.Descriptor instead.
+func (*GetSchedulerUptimeSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetSchedulerUptimeSeriesResponse) GetResolution() SeriesResolution {
+	if x != nil {
+		return x.Resolution
+	}
+	return SeriesResolution_SERIES_RESOLUTION_UNSPECIFIED
+}
+
+func (x *GetSchedulerUptimeSeriesResponse) GetPoints() []*UptimeSeriesPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type GetSchedulerOutagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SchedulerId string      `protobuf:"bytes,1,opt,name=scheduler_id,json=schedulerId,proto3" json:"scheduler_id,omitempty"`
+	TimeRange   *TimeFilter `protobuf:"bytes,2,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+}
+
+func (x *GetSchedulerOutagesRequest) Reset() {
+	*x = GetSchedulerOutagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSchedulerOutagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSchedulerOutagesRequest) ProtoMessage() {}
+
+func (x *GetSchedulerOutagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSchedulerOutagesRequest.ProtoReflect.Descriptor instead.
+func (*GetSchedulerOutagesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetSchedulerOutagesRequest) GetSchedulerId() string {
+	if x != nil {
+		return x.SchedulerId
+	}
+	return ""
+}
+
+func (x *GetSchedulerOutagesRequest) GetTimeRange() *TimeFilter {
+	if x != nil {
+		return x.TimeRange
+	}
+	return nil
+}
+
+// Outage is ended by the first successful check, end_time is empty while outage is ongoing
+type SchedulerOutage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Nanoseconds
+	Duration    int64  `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	Error       string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	FailedCount int64  `protobuf:"varint,5,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+}
+
+func (x *SchedulerOutage) Reset() {
+	*x = SchedulerOutage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchedulerOutage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulerOutage) ProtoMessage() {}
+
+func (x *SchedulerOutage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulerOutage.ProtoReflect.Descriptor instead.
+func (*SchedulerOutage) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SchedulerOutage) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *SchedulerOutage) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *SchedulerOutage) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *SchedulerOutage) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SchedulerOutage) GetFailedCount() int64 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+type GetSchedulerOutagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Outages []*SchedulerOutage `protobuf:"bytes,1,rep,name=outages,proto3" json:"outages,omitempty"`
+}
+
+func (x *GetSchedulerOutagesResponse) Reset() {
+	*x = GetSchedulerOutagesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSchedulerOutagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSchedulerOutagesResponse) ProtoMessage() {}
+
+func (x *GetSchedulerOutagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSchedulerOutagesResponse.ProtoReflect.Descriptor instead.
+func (*GetSchedulerOutagesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetSchedulerOutagesResponse) GetOutages() []*SchedulerOutage {
+	if x != nil {
+		return x.Outages
+	}
+	return nil
+}
+
+// Bucket size is in seconds, it is increased if time range contains too many buckets, unspecified one is chosen by time range
+type GetAgentSeriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AgentId    string      `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	TimeRange  *TimeFilter `protobuf:"bytes,2,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	BucketSize int64       `protobuf:"varint,3,opt,name=bucket_size,json=bucketSize,proto3" json:"bucket_size,omitempty"`
+}
+
+func (x *GetAgentSeriesRequest) Reset() {
+	*x = GetAgentSeriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAgentSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAgentSeriesRequest) ProtoMessage() {}
+
+func (x *GetAgentSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAgentSeriesRequest.ProtoReflect.Descriptor instead.
+func (*GetAgentSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetAgentSeriesRequest) GetAgentId() string {
+	if x != nil {
+		return x.AgentId
+	}
+	return ""
+}
+
+func (x *GetAgentSeriesRequest) GetTimeRange() *TimeFilter {
+	if x != nil {
+		return x.TimeRange
+	}
+	return nil
+}
+
+func (x *GetAgentSeriesRequest) GetBucketSize() int64 {
+	if x != nil {
+		return x.BucketSize
+	}
+	return 0
+}
+
+type AgentSeriesValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Avg float64 `protobuf:"fixed64,1,opt,name=avg,proto3" json:"avg,omitempty"`
+	Max float64 `protobuf:"fixed64,2,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (x *AgentSeriesValue) Reset() {
+	*x = AgentSeriesValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgentSeriesValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentSeriesValue) ProtoMessage() {}
+
+func (x *AgentSeriesValue) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentSeriesValue.ProtoReflect.Descriptor instead.
+func (*AgentSeriesValue) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AgentSeriesValue) GetAvg() float64 {
+	if x != nil {
+		return x.Avg
+	}
+	return 0
+}
+
+func (x *AgentSeriesValue) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+// Bytes per second
+type AgentSeriesNetRate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BytesSent float64 `protobuf:"fixed64,1,opt,name=bytes_sent,json=bytesSent,proto3" json:"bytes_sent,omitempty"`
+	BytesRecv float64 `protobuf:"fixed64,2,opt,name=bytes_recv,json=bytesRecv,proto3" json:"bytes_recv,omitempty"`
+}
+
+func (x *AgentSeriesNetRate) Reset() {
+	*x = AgentSeriesNetRate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgentSeriesNetRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentSeriesNetRate) ProtoMessage() {}
+
+func (x *AgentSeriesNetRate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentSeriesNetRate.ProtoReflect.Descriptor instead.
+func (*AgentSeriesNetRate) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AgentSeriesNetRate) GetBytesSent() float64 {
+	if x != nil {
+		return x.BytesSent
+	}
+	return 0
+}
+
+func (x *AgentSeriesNetRate) GetBytesRecv() float64 {
+	if x != nil {
+		return x.BytesRecv
+	}
+	return 0
+}
+
+// Buckets without metrics are skipped
+type AgentSeriesPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// Load by core index
+	Cpus              []*AgentSeriesValue `protobuf:"bytes,2,rep,name=cpus,proto3" json:"cpus,omitempty"`
+	MemoryUsedPercent *AgentSeriesValue   `protobuf:"bytes,3,opt,name=memory_used_percent,json=memoryUsedPercent,proto3" json:"memory_used_percent,omitempty"`
+	SwapUsedPercent   *AgentSeriesValue   `protobuf:"bytes,4,opt,name=swap_used_percent,json=swapUsedPercent,proto3" json:"swap_used_percent,omitempty"`
+	// Used percent by mount
+	Disks      map[string]*AgentSeriesValue   `protobuf:"bytes,5,rep,name=disks,proto3" json:"disks,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Interfaces map[string]*AgentSeriesNetRate `protobuf:"bytes,6,rep,name=interfaces,proto3" json:"interfaces,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *AgentSeriesPoint) Reset() {
+	*x = AgentSeriesPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgentSeriesPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentSeriesPoint) ProtoMessage() {}
+
+func (x *AgentSeriesPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentSeriesPoint.ProtoReflect.Descriptor instead.
+func (*AgentSeriesPoint) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AgentSeriesPoint) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *AgentSeriesPoint) GetCpus() []*AgentSeriesValue {
+	if x != nil {
+		return x.Cpus
+	}
+	return nil
+}
+
+func (x *AgentSeriesPoint) GetMemoryUsedPercent() *AgentSeriesValue {
+	if x != nil {
+		return x.MemoryUsedPercent
+	}
+	return nil
+}
+
+func (x *AgentSeriesPoint) GetSwapUsedPercent() *AgentSeriesValue {
+	if x != nil {
+		return x.SwapUsedPercent
+	}
+	return nil
+}
+
+func (x *AgentSeriesPoint) GetDisks() map[string]*AgentSeriesValue {
+	if x != nil {
+		return x.Disks
+	}
+	return nil
+}
+
+func (x *AgentSeriesPoint) GetInterfaces() map[string]*AgentSeriesNetRate {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+type GetAgentSeriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BucketSize int64               `protobuf:"varint,1,opt,name=bucket_size,json=bucketSize,proto3" json:"bucket_size,omitempty"`
+	Points     []*AgentSeriesPoint `protobuf:"bytes,2,rep,name=points,proto3" json:"points,omitempty"`
+}
+
+func (x *GetAgentSeriesResponse) Reset() {
+	*x = GetAgentSeriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAgentSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAgentSeriesResponse) ProtoMessage() {}
+
+func (x *GetAgentSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAgentSeriesResponse.ProtoReflect.Descriptor instead.
+func (*GetAgentSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetAgentSeriesResponse) GetBucketSize() int64 {
+	if x != nil {
+		return x.BucketSize
+	}
+	return 0
+}
+
+func (x *GetAgentSeriesResponse) GetPoints() []*AgentSeriesPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type GetTransactionByIdResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transaction *TransactionInfo   `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	Children    []*TransactionInfo `protobuf:"bytes,2,rep,name=children,proto3" json:"children,omitempty"`
+}
+
+func (x *GetTransactionByIdResponse) Reset() {
+	*x = GetTransactionByIdResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTransactionByIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionByIdResponse) ProtoMessage() {}
+
+func (x *GetTransactionByIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionByIdResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionByIdResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetTransactionByIdResponse) GetTransaction() *TransactionInfo {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *GetTransactionByIdResponse) GetChildren() []*TransactionInfo {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type GetTransactionByNameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApplicationId   string      `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	TransactionName string      `protobuf:"bytes,2,opt,name=transaction_name,json=transactionName,proto3" json:"transaction_name,omitempty"`
+	Pagination      *Pagination `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	TimeRange       *TimeFilter `protobuf:"bytes,4,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+}
+
+func (x *GetTransactionByNameRequest) Reset() {
+	*x = GetTransactionByNameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTransactionByNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionByNameRequest) ProtoMessage() {}
+
+func (x *GetTransactionByNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionByNameRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionByNameRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetTransactionByNameRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *GetTransactionByNameRequest) GetTransactionName() string {
+	if x != nil {
+		return x.TransactionName
+	}
+	return ""
+}
+
+func (x *GetTransactionByNameRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *GetTransactionByNameRequest) GetTimeRange() *TimeFilter {
+	if x != nil {
+		return x.TimeRange
+	}
+	return nil
+}
+
+type GetTransactionByNameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count        int64              `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Transactions []*TransactionInfo `protobuf:"bytes,2,rep,name=transactions,proto3" json:"transactions,omitempty"`
+}
+
+func (x *GetTransactionByNameResponse) Reset() {
+	*x = GetTransactionByNameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTransactionByNameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionByNameResponse) ProtoMessage() {}
+
+func (x *GetTransactionByNameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionByNameResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionByNameResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetTransactionByNameResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetTransactionByNameResponse) GetTransactions() []*TransactionInfo {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+type GetTransactionByIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransactionId string `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+}
+
+func (x *GetTransactionByIdRequest) Reset() {
+	*x = GetTransactionByIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTransactionByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionByIdRequest) ProtoMessage() {}
+
+func (x *GetTransactionByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionByIdRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetTransactionByIdRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+// Times are in nanoseconds
+type TransactionTreeNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transaction *TransactionInfo `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// From start of the root transaction
+	Offset   int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Duration int64 `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	// Part of duration which is not covered by children
+	SelfTime int64                  `protobuf:"varint,4,opt,name=self_time,json=selfTime,proto3" json:"self_time,omitempty"`
+	Depth    int32                  `protobuf:"varint,5,opt,name=depth,proto3" json:"depth,omitempty"`
+	Children []*TransactionTreeNode `protobuf:"bytes,6,rep,name=children,proto3" json:"children,omitempty"`
+}
+
+func (x *TransactionTreeNode) Reset() {
+	*x = TransactionTreeNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionTreeNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionTreeNode) ProtoMessage() {}
+
+func (x *TransactionTreeNode) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionTreeNode.ProtoReflect.Descriptor instead.
+func (*TransactionTreeNode) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TransactionTreeNode) GetTransaction() *TransactionInfo {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *TransactionTreeNode) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *TransactionTreeNode) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *TransactionTreeNode) GetSelfTime() int64 {
+	if x != nil {
+		return x.SelfTime
+	}
+	return 0
+}
+
+func (x *TransactionTreeNode) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *TransactionTreeNode) GetChildren() []*TransactionTreeNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type GetTransactionTreeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Root *TransactionTreeNode `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	// From start of the root till the end of the latest transaction in the tree
+	Duration int64 `protobuf:"varint,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	Count    int32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GetTransactionTreeResponse) Reset() {
+	*x = GetTransactionTreeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTransactionTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionTreeResponse) ProtoMessage() {}
+
+func (x *GetTransactionTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionTreeResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionTreeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetTransactionTreeResponse) GetRoot() *TransactionTreeNode {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *GetTransactionTreeResponse) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *GetTransactionTreeResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetTransactionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count        int64              `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Transactions []*TransactionInfo `protobuf:"bytes,2,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	Cursors      *PageCursors       `protobuf:"bytes,3,opt,name=cursors,proto3" json:"cursors,omitempty"`
+}
+
+func (x *GetTransactionsResponse) Reset() {
+	*x = GetTransactionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionsResponse) ProtoMessage() {}
+
+func (x *GetTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetTransactionsResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetTransactionsResponse) GetTransactions() []*TransactionInfo {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *GetTransactionsResponse) GetCursors() *PageCursors {
+	if x != nil {
+		return x.Cursors
+	}
+	return nil
+}
+
+type GetTransactionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApplicationId string                  `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Pagination    *Pagination             `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	TimeRange     *TimeFilter             `protobuf:"bytes,3,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	Type          TransactionType         `protobuf:"varint,4,opt,name=type,proto3,enum=squzy.v1.monitoring.TransactionType" json:"type,omitempty"`
+	Status        TransactionStatus       `protobuf:"varint,5,opt,name=status,proto3,enum=squzy.v1.monitoring.TransactionStatus" json:"status,omitempty"`
+	Host          *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=host,proto3" json:"host,omitempty"`
+	Name          *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
+	Path          *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=path,proto3" json:"path,omitempty"`
+	Method        *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=method,proto3" json:"method,omitempty"`
+	Sort          *SortingTransactionList `protobuf:"bytes,10,opt,name=sort,proto3" json:"sort,omitempty"`
+}
+
+func (x *GetTransactionsRequest) Reset() {
+	*x = GetTransactionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionsRequest) ProtoMessage() {}
+
+func (x *GetTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetTransactionsRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *GetTransactionsRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *GetTransactionsRequest) GetTimeRange() *TimeFilter {
+	if x != nil {
+		return x.TimeRange
+	}
+	return nil
+}
+
+func (x *GetTransactionsRequest) GetType() TransactionType {
+	if x != nil {
+		return x.Type
+	}
+	return TransactionType_TRANSACTION_TYPE_UNSPECIFIED
+}
+
+func (x *GetTransactionsRequest) GetStatus() TransactionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TransactionStatus_TRANSACTION_CODE_UNSPECIFIED
+}
+
+func (x *GetTransactionsRequest) GetHost() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Host
+	}
+	return nil
+}
+
+func (x *GetTransactionsRequest) GetName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *GetTransactionsRequest) GetPath() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *GetTransactionsRequest) GetMethod() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Method
+	}
+	return nil
+}
+
+func (x *GetTransactionsRequest) GetSort() *SortingTransactionList {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+type SortingTransactionList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SortBy    SortTransactionList `protobuf:"varint,1,opt,name=sort_by,json=sortBy,proto3,enum=squzy.v1.storage.SortTransactionList" json:"sort_by,omitempty"`
+	Direction SortDirection       `protobuf:"varint,2,opt,name=direction,proto3,enum=squzy.v1.storage.SortDirection" json:"direction,omitempty"`
+}
+
+func (x *SortingTransactionList) Reset() {
+	*x = SortingTransactionList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SortingTransactionList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortingTransactionList) ProtoMessage() {}
+
+func (x *SortingTransactionList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortingTransactionList.ProtoReflect.Descriptor instead.
+func (*SortingTransactionList) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SortingTransactionList) GetSortBy() SortTransactionList {
+	if x != nil {
+		return x.SortBy
+	}
+	return SortTransactionList_SORT_TRANSACTION_LIST_UNSPECIFIED
+}
+
+func (x *SortingTransactionList) GetDirection() SortDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return SortDirection_SORT_DIRECTION_UNSPECIFIED
+}
+
+type GetTransactionGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApplicationId string            `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	TimeRange     *TimeFilter       `protobuf:"bytes,2,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	GroupType     GroupTransaction  `protobuf:"varint,3,opt,name=group_type,json=groupType,proto3,enum=squzy.v1.storage.GroupTransaction" json:"group_type,omitempty"`
+	Type          TransactionType   `protobuf:"varint,4,opt,name=type,proto3,enum=squzy.v1.monitoring.TransactionType" json:"type,omitempty"`
+	Status        TransactionStatus `protobuf:"varint,5,opt,name=status,proto3,enum=squzy.v1.monitoring.TransactionStatus" json:"status,omitempty"`
+	// Apdex threshold T in milliseconds, default one is used if it is not set
+	ApdexThreshold float64 `protobuf:"fixed64,6,opt,name=apdex_threshold,json=apdexThreshold,proto3" json:"apdex_threshold,omitempty"`
+}
+
+func (x *GetTransactionGroupRequest) Reset() {
+	*x = GetTransactionGroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTransactionGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionGroupRequest) ProtoMessage() {}
+
+func (x *GetTransactionGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionGroupRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionGroupRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetTransactionGroupRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *GetTransactionGroupRequest) GetTimeRange() *TimeFilter {
+	if x != nil {
+		return x.TimeRange
+	}
+	return nil
+}
+
+func (x *GetTransactionGroupRequest) GetGroupType() GroupTransaction {
+	if x != nil {
+		return x.GroupType
+	}
+	return GroupTransaction_GROUP_TRANSACTION_UNSPECIFIED
+}
+
+func (x *GetTransactionGroupRequest) GetType() TransactionType {
+	if x != nil {
+		return x.Type
+	}
+	return TransactionType_TRANSACTION_TYPE_UNSPECIFIED
+}
+
+func (x *GetTransactionGroupRequest) GetStatus() TransactionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TransactionStatus_TRANSACTION_CODE_UNSPECIFIED
+}
+
+func (x *GetTransactionGroupRequest) GetApdexThreshold() float64 {
+	if x != nil {
+		return x.ApdexThreshold
+	}
+	return 0
+}
+
+// All times are in milliseconds
+type TransactionGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count        int64   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	AverageTime  float64 `protobuf:"fixed64,2,opt,name=average_time,json=averageTime,proto3" json:"average_time,omitempty"`
+	SuccessRatio float64 `protobuf:"fixed64,3,opt,name=success_ratio,json=successRatio,proto3" json:"success_ratio,omitempty"`
+	MinTime      float64 `protobuf:"fixed64,4,opt,name=min_time,json=minTime,proto3" json:"min_time,omitempty"`
+	MaxTime      float64 `protobuf:"fixed64,5,opt,name=max_time,json=maxTime,proto3" json:"max_time,omitempty"`
+	Throughput   float64 `protobuf:"fixed64,6,opt,name=throughput,proto3" json:"throughput,omitempty"`
+	LatencyP50   float64 `protobuf:"fixed64,7,opt,name=latency_p50,json=latencyP50,proto3" json:"latency_p50,omitempty"`
+	LatencyP90   float64 `protobuf:"fixed64,8,opt,name=latency_p90,json=latencyP90,proto3" json:"latency_p90,omitempty"`
+	LatencyP95   float64 `protobuf:"fixed64,9,opt,name=latency_p95,json=latencyP95,proto3" json:"latency_p95,omitempty"`
+	LatencyP99   float64 `protobuf:"fixed64,10,opt,name=latency_p99,json=latencyP99,proto3" json:"latency_p99,omitempty"`
+	ErrorRate    float64 `protobuf:"fixed64,11,opt,name=error_rate,json=errorRate,proto3" json:"error_rate,omitempty"`
+	Apdex        float64 `protobuf:"fixed64,12,opt,name=apdex,proto3" json:"apdex,omitempty"`
+}
+
+func (x *TransactionGroup) Reset() {
+	*x = TransactionGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionGroup) ProtoMessage() {}
+
+func (x *TransactionGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionGroup.ProtoReflect.Descriptor instead.
+func (*TransactionGroup) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *TransactionGroup) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *TransactionGroup) GetAverageTime() float64 {
+	if x != nil {
+		return x.AverageTime
+	}
+	return 0
+}
+
+func (x *TransactionGroup) GetSuccessRatio() float64 {
+	if x != nil {
+		return x.SuccessRatio
+	}
+	return 0
+}
+
+func (x *TransactionGroup) GetMinTime() float64 {
+	if x != nil {
+		return x.MinTime
+	}
+	return 0
+}
+
+func (x *TransactionGroup) GetMaxTime() float64 {
+	if x != nil {
+		return x.MaxTime
+	}
+	return 0
+}
+
+func (x *TransactionGroup) GetThroughput() float64 {
+	if x != nil {
+		return x.Throughput
+	}
+	return 0
+}
+
+func (x *TransactionGroup) GetLatencyP50() float64 {
+	if x != nil {
+		return x.LatencyP50
+	}
+	return 0
+}
+
+func (x *TransactionGroup) GetLatencyP90() float64 {
+	if x != nil {
+		return x.LatencyP90
+	}
+	return 0
+}
+
+func (x *TransactionGroup) GetLatencyP95() float64 {
+	if x != nil {
+		return x.LatencyP95
+	}
+	return 0
+}
+
+func (x *TransactionGroup) GetLatencyP99() float64 {
+	if x != nil {
+		return x.LatencyP99
+	}
+	return 0
+}
+
+func (x *TransactionGroup) GetErrorRate() float64 {
+	if x != nil {
+		return x.ErrorRate
+	}
+	return 0
+}
+
+func (x *TransactionGroup) GetApdex() float64 {
+	if x != nil {
+		return x.Apdex
+	}
+	return 0
+}
+
+type GetTransactionGroupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transactions map[string]*TransactionGroup `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *GetTransactionGroupResponse) Reset() {
+	*x = GetTransactionGroupResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTransactionGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionGroupResponse) ProtoMessage() {}
+
+func (x *GetTransactionGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionGroupResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionGroupResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetTransactionGroupResponse) GetTransactions() map[string]*TransactionGroup {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+type SchedulerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SchedulerId string             `protobuf:"bytes,1,opt,name=scheduler_id,json=schedulerId,proto3" json:"scheduler_id,omitempty"`
+	Snapshot    *SchedulerSnapshot `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+}
+
+func (x *SchedulerResponse) Reset() {
+	*x = SchedulerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchedulerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulerResponse) ProtoMessage() {}
+
+func (x *SchedulerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulerResponse.ProtoReflect.Descriptor instead.
+func (*SchedulerResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SchedulerResponse) GetSchedulerId() string {
+	if x != nil {
+		return x.SchedulerId
+	}
+	return ""
+}
+
+func (x *SchedulerResponse) GetSnapshot() *SchedulerSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+type SchedulerResponseBatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Responses []*SchedulerResponse `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+}
+
+func (x *SchedulerResponseBatch) Reset() {
+	*x = SchedulerResponseBatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchedulerResponseBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulerResponseBatch) ProtoMessage() {}
+
+func (x *SchedulerResponseBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulerResponseBatch.ProtoReflect.Descriptor instead.
+func (*SchedulerResponseBatch) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SchedulerResponseBatch) GetResponses() []*SchedulerResponse {
+	if x != nil {
+		return x.Responses
+	}
+	return nil
+}
+
+type TimeFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *TimeFilter) Reset() {
+	*x = TimeFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimeFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeFilter) ProtoMessage() {}
+
+func (x *TimeFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeFilter.ProtoReflect.Descriptor instead.
+func (*TimeFilter) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *TimeFilter) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *TimeFilter) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type Pagination struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page  int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Opaque cursor from PageCursors of previous response, page is ignored when it is set
+	Cursor string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *Pagination) Reset() {
+	*x = Pagination{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pagination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pagination) ProtoMessage() {}
+
+func (x *Pagination) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pagination.ProtoReflect.Descriptor instead.
+func (*Pagination) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Pagination) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *Pagination) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *Pagination) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+// Keyset cursors of page, empty when there is no page in that direction
+type PageCursors struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Next string `protobuf:"bytes,1,opt,name=next,proto3" json:"next,omitempty"`
+	Prev string `protobuf:"bytes,2,opt,name=prev,proto3" json:"prev,omitempty"`
+}
+
+func (x *PageCursors) Reset() {
+	*x = PageCursors{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PageCursors) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageCursors) ProtoMessage() {}
+
+func (x *PageCursors) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageCursors.ProtoReflect.Descriptor instead.
+func (*PageCursors) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PageCursors) GetNext() string {
+	if x != nil {
+		return x.Next
+	}
+	return ""
+}
+
+func (x *PageCursors) GetPrev() string {
+	if x != nil {
+		return x.Prev
+	}
+	return ""
+}
+
+type SortingSchedulerList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SortBy    SortSchedulerList `protobuf:"varint,1,opt,name=sort_by,json=sortBy,proto3,enum=squzy.v1.storage.SortSchedulerList" json:"sort_by,omitempty"`
+	Direction SortDirection     `protobuf:"varint,2,opt,name=direction,proto3,enum=squzy.v1.storage.SortDirection" json:"direction,omitempty"`
+}
+
+func (x *SortingSchedulerList) Reset() {
+	*x = SortingSchedulerList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SortingSchedulerList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortingSchedulerList) ProtoMessage() {}
+
+func (x *SortingSchedulerList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortingSchedulerList.ProtoReflect.Descriptor instead.
+func (*SortingSchedulerList) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SortingSchedulerList) GetSortBy() SortSchedulerList {
+	if x != nil {
+		return x.SortBy
+	}
+	return SortSchedulerList_SORT_SCHEDULER_LIST_UNSPECIFIED
+}
+
+func (x *SortingSchedulerList) GetDirection() SortDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return SortDirection_SORT_DIRECTION_UNSPECIFIED
+}
+
+type GetSchedulerInformationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SchedulerId string                `protobuf:"bytes,1,opt,name=scheduler_id,json=schedulerId,proto3" json:"scheduler_id,omitempty"`
+	Pagination  *Pagination           `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	TimeRange   *TimeFilter           `protobuf:"bytes,3,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	Sort        *SortingSchedulerList `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`
+	Status      SchedulerCode         `protobuf:"varint,5,opt,name=status,proto3,enum=squzy.v1.monitoring.SchedulerCode" json:"status,omitempty"`
+}
+
+func (x *GetSchedulerInformationRequest) Reset() {
+	*x = GetSchedulerInformationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSchedulerInformationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSchedulerInformationRequest) ProtoMessage() {}
+
+func (x *GetSchedulerInformationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSchedulerInformationRequest.ProtoReflect.Descriptor instead.
+func (*GetSchedulerInformationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetSchedulerInformationRequest) GetSchedulerId() string {
+	if x != nil {
+		return x.SchedulerId
+	}
+	return ""
+}
+
+func (x *GetSchedulerInformationRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *GetSchedulerInformationRequest) GetTimeRange() *TimeFilter {
+	if x != nil {
+		return x.TimeRange
+	}
+	return nil
+}
+
+func (x *GetSchedulerInformationRequest) GetSort() *SortingSchedulerList {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+func (x *GetSchedulerInformationRequest) GetStatus() SchedulerCode {
+	if x != nil {
+		return x.Status
+	}
+	return SchedulerCode_SCHEDULER_CODE_UNSPECIFIED
+}
+
+type GetSchedulerInformationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Snapshots []*SchedulerSnapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	Count     int32                `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Cursors   *PageCursors         `protobuf:"bytes,3,opt,name=cursors,proto3" json:"cursors,omitempty"`
+}
+
+func (x *GetSchedulerInformationResponse) Reset() {
+	*x = GetSchedulerInformationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSchedulerInformationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSchedulerInformationResponse) ProtoMessage() {}
+
+func (x *GetSchedulerInformationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSchedulerInformationResponse.ProtoReflect.Descriptor instead.
+func (*GetSchedulerInformationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetSchedulerInformationResponse) GetSnapshots() []*SchedulerSnapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+func (x *GetSchedulerInformationResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetSchedulerInformationResponse) GetCursors() *PageCursors {
+	if x != nil {
+		return x.Cursors
+	}
+	return nil
+}
+
+type GetAgentInformationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AgentId    string        `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	Type       TypeAgentStat `protobuf:"varint,2,opt,name=type,proto3,enum=squzy.v1.storage.TypeAgentStat" json:"type,omitempty"`
+	Pagination *Pagination   `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	TimeRange  *TimeFilter   `protobuf:"bytes,4,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+}
+
+func (x *GetAgentInformationRequest) Reset() {
+	*x = GetAgentInformationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAgentInformationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAgentInformationRequest) ProtoMessage() {}
+
+func (x *GetAgentInformationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAgentInformationRequest.ProtoReflect.Descriptor instead.
+func (*GetAgentInformationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetAgentInformationRequest) GetAgentId() string {
+	if x != nil {
+		return x.AgentId
+	}
+	return ""
+}
+
+func (x *GetAgentInformationRequest) GetType() TypeAgentStat {
+	if x != nil {
+		return x.Type
+	}
+	return TypeAgentStat_AGENT_STATS_TYPE_UNSPECIFIED
+}
+
+func (x *GetAgentInformationRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *GetAgentInformationRequest) GetTimeRange() *TimeFilter {
+	if x != nil {
+		return x.TimeRange
+	}
+	return nil
+}
+
+type GetAgentInformationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stats   []*GetAgentInformationResponse_Statistic `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	Count   int32                                    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Cursors *PageCursors                             `protobuf:"bytes,3,opt,name=cursors,proto3" json:"cursors,omitempty"`
+}
+
+func (x *GetAgentInformationResponse) Reset() {
+	*x = GetAgentInformationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAgentInformationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAgentInformationResponse) ProtoMessage() {}
+
+func (x *GetAgentInformationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAgentInformationResponse.ProtoReflect.Descriptor instead.
+func (*GetAgentInformationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetAgentInformationResponse) GetStats() []*GetAgentInformationResponse_Statistic {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *GetAgentInformationResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetAgentInformationResponse) GetCursors() *PageCursors {
+	if x != nil {
+		return x.Cursors
+	}
+	return nil
+}
+
+type SubscribeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Empty list means events of all types
+	Types []StreamEventType `protobuf:"varint,1,rep,packed,name=types,proto3,enum=squzy.v1.storage.StreamEventType" json:"types,omitempty"`
+	// Scheduler, agent, application or rule id, empty list means events of all owners
+	OwnerIds []string `protobuf:"bytes,2,rep,name=owner_ids,json=ownerIds,proto3" json:"owner_ids,omitempty"`
+}
+
+func (x *SubscribeRequest) Reset() {
+	*x = SubscribeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRequest) ProtoMessage() {}
+
+func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SubscribeRequest) GetTypes() []StreamEventType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetOwnerIds() []string {
+	if x != nil {
+		return x.OwnerIds
+	}
+	return nil
+}
+
+type StreamEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type    StreamEventType        `protobuf:"varint,1,opt,name=type,proto3,enum=squzy.v1.storage.StreamEventType" json:"type,omitempty"`
+	OwnerId string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Time    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	// Types that are assignable to Event:
+	//	*StreamEvent_Snapshot
+	//	*StreamEvent_AgentMetric
+	//	*StreamEvent_Transaction
+	//	*StreamEvent_Incident
+	Event isStreamEvent_Event `protobuf_oneof:"event"`
+}
+
+func (x *StreamEvent) Reset() {
+	*x = StreamEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEvent) ProtoMessage() {}
+
+func (x *StreamEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEvent.ProtoReflect.Descriptor instead.
+func (*StreamEvent) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *StreamEvent) GetType() StreamEventType {
+	if x != nil {
+		return x.Type
+	}
+	return StreamEventType_STREAM_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *StreamEvent) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *StreamEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (m *StreamEvent) GetEvent() isStreamEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *StreamEvent) GetSnapshot() *SchedulerResponse {
+	if x, ok := x.GetEvent().(*StreamEvent_Snapshot); ok {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *StreamEvent) GetAgentMetric() *Metric {
+	if x, ok := x.GetEvent().(*StreamEvent_AgentMetric); ok {
+		return x.AgentMetric
+	}
+	return nil
+}
+
+func (x *StreamEvent) GetTransaction() *TransactionInfo {
+	if x, ok := x.GetEvent().(*StreamEvent_Transaction); ok {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *StreamEvent) GetIncident() *Incident {
+	if x, ok := x.GetEvent().(*StreamEvent_Incident); ok {
+		return x.Incident
+	}
+	return nil
+}
+
+type isStreamEvent_Event interface {
+	isStreamEvent_Event()
+}
+
+type StreamEvent_Snapshot struct {
+	Snapshot *SchedulerResponse `protobuf:"bytes,4,opt,name=snapshot,proto3,oneof"`
+}
+
+type StreamEvent_AgentMetric struct {
+	AgentMetric *Metric `protobuf:"bytes,5,opt,name=agent_metric,json=agentMetric,proto3,oneof"`
+}
+
+type StreamEvent_Transaction struct {
+	Transaction *TransactionInfo `protobuf:"bytes,6,opt,name=transaction,proto3,oneof"`
+}
+
+type StreamEvent_Incident struct {
+	Incident *Incident `protobuf:"bytes,7,opt,name=incident,proto3,oneof"`
+}
+
+func (*StreamEvent_Snapshot) isStreamEvent_Event() {}
+
+func (*StreamEvent_AgentMetric) isStreamEvent_Event() {}
+
+func (*StreamEvent_Transaction) isStreamEvent_Event() {}
+
+func (*StreamEvent_Incident) isStreamEvent_Event() {}
+
+type GetAgentInformationResponse_Statistic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time       *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	CpuInfo    *CpuInfo               `protobuf:"bytes,2,opt,name=cpu_info,json=cpuInfo,proto3" json:"cpu_info,omitempty"`
+	MemoryInfo *MemoryInfo            `protobuf:"bytes,3,opt,name=memory_info,json=memoryInfo,proto3" json:"memory_info,omitempty"`
+	DiskInfo   *DiskInfo              `protobuf:"bytes,4,opt,name=disk_info,json=diskInfo,proto3" json:"disk_info,omitempty"`
+	NetInfo    *NetInfo               `protobuf:"bytes,5,opt,name=net_info,json=netInfo,proto3" json:"net_info,omitempty"`
+}
+
+func (x *GetAgentInformationResponse_Statistic) Reset() {
+	*x = GetAgentInformationResponse_Statistic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_squzy_storage_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAgentInformationResponse_Statistic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAgentInformationResponse_Statistic) ProtoMessage() {}
+
+func (x *GetAgentInformationResponse_Statistic) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_squzy_storage_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAgentInformationResponse_Statistic.ProtoReflect.Descriptor instead.
+func (*GetAgentInformationResponse_Statistic) Descriptor() ([]byte, []int) {
+	return file_proto_v1_squzy_storage_proto_rawDescGZIP(), []int{38, 0}
+}
+
+func (x *GetAgentInformationResponse_Statistic) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *GetAgentInformationResponse_Statistic) GetCpuInfo() *CpuInfo {
+	if x != nil {
+		return x.CpuInfo
+	}
+	return nil
+}
+
+func (x *GetAgentInformationResponse_Statistic) GetMemoryInfo() *MemoryInfo {
+	if x != nil {
+		return x.MemoryInfo
+	}
+	return nil
+}
+
+func (x *GetAgentInformationResponse_Statistic) GetDiskInfo() *DiskInfo {
+	if x != nil {
+		return x.DiskInfo
+	}
+	return nil
+}
+
+func (x *GetAgentInformationResponse_Statistic) GetNetInfo() *NetInfo {
+	if x != nil {
+		return x.NetInfo
+	}
+	return nil
+}
+
+var File_proto_v1_squzy_storage_proto protoreflect.FileDescriptor
+
+var file_proto_v1_squzy_storage_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x71, 0x75, 0x7a, 0x79,
+	0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10,
+	0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x5f, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x2b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x71, 0x75, 0x7a,
+	0x79, 0x5f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x24,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x5f, 0x69,
+	0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x73,
+	0x71, 0x75, 0x7a, 0x79, 0x5f, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x79, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49,
+	0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x63, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x91, 0x01, 0x0a, 0x13, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x63, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74,
+	0x5f, 0x62, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x73, 0x71, 0x75, 0x7a,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x6f, 0x72,
+	0x74, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x06, 0x73,
+	0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x3d, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc1, 0x02, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x21, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x69, 0x6e, 0x63, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x2e, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x72,
+	0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x72, 0x75, 0x6c, 0x65,
+	0x49, 0x64, 0x12, 0x3c, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x3b, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x39, 0x0a,
+	0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x71,
+	0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53,
+	0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x22, 0xa4, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74,
+	0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x09, 0x69,
+	0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x2e, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x69, 0x6e, 0x63,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x37, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x43,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x73, 0x52, 0x07, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x73, 0x22,
+	0x7b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x55,
+	0x70, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x3b, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x4e, 0x0a, 0x1a,
+	0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x55, 0x70, 0x74, 0x69,
+	0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x75, 0x70, 0x74, 0x69,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xc5, 0x01, 0x0a,
+	0x1f, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x55, 0x70, 0x74,
+	0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x42, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd4, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x53,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x06, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x70, 0x35, 0x30, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x6c,
+	0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x35, 0x30, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x39, 0x35, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
+	0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x39, 0x35, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x39, 0x39, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0a, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x39, 0x39, 0x22, 0xa3, 0x01, 0x0a, 0x20,
+	0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x55, 0x70, 0x74, 0x69,
+	0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x42, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x22, 0x7c, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x4f, 0x75, 0x74, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x22,
+	0xd8, 0x01, 0x0a, 0x0f, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x4f, 0x75, 0x74,
+	0x61, 0x67, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35,
+	0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5a, 0x0a, 0x1b, 0x47, 0x65,
+	0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x61, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x07, 0x6f, 0x75, 0x74,
+	0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x71, 0x75,
+	0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6f,
+	0x75, 0x74, 0x61, 0x67, 0x65, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0a, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x36, 0x0a, 0x10, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x61, 0x76, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x61, 0x76, 0x67, 0x12,
+	0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x61,
+	0x78, 0x22, 0x52, 0x0a, 0x12, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x4e, 0x65, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f,
+	0x72, 0x65, 0x63, 0x76, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x63, 0x76, 0x22, 0xfa, 0x04, 0x0a, 0x10, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x04, 0x63, 0x70,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x41, 0x67, 0x65, 0x6e,
+	0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x63, 0x70,
+	0x75, 0x73, 0x12, 0x52, 0x0a, 0x13, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x75, 0x73, 0x65,
+	0x64, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x11, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x73, 0x65, 0x64, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x4e, 0x0a, 0x11, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x75,
+	0x73, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x73, 0x77, 0x61, 0x70, 0x55, 0x73, 0x65, 0x64, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x12, 0x52, 0x0a, 0x0a, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x32, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x1a,
+	0x5c, 0x0a, 0x0a, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x38, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x63, 0x0a,
+	0x0f, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x3a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x4e, 0x65, 0x74, 0x52, 0x61, 0x74, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x75, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x3a, 0x0a,
+	0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0xa6, 0x01, 0x0a, 0x1a, 0x47, 0x65,
+	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x49, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
+	0x69, 0x6e, 0x67, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x40, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72,
+	0x65, 0x6e, 0x22, 0xea, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x22,
+	0x7e, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x48, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x71,
+	0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e,
+	0x67, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x42, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x22, 0x87, 0x02, 0x0a, 0x13, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69,
+	0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x6c, 0x66, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x66,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x12, 0x41, 0x0a, 0x08, 0x63, 0x68,
+	0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73,
+	0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x65, 0x65, 0x4e,
+	0x6f, 0x64, 0x65, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x22, 0x89, 0x01,
+	0x0a, 0x1a, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x04,
+	0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x71, 0x75,
+	0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb2, 0x01, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x48, 0x0a, 0x0c, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x43, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x73, 0x52, 0x07, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x73, 0x22, 0xbe,
+	0x04, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x3c, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b,
+	0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x38, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x73, 0x71, 0x75, 0x7a,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x3e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x30, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x34, 0x0a, 0x06, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x12, 0x3c, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x22,
+	0x97, 0x01, 0x0a, 0x16, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x07, 0x73, 0x6f,
+	0x72, 0x74, 0x5f, 0x62, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x73, 0x71,
+	0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53,
+	0x6f, 0x72, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x3d, 0x0a, 0x09, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e,
+	0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xe6, 0x02, 0x0a, 0x1a, 0x47, 0x65,
+	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x3b, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x41, 0x0a, 0x0a,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x22, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x38, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e,
+	0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
+	0x69, 0x6e, 0x67, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x3e, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73, 0x71, 0x75, 0x7a,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x70, 0x64,
+	0x65, 0x78, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0e, 0x61, 0x70, 0x64, 0x65, 0x78, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x22, 0xff, 0x02, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0b, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74,
+	0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0a, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6c,
+	0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x35, 0x30, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x35, 0x30, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x39, 0x30, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x39, 0x30, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x39, 0x35, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x39, 0x35, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x39, 0x39, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x39, 0x39, 0x12,
+	0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x61, 0x74, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x70, 0x64, 0x65, 0x78, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x61,
+	0x70, 0x64, 0x65, 0x78, 0x22, 0xe7, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x73, 0x71, 0x75,
+	0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x63, 0x0a, 0x11, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x38, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x7a,
+	0x0a, 0x11, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x12, 0x42, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0x5b, 0x0a, 0x16, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x41, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22, 0x68, 0x0a, 0x0a, 0x54, 0x69, 0x6d, 0x65, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74,
+	0x6f, 0x22, 0x4e, 0x0a, 0x0a, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x22, 0x35, 0x0a, 0x0b, 0x50, 0x61, 0x67, 0x65, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x65, 0x78, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x72, 0x65, 0x76, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x72, 0x65, 0x76, 0x22, 0x93, 0x01, 0x0a, 0x14, 0x53, 0x6f, 0x72,
+	0x74, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x3c, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x23, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12,
+	0x3d, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb6,
+	0x02, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x3a, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x3a, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x73, 0x71,
+	0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e,
+	0x67, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xb6, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x09, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x65,
+	0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x73, 0x52, 0x07, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x73,
+	0x22, 0xe7, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65,
+	0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x3c, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a,
+	0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x22, 0xd5, 0x03, 0x0a, 0x1b, 0x47,
+	0x65, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x73, 0x71, 0x75, 0x7a,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74,
+	0x69, 0x63, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x37, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x73, 0x52,
+	0x07, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x73, 0x1a, 0x97, 0x02, 0x0a, 0x09, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x63, 0x70, 0x75, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x70, 0x75, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x07, 0x63, 0x70, 0x75, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3b, 0x0a, 0x0b, 0x6d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x6d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x35, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f,
+	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x71, 0x75,
+	0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x69, 0x73, 0x6b,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x32,
+	0x0a, 0x08, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x2e, 0x4e, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x22, 0x68, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12,
+	0x1b, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x73, 0x22, 0x9d, 0x03, 0x0a,
+	0x0b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x73, 0x71, 0x75,
+	0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2e,
+	0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x41,
+	0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x12, 0x3b, 0x0a, 0x0c, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x48,
+	0x00, 0x52, 0x0b, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x48,
+	0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x6d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x08, 0x69, 0x6e, 0x63, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x71, 0x75,
+	0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x49,
+	0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x63, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2a, 0x76, 0x0a, 0x10,
+	0x53, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x22, 0x0a, 0x1e, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x49, 0x4e, 0x43, 0x49, 0x44, 0x45, 0x4e,
+	0x54, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x49, 0x4e, 0x43, 0x49, 0x44, 0x45, 0x4e, 0x54,
+	0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x42, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x5f, 0x54,
+	0x49, 0x4d, 0x45, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x4e, 0x43, 0x49, 0x44, 0x45, 0x4e,
+	0x54, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x42, 0x59, 0x5f, 0x45, 0x4e, 0x44, 0x5f, 0x54, 0x49,
+	0x4d, 0x45, 0x10, 0x02, 0x2a, 0x86, 0x01, 0x0a, 0x13, 0x53, 0x6f, 0x72, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x21,
+	0x53, 0x4f, 0x52, 0x54, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x55, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10,
+	0x01, 0x12, 0x1d, 0x0a, 0x19, 0x42, 0x59, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x02,
+	0x12, 0x1b, 0x0a, 0x17, 0x42, 0x59, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x45, 0x4e, 0x44, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x03, 0x2a, 0x8a, 0x01,
+	0x0a, 0x10, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x45, 0x52, 0x49, 0x45, 0x53, 0x5f, 0x52, 0x45, 0x53,
+	0x4f, 0x4c, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x45, 0x52, 0x49, 0x45, 0x53, 0x5f,
+	0x52, 0x45, 0x53, 0x4f, 0x4c, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x49, 0x4e, 0x55, 0x54,
+	0x45, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x45, 0x52, 0x49, 0x45, 0x53, 0x5f, 0x52, 0x45,
+	0x53, 0x4f, 0x4c, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x48, 0x4f, 0x55, 0x52, 0x10, 0x02, 0x12,
+	0x19, 0x0a, 0x15, 0x53, 0x45, 0x52, 0x49, 0x45, 0x53, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x55,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x03, 0x2a, 0x42, 0x0a, 0x0d, 0x53, 0x6f,
+	0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x1a, 0x53,
+	0x4f, 0x52, 0x54, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x41,
+	0x53, 0x43, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x45, 0x53, 0x43, 0x10, 0x02, 0x2a, 0x6c,
+	0x0a, 0x11, 0x53, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x1f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x43, 0x48, 0x45,
+	0x44, 0x55, 0x4c, 0x45, 0x52, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x42, 0x59, 0x5f, 0x53,
+	0x54, 0x41, 0x52, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x42,
+	0x59, 0x5f, 0x45, 0x4e, 0x44, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a,
+	0x42, 0x59, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x4e, 0x43, 0x59, 0x10, 0x03, 0x2a, 0x78, 0x0a, 0x10,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x21, 0x0a, 0x1d, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x42, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x01,
+	0x12, 0x0b, 0x0a, 0x07, 0x42, 0x59, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x02, 0x12, 0x0d, 0x0a,
+	0x09, 0x42, 0x59, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07,
+	0x42, 0x59, 0x5f, 0x48, 0x4f, 0x53, 0x54, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x42, 0x59, 0x5f,
+	0x50, 0x41, 0x54, 0x48, 0x10, 0x05, 0x2a, 0x62, 0x0a, 0x0d, 0x54, 0x79, 0x70, 0x65, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x12, 0x20, 0x0a, 0x1c, 0x41, 0x47, 0x45, 0x4e, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4c, 0x4c,
+	0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x50, 0x55, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x44,
+	0x49, 0x53, 0x4b, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59, 0x10,
+	0x04, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x45, 0x54, 0x10, 0x05, 0x2a, 0xa7, 0x01, 0x0a, 0x0f, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21,
+	0x0a, 0x1d, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19,
+	0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x47, 0x45,
+	0x4e, 0x54, 0x5f, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x53,
+	0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x52, 0x41, 0x4e,
+	0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x54, 0x52,
+	0x45, 0x41, 0x4d, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x4e, 0x43, 0x49, 0x44, 0x45,
+	0x4e, 0x54, 0x10, 0x04, 0x32, 0xf0, 0x0f, 0x0a, 0x07, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x12, 0x58, 0x0a, 0x19, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x46, 0x72, 0x6f, 0x6d, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x12, 0x23, 0x2e,
+	0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x5e, 0x0a, 0x1a, 0x53, 0x61,
+	0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x12, 0x28, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x47, 0x0a, 0x15, 0x53, 0x61,
+	0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x4f, 0x0a, 0x0f, 0x53, 0x61, 0x76, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x7e, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x30, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x31, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x2b, 0x2e, 0x73, 0x71, 0x75,
+	0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x81, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x31, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x72, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x61, 0x67, 0x65, 0x73,
+	0x12, 0x2c, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x4f, 0x75, 0x74, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d,
+	0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x4f, 0x75,
+	0x74, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x63, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x73,
+	0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
+	0x47, 0x65, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x2c,
+	0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x73,
+	0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
+	0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28,
+	0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x49, 0x64, 0x12, 0x2b, 0x2e, 0x73, 0x71, 0x75, 0x7a,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x49, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x65, 0x65, 0x12, 0x2b, 0x2e, 0x73, 0x71, 0x75,
+	0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x49, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0c, 0x53, 0x61, 0x76, 0x65, 0x49, 0x6e, 0x63,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x62, 0x0a, 0x14, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x2d, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x63, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x69, 0x6e, 0x63,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x54,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x49,
+	0x64, 0x12, 0x24, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x69, 0x6e, 0x63,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x49, 0x6e, 0x63, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x12, 0x54, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x42, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x20, 0x2e, 0x73, 0x71,
+	0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x2e,
+	0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x2e, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x69, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x29,
+	0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x73, 0x71, 0x75, 0x7a,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x12, 0x22, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x42, 0x24, 0x5a, 0x22, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x71, 0x75, 0x7a, 0x79, 0x2f, 0x73, 0x71, 0x75, 0x7a,
+	0x79, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_v1_squzy_storage_proto_rawDescOnce sync.Once
+	file_proto_v1_squzy_storage_proto_rawDescData = file_proto_v1_squzy_storage_proto_rawDesc
+)
+
+func file_proto_v1_squzy_storage_proto_rawDescGZIP() []byte {
+	file_proto_v1_squzy_storage_proto_rawDescOnce.Do(func() {
+		file_proto_v1_squzy_storage_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_v1_squzy_storage_proto_rawDescData)
+	})
+	return file_proto_v1_squzy_storage_proto_rawDescData
+}
+
+var file_proto_v1_squzy_storage_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_proto_v1_squzy_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
+var file_proto_v1_squzy_storage_proto_goTypes = []interface{}{
+	(SortIncidentList)(0),                         // 0: squzy.v1.storage.SortIncidentList
+	(SortTransactionList)(0),                      // 1: squzy.v1.storage.SortTransactionList
+	(SeriesResolution)(0),                         // 2: squzy.v1.storage.SeriesResolution
+	(SortDirection)(0),                            // 3: squzy.v1.storage.SortDirection
+	(SortSchedulerList)(0),                        // 4: squzy.v1.storage.SortSchedulerList
+	(GroupTransaction)(0),                         // 5: squzy.v1.storage.GroupTransaction
+	(TypeAgentStat)(0),                            // 6: squzy.v1.storage.TypeAgentStat
+	(StreamEventType)(0),                          // 7: squzy.v1.storage.StreamEventType
+	(*UpdateIncidentStatusRequest)(nil),           // 8: squzy.v1.storage.UpdateIncidentStatusRequest
+	(*SortingIncidentList)(nil),                   // 9: squzy.v1.storage.SortingIncidentList
+	(*GetIncidentsListRequest)(nil),               // 10: squzy.v1.storage.GetIncidentsListRequest
+	(*GetIncidentsListResponse)(nil),              // 11: squzy.v1.storage.GetIncidentsListResponse
+	(*GetSchedulerUptimeRequest)(nil),             // 12: squzy.v1.storage.GetSchedulerUptimeRequest
+	(*GetSchedulerUptimeResponse)(nil),            // 13: squzy.v1.storage.GetSchedulerUptimeResponse
+	(*GetSchedulerUptimeSeriesRequest)(nil),       // 14: squzy.v1.storage.GetSchedulerUptimeSeriesRequest
+	(*UptimeSeriesPoint)(nil),                     // 15: squzy.v1.storage.UptimeSeriesPoint
+	(*GetSchedulerUptimeSeriesResponse)(nil),      // 16: squzy.v1.storage.GetSchedulerUptimeSeriesResponse
+	(*GetSchedulerOutagesRequest)(nil),            // 17: squzy.v1.storage.GetSchedulerOutagesRequest
+	(*SchedulerOutage)(nil),                       // 18: squzy.v1.storage.SchedulerOutage
+	(*GetSchedulerOutagesResponse)(nil),           // 19: squzy.v1.storage.GetSchedulerOutagesResponse
+	(*GetAgentSeriesRequest)(nil),                 // 20: squzy.v1.storage.GetAgentSeriesRequest
+	(*AgentSeriesValue)(nil),                      // 21: squzy.v1.storage.AgentSeriesValue
+	(*AgentSeriesNetRate)(nil),                    // 22: squzy.v1.storage.AgentSeriesNetRate
+	(*AgentSeriesPoint)(nil),                      // 23: squzy.v1.storage.AgentSeriesPoint
+	(*GetAgentSeriesResponse)(nil),                // 24: squzy.v1.storage.GetAgentSeriesResponse
+	(*GetTransactionByIdResponse)(nil),            // 25: squzy.v1.storage.GetTransactionByIdResponse
+	(*GetTransactionByNameRequest)(nil),           // 26: squzy.v1.storage.GetTransactionByNameRequest
+	(*GetTransactionByNameResponse)(nil),          // 27: squzy.v1.storage.GetTransactionByNameResponse
+	(*GetTransactionByIdRequest)(nil),             // 28: squzy.v1.storage.GetTransactionByIdRequest
+	(*TransactionTreeNode)(nil),                   // 29: squzy.v1.storage.TransactionTreeNode
+	(*GetTransactionTreeResponse)(nil),            // 30: squzy.v1.storage.GetTransactionTreeResponse
+	(*GetTransactionsResponse)(nil),               // 31: squzy.v1.storage.GetTransactionsResponse
+	(*GetTransactionsRequest)(nil),                // 32: squzy.v1.storage.GetTransactionsRequest
+	(*SortingTransactionList)(nil),                // 33: squzy.v1.storage.SortingTransactionList
+	(*GetTransactionGroupRequest)(nil),            // 34: squzy.v1.storage.GetTransactionGroupRequest
+	(*TransactionGroup)(nil),                      // 35: squzy.v1.storage.TransactionGroup
+	(*GetTransactionGroupResponse)(nil),           // 36: squzy.v1.storage.GetTransactionGroupResponse
+	(*SchedulerResponse)(nil),                     // 37: squzy.v1.storage.SchedulerResponse
+	(*SchedulerResponseBatch)(nil),                // 38: squzy.v1.storage.SchedulerResponseBatch
+	(*TimeFilter)(nil),                            // 39: squzy.v1.storage.TimeFilter
+	(*Pagination)(nil),                            // 40: squzy.v1.storage.Pagination
+	(*PageCursors)(nil),                           // 41: squzy.v1.storage.PageCursors
+	(*SortingSchedulerList)(nil),                  // 42: squzy.v1.storage.SortingSchedulerList
+	(*GetSchedulerInformationRequest)(nil),        // 43: squzy.v1.storage.GetSchedulerInformationRequest
+	(*GetSchedulerInformationResponse)(nil),       // 44: squzy.v1.storage.GetSchedulerInformationResponse
+	(*GetAgentInformationRequest)(nil),            // 45: squzy.v1.storage.GetAgentInformationRequest
+	(*GetAgentInformationResponse)(nil),           // 46: squzy.v1.storage.GetAgentInformationResponse
+	(*SubscribeRequest)(nil),                      // 47: squzy.v1.storage.SubscribeRequest
+	(*StreamEvent)(nil),                           // 48: squzy.v1.storage.StreamEvent
+	nil,                                           // 49: squzy.v1.storage.AgentSeriesPoint.DisksEntry
+	nil,                                           // 50: squzy.v1.storage.AgentSeriesPoint.InterfacesEntry
+	nil,                                           // 51: squzy.v1.storage.GetTransactionGroupResponse.TransactionsEntry
+	(*GetAgentInformationResponse_Statistic)(nil), // 52: squzy.v1.storage.GetAgentInformationResponse.Statistic
+	(IncidentStatus)(0),                           // 53: squzy.v1.incident.IncidentStatus
+	(*wrapperspb.StringValue)(nil),                // 54: google.protobuf.StringValue
+	(*Incident)(nil),                              // 55: squzy.v1.incident.Incident
+	(*timestamppb.Timestamp)(nil),                 // 56: google.protobuf.Timestamp
+	(*TransactionInfo)(nil),                       // 57: squzy.v1.monitoring.TransactionInfo
+	(TransactionType)(0),                          // 58: squzy.v1.monitoring.TransactionType
+	(TransactionStatus)(0),                        // 59: squzy.v1.monitoring.TransactionStatus
+	(*SchedulerSnapshot)(nil),                     // 60: squzy.v1.monitoring.SchedulerSnapshot
+	(SchedulerCode)(0),                            // 61: squzy.v1.monitoring.SchedulerCode
+	(*Metric)(nil),                                // 62: squzy.v1.agent.Metric
+	(*CpuInfo)(nil),                               // 63: squzy.v1.agent.CpuInfo
+	(*MemoryInfo)(nil),                            // 64: squzy.v1.agent.MemoryInfo
+	(*DiskInfo)(nil),                              // 65: squzy.v1.agent.DiskInfo
+	(*NetInfo)(nil),                               // 66: squzy.v1.agent.NetInfo
+	(*IncidentIdRequest)(nil),                     // 67: squzy.v1.incident.IncidentIdRequest
+	(*RuleIdRequest)(nil),                         // 68: squzy.v1.incident.RuleIdRequest
+	(*emptypb.Empty)(nil),                         // 69: google.protobuf.Empty
+}
+var file_proto_v1_squzy_storage_proto_depIdxs = []int32{
+	53,  // 0: squzy.v1.storage.UpdateIncidentStatusRequest.status:type_name -> squzy.v1.incident.IncidentStatus
+	0,   // 1: squzy.v1.storage.SortingIncidentList.sort_by:type_name -> squzy.v1.storage.SortIncidentList
+	3,   // 2: squzy.v1.storage.SortingIncidentList.direction:type_name -> squzy.v1.storage.SortDirection
+	53,  // 3: squzy.v1.storage.GetIncidentsListRequest.status:type_name -> squzy.v1.incident.IncidentStatus
+	54,  // 4: squzy.v1.storage.GetIncidentsListRequest.rule_id:type_name -> google.protobuf.StringValue
+	40,  // 5: squzy.v1.storage.GetIncidentsListRequest.pagination:type_name -> squzy.v1.storage.Pagination
+	39,  // 6: squzy.v1.storage.GetIncidentsListRequest.time_range:type_name -> squzy.v1.storage.TimeFilter
+	9,   // 7: squzy.v1.storage.GetIncidentsListRequest.sort:type_name -> squzy.v1.storage.SortingIncidentList
+	55,  // 8: squzy.v1.storage.GetIncidentsListResponse.incidents:type_name -> squzy.v1.incident.Incident
+	41,  // 9: squzy.v1.storage.GetIncidentsListResponse.cursors:type_name -> squzy.v1.storage.PageCursors
+	39,  // 10: squzy.v1.storage.GetSchedulerUptimeRequest.time_range:type_name -> squzy.v1.storage.TimeFilter
+	39,  // 11: squzy.v1.storage.GetSchedulerUptimeSeriesRequest.time_range:type_name -> squzy.v1.storage.TimeFilter
+	2,   // 12: squzy.v1.storage.GetSchedulerUptimeSeriesRequest.resolution:type_name -> squzy.v1.storage.SeriesResolution
+	56,  // 13: squzy.v1.storage.UptimeSeriesPoint.time:type_name -> google.protobuf.Timestamp
+	2,   // 14: squzy.v1.storage.GetSchedulerUptimeSeriesResponse.resolution:type_name -> squzy.v1.storage.SeriesResolution
+	15,  // 15: squzy.v1.storage.GetSchedulerUptimeSeriesResponse.points:type_name -> squzy.v1.storage.UptimeSeriesPoint
+	39,  // 16: squzy.v1.storage.GetSchedulerOutagesRequest.time_range:type_name -> squzy.v1.storage.TimeFilter
+	56,  // 17: squzy.v1.storage.SchedulerOutage.start_time:type_name -> google.protobuf.Timestamp
+	56,  // 18: squzy.v1.storage.SchedulerOutage.end_time:type_name -> google.protobuf.Timestamp
+	18,  // 19: squzy.v1.storage.GetSchedulerOutagesResponse.outages:type_name -> squzy.v1.storage.SchedulerOutage
+	39,  // 20: squzy.v1.storage.GetAgentSeriesRequest.time_range:type_name -> squzy.v1.storage.TimeFilter
+	56,  // 21: squzy.v1.storage.AgentSeriesPoint.time:type_name -> google.protobuf.Timestamp
+	21,  // 22: squzy.v1.storage.AgentSeriesPoint.cpus:type_name -> squzy.v1.storage.AgentSeriesValue
+	21,  // 23: squzy.v1.storage.AgentSeriesPoint.memory_used_percent:type_name -> squzy.v1.storage.AgentSeriesValue
+	21,  // 24: squzy.v1.storage.AgentSeriesPoint.swap_used_percent:type_name -> squzy.v1.storage.AgentSeriesValue
+	49,  // 25: squzy.v1.storage.AgentSeriesPoint.disks:type_name -> squzy.v1.storage.AgentSeriesPoint.DisksEntry
+	50,  // 26: squzy.v1.storage.AgentSeriesPoint.interfaces:type_name -> squzy.v1.storage.AgentSeriesPoint.InterfacesEntry
+	23,  // 27: squzy.v1.storage.GetAgentSeriesResponse.points:type_name -> squzy.v1.storage.AgentSeriesPoint
+	57,  // 28: squzy.v1.storage.GetTransactionByIdResponse.transaction:type_name -> squzy.v1.monitoring.TransactionInfo
+	57,  // 29: squzy.v1.storage.GetTransactionByIdResponse.children:type_name -> squzy.v1.monitoring.TransactionInfo
+	40,  // 30: squzy.v1.storage.GetTransactionByNameRequest.pagination:type_name -> squzy.v1.storage.Pagination
+	39,  // 31: squzy.v1.storage.GetTransactionByNameRequest.time_range:type_name -> squzy.v1.storage.TimeFilter
+	57,  // 32: squzy.v1.storage.GetTransactionByNameResponse.transactions:type_name -> squzy.v1.monitoring.TransactionInfo
+	57,  // 33: squzy.v1.storage.TransactionTreeNode.transaction:type_name -> squzy.v1.monitoring.TransactionInfo
+	29,  // 34: squzy.v1.storage.TransactionTreeNode.children:type_name -> squzy.v1.storage.TransactionTreeNode
+	29,  // 35: squzy.v1.storage.GetTransactionTreeResponse.root:type_name -> squzy.v1.storage.TransactionTreeNode
+	57,  // 36: squzy.v1.storage.GetTransactionsResponse.transactions:type_name -> squzy.v1.monitoring.TransactionInfo
+	41,  // 37: squzy.v1.storage.GetTransactionsResponse.cursors:type_name -> squzy.v1.storage.PageCursors
+	40,  // 38: squzy.v1.storage.GetTransactionsRequest.pagination:type_name -> squzy.v1.storage.Pagination
+	39,  // 39: squzy.v1.storage.GetTransactionsRequest.time_range:type_name -> squzy.v1.storage.TimeFilter
+	58,  // 40: squzy.v1.storage.GetTransactionsRequest.type:type_name -> squzy.v1.monitoring.TransactionType
+	59,  // 41: squzy.v1.storage.GetTransactionsRequest.status:type_name -> squzy.v1.monitoring.TransactionStatus
+	54,  // 42: squzy.v1.storage.GetTransactionsRequest.host:type_name -> google.protobuf.StringValue
+	54,  // 43: squzy.v1.storage.GetTransactionsRequest.name:type_name -> google.protobuf.StringValue
+	54,  // 44: squzy.v1.storage.GetTransactionsRequest.path:type_name -> google.protobuf.StringValue
+	54,  // 45: squzy.v1.storage.GetTransactionsRequest.method:type_name -> google.protobuf.StringValue
+	33,  // 46: squzy.v1.storage.GetTransactionsRequest.sort:type_name -> squzy.v1.storage.SortingTransactionList
+	1,   // 47: squzy.v1.storage.SortingTransactionList.sort_by:type_name -> squzy.v1.storage.SortTransactionList
+	3,   // 48: squzy.v1.storage.SortingTransactionList.direction:type_name -> squzy.v1.storage.SortDirection
+	39,  // 49: squzy.v1.storage.GetTransactionGroupRequest.time_range:type_name -> squzy.v1.storage.TimeFilter
+	5,   // 50: squzy.v1.storage.GetTransactionGroupRequest.group_type:type_name -> squzy.v1.storage.GroupTransaction
+	58,  // 51: squzy.v1.storage.GetTransactionGroupRequest.type:type_name -> squzy.v1.monitoring.TransactionType
+	59,  // 52: squzy.v1.storage.GetTransactionGroupRequest.status:type_name -> squzy.v1.monitoring.TransactionStatus
+	51,  // 53: squzy.v1.storage.GetTransactionGroupResponse.transactions:type_name -> squzy.v1.storage.GetTransactionGroupResponse.TransactionsEntry
+	60,  // 54: squzy.v1.storage.SchedulerResponse.snapshot:type_name -> squzy.v1.monitoring.SchedulerSnapshot
+	37,  // 55: squzy.v1.storage.SchedulerResponseBatch.responses:type_name -> squzy.v1.storage.SchedulerResponse
+	56,  // 56: squzy.v1.storage.TimeFilter.from:type_name -> google.protobuf.Timestamp
+	56,  // 57: squzy.v1.storage.TimeFilter.to:type_name -> google.protobuf.Timestamp
+	4,   // 58: squzy.v1.storage.SortingSchedulerList.sort_by:type_name -> squzy.v1.storage.SortSchedulerList
+	3,   // 59: squzy.v1.storage.SortingSchedulerList.direction:type_name -> squzy.v1.storage.SortDirection
+	40,  // 60: squzy.v1.storage.GetSchedulerInformationRequest.pagination:type_name -> squzy.v1.storage.Pagination
+	39,  // 61: squzy.v1.storage.GetSchedulerInformationRequest.time_range:type_name -> squzy.v1.storage.TimeFilter
+	42,  // 62: squzy.v1.storage.GetSchedulerInformationRequest.sort:type_name -> squzy.v1.storage.SortingSchedulerList
+	61,  // 63: squzy.v1.storage.GetSchedulerInformationRequest.status:type_name -> squzy.v1.monitoring.SchedulerCode
+	60,  // 64: squzy.v1.storage.GetSchedulerInformationResponse.snapshots:type_name -> squzy.v1.monitoring.SchedulerSnapshot
+	41,  // 65: squzy.v1.storage.GetSchedulerInformationResponse.cursors:type_name -> squzy.v1.storage.PageCursors
+	6,   // 66: squzy.v1.storage.GetAgentInformationRequest.type:type_name -> squzy.v1.storage.TypeAgentStat
+	40,  // 67: squzy.v1.storage.GetAgentInformationRequest.pagination:type_name -> squzy.v1.storage.Pagination
+	39,  // 68: squzy.v1.storage.GetAgentInformationRequest.time_range:type_name -> squzy.v1.storage.TimeFilter
+	52,  // 69: squzy.v1.storage.GetAgentInformationResponse.stats:type_name -> squzy.v1.storage.GetAgentInformationResponse.Statistic
+	41,  // 70: squzy.v1.storage.GetAgentInformationResponse.cursors:type_name -> squzy.v1.storage.PageCursors
+	7,   // 71: squzy.v1.storage.SubscribeRequest.types:type_name -> squzy.v1.storage.StreamEventType
+	7,   // 72: squzy.v1.storage.StreamEvent.type:type_name -> squzy.v1.storage.StreamEventType
+	56,  // 73: squzy.v1.storage.StreamEvent.time:type_name -> google.protobuf.Timestamp
+	37,  // 74: squzy.v1.storage.StreamEvent.snapshot:type_name -> squzy.v1.storage.SchedulerResponse
+	62,  // 75: squzy.v1.storage.StreamEvent.agent_metric:type_name -> squzy.v1.agent.Metric
+	57,  // 76: squzy.v1.storage.StreamEvent.transaction:type_name -> squzy.v1.monitoring.TransactionInfo
+	55,  // 77: squzy.v1.storage.StreamEvent.incident:type_name -> squzy.v1.incident.Incident
+	21,  // 78: squzy.v1.storage.AgentSeriesPoint.DisksEntry.value:type_name -> squzy.v1.storage.AgentSeriesValue
+	22,  // 79: squzy.v1.storage.AgentSeriesPoint.InterfacesEntry.value:type_name -> squzy.v1.storage.AgentSeriesNetRate
+	35,  // 80: squzy.v1.storage.GetTransactionGroupResponse.TransactionsEntry.value:type_name -> squzy.v1.storage.TransactionGroup
+	56,  // 81: squzy.v1.storage.GetAgentInformationResponse.Statistic.time:type_name -> google.protobuf.Timestamp
+	63,  // 82: squzy.v1.storage.GetAgentInformationResponse.Statistic.cpu_info:type_name -> squzy.v1.agent.CpuInfo
+	64,  // 83: squzy.v1.storage.GetAgentInformationResponse.Statistic.memory_info:type_name -> squzy.v1.agent.MemoryInfo
+	65,  // 84: squzy.v1.storage.GetAgentInformationResponse.Statistic.disk_info:type_name -> squzy.v1.agent.DiskInfo
+	66,  // 85: squzy.v1.storage.GetAgentInformationResponse.Statistic.net_info:type_name -> squzy.v1.agent.NetInfo
+	37,  // 86: squzy.v1.storage.Storage.SaveResponseFromScheduler:input_type -> squzy.v1.storage.SchedulerResponse
+	38,  // 87: squzy.v1.storage.Storage.SaveResponsesFromScheduler:input_type -> squzy.v1.storage.SchedulerResponseBatch
+	62,  // 88: squzy.v1.storage.Storage.SaveResponseFromAgent:input_type -> squzy.v1.agent.Metric
+	57,  // 89: squzy.v1.storage.Storage.SaveTransaction:input_type -> squzy.v1.monitoring.TransactionInfo
+	43,  // 90: squzy.v1.storage.Storage.GetSchedulerInformation:input_type -> squzy.v1.storage.GetSchedulerInformationRequest
+	12,  // 91: squzy.v1.storage.Storage.GetSchedulerUptime:input_type -> squzy.v1.storage.GetSchedulerUptimeRequest
+	14,  // 92: squzy.v1.storage.Storage.GetSchedulerUptimeSeries:input_type -> squzy.v1.storage.GetSchedulerUptimeSeriesRequest
+	17,  // 93: squzy.v1.storage.Storage.GetSchedulerOutages:input_type -> squzy.v1.storage.GetSchedulerOutagesRequest
+	45,  // 94: squzy.v1.storage.Storage.GetAgentInformation:input_type -> squzy.v1.storage.GetAgentInformationRequest
+	20,  // 95: squzy.v1.storage.Storage.GetAgentSeries:input_type -> squzy.v1.storage.GetAgentSeriesRequest
+	34,  // 96: squzy.v1.storage.Storage.GetTransactionsGroup:input_type -> squzy.v1.storage.GetTransactionGroupRequest
+	32,  // 97: squzy.v1.storage.Storage.GetTransactions:input_type -> squzy.v1.storage.GetTransactionsRequest
+	28,  // 98: squzy.v1.storage.Storage.GetTransactionById:input_type -> squzy.v1.storage.GetTransactionByIdRequest
+	28,  // 99: squzy.v1.storage.Storage.GetTransactionTree:input_type -> squzy.v1.storage.GetTransactionByIdRequest
+	55,  // 100: squzy.v1.storage.Storage.SaveIncident:input_type -> squzy.v1.incident.Incident
+	8,   // 101: squzy.v1.storage.Storage.UpdateIncidentStatus:input_type -> squzy.v1.storage.UpdateIncidentStatusRequest
+	67,  // 102: squzy.v1.storage.Storage.GetIncidentById:input_type -> squzy.v1.incident.IncidentIdRequest
+	68,  // 103: squzy.v1.storage.Storage.GetIncidentByRuleId:input_type -> squzy.v1.incident.RuleIdRequest
+	10,  // 104: squzy.v1.storage.Storage.GetIncidentsList:input_type -> squzy.v1.storage.GetIncidentsListRequest
+	47,  // 105: squzy.v1.storage.Storage.Subscribe:input_type -> squzy.v1.storage.SubscribeRequest
+	69,  // 106: squzy.v1.storage.Storage.SaveResponseFromScheduler:output_type -> google.protobuf.Empty
+	69,  // 107: squzy.v1.storage.Storage.SaveResponsesFromScheduler:output_type -> google.protobuf.Empty
+	69,  // 108: squzy.v1.storage.Storage.SaveResponseFromAgent:output_type -> google.protobuf.Empty
+	69,  // 109: squzy.v1.storage.Storage.SaveTransaction:output_type -> google.protobuf.Empty
+	44,  // 110: squzy.v1.storage.Storage.GetSchedulerInformation:output_type -> squzy.v1.storage.GetSchedulerInformationResponse
+	13,  // 111: squzy.v1.storage.Storage.GetSchedulerUptime:output_type -> squzy.v1.storage.GetSchedulerUptimeResponse
+	16,  // 112: squzy.v1.storage.Storage.GetSchedulerUptimeSeries:output_type -> squzy.v1.storage.GetSchedulerUptimeSeriesResponse
+	19,  // 113: squzy.v1.storage.Storage.GetSchedulerOutages:output_type -> squzy.v1.storage.GetSchedulerOutagesResponse
+	46,  // 114: squzy.v1.storage.Storage.GetAgentInformation:output_type -> squzy.v1.storage.GetAgentInformationResponse
+	24,  // 115: squzy.v1.storage.Storage.GetAgentSeries:output_type -> squzy.v1.storage.GetAgentSeriesResponse
+	36,  // 116: squzy.v1.storage.Storage.GetTransactionsGroup:output_type -> squzy.v1.storage.GetTransactionGroupResponse
+	31,  // 117: squzy.v1.storage.Storage.GetTransactions:output_type -> squzy.v1.storage.GetTransactionsResponse
+	25,  // 118: squzy.v1.storage.Storage.GetTransactionById:output_type -> squzy.v1.storage.GetTransactionByIdResponse
+	30,  // 119: squzy.v1.storage.Storage.GetTransactionTree:output_type -> squzy.v1.storage.GetTransactionTreeResponse
+	69,  // 120: squzy.v1.storage.Storage.SaveIncident:output_type -> google.protobuf.Empty
+	55,  // 121: squzy.v1.storage.Storage.UpdateIncidentStatus:output_type -> squzy.v1.incident.Incident
+	55,  // 122: squzy.v1.storage.Storage.GetIncidentById:output_type -> squzy.v1.incident.Incident
+	55,  // 123: squzy.v1.storage.Storage.GetIncidentByRuleId:output_type -> squzy.v1.incident.Incident
+	11,  // 124: squzy.v1.storage.Storage.GetIncidentsList:output_type -> squzy.v1.storage.GetIncidentsListResponse
+	48,  // 125: squzy.v1.storage.Storage.Subscribe:output_type -> squzy.v1.storage.StreamEvent
+	106, // [106:126] is the sub-list for method output_type
+	86,  // [86:106] is the sub-list for method input_type
+	86,  // [86:86] is the sub-list for extension type_name
+	86,  // [86:86] is the sub-list for extension extendee
+	0,   // [0:86] is the sub-list for field type_name
+}
+
+func init() { file_proto_v1_squzy_storage_proto_init() }
+func file_proto_v1_squzy_storage_proto_init() {
+	if File_proto_v1_squzy_storage_proto != nil {
+		return
+	}
+	file_proto_v1_squzy_agent_server_proto_init()
+	file_proto_v1_squzy_application_monitoring_proto_init()
+	file_proto_v1_squzy_incident_server_proto_init()
+	file_proto_v1_squzy_monitoring_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_proto_v1_squzy_storage_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateIncidentStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SortingIncidentList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetIncidentsListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetIncidentsListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSchedulerUptimeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSchedulerUptimeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSchedulerUptimeSeriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UptimeSeriesPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSchedulerUptimeSeriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSchedulerOutagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SchedulerOutage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSchedulerOutagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAgentSeriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentSeriesValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentSeriesNetRate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentSeriesPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAgentSeriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionByIdResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionByNameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionByNameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionByIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionTreeNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionTreeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SortingTransactionList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionGroupResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SchedulerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SchedulerResponseBatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pagination); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PageCursors); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SortingSchedulerList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSchedulerInformationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSchedulerInformationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAgentInformationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAgentInformationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_squzy_storage_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAgentInformationResponse_Statistic); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_proto_v1_squzy_storage_proto_msgTypes[40].OneofWrappers = []interface{}{
+		(*StreamEvent_Snapshot)(nil),
+		(*StreamEvent_AgentMetric)(nil),
+		(*StreamEvent_Transaction)(nil),
+		(*StreamEvent_Incident)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_v1_squzy_storage_proto_rawDesc,
+			NumEnums:      8,
+			NumMessages:   45,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_v1_squzy_storage_proto_goTypes,
+		DependencyIndexes: file_proto_v1_squzy_storage_proto_depIdxs,
+		EnumInfos:         file_proto_v1_squzy_storage_proto_enumTypes,
+		MessageInfos:      file_proto_v1_squzy_storage_proto_msgTypes,
+	}.Build()
+	File_proto_v1_squzy_storage_proto = out.File
+	file_proto_v1_squzy_storage_proto_rawDesc = nil
+	file_proto_v1_squzy_storage_proto_goTypes = nil
+	file_proto_v1_squzy_storage_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// StorageClient is the client API for Storage service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type StorageClient interface {
+	SaveResponseFromScheduler(ctx context.Context, in *SchedulerResponse, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// All responses of the batch are saved in one transaction
+	SaveResponsesFromScheduler(ctx context.Context, in *SchedulerResponseBatch, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SaveResponseFromAgent(ctx context.Context, in *Metric, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SaveTransaction(ctx context.Context, in *TransactionInfo, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetSchedulerInformation(ctx context.Context, in *GetSchedulerInformationRequest, opts ...grpc.CallOption) (*GetSchedulerInformationResponse, error)
+	GetSchedulerUptime(ctx context.Context, in *GetSchedulerUptimeRequest, opts ...grpc.CallOption) (*GetSchedulerUptimeResponse, error)
+	GetSchedulerUptimeSeries(ctx context.Context, in *GetSchedulerUptimeSeriesRequest, opts ...grpc.CallOption) (*GetSchedulerUptimeSeriesResponse, error)
+	GetSchedulerOutages(ctx context.Context, in *GetSchedulerOutagesRequest, opts ...grpc.CallOption) (*GetSchedulerOutagesResponse, error)
+	GetAgentInformation(ctx context.Context, in *GetAgentInformationRequest, opts ...grpc.CallOption) (*GetAgentInformationResponse, error)
+	GetAgentSeries(ctx context.Context, in *GetAgentSeriesRequest, opts ...grpc.CallOption) (*GetAgentSeriesResponse, error)
+	GetTransactionsGroup(ctx context.Context, in *GetTransactionGroupRequest, opts ...grpc.CallOption) (*GetTransactionGroupResponse, error)
+	GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*GetTransactionsResponse, error)
+	GetTransactionById(ctx context.Context, in *GetTransactionByIdRequest, opts ...grpc.CallOption) (*GetTransactionByIdResponse, error)
+	GetTransactionTree(ctx context.Context, in *GetTransactionByIdRequest, opts ...grpc.CallOption) (*GetTransactionTreeResponse, error)
+	SaveIncident(ctx context.Context, in *Incident, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UpdateIncidentStatus(ctx context.Context, in *UpdateIncidentStatusRequest, opts ...grpc.CallOption) (*Incident, error)
+	GetIncidentById(ctx context.Context, in *IncidentIdRequest, opts ...grpc.CallOption) (*Incident, error)
+	GetIncidentByRuleId(ctx context.Context, in *RuleIdRequest, opts ...grpc.CallOption) (*Incident, error)
+	GetIncidentsList(ctx context.Context, in *GetIncidentsListRequest, opts ...grpc.CallOption) (*GetIncidentsListResponse, error)
+	// Streams new snapshots, agent metrics, transactions and incident changes as soon as they are saved
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Storage_SubscribeClient, error)
+}
+
+type storageClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStorageClient(cc grpc.ClientConnInterface) StorageClient {
+	return &storageClient{cc}
+}
+
+func (c *storageClient) SaveResponseFromScheduler(ctx context.Context, in *SchedulerResponse, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/squzy.v1.storage.Storage/SaveResponseFromScheduler", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageClient) SaveResponsesFromScheduler(ctx context.Context, in *SchedulerResponseBatch, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/squzy.v1.storage.Storage/SaveResponsesFromScheduler", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageClient) SaveResponseFromAgent(ctx context.Context, in *Metric, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/squzy.v1.storage.Storage/SaveResponseFromAgent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageClient) SaveTransaction(ctx context.Context, in *TransactionInfo, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/squzy.v1.storage.Storage/SaveTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageClient) GetSchedulerInformation(ctx context.Context, in *GetSchedulerInformationRequest, opts ...grpc.CallOption) (*GetSchedulerInformationResponse, error) {
+	out := new(GetSchedulerInformationResponse)
+	err := c.cc.Invoke(ctx, "/squzy.v1.storage.Storage/GetSchedulerInformation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageClient) GetSchedulerUptime(ctx context.Context, in *GetSchedulerUptimeRequest, opts ...grpc.CallOption) (*GetSchedulerUptimeResponse, error) {
+	out := new(GetSchedulerUptimeResponse)
+	err := c.cc.Invoke(ctx, "/squzy.v1.storage.Storage/GetSchedulerUptime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageClient) GetSchedulerUptimeSeries(ctx context.Context, in *GetSchedulerUptimeSeriesRequest, opts ...grpc.CallOption) (*GetSchedulerUptimeSeriesResponse, error) {
+	out := new(GetSchedulerUptimeSeriesResponse)
+	err := c.cc.Invoke(ctx, "/squzy.v1.storage.Storage/GetSchedulerUptimeSeries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageClient) GetSchedulerOutages(ctx context.Context, in *GetSchedulerOutagesRequest, opts ...grpc.CallOption) (*GetSchedulerOutagesResponse, error) {
+	out := new(GetSchedulerOutagesResponse)
+	err := c.cc.Invoke(ctx, "/squzy.v1.storage.Storage/GetSchedulerOutages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageClient) GetAgentInformation(ctx context.Context, in *GetAgentInformationRequest, opts ...grpc.CallOption) (*GetAgentInformationResponse, error) {
+	out := new(GetAgentInformationResponse)
+	err := c.cc.Invoke(ctx, "/squzy.v1.storage.Storage/GetAgentInformation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageClient) GetAgentSeries(ctx context.Context, in *GetAgentSeriesRequest, opts ...grpc.CallOption) (*GetAgentSeriesResponse, error) {
+	out := new(GetAgentSeriesResponse)
+	err := c.cc.Invoke(ctx, "/squzy.v1.storage.Storage/GetAgentSeries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageClient) GetTransactionsGroup(ctx context.Context, in *GetTransactionGroupRequest, opts ...grpc.CallOption) (*GetTransactionGroupResponse, error) {
+	out := new(GetTransactionGroupResponse)
+	err := c.cc.Invoke(ctx, "/squzy.v1.storage.Storage/GetTransactionsGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageClient) GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*GetTransactionsResponse, error) {
+	out := new(GetTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/squzy.v1.storage.Storage/GetTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageClient) GetTransactionById(ctx context.Context, in *GetTransactionByIdRequest, opts ...grpc.CallOption) (*GetTransactionByIdResponse, error) {
+	out := new(GetTransactionByIdResponse)
+	err := c.cc.Invoke(ctx, "/squzy.v1.storage.Storage/GetTransactionById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageClient) GetTransactionTree(ctx context.Context, in *GetTransactionByIdRequest, opts ...grpc.CallOption) (*GetTransactionTreeResponse, error) {
+	out := new(GetTransactionTreeResponse)
+	err := c.cc.Invoke(ctx, "/squzy.v1.storage.Storage/GetTransactionTree", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageClient) SaveIncident(ctx context.Context, in *Incident, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/squzy.v1.storage.Storage/SaveIncident", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageClient) UpdateIncidentStatus(ctx context.Context, in *UpdateIncidentStatusRequest, opts ...grpc.CallOption) (*Incident, error) {
+	out := new(Incident)
+	err := c.cc.Invoke(ctx, "/squzy.v1.storage.Storage/UpdateIncidentStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageClient) GetIncidentById(ctx context.Context, in *IncidentIdRequest, opts ...grpc.CallOption) (*Incident, error) {
+	out := new(Incident)
+	err := c.cc.Invoke(ctx, "/squzy.v1.storage.Storage/GetIncidentById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageClient) GetIncidentByRuleId(ctx context.Context, in *RuleIdRequest, opts ...grpc.CallOption) (*Incident, error) {
+	out := new(Incident)
+	err := c.cc.Invoke(ctx, "/squzy.v1.storage.Storage/GetIncidentByRuleId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageClient) GetIncidentsList(ctx context.Context, in *GetIncidentsListRequest, opts ...grpc.CallOption) (*GetIncidentsListResponse, error) {
+	out := new(GetIncidentsListResponse)
+	err := c.cc.Invoke(ctx, "/squzy.v1.storage.Storage/GetIncidentsList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Storage_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Storage_serviceDesc.Streams[0], "/squzy.v1.storage.Storage/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &storageSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Storage_SubscribeClient interface {
+	Recv() (*StreamEvent, error)
+	grpc.ClientStream
+}
+
+type storageSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *storageSubscribeClient) Recv() (*StreamEvent, error) {
+	m := new(StreamEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StorageServer is the server API for Storage service.
+type StorageServer interface {
+	SaveResponseFromScheduler(context.Context, *SchedulerResponse) (*emptypb.Empty, error)
+	// All responses of the batch are saved in one transaction
+	SaveResponsesFromScheduler(context.Context, *SchedulerResponseBatch) (*emptypb.Empty, error)
+	SaveResponseFromAgent(context.Context, *Metric) (*emptypb.Empty, error)
+	SaveTransaction(context.Context, *TransactionInfo) (*emptypb.Empty, error)
+	GetSchedulerInformation(context.Context, *GetSchedulerInformationRequest) (*GetSchedulerInformationResponse, error)
+	GetSchedulerUptime(context.Context, *GetSchedulerUptimeRequest) (*GetSchedulerUptimeResponse, error)
+	GetSchedulerUptimeSeries(context.Context, *GetSchedulerUptimeSeriesRequest) (*GetSchedulerUptimeSeriesResponse, error)
+	GetSchedulerOutages(context.Context, *GetSchedulerOutagesRequest) (*GetSchedulerOutagesResponse, error)
+	GetAgentInformation(context.Context, *GetAgentInformationRequest) (*GetAgentInformationResponse, error)
+	GetAgentSeries(context.Context, *GetAgentSeriesRequest) (*GetAgentSeriesResponse, error)
+	GetTransactionsGroup(context.Context, *GetTransactionGroupRequest) (*GetTransactionGroupResponse, error)
+	GetTransactions(context.Context, *GetTransactionsRequest) (*GetTransactionsResponse, error)
+	GetTransactionById(context.Context, *GetTransactionByIdRequest) (*GetTransactionByIdResponse, error)
+	GetTransactionTree(context.Context, *GetTransactionByIdRequest) (*GetTransactionTreeResponse, error)
+	SaveIncident(context.Context, *Incident) (*emptypb.Empty, error)
+	UpdateIncidentStatus(context.Context, *UpdateIncidentStatusRequest) (*Incident, error)
+	GetIncidentById(context.Context, *IncidentIdRequest) (*Incident, error)
+	GetIncidentByRuleId(context.Context, *RuleIdRequest) (*Incident, error)
+	GetIncidentsList(context.Context, *GetIncidentsListRequest) (*GetIncidentsListResponse, error)
+	// Streams new snapshots, agent metrics, transactions and incident changes as soon as they are saved
+	Subscribe(*SubscribeRequest, Storage_SubscribeServer) error
+}
+
+// UnimplementedStorageServer can be embedded to have forward compatible implementations.
+type UnimplementedStorageServer struct {
+}
+
+func (*UnimplementedStorageServer) SaveResponseFromScheduler(context.Context, *SchedulerResponse) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveResponseFromScheduler not implemented")
+}
+func (*UnimplementedStorageServer) SaveResponsesFromScheduler(context.Context, *SchedulerResponseBatch) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveResponsesFromScheduler not implemented")
+}
+func (*UnimplementedStorageServer) SaveResponseFromAgent(context.Context, *Metric) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveResponseFromAgent not implemented")
+}
+func (*UnimplementedStorageServer) SaveTransaction(context.Context, *TransactionInfo) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveTransaction not implemented")
+}
+func (*UnimplementedStorageServer) GetSchedulerInformation(context.Context, *GetSchedulerInformationRequest) (*GetSchedulerInformationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchedulerInformation not implemented")
+}
+func (*UnimplementedStorageServer) GetSchedulerUptime(context.Context, *GetSchedulerUptimeRequest) (*GetSchedulerUptimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchedulerUptime not implemented")
+}
+func (*UnimplementedStorageServer) GetSchedulerUptimeSeries(context.Context, *GetSchedulerUptimeSeriesRequest) (*GetSchedulerUptimeSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchedulerUptimeSeries not implemented")
+}
+func (*UnimplementedStorageServer) GetSchedulerOutages(context.Context, *GetSchedulerOutagesRequest) (*GetSchedulerOutagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchedulerOutages not implemented")
+}
+func (*UnimplementedStorageServer) GetAgentInformation(context.Context, *GetAgentInformationRequest) (*GetAgentInformationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAgentInformation not implemented")
+}
+func (*UnimplementedStorageServer) GetAgentSeries(context.Context, *GetAgentSeriesRequest) (*GetAgentSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAgentSeries not implemented")
+}
+func (*UnimplementedStorageServer) GetTransactionsGroup(context.Context, *GetTransactionGroupRequest) (*GetTransactionGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionsGroup not implemented")
+}
+func (*UnimplementedStorageServer) GetTransactions(context.Context, *GetTransactionsRequest) (*GetTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactions not implemented")
+}
+func (*UnimplementedStorageServer) GetTransactionById(context.Context, *GetTransactionByIdRequest) (*GetTransactionByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionById not implemented")
+}
+func (*UnimplementedStorageServer) GetTransactionTree(context.Context, *GetTransactionByIdRequest) (*GetTransactionTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionTree not implemented")
+}
+func (*UnimplementedStorageServer) SaveIncident(context.Context, *Incident) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveIncident not implemented")
+}
+func (*UnimplementedStorageServer) UpdateIncidentStatus(context.Context, *UpdateIncidentStatusRequest) (*Incident, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateIncidentStatus not implemented")
+}
+func (*UnimplementedStorageServer) GetIncidentById(context.Context, *IncidentIdRequest) (*Incident, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIncidentById not implemented")
+}
+func (*UnimplementedStorageServer) GetIncidentByRuleId(context.Context, *RuleIdRequest) (*Incident, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIncidentByRuleId not implemented")
+}
+func (*UnimplementedStorageServer) GetIncidentsList(context.Context, *GetIncidentsListRequest) (*GetIncidentsListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIncidentsList not implemented")
+}
+func (*UnimplementedStorageServer) Subscribe(*SubscribeRequest, Storage_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+
+func RegisterStorageServer(s *grpc.Server, srv StorageServer) {
+	s.RegisterService(&_Storage_serviceDesc, srv)
+}
+
+func _Storage_SaveResponseFromScheduler_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulerResponse)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServer).SaveResponseFromScheduler(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.storage.Storage/SaveResponseFromScheduler",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServer).SaveResponseFromScheduler(ctx, req.(*SchedulerResponse))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Storage_SaveResponsesFromScheduler_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulerResponseBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServer).SaveResponsesFromScheduler(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.storage.Storage/SaveResponsesFromScheduler",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServer).SaveResponsesFromScheduler(ctx, req.(*SchedulerResponseBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Storage_SaveResponseFromAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Metric)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServer).SaveResponseFromAgent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.storage.Storage/SaveResponseFromAgent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServer).SaveResponseFromAgent(ctx, req.(*Metric))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Storage_SaveTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServer).SaveTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.storage.Storage/SaveTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServer).SaveTransaction(ctx, req.(*TransactionInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Storage_GetSchedulerInformation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSchedulerInformationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServer).GetSchedulerInformation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.storage.Storage/GetSchedulerInformation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServer).GetSchedulerInformation(ctx, req.(*GetSchedulerInformationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Storage_GetSchedulerUptime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSchedulerUptimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServer).GetSchedulerUptime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.storage.Storage/GetSchedulerUptime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServer).GetSchedulerUptime(ctx, req.(*GetSchedulerUptimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Storage_GetSchedulerUptimeSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSchedulerUptimeSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServer).GetSchedulerUptimeSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.storage.Storage/GetSchedulerUptimeSeries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServer).GetSchedulerUptimeSeries(ctx, req.(*GetSchedulerUptimeSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Storage_GetSchedulerOutages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSchedulerOutagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServer).GetSchedulerOutages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.storage.Storage/GetSchedulerOutages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServer).GetSchedulerOutages(ctx, req.(*GetSchedulerOutagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Storage_GetAgentInformation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAgentInformationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServer).GetAgentInformation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.storage.Storage/GetAgentInformation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServer).GetAgentInformation(ctx, req.(*GetAgentInformationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Storage_GetAgentSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAgentSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServer).GetAgentSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.storage.Storage/GetAgentSeries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServer).GetAgentSeries(ctx, req.(*GetAgentSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Storage_GetTransactionsGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServer).GetTransactionsGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.storage.Storage/GetTransactionsGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServer).GetTransactionsGroup(ctx, req.(*GetTransactionGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Storage_GetTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServer).GetTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.storage.Storage/GetTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServer).GetTransactions(ctx, req.(*GetTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Storage_GetTransactionById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServer).GetTransactionById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.storage.Storage/GetTransactionById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServer).GetTransactionById(ctx, req.(*GetTransactionByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Storage_GetTransactionTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServer).GetTransactionTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.storage.Storage/GetTransactionTree",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServer).GetTransactionTree(ctx, req.(*GetTransactionByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Storage_SaveIncident_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Incident)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServer).SaveIncident(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.storage.Storage/SaveIncident",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServer).SaveIncident(ctx, req.(*Incident))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Storage_UpdateIncidentStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateIncidentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServer).UpdateIncidentStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.storage.Storage/UpdateIncidentStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServer).UpdateIncidentStatus(ctx, req.(*UpdateIncidentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Storage_GetIncidentById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncidentIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServer).GetIncidentById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.storage.Storage/GetIncidentById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServer).GetIncidentById(ctx, req.(*IncidentIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Storage_GetIncidentByRuleId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RuleIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServer).GetIncidentByRuleId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.storage.Storage/GetIncidentByRuleId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServer).GetIncidentByRuleId(ctx, req.(*RuleIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Storage_GetIncidentsList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIncidentsListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServer).GetIncidentsList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squzy.v1.storage.Storage/GetIncidentsList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServer).GetIncidentsList(ctx, req.(*GetIncidentsListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Storage_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StorageServer).Subscribe(m, &storageSubscribeServer{stream})
+}
+
+type Storage_SubscribeServer interface {
+	Send(*StreamEvent) error
+	grpc.ServerStream
+}
+
+type storageSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *storageSubscribeServer) Send(m *StreamEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Storage_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "squzy.v1.storage.Storage",
+	HandlerType: (*StorageServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SaveResponseFromScheduler",
+			Handler:    _Storage_SaveResponseFromScheduler_Handler,
+		},
+		{
+			MethodName: "SaveResponsesFromScheduler",
+			Handler:    _Storage_SaveResponsesFromScheduler_Handler,
+		},
+		{
+			MethodName: "SaveResponseFromAgent",
+			Handler:    _Storage_SaveResponseFromAgent_Handler,
+		},
+		{
+			MethodName: "SaveTransaction",
+			Handler:    _Storage_SaveTransaction_Handler,
+		},
+		{
+			MethodName: "GetSchedulerInformation",
+			Handler:    _Storage_GetSchedulerInformation_Handler,
+		},
+		{
+			MethodName: "GetSchedulerUptime",
+			Handler:    _Storage_GetSchedulerUptime_Handler,
+		},
+		{
+			MethodName: "GetSchedulerUptimeSeries",
+			Handler:    _Storage_GetSchedulerUptimeSeries_Handler,
+		},
+		{
+			MethodName: "GetSchedulerOutages",
+			Handler:    _Storage_GetSchedulerOutages_Handler,
+		},
+		{
+			MethodName: "GetAgentInformation",
+			Handler:    _Storage_GetAgentInformation_Handler,
+		},
+		{
+			MethodName: "GetAgentSeries",
+			Handler:    _Storage_GetAgentSeries_Handler,
+		},
+		{
+			MethodName: "GetTransactionsGroup",
+			Handler:    _Storage_GetTransactionsGroup_Handler,
+		},
+		{
+			MethodName: "GetTransactions",
+			Handler:    _Storage_GetTransactions_Handler,
+		},
+		{
+			MethodName: "GetTransactionById",
+			Handler:    _Storage_GetTransactionById_Handler,
+		},
+		{
+			MethodName: "GetTransactionTree",
+			Handler:    _Storage_GetTransactionTree_Handler,
+		},
+		{
+			MethodName: "SaveIncident",
+			Handler:    _Storage_SaveIncident_Handler,
+		},
+		{
+			MethodName: "UpdateIncidentStatus",
+			Handler:    _Storage_UpdateIncidentStatus_Handler,
+		},
+		{
+			MethodName: "GetIncidentById",
+			Handler:    _Storage_GetIncidentById_Handler,
+		},
+		{
+			MethodName: "GetIncidentByRuleId",
+			Handler:    _Storage_GetIncidentByRuleId_Handler,
+		},
+		{
+			MethodName: "GetIncidentsList",
+			Handler:    _Storage_GetIncidentsList_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Storage_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/v1/squzy_storage.proto",
+}
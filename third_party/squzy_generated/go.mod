@@ -0,0 +1,8 @@
+module github.com/squzy/squzy_generated
+
+go 1.13
+
+require (
+	google.golang.org/grpc v1.43.0
+	google.golang.org/protobuf v1.27.1
+)